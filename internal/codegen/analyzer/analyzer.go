@@ -72,6 +72,7 @@ type AnalyzedProperty struct {
 	IsSummary      bool     // Whether this field is marked as isSummary in FHIR spec
 	TargetTypes    []string // For Reference/canonical types: allowed target resource type names
 	ContentRef     string   // For contentReference properties: the target FHIR path (e.g., "Questionnaire.item")
+	IsXHTML        bool     // Whether this is an xhtml element (raw XHTML: never HTML-escaped, injected verbatim in XML)
 }
 
 // AnalyzedBinding represents a value set binding.
@@ -400,7 +401,7 @@ func (a *Analyzer) analyzeChoiceType(elem *parser.ElementDefinition, baseName st
 			Description:    elem.Short,
 			IsPointer:      usePointer,
 			IsArray:        false,
-			IsRequired:     false,
+			IsRequired:     elem.IsRequired(), // the choice as a whole
 			IsPrimitive:    IsPrimitiveType(typeName),
 			IsChoice:       true,
 			ChoiceTypes:    choiceTypes,
@@ -574,6 +575,7 @@ func (a *Analyzer) createProperty(elem *parser.ElementDefinition, fieldName stri
 		FHIRType:     typeName,
 		HasExtension: isPrimitive,
 		IsSummary:    elem.IsSummary,
+		IsXHTML:      typeName == "xhtml",
 	}
 
 	if (typeRef.Code == "Reference" || typeRef.Code == "canonical") && len(typeRef.TargetProfile) > 0 {
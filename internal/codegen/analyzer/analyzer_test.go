@@ -207,6 +207,83 @@ func TestAnalyzer_Analyze(t *testing.T) {
 	})
 }
 
+func TestAnalyzer_XHTML(t *testing.T) {
+	sd, err := parser.ParseStructureDefinition([]byte(`{
+		"resourceType": "StructureDefinition",
+		"id": "Narrative",
+		"url": "http://hl7.org/fhir/StructureDefinition/Narrative",
+		"name": "Narrative",
+		"status": "active",
+		"kind": "complex-type",
+		"abstract": false,
+		"type": "Narrative",
+		"snapshot": {
+			"element": [
+				{"id": "Narrative", "path": "Narrative", "min": 0, "max": "*"},
+				{"id": "Narrative.status", "path": "Narrative.status", "min": 1, "max": "1", "type": [{"code": "code"}]},
+				{"id": "Narrative.div", "path": "Narrative.div", "min": 1, "max": "1", "type": [{"code": "xhtml"}]}
+			]
+		}
+	}`))
+	require.NoError(t, err)
+
+	result, err := NewAnalyzer([]*parser.StructureDefinition{sd}, nil).Analyze(sd)
+	require.NoError(t, err)
+
+	propMap := make(map[string]AnalyzedProperty)
+	for _, p := range result.Properties {
+		propMap[p.Name] = p
+	}
+
+	divProp, ok := propMap["Div"]
+	require.True(t, ok, "should have Div property")
+	assert.True(t, divProp.IsXHTML)
+	assert.Equal(t, "*string", divProp.GoType)
+
+	assert.False(t, propMap["Status"].IsXHTML)
+}
+
+func TestAnalyzer_RequiredChoice(t *testing.T) {
+	sd, err := parser.ParseStructureDefinition([]byte(`{
+		"resourceType": "StructureDefinition",
+		"id": "UsageContext",
+		"url": "http://hl7.org/fhir/StructureDefinition/UsageContext",
+		"name": "UsageContext",
+		"status": "active",
+		"kind": "complex-type",
+		"abstract": false,
+		"type": "UsageContext",
+		"snapshot": {
+			"element": [
+				{"id": "UsageContext", "path": "UsageContext", "min": 0, "max": "*"},
+				{"id": "UsageContext.code", "path": "UsageContext.code", "min": 1, "max": "1", "type": [{"code": "Coding"}]},
+				{"id": "UsageContext.value[x]", "path": "UsageContext.value[x]", "min": 1, "max": "1", "type": [
+					{"code": "CodeableConcept"},
+					{"code": "Quantity"}
+				]}
+			]
+		}
+	}`))
+	require.NoError(t, err)
+
+	result, err := NewAnalyzer([]*parser.StructureDefinition{sd}, nil).Analyze(sd)
+	require.NoError(t, err)
+
+	propMap := make(map[string]AnalyzedProperty)
+	for _, p := range result.Properties {
+		propMap[p.Name] = p
+	}
+
+	// Each variant carries the cardinality of the choice as a whole, but
+	// stays a pointer since only one of them is populated.
+	for _, name := range []string{"ValueCodeableConcept", "ValueQuantity"} {
+		prop, ok := propMap[name]
+		require.True(t, ok, "should have %s property", name)
+		assert.True(t, prop.IsRequired, name)
+		assert.True(t, prop.IsPointer, name)
+	}
+}
+
 func TestAnalyzer_NilInput(t *testing.T) {
 	analyzer := NewAnalyzer(nil, nil)
 	result, err := analyzer.Analyze(nil)
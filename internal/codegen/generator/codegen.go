@@ -2,9 +2,15 @@
 package generator
 
 import (
+	"errors"
 	"fmt"
+	"go/ast"
+	goparser "go/parser"
+	"go/token"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strconv"
 	"strings"
 	"unicode/utf8"
 
@@ -201,6 +207,11 @@ func (c *CodeGen) Generate() error {
 		return fmt.Errorf("failed to generate summary: %w", err)
 	}
 
+	// Generate element_order.go (spec element order per type)
+	if err := c.generateElementOrderFromTemplate(); err != nil {
+		return fmt.Errorf("failed to generate element order: %w", err)
+	}
+
 	// Generate fhirpath_model.go (runtime metadata for FHIRPath evaluation)
 	if err := c.generateFHIRPathModel(); err != nil {
 		return fmt.Errorf("failed to generate fhirpath model: %w", err)
@@ -221,9 +232,85 @@ func (c *CodeGen) Generate() error {
 		return fmt.Errorf("failed to generate XML helpers: %w", err)
 	}
 
+	// Reject output where a struct declares the same JSON name twice, which
+	// encoding/json would silently resolve by dropping both fields
+	if err := checkDuplicateJSONNames(c.config.OutputDir); err != nil {
+		return fmt.Errorf("generated code is invalid: %w", err)
+	}
+
+	return nil
+}
+
+// checkDuplicateJSONNames parses the generated Go files in dir and returns an
+// error naming every struct type that has two fields with the same json tag
+// name. Hand-written files in dir are skipped.
+func checkDuplicateJSONNames(dir string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+
+	fset := token.NewFileSet()
+	var problems []string
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := goparser.ParseFile(fset, path, nil, goparser.ParseComments)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		if !ast.IsGenerated(file) {
+			continue
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			spec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			st, ok := spec.Type.(*ast.StructType)
+			if !ok {
+				return true
+			}
+			seen := make(map[string]bool)
+			for _, field := range st.Fields.List {
+				name := jsonTagName(field.Tag)
+				if name == "" {
+					continue
+				}
+				if seen[name] {
+					problems = append(problems, fmt.Sprintf("%s: type %s has duplicate JSON name %q",
+						filepath.Base(path), spec.Name.Name, name))
+				}
+				seen[name] = true
+			}
+			return true
+		})
+	}
+
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
 	return nil
 }
 
+// jsonTagName returns the name part of a struct field's json tag, or "" if
+// the field has no json tag or is ignored ("-").
+func jsonTagName(tag *ast.BasicLit) string {
+	if tag == nil {
+		return ""
+	}
+	raw, err := strconv.Unquote(tag.Value)
+	if err != nil {
+		return ""
+	}
+	name, _, _ := strings.Cut(reflect.StructTag(raw).Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
 // sanitizeTypeName converts a ValueSet name to a valid Go type name.
 func sanitizeTypeName(name string) string {
 	// Remove/replace invalid characters
@@ -0,0 +1,172 @@
+package generator
+
+import (
+	"go/ast"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/internal/codegen/analyzer"
+	"github.com/gofhir/models/internal/codegen/parser"
+)
+
+func newTestCodeGen(t *testing.T, types ...*analyzer.AnalyzedType) *CodeGen {
+	t.Helper()
+	valueSets := parser.NewValueSetRegistry()
+	c := New(Config{OutputDir: t.TempDir(), PackageName: "r4", Version: "r4"})
+	c.types = types
+	c.analyzer = analyzer.NewAnalyzer(nil, valueSets)
+	c.valueSets = valueSets
+	return c
+}
+
+func basicResource(props ...analyzer.AnalyzedProperty) *analyzer.AnalyzedType {
+	return &analyzer.AnalyzedType{
+		Name:     "Basic",
+		FHIRName: "Basic",
+		Kind:     "resource",
+		Properties: append([]analyzer.AnalyzedProperty{{
+			Name:        "Id",
+			JSONName:    "id",
+			GoType:      "*string",
+			IsPointer:   true,
+			IsPrimitive: true,
+			FHIRType:    "id",
+		}}, props...),
+	}
+}
+
+func TestGenerateDuplicateJSONNames(t *testing.T) {
+	t.Run("unique names", func(t *testing.T) {
+		c := newTestCodeGen(t, basicResource(analyzer.AnalyzedProperty{
+			Name:        "Created",
+			JSONName:    "created",
+			GoType:      "*string",
+			IsPointer:   true,
+			IsPrimitive: true,
+			FHIRType:    "date",
+		}))
+
+		require.NoError(t, c.Generate())
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		c := newTestCodeGen(t, basicResource(analyzer.AnalyzedProperty{
+			Name:        "Identifier",
+			JSONName:    "id",
+			GoType:      "*string",
+			IsPointer:   true,
+			IsPrimitive: true,
+			FHIRType:    "string",
+		}))
+
+		err := c.Generate()
+		require.Error(t, err)
+		assert.ErrorContains(t, err, `type Basic has duplicate JSON name "id"`)
+	})
+}
+
+func TestJSONTagName(t *testing.T) {
+	tests := map[string]string{
+		"`json:\"id,omitempty\"`":     "id",
+		"`json:\"id\" xml:\"other\"`": "id",
+		"`json:\"-\"`":                "",
+		"`json:\",omitempty\"`":       "",
+		"`xml:\"value,attr\"`":        "",
+	}
+	for tag, want := range tests {
+		assert.Equal(t, want, jsonTagName(&ast.BasicLit{Value: tag}), tag)
+	}
+	assert.Equal(t, "", jsonTagName(nil))
+}
+
+func TestGenerateChoicePredicates(t *testing.T) {
+	choice := func(base, name, goType, fhirType string) analyzer.AnalyzedProperty {
+		return analyzer.AnalyzedProperty{
+			Name:           name,
+			JSONName:       toLowerFirstChar(name),
+			GoType:         goType,
+			IsPointer:      true,
+			IsPrimitive:    true,
+			IsChoice:       true,
+			ChoiceBaseName: base,
+			FHIRType:       fhirType,
+		}
+	}
+	basic := basicResource(
+		choice("deceased", "DeceasedBoolean", "*bool", "boolean"),
+		choice("deceased", "DeceasedDateTime", "*string", "dateTime"),
+	)
+	basic.BackboneTypes = []*analyzer.AnalyzedType{{
+		Name:     "BasicPart",
+		FHIRName: "Basic.part",
+		Kind:     "backbone",
+		Properties: []analyzer.AnalyzedProperty{
+			choice("value", "ValueString", "*string", "string"),
+			choice("value", "ValueInteger", "*int", "integer"),
+		},
+	}}
+	c := newTestCodeGen(t, basic)
+	require.NoError(t, c.Generate())
+
+	data, err := os.ReadFile(filepath.Join(c.config.OutputDir, "resource_basic.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "func (r *Basic) HasDeceased() bool {\n\treturn r.DeceasedBoolean != nil || r.DeceasedDateTime != nil\n}")
+	assert.Contains(t, string(data), "func (b *BasicPart) HasValue() bool {\n\treturn b.ValueString != nil || b.ValueInteger != nil\n}")
+}
+
+func TestGenerateGoFieldNames(t *testing.T) {
+	c := newTestCodeGen(t, basicResource(analyzer.AnalyzedProperty{
+		Name:        "Created",
+		JSONName:    "created",
+		GoType:      "*string",
+		IsPointer:   true,
+		IsPrimitive: true,
+		FHIRType:    "date",
+	}, analyzer.AnalyzedProperty{
+		Name:     "CreatedExt",
+		JSONName: "_created",
+		GoType:   "*Element",
+	}))
+	require.NoError(t, c.Generate())
+
+	data, err := os.ReadFile(filepath.Join(c.config.OutputDir, "element_order.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "var goFieldNames = map[string]map[string]string{\n\t\"Basic\": {\n\t\t\"id\":      \"Id\",\n\t\t\"created\": \"Created\",\n\t},\n}")
+	assert.Contains(t, string(data), "func GoFieldName(resourceType, jsonName string) (string, bool)")
+}
+
+func TestGeneratePrimaryCode(t *testing.T) {
+	c := newTestCodeGen(t, basicResource(analyzer.AnalyzedProperty{
+		Name:      "Code",
+		JSONName:  "code",
+		GoType:    "CodeableConcept",
+		FHIRType:  "CodeableConcept",
+		IsSummary: true,
+	}))
+	require.NoError(t, c.Generate())
+
+	data, err := os.ReadFile(filepath.Join(c.config.OutputDir, "resource_basic.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "func (r *Basic) PrimaryCode() *CodeableConcept {\n\treturn codeOrNil(&r.Code)\n}")
+
+	data, err = os.ReadFile(filepath.Join(c.config.OutputDir, "interfaces.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "type CodeHolder interface {")
+}
+
+func TestGenerateCodeStringer(t *testing.T) {
+	c := newTestCodeGen(t, basicResource())
+	require.NoError(t, c.valueSets.LoadFromBundle([]byte(`{"resourceType":"Bundle","entry":[{"resource":{
+		"resourceType":"ValueSet","url":"http://example.org/vs","name":"ExampleStatus",
+		"compose":{"include":[{"system":"http://example.org/cs","concept":[{"code":"final"}]}]}}}]}`)))
+	c.analyzer.UsedBindings["http://example.org/vs"] = true
+	require.NoError(t, c.Generate())
+
+	data, err := os.ReadFile(filepath.Join(c.config.OutputDir, "codesystems.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "func (c ExampleStatus) String() string {\n\treturn string(c)\n}")
+}
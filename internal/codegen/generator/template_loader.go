@@ -303,6 +303,97 @@ func (c *CodeGen) generateSummaryFromTemplate() error {
 	return writeTemplateFile(path, "summary.go.tmpl", data)
 }
 
+// ElementOrderTemplateData holds data for element order template.
+type ElementOrderTemplateData struct {
+	TemplateData
+	Types []TypeElementOrderData
+}
+
+// TypeElementOrderData holds the ordered element names of a single type.
+type TypeElementOrderData struct {
+	Name     string
+	Elements []string
+	Fields   []ElementFieldData
+	Required []RequiredElementData
+}
+
+// ElementFieldData pairs an element's JSON name with its Go struct field name.
+type ElementFieldData struct {
+	JSONName string
+	GoName   string
+}
+
+// RequiredElementData describes an element with a minimum cardinality of 1.
+// For a choice element Name is the base name and FHIRType the first allowed type.
+type RequiredElementData struct {
+	Name     string
+	FHIRType string
+	IsChoice bool
+}
+
+// generateElementOrderFromTemplate generates element_order.go using template.
+func (c *CodeGen) generateElementOrderFromTemplate() error {
+	types := make([]TypeElementOrderData, 0, len(c.types))
+
+	add := func(t *analyzer.AnalyzedType) {
+		elements := make([]string, 0, len(t.Properties))
+		fields := make([]ElementFieldData, 0, len(t.Properties))
+		var required []RequiredElementData
+		seenChoice := make(map[string]bool)
+		for _, prop := range t.Properties {
+			// _field extension companions share their primitive's XML element
+			if strings.HasPrefix(prop.JSONName, "_") {
+				continue
+			}
+			elements = append(elements, prop.JSONName)
+			fields = append(fields, ElementFieldData{JSONName: prop.JSONName, GoName: prop.Name})
+			if !prop.IsRequired {
+				continue
+			}
+			switch {
+			case prop.IsChoice && !seenChoice[prop.ChoiceBaseName]:
+				seenChoice[prop.ChoiceBaseName] = true
+				required = append(required, RequiredElementData{
+					Name:     toLowerFirstChar(prop.ChoiceBaseName),
+					FHIRType: prop.FHIRType,
+					IsChoice: true,
+				})
+			case !prop.IsChoice:
+				required = append(required, RequiredElementData{Name: prop.JSONName, FHIRType: prop.FHIRType})
+			}
+		}
+		if len(elements) > 0 {
+			types = append(types, TypeElementOrderData{Name: t.Name, Elements: elements, Fields: fields, Required: required})
+		}
+	}
+
+	for _, t := range c.types {
+		if t.Kind != kindResource && t.Kind != "datatype" && t.Kind != "backbone" {
+			continue
+		}
+		add(t)
+		for _, bb := range t.BackboneTypes {
+			add(bb)
+		}
+	}
+
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].Name < types[j].Name
+	})
+
+	data := ElementOrderTemplateData{
+		TemplateData: TemplateData{
+			PackageName: c.config.PackageName,
+			Version:     strings.ToUpper(c.config.Version),
+			FileType:    "element_order",
+		},
+		Types: types,
+	}
+
+	path := filepath.Join(c.config.OutputDir, "element_order.go")
+	return writeTemplateFile(path, "element_order.go.tmpl", data)
+}
+
 // buildResourceBuilderData converts an AnalyzedType to ResourceBuilderData.
 func buildResourceBuilderData(t *analyzer.AnalyzedType) ResourceBuilderData {
 	resource := ResourceBuilderData{
@@ -422,6 +513,15 @@ func xmlTemplateFuncMap() template.FuncMap {
 			return fmt.Sprintf("%s.%sExt", receiver, prop.Name)
 		},
 
+		// choiceGroups groups the choice variants of a type by base name.
+		"choiceGroups": choiceGroups,
+
+		// performerFields lists the elements collected by Performers.
+		"performerFields": performerFields,
+
+		// firstFields lists the elements that get First<Field> accessors.
+		"firstFields": firstFields,
+
 		// hasIdField checks whether a type has an "id" property.
 		"hasIdField": func(t *analyzer.AnalyzedType) bool {
 			for _, prop := range t.Properties {
@@ -489,6 +589,123 @@ func loadTemplateWithFuncs(name string, funcMap template.FuncMap) (*template.Tem
 	return tmpl, nil
 }
 
+// ChoiceGroupData holds the typed variants of a choice element.
+type ChoiceGroupData struct {
+	Name   string   // base name, e.g. "value" for value[x]
+	GoName string   // base name in Go form, e.g. "Value"
+	Fields []string // Go field names of the variants
+}
+
+// choiceGroups returns the choice elements of t in element order.
+func choiceGroups(t *analyzer.AnalyzedType) []ChoiceGroupData {
+	var groups []ChoiceGroupData
+	for _, prop := range t.Properties {
+		if !prop.IsChoice {
+			continue
+		}
+		name := toLowerFirstChar(prop.ChoiceBaseName)
+		if len(groups) == 0 || groups[len(groups)-1].Name != name {
+			groups = append(groups, ChoiceGroupData{Name: name, GoName: strings.ToUpper(name[:1]) + name[1:]})
+		}
+		g := &groups[len(groups)-1]
+		g.Fields = append(g.Fields, prop.Name)
+	}
+	return groups
+}
+
+// performerElements lists the top-level elements collected by the generated
+// Performers method.
+var performerElements = map[string]bool{
+	"performer":          true,
+	"author":             true,
+	"resultsInterpreter": true,
+}
+
+// PerformerFieldData describes an element collected by Performers: either a
+// Reference itself, or a backbone element whose actor is the Reference.
+type PerformerFieldData struct {
+	Name           string // Go field name
+	IsArray        bool
+	IsPointer      bool
+	Actor          string // Go name of the backbone's actor field, "" for a Reference
+	ActorIsPointer bool
+}
+
+// performerFields returns the performer elements of resource t in element
+// order, or nil if it has none.
+func performerFields(t *analyzer.AnalyzedType) []PerformerFieldData {
+	var fields []PerformerFieldData
+	for _, prop := range t.Properties {
+		if !performerElements[prop.JSONName] {
+			continue
+		}
+		f := PerformerFieldData{Name: prop.Name, IsArray: prop.IsArray, IsPointer: prop.IsPointer}
+		switch prop.FHIRType {
+		case "Reference":
+		case "BackboneElement":
+			actor := backboneActor(t, prop.BackboneType)
+			if actor == nil {
+				continue
+			}
+			f.Actor, f.ActorIsPointer = actor.Name, actor.IsPointer
+		default:
+			continue
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// backboneActor returns the single Reference "actor" property of the named
+// backbone type of t, or nil.
+func backboneActor(t *analyzer.AnalyzedType, backbone string) *analyzer.AnalyzedProperty {
+	for _, b := range t.BackboneTypes {
+		if b.Name != backbone {
+			continue
+		}
+		for i, prop := range b.Properties {
+			if prop.JSONName == "actor" && prop.FHIRType == "Reference" && !prop.IsArray {
+				return &b.Properties[i]
+			}
+		}
+	}
+	return nil
+}
+
+// firstElements lists the top-level repeating elements that get a generated
+// First<Field> accessor.
+var firstElements = map[string]bool{
+	"identifier": true,
+	"name":       true,
+	"telecom":    true,
+	"address":    true,
+	"category":   true,
+	"note":       true,
+}
+
+// FirstFieldData describes an element that gets a First<Field> accessor.
+type FirstFieldData struct {
+	Name        string // Go field name
+	JSONName    string
+	ElementType string // Go type of the entries, e.g. "HumanName"
+}
+
+// firstFields returns the elements of resource t that get a First<Field>
+// accessor: the repeating complex elements listed in firstElements.
+func firstFields(t *analyzer.AnalyzedType) []FirstFieldData {
+	var fields []FirstFieldData
+	for _, prop := range t.Properties {
+		if firstElements[prop.JSONName] && prop.IsArray && !analyzer.IsPrimitiveType(prop.FHIRType) {
+			fields = append(fields, FirstFieldData{
+				Name:        prop.Name,
+				JSONName:    prop.JSONName,
+				ElementType: strings.TrimPrefix(prop.GoType, "[]"),
+			})
+		}
+	}
+	return fields
+}
+
 // writeXMLTemplateFile executes an XML template with FuncMap and writes to file.
 func writeXMLTemplateFile(outputPath, templateName string, data interface{}) error {
 	tmpl, err := loadTemplateWithFuncs(templateName, xmlTemplateFuncMap())
@@ -0,0 +1,418 @@
+package r4
+
+import (
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// MergeBundles concatenates the entries of bundles, typically the pages of a
+// paginated search, into a new Bundle.
+//
+// The result's type is taken from the first bundle. For searchset results
+// the totals of the inputs that have one are summed. Entries sharing a
+// fullUrl are de-duplicated, keeping the first; entries without a fullUrl are
+// always kept. Ids, metadata, and paging links are not carried over. Entries
+// are copied shallowly, so resources are shared with the inputs.
+//
+// Nil bundles are skipped; MergeBundles returns nil if none remain.
+func MergeBundles(bundles ...*Bundle) *Bundle {
+	var merged *Bundle
+	seen := make(map[string]bool)
+	var total uint32
+	hasTotal := false
+
+	for _, b := range bundles {
+		if b == nil {
+			continue
+		}
+		if merged == nil {
+			merged = &Bundle{}
+			if b.Type != nil {
+				t := *b.Type
+				merged.Type = &t
+			}
+		}
+		if b.Total != nil {
+			total += *b.Total
+			hasTotal = true
+		}
+		for _, entry := range b.Entry {
+			if entry.FullUrl != nil {
+				if seen[*entry.FullUrl] {
+					continue
+				}
+				seen[*entry.FullUrl] = true
+			}
+			merged.Entry = append(merged.Entry, entry)
+		}
+	}
+
+	if merged != nil && hasTotal && merged.Type != nil && *merged.Type == BundleTypeSearchset {
+		merged.Total = &total
+	}
+	return merged
+}
+
+// AddMatch appends r as a searchset entry matching the search criteria.
+func (b *Bundle) AddMatch(r Resource) {
+	b.addSearchEntry(r, SearchEntryModeMatch)
+}
+
+// AddInclude appends r as a searchset entry included via _include or
+// _revinclude.
+func (b *Bundle) AddInclude(r Resource) {
+	b.addSearchEntry(r, SearchEntryModeInclude)
+}
+
+// AddOutcome appends oo as a searchset entry with search.mode "outcome",
+// carrying warnings or information about the search.
+func (b *Bundle) AddOutcome(oo *OperationOutcome) {
+	b.addSearchEntry(oo, SearchEntryModeOutcome)
+}
+
+// addSearchEntry appends r with the given search mode.
+func (b *Bundle) addSearchEntry(r Resource, mode SearchEntryMode) {
+	b.Entry = append(b.Entry, BundleEntry{
+		Resource: r,
+		Search:   &BundleEntrySearch{Mode: &mode},
+	})
+}
+
+// FlattenBundle returns the entry resources of b as standalone resources, for
+// loading into a store. Contained resources are externalized: each is
+// returned right after its container as a resource of its own, and local
+// references to it ("#id") anywhere in the container are rewritten to
+// "Type/id". Contained ids are only unique within their container, so each
+// externalized resource gets the id "<containerId>-<id>". If the container
+// has no id, the id is instead a name-based UUID derived from the entry's
+// fullUrl (or its index if it has none) and the contained id, so flattening
+// the same bundle always yields the same ids. Resources nested in contained
+// resources are externalized the same way and returned after them. A
+// reference to the container itself ("#") becomes the container's "Type/id"
+// if it has an id.
+//
+// Entries without a resource are skipped. Resources are copied, so b is not
+// modified. It returns an error if a contained resource has no id, or if two
+// resources in the result share a type and id.
+func FlattenBundle(b *Bundle) ([]Resource, error) {
+	if b == nil {
+		return nil, nil
+	}
+	var resources []Resource
+	seen := make(map[string]bool)
+	for i, entry := range b.Entry {
+		if entry.Resource == nil {
+			continue
+		}
+		r := cloneResource(entry.Resource)
+		scope := fmt.Sprintf("entry[%d]", i)
+		if entry.FullUrl != nil && *entry.FullUrl != "" {
+			scope = *entry.FullUrl
+		}
+		contained, err := externalizeContained(r, scope)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+		for _, res := range append([]Resource{r}, contained...) {
+			if id := res.GetId(); id != nil {
+				key := res.GetResourceType() + "/" + *id
+				if seen[key] {
+					return nil, fmt.Errorf("entry %d: duplicate resource %s", i, key)
+				}
+				seen[key] = true
+			}
+			resources = append(resources, res)
+		}
+	}
+	return resources, nil
+}
+
+// externalizeContained removes the contained resources of r, and any
+// nested within them, and returns them with ids unique outside r, rewriting
+// the local references in r and the returned resources to point at the
+// standalone resources. If r has no id, the ids are derived from scope,
+// which identifies r within its bundle.
+func externalizeContained(r Resource, scope string) ([]Resource, error) {
+	dr, ok := r.(DomainResource)
+	if !ok || len(dr.GetContained()) == 0 {
+		return nil, nil
+	}
+	containerID := derefString(r.GetId())
+	var contained []Resource
+	targets := make(map[string]string) // local reference -> "Type/id"
+	for i, c := range dr.GetContained() {
+		if c == nil {
+			continue
+		}
+		localID := derefString(c.GetId())
+		if localID == "" {
+			return nil, fmt.Errorf("contained resource %d (%s) has no id", i, c.GetResourceType())
+		}
+		id := containerID + "-" + localID
+		if containerID == "" {
+			id = nameUUID(scope + "#" + localID)
+		}
+		c.SetId(id)
+		targets["#"+localID] = c.GetResourceType() + "/" + id
+		contained = append(contained, c)
+	}
+	if containerID != "" {
+		targets["#"] = r.GetResourceType() + "/" + containerID
+	}
+
+	_ = Walk(r, func(_ string, node any) error {
+		if ref, ok := node.(*Reference); ok && ref.Reference != nil {
+			if target, ok := targets[*ref.Reference]; ok {
+				ref.Reference = &target
+			}
+		}
+		return nil
+	})
+	f := reflect.ValueOf(r).Elem().FieldByName("Contained")
+	f.Set(reflect.Zero(f.Type()))
+
+	// Contained resources should not contain others, but any nested ones are
+	// externalized in turn. Local references matching r's contained resources
+	// were already rewritten above, so those take precedence.
+	all := contained
+	for _, c := range contained {
+		nested, err := externalizeContained(c, scope)
+		if err != nil {
+			return nil, fmt.Errorf("%s/%s: %w", c.GetResourceType(), *c.GetId(), err)
+		}
+		all = append(all, nested...)
+	}
+	return all, nil
+}
+
+// nameUUID returns the name-based (version 5) UUID of name in the URL
+// namespace, so the same name always yields the same UUID.
+func nameUUID(name string) string {
+	h := sha1.New()
+	h.Write([]byte{0x6b, 0xa7, 0xb8, 0x11, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8})
+	h.Write([]byte(name))
+	b := h.Sum(nil)
+	b[6] = b[6]&0x0f | 0x50
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// TransactionResult pairs an entry of a transaction or batch Bundle with the
+// outcome the server reported for it.
+type TransactionResult struct {
+	Request  BundleEntry // the original request entry, holding the resource sent
+	Status   string      // response.status, e.g. "201 Created"; "" if absent
+	Location string      // response.location; "" if absent
+	Resource Resource    // the resource returned in the response entry, if any
+	Outcome  Resource    // response.outcome, if any
+}
+
+// CorrelateTransaction matches the entries of a transaction or batch request
+// Bundle to those of its response by position, as the spec requires servers
+// to return them in request order. It returns an error if either bundle is
+// nil or their entry counts differ.
+func CorrelateTransaction(request, response *Bundle) ([]TransactionResult, error) {
+	if request == nil || response == nil {
+		return nil, errors.New("request and response bundles are required")
+	}
+	if len(request.Entry) != len(response.Entry) {
+		return nil, fmt.Errorf("request has %d entries but response has %d", len(request.Entry), len(response.Entry))
+	}
+	results := make([]TransactionResult, len(request.Entry))
+	for i, entry := range response.Entry {
+		res := TransactionResult{Request: request.Entry[i], Resource: entry.Resource}
+		if r := entry.Response; r != nil {
+			res.Status = derefString(r.Status)
+			res.Location = derefString(r.Location)
+			res.Outcome = r.Outcome
+		}
+		results[i] = res
+	}
+	return results, nil
+}
+
+// ReferenceGraph returns the reference edges between the entry resources of
+// b, for visualization. Each entry resource is a node keyed by its "Type/id",
+// or by its fullUrl (e.g. a urn:uuid) if it has no id; entries with neither
+// are skipped. Every node maps to the distinct targets of the references
+// found anywhere in the resource, including its contained resources, in Walk
+// order. Nodes without references map to nil.
+//
+// A reference resolves to an entry when it equals that entry's fullUrl or
+// "Type/id", ignoring any "/_history/<version>" suffix. Unresolvable
+// references are included as written; local references ("#id") are
+// skipped.
+func ReferenceGraph(b *Bundle) map[string][]string {
+	if b == nil {
+		return nil
+	}
+	graph := make(map[string][]string)
+	nodes, targets := bundleNodes(b)
+	for _, node := range nodes {
+		if node != "" {
+			graph[node] = nil
+		}
+	}
+	for i, entry := range b.Entry {
+		node := nodes[i]
+		if node == "" {
+			continue
+		}
+		seen := make(map[string]bool)
+		_ = Walk(entry.Resource, func(_ string, n any) error {
+			ref, ok := n.(*Reference)
+			if !ok || ref.Reference == nil || *ref.Reference == "" || strings.HasPrefix(*ref.Reference, "#") {
+				return nil
+			}
+			target := *ref.Reference
+			if t, ok := targets[stripHistory(target)]; ok {
+				target = t
+			}
+			if !seen[target] {
+				seen[target] = true
+				graph[node] = append(graph[node], target)
+			}
+			return nil
+		})
+	}
+	return graph
+}
+
+// bundleNodes returns the ReferenceGraph node key of each entry of b ("" for
+// entries without one) and a map from every fullUrl and node key to the node
+// it identifies.
+func bundleNodes(b *Bundle) (nodes []string, targets map[string]string) {
+	nodes = make([]string, len(b.Entry))
+	targets = make(map[string]string)
+	for i, entry := range b.Entry {
+		node := entryNode(entry)
+		if node == "" {
+			continue
+		}
+		nodes[i] = node
+		targets[node] = node
+		if entry.FullUrl != nil && *entry.FullUrl != "" {
+			targets[*entry.FullUrl] = node
+		}
+	}
+	return nodes, targets
+}
+
+// entryNode returns the ReferenceGraph node key of entry, or "" if it has
+// none.
+func entryNode(entry BundleEntry) string {
+	if entry.Resource == nil {
+		return ""
+	}
+	if id := entry.Resource.GetId(); id != nil && *id != "" {
+		return entry.Resource.GetResourceType() + "/" + *id
+	}
+	if entry.FullUrl != nil {
+		return *entry.FullUrl
+	}
+	return ""
+}
+
+// stripHistory removes a trailing "/_history/<version>" from a reference.
+func stripHistory(ref string) string {
+	if i := strings.Index(ref, "/_history/"); i >= 0 {
+		return ref[:i]
+	}
+	return ref
+}
+
+// ValidateBundle checks b before it is submitted as a transaction or batch.
+// Every entry resource is checked with Validate, and every reference in it,
+// including those of contained resources, must resolve: to the fullUrl or
+// "Type/id" of another entry, to a contained resource ("#id"), or to a
+// resource outside the bundle. Local references must name a resource
+// contained in the same entry ("#" names the entry resource itself), and
+// urn:uuid: and urn:oid: references are internal by definition, so those
+// that match nothing are reported as dangling; relative and absolute URLs
+// may name resources already on the server and are accepted.
+//
+// Each problem becomes an error issue whose expression locates it within b,
+// e.g. "Bundle.entry[1].resource.subject". ValidateBundle returns nil if
+// there are none.
+func ValidateBundle(b *Bundle) *OperationOutcome {
+	if b == nil {
+		return nil
+	}
+	_, targets := bundleNodes(b)
+	var issues []OperationOutcomeIssue
+	for i, entry := range b.Entry {
+		if entry.Resource == nil {
+			continue
+		}
+		prefix := fmt.Sprintf("Bundle.entry[%d].resource", i)
+		rt := entry.Resource.GetResourceType()
+		for _, err := range unjoin(Validate(entry.Resource)) {
+			expr, msg := prefix, err.Error()
+			var verr *ValidationError
+			if errors.As(err, &verr) {
+				expr, msg = prefix+strings.TrimPrefix(verr.Path, rt), verr.Message
+			}
+			issues = append(issues, bundleIssue(IssueTypeInvalid, msg, expr))
+		}
+		local := map[string]bool{"#": true}
+		if dr, ok := entry.Resource.(DomainResource); ok {
+			for _, c := range dr.GetContained() {
+				if c != nil && c.GetId() != nil {
+					local["#"+*c.GetId()] = true
+				}
+			}
+		}
+		_ = Walk(entry.Resource, func(path string, node any) error {
+			ref, ok := node.(*Reference)
+			if !ok || ref.Reference == nil {
+				return nil
+			}
+			s := *ref.Reference
+			var msg string
+			switch {
+			case strings.HasPrefix(s, "#"):
+				if !local[s] {
+					msg = "reference " + s + " does not resolve to a contained resource"
+				}
+			case strings.HasPrefix(s, "urn:uuid:"), strings.HasPrefix(s, "urn:oid:"):
+				if _, ok := targets[s]; !ok {
+					msg = "reference " + s + " does not resolve to an entry in the bundle"
+				}
+			}
+			if msg != "" {
+				issues = append(issues, bundleIssue(IssueTypeNotFound, msg, prefix+strings.TrimPrefix(path, rt)))
+			}
+			return nil
+		})
+	}
+	if len(issues) == 0 {
+		return nil
+	}
+	return &OperationOutcome{Issue: issues}
+}
+
+// bundleIssue returns an error issue for ValidateBundle.
+func bundleIssue(code IssueType, diagnostics, expression string) OperationOutcomeIssue {
+	severity := IssueSeverityError
+	return OperationOutcomeIssue{
+		Severity:    &severity,
+		Code:        &code,
+		Diagnostics: &diagnostics,
+		Expression:  []string{expression},
+	}
+}
+
+// unjoin returns the errors joined in err by errors.Join, err itself if it
+// is a single error, or nil.
+func unjoin(err error) []error {
+	if err == nil {
+		return nil
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		return joined.Unwrap()
+	}
+	return []error{err}
+}
@@ -0,0 +1,67 @@
+package r4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4"
+)
+
+func TestMarshalCanonical(t *testing.T) {
+	mrn := r4.Identifier{System: ptrString("urn:mrn"), Value: ptrString("123")}
+	ssn := r4.Identifier{System: ptrString("urn:ssn"), Value: ptrString("999")}
+	name := r4.HumanName{Family: ptrString("Doe"), Given: []string{"Mary", "Anne"}}
+
+	t.Run("normalizes identifier order", func(t *testing.T) {
+		a, err := r4.MarshalCanonical(&r4.Patient{Identifier: []r4.Identifier{mrn, ssn}})
+		require.NoError(t, err)
+		b, err := r4.MarshalCanonical(&r4.Patient{Identifier: []r4.Identifier{ssn, mrn}})
+		require.NoError(t, err)
+		assert.Equal(t, string(a), string(b))
+		assert.Equal(t, `{"resourceType":"Patient","identifier":[`+
+			`{"system":"urn:mrn","value":"123"},{"system":"urn:ssn","value":"999"}]}`, string(a))
+
+		x, err := r4.MarshalCanonicalXML(&r4.Patient{Identifier: []r4.Identifier{ssn, mrn}})
+		require.NoError(t, err)
+		y, err := r4.MarshalCanonicalXML(&r4.Patient{Identifier: []r4.Identifier{mrn, ssn}})
+		require.NoError(t, err)
+		assert.Equal(t, string(x), string(y))
+	})
+
+	t.Run("keeps given name order", func(t *testing.T) {
+		data, err := r4.MarshalCanonical(&r4.Patient{Name: []r4.HumanName{name}})
+		require.NoError(t, err)
+		assert.Equal(t, `{"resourceType":"Patient","name":[{"family":"Doe","given":["Mary","Anne"]}]}`, string(data))
+
+		reversed := r4.HumanName{Family: ptrString("Doe"), Given: []string{"Anne", "Mary"}}
+		other, err := r4.MarshalCanonical(&r4.Patient{Name: []r4.HumanName{reversed}})
+		require.NoError(t, err)
+		assert.NotEqual(t, string(data), string(other))
+	})
+
+	t.Run("sorts nested unordered elements", func(t *testing.T) {
+		a := &r4.Patient{Contact: []r4.PatientContact{{Telecom: []r4.ContactPoint{
+			{Value: ptrString("555-2")}, {Value: ptrString("555-1")},
+		}}}}
+		b := &r4.Patient{Contact: []r4.PatientContact{{Telecom: []r4.ContactPoint{
+			{Value: ptrString("555-1")}, {Value: ptrString("555-2")},
+		}}}}
+		x, err := r4.MarshalCanonical(a)
+		require.NoError(t, err)
+		y, err := r4.MarshalCanonical(b)
+		require.NoError(t, err)
+		assert.Equal(t, string(x), string(y))
+		assert.Equal(t, "555-2", *a.Contact[0].Telecom[0].Value, "input must not be modified")
+	})
+
+	t.Run("configurable", func(t *testing.T) {
+		r4.CanonicalUnorderedElements["HumanName.given"] = true
+		defer delete(r4.CanonicalUnorderedElements, "HumanName.given")
+
+		data, err := r4.MarshalCanonical(&r4.Patient{Name: []r4.HumanName{name}})
+		require.NoError(t, err)
+		assert.Equal(t, `{"resourceType":"Patient","name":[{"family":"Doe","given":["Anne","Mary"]}]}`, string(data))
+	})
+}
@@ -4,6 +4,45 @@
 
 package r4
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// StrictCodes controls how code values are decoded into the generated code
+// types below, from both JSON and XML. When false (the default), unknown
+// codes pass through as the raw string for forward compatibility; when true,
+// they are rejected with an error. Set it once during initialization.
+var StrictCodes bool
+
+// knownCode is implemented by the generated code types.
+type knownCode interface {
+	~string
+	known() bool
+}
+
+// checkCode returns an error for an unknown code when StrictCodes is set.
+func checkCode[T knownCode](c T) error {
+	if StrictCodes && !c.known() {
+		return fmt.Errorf("unknown %T code %q", c, string(c))
+	}
+	return nil
+}
+
+// unmarshalCode decodes a JSON string into a generated code type.
+func unmarshalCode[T knownCode](data []byte, c *T) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v := T(s)
+	if err := checkCode(v); err != nil {
+		return err
+	}
+	*c = v
+	return nil
+}
+
 // FHIRVersion represents FHIRVersion.
 type FHIRVersion string
 
@@ -55,6 +94,51 @@ const (
 	FHIRVersion401 FHIRVersion = "4.0.1"
 )
 
+// known reports whether c is one of the FHIRVersion values.
+func (c FHIRVersion) known() bool {
+	switch c {
+	case FHIRVersion001,
+		FHIRVersion005,
+		FHIRVersion006,
+		FHIRVersion011,
+		FHIRVersion0080,
+		FHIRVersion0081,
+		FHIRVersion0082,
+		FHIRVersion040,
+		FHIRVersion050,
+		FHIRVersion100,
+		FHIRVersion101,
+		FHIRVersion102,
+		FHIRVersion110,
+		FHIRVersion140,
+		FHIRVersion160,
+		FHIRVersion180,
+		FHIRVersion300,
+		FHIRVersion301,
+		FHIRVersion330,
+		FHIRVersion350,
+		FHIRVersion400,
+		FHIRVersion401:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FHIRVersion value in definition order.
+func (FHIRVersion) firstCode() string {
+	return string(FHIRVersion001)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FHIRVersion) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FHIRVersion) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AccountStatus represents AccountStatus.
 type AccountStatus string
 
@@ -72,6 +156,34 @@ const (
 	AccountStatusUnknown AccountStatus = "unknown"
 )
 
+// known reports whether c is one of the AccountStatus values.
+func (c AccountStatus) known() bool {
+	switch c {
+	case AccountStatusActive,
+		AccountStatusInactive,
+		AccountStatusEnteredInError,
+		AccountStatusOnHold,
+		AccountStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AccountStatus value in definition order.
+func (AccountStatus) firstCode() string {
+	return string(AccountStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AccountStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AccountStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionCardinalityBehavior represents ActionCardinalityBehavior.
 type ActionCardinalityBehavior string
 
@@ -83,6 +195,31 @@ const (
 	ActionCardinalityBehaviorMultiple ActionCardinalityBehavior = "multiple"
 )
 
+// known reports whether c is one of the ActionCardinalityBehavior values.
+func (c ActionCardinalityBehavior) known() bool {
+	switch c {
+	case ActionCardinalityBehaviorSingle,
+		ActionCardinalityBehaviorMultiple:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionCardinalityBehavior value in definition order.
+func (ActionCardinalityBehavior) firstCode() string {
+	return string(ActionCardinalityBehaviorSingle)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionCardinalityBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionCardinalityBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionConditionKind represents ActionConditionKind.
 type ActionConditionKind string
 
@@ -96,6 +233,32 @@ const (
 	ActionConditionKindStop ActionConditionKind = "stop"
 )
 
+// known reports whether c is one of the ActionConditionKind values.
+func (c ActionConditionKind) known() bool {
+	switch c {
+	case ActionConditionKindApplicability,
+		ActionConditionKindStart,
+		ActionConditionKindStop:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionConditionKind value in definition order.
+func (ActionConditionKind) firstCode() string {
+	return string(ActionConditionKindApplicability)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionConditionKind) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionConditionKind) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionGroupingBehavior represents ActionGroupingBehavior.
 type ActionGroupingBehavior string
 
@@ -109,6 +272,32 @@ const (
 	ActionGroupingBehaviorSentenceGroup ActionGroupingBehavior = "sentence-group"
 )
 
+// known reports whether c is one of the ActionGroupingBehavior values.
+func (c ActionGroupingBehavior) known() bool {
+	switch c {
+	case ActionGroupingBehaviorVisualGroup,
+		ActionGroupingBehaviorLogicalGroup,
+		ActionGroupingBehaviorSentenceGroup:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionGroupingBehavior value in definition order.
+func (ActionGroupingBehavior) firstCode() string {
+	return string(ActionGroupingBehaviorVisualGroup)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionGroupingBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionGroupingBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionParticipantType represents ActionParticipantType.
 type ActionParticipantType string
 
@@ -124,6 +313,33 @@ const (
 	ActionParticipantTypeDevice ActionParticipantType = "device"
 )
 
+// known reports whether c is one of the ActionParticipantType values.
+func (c ActionParticipantType) known() bool {
+	switch c {
+	case ActionParticipantTypePatient,
+		ActionParticipantTypePractitioner,
+		ActionParticipantTypeRelatedPerson,
+		ActionParticipantTypeDevice:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionParticipantType value in definition order.
+func (ActionParticipantType) firstCode() string {
+	return string(ActionParticipantTypePatient)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionParticipantType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionParticipantType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionPrecheckBehavior represents ActionPrecheckBehavior.
 type ActionPrecheckBehavior string
 
@@ -135,6 +351,31 @@ const (
 	ActionPrecheckBehaviorNo ActionPrecheckBehavior = "no"
 )
 
+// known reports whether c is one of the ActionPrecheckBehavior values.
+func (c ActionPrecheckBehavior) known() bool {
+	switch c {
+	case ActionPrecheckBehaviorYes,
+		ActionPrecheckBehaviorNo:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionPrecheckBehavior value in definition order.
+func (ActionPrecheckBehavior) firstCode() string {
+	return string(ActionPrecheckBehaviorYes)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionPrecheckBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionPrecheckBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionRelationshipType represents ActionRelationshipType.
 type ActionRelationshipType string
 
@@ -160,6 +401,38 @@ const (
 	ActionRelationshipTypeAfterEnd ActionRelationshipType = "after-end"
 )
 
+// known reports whether c is one of the ActionRelationshipType values.
+func (c ActionRelationshipType) known() bool {
+	switch c {
+	case ActionRelationshipTypeBeforeStart,
+		ActionRelationshipTypeBefore,
+		ActionRelationshipTypeBeforeEnd,
+		ActionRelationshipTypeConcurrentWithStart,
+		ActionRelationshipTypeConcurrent,
+		ActionRelationshipTypeConcurrentWithEnd,
+		ActionRelationshipTypeAfterStart,
+		ActionRelationshipTypeAfter,
+		ActionRelationshipTypeAfterEnd:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionRelationshipType value in definition order.
+func (ActionRelationshipType) firstCode() string {
+	return string(ActionRelationshipTypeBeforeStart)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionRelationshipType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionRelationshipType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionRequiredBehavior represents ActionRequiredBehavior.
 type ActionRequiredBehavior string
 
@@ -173,6 +446,32 @@ const (
 	ActionRequiredBehaviorMustUnlessDocumented ActionRequiredBehavior = "must-unless-documented"
 )
 
+// known reports whether c is one of the ActionRequiredBehavior values.
+func (c ActionRequiredBehavior) known() bool {
+	switch c {
+	case ActionRequiredBehaviorMust,
+		ActionRequiredBehaviorCould,
+		ActionRequiredBehaviorMustUnlessDocumented:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionRequiredBehavior value in definition order.
+func (ActionRequiredBehavior) firstCode() string {
+	return string(ActionRequiredBehaviorMust)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionRequiredBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionRequiredBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionSelectionBehavior represents ActionSelectionBehavior.
 type ActionSelectionBehavior string
 
@@ -192,6 +491,35 @@ const (
 	ActionSelectionBehaviorOneOrMore ActionSelectionBehavior = "one-or-more"
 )
 
+// known reports whether c is one of the ActionSelectionBehavior values.
+func (c ActionSelectionBehavior) known() bool {
+	switch c {
+	case ActionSelectionBehaviorAny,
+		ActionSelectionBehaviorAll,
+		ActionSelectionBehaviorAllOrNone,
+		ActionSelectionBehaviorExactlyOne,
+		ActionSelectionBehaviorAtMostOne,
+		ActionSelectionBehaviorOneOrMore:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionSelectionBehavior value in definition order.
+func (ActionSelectionBehavior) firstCode() string {
+	return string(ActionSelectionBehaviorAny)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionSelectionBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionSelectionBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AddressType represents AddressType.
 type AddressType string
 
@@ -205,6 +533,32 @@ const (
 	AddressTypeBoth AddressType = "both"
 )
 
+// known reports whether c is one of the AddressType values.
+func (c AddressType) known() bool {
+	switch c {
+	case AddressTypePostal,
+		AddressTypePhysical,
+		AddressTypeBoth:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AddressType value in definition order.
+func (AddressType) firstCode() string {
+	return string(AddressTypePostal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AddressType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AddressType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AddressUse represents AddressUse.
 type AddressUse string
 
@@ -222,6 +576,34 @@ const (
 	AddressUseBilling AddressUse = "billing"
 )
 
+// known reports whether c is one of the AddressUse values.
+func (c AddressUse) known() bool {
+	switch c {
+	case AddressUseHome,
+		AddressUseWork,
+		AddressUseTemp,
+		AddressUseOld,
+		AddressUseBilling:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AddressUse value in definition order.
+func (AddressUse) firstCode() string {
+	return string(AddressUseHome)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AddressUse) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AddressUse) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AdministrativeGender represents AdministrativeGender.
 type AdministrativeGender string
 
@@ -237,6 +619,33 @@ const (
 	AdministrativeGenderUnknown AdministrativeGender = "unknown"
 )
 
+// known reports whether c is one of the AdministrativeGender values.
+func (c AdministrativeGender) known() bool {
+	switch c {
+	case AdministrativeGenderMale,
+		AdministrativeGenderFemale,
+		AdministrativeGenderOther,
+		AdministrativeGenderUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AdministrativeGender value in definition order.
+func (AdministrativeGender) firstCode() string {
+	return string(AdministrativeGenderMale)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AdministrativeGender) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AdministrativeGender) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AdverseEventActuality represents AdverseEventActuality.
 type AdverseEventActuality string
 
@@ -248,6 +657,31 @@ const (
 	AdverseEventActualityPotential AdverseEventActuality = "potential"
 )
 
+// known reports whether c is one of the AdverseEventActuality values.
+func (c AdverseEventActuality) known() bool {
+	switch c {
+	case AdverseEventActualityActual,
+		AdverseEventActualityPotential:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AdverseEventActuality value in definition order.
+func (AdverseEventActuality) firstCode() string {
+	return string(AdverseEventActualityActual)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AdverseEventActuality) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AdverseEventActuality) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AllergyIntoleranceCategory represents AllergyIntoleranceCategory.
 type AllergyIntoleranceCategory string
 
@@ -263,6 +697,33 @@ const (
 	AllergyIntoleranceCategoryBiologic AllergyIntoleranceCategory = "biologic"
 )
 
+// known reports whether c is one of the AllergyIntoleranceCategory values.
+func (c AllergyIntoleranceCategory) known() bool {
+	switch c {
+	case AllergyIntoleranceCategoryFood,
+		AllergyIntoleranceCategoryMedication,
+		AllergyIntoleranceCategoryEnvironment,
+		AllergyIntoleranceCategoryBiologic:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AllergyIntoleranceCategory value in definition order.
+func (AllergyIntoleranceCategory) firstCode() string {
+	return string(AllergyIntoleranceCategoryFood)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AllergyIntoleranceCategory) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AllergyIntoleranceCategory) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AllergyIntoleranceCriticality represents AllergyIntoleranceCriticality.
 type AllergyIntoleranceCriticality string
 
@@ -276,6 +737,32 @@ const (
 	AllergyIntoleranceCriticalityUnableToAssess AllergyIntoleranceCriticality = "unable-to-assess"
 )
 
+// known reports whether c is one of the AllergyIntoleranceCriticality values.
+func (c AllergyIntoleranceCriticality) known() bool {
+	switch c {
+	case AllergyIntoleranceCriticalityLow,
+		AllergyIntoleranceCriticalityHigh,
+		AllergyIntoleranceCriticalityUnableToAssess:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AllergyIntoleranceCriticality value in definition order.
+func (AllergyIntoleranceCriticality) firstCode() string {
+	return string(AllergyIntoleranceCriticalityLow)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AllergyIntoleranceCriticality) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AllergyIntoleranceCriticality) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AllergyIntoleranceType represents AllergyIntoleranceType.
 type AllergyIntoleranceType string
 
@@ -287,6 +774,31 @@ const (
 	AllergyIntoleranceTypeIntolerance AllergyIntoleranceType = "intolerance"
 )
 
+// known reports whether c is one of the AllergyIntoleranceType values.
+func (c AllergyIntoleranceType) known() bool {
+	switch c {
+	case AllergyIntoleranceTypeAllergy,
+		AllergyIntoleranceTypeIntolerance:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AllergyIntoleranceType value in definition order.
+func (AllergyIntoleranceType) firstCode() string {
+	return string(AllergyIntoleranceTypeAllergy)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AllergyIntoleranceType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AllergyIntoleranceType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AppointmentStatus represents AppointmentStatus.
 type AppointmentStatus string
 
@@ -314,6 +826,39 @@ const (
 	AppointmentStatusWaitlist AppointmentStatus = "waitlist"
 )
 
+// known reports whether c is one of the AppointmentStatus values.
+func (c AppointmentStatus) known() bool {
+	switch c {
+	case AppointmentStatusProposed,
+		AppointmentStatusPending,
+		AppointmentStatusBooked,
+		AppointmentStatusArrived,
+		AppointmentStatusFulfilled,
+		AppointmentStatusCancelled,
+		AppointmentStatusNoshow,
+		AppointmentStatusEnteredInError,
+		AppointmentStatusCheckedIn,
+		AppointmentStatusWaitlist:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AppointmentStatus value in definition order.
+func (AppointmentStatus) firstCode() string {
+	return string(AppointmentStatusProposed)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AppointmentStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AppointmentStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AssertionDirectionType represents AssertionDirectionType.
 type AssertionDirectionType string
 
@@ -325,6 +870,31 @@ const (
 	AssertionDirectionTypeRequest AssertionDirectionType = "request"
 )
 
+// known reports whether c is one of the AssertionDirectionType values.
+func (c AssertionDirectionType) known() bool {
+	switch c {
+	case AssertionDirectionTypeResponse,
+		AssertionDirectionTypeRequest:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AssertionDirectionType value in definition order.
+func (AssertionDirectionType) firstCode() string {
+	return string(AssertionDirectionTypeResponse)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AssertionDirectionType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AssertionDirectionType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AssertionOperatorType represents AssertionOperatorType.
 type AssertionOperatorType string
 
@@ -354,6 +924,40 @@ const (
 	AssertionOperatorTypeEval AssertionOperatorType = "eval"
 )
 
+// known reports whether c is one of the AssertionOperatorType values.
+func (c AssertionOperatorType) known() bool {
+	switch c {
+	case AssertionOperatorTypeEquals,
+		AssertionOperatorTypeNotequals,
+		AssertionOperatorTypeIn,
+		AssertionOperatorTypeNotin,
+		AssertionOperatorTypeGreaterthan,
+		AssertionOperatorTypeLessthan,
+		AssertionOperatorTypeEmpty,
+		AssertionOperatorTypeNotempty,
+		AssertionOperatorTypeContains,
+		AssertionOperatorTypeNotcontains,
+		AssertionOperatorTypeEval:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AssertionOperatorType value in definition order.
+func (AssertionOperatorType) firstCode() string {
+	return string(AssertionOperatorTypeEquals)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AssertionOperatorType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AssertionOperatorType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AssertionResponseTypes represents AssertionResponseTypes.
 type AssertionResponseTypes string
 
@@ -385,6 +989,41 @@ const (
 	AssertionResponseTypesUnprocessable AssertionResponseTypes = "unprocessable"
 )
 
+// known reports whether c is one of the AssertionResponseTypes values.
+func (c AssertionResponseTypes) known() bool {
+	switch c {
+	case AssertionResponseTypesOkay,
+		AssertionResponseTypesCreated,
+		AssertionResponseTypesNocontent,
+		AssertionResponseTypesNotmodified,
+		AssertionResponseTypesBad,
+		AssertionResponseTypesForbidden,
+		AssertionResponseTypesNotfound,
+		AssertionResponseTypesMethodnotallowed,
+		AssertionResponseTypesConflict,
+		AssertionResponseTypesGone,
+		AssertionResponseTypesPreconditionfailed,
+		AssertionResponseTypesUnprocessable:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AssertionResponseTypes value in definition order.
+func (AssertionResponseTypes) firstCode() string {
+	return string(AssertionResponseTypesOkay)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AssertionResponseTypes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AssertionResponseTypes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AuditEventAction represents AuditEventAction.
 type AuditEventAction string
 
@@ -402,6 +1041,34 @@ const (
 	AuditEventActionE AuditEventAction = "E"
 )
 
+// known reports whether c is one of the AuditEventAction values.
+func (c AuditEventAction) known() bool {
+	switch c {
+	case AuditEventActionC,
+		AuditEventActionR,
+		AuditEventActionU,
+		AuditEventActionD,
+		AuditEventActionE:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AuditEventAction value in definition order.
+func (AuditEventAction) firstCode() string {
+	return string(AuditEventActionC)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AuditEventAction) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AuditEventAction) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AuditEventOutcome represents AuditEventOutcome.
 type AuditEventOutcome string
 
@@ -417,6 +1084,33 @@ const (
 	AuditEventOutcome12 AuditEventOutcome = "12"
 )
 
+// known reports whether c is one of the AuditEventOutcome values.
+func (c AuditEventOutcome) known() bool {
+	switch c {
+	case AuditEventOutcome0,
+		AuditEventOutcome4,
+		AuditEventOutcome8,
+		AuditEventOutcome12:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AuditEventOutcome value in definition order.
+func (AuditEventOutcome) firstCode() string {
+	return string(AuditEventOutcome0)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AuditEventOutcome) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AuditEventOutcome) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // BindingStrength represents BindingStrength.
 type BindingStrength string
 
@@ -432,6 +1126,33 @@ const (
 	BindingStrengthExample BindingStrength = "example"
 )
 
+// known reports whether c is one of the BindingStrength values.
+func (c BindingStrength) known() bool {
+	switch c {
+	case BindingStrengthRequired,
+		BindingStrengthExtensible,
+		BindingStrengthPreferred,
+		BindingStrengthExample:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first BindingStrength value in definition order.
+func (BindingStrength) firstCode() string {
+	return string(BindingStrengthRequired)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c BindingStrength) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *BindingStrength) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // BundleType represents BundleType.
 type BundleType string
 
@@ -457,6 +1178,38 @@ const (
 	BundleTypeCollection BundleType = "collection"
 )
 
+// known reports whether c is one of the BundleType values.
+func (c BundleType) known() bool {
+	switch c {
+	case BundleTypeDocument,
+		BundleTypeMessage,
+		BundleTypeTransaction,
+		BundleTypeTransactionResponse,
+		BundleTypeBatch,
+		BundleTypeBatchResponse,
+		BundleTypeHistory,
+		BundleTypeSearchset,
+		BundleTypeCollection:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first BundleType value in definition order.
+func (BundleType) firstCode() string {
+	return string(BundleTypeDocument)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c BundleType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *BundleType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CapabilityStatementKind represents CapabilityStatementKind.
 type CapabilityStatementKind string
 
@@ -470,6 +1223,32 @@ const (
 	CapabilityStatementKindRequirements CapabilityStatementKind = "requirements"
 )
 
+// known reports whether c is one of the CapabilityStatementKind values.
+func (c CapabilityStatementKind) known() bool {
+	switch c {
+	case CapabilityStatementKindInstance,
+		CapabilityStatementKindCapability,
+		CapabilityStatementKindRequirements:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CapabilityStatementKind value in definition order.
+func (CapabilityStatementKind) firstCode() string {
+	return string(CapabilityStatementKindInstance)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CapabilityStatementKind) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CapabilityStatementKind) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CarePlanActivityKind represents Care Plan Activity Kind.
 type CarePlanActivityKind string
 
@@ -485,6 +1264,37 @@ const (
 	CarePlanActivityKindVisionprescription   CarePlanActivityKind = "VisionPrescription"
 )
 
+// known reports whether c is one of the CarePlanActivityKind values.
+func (c CarePlanActivityKind) known() bool {
+	switch c {
+	case CarePlanActivityKindAppointment,
+		CarePlanActivityKindCommunicationrequest,
+		CarePlanActivityKindDevicerequest,
+		CarePlanActivityKindMedicationrequest,
+		CarePlanActivityKindNutritionorder,
+		CarePlanActivityKindTask,
+		CarePlanActivityKindServicerequest,
+		CarePlanActivityKindVisionprescription:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CarePlanActivityKind value in definition order.
+func (CarePlanActivityKind) firstCode() string {
+	return string(CarePlanActivityKindAppointment)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CarePlanActivityKind) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CarePlanActivityKind) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CarePlanActivityStatus represents CarePlanActivityStatus.
 type CarePlanActivityStatus string
 
@@ -510,6 +1320,38 @@ const (
 	CarePlanActivityStatusEnteredInError CarePlanActivityStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the CarePlanActivityStatus values.
+func (c CarePlanActivityStatus) known() bool {
+	switch c {
+	case CarePlanActivityStatusNotStarted,
+		CarePlanActivityStatusScheduled,
+		CarePlanActivityStatusInProgress,
+		CarePlanActivityStatusOnHold,
+		CarePlanActivityStatusCompleted,
+		CarePlanActivityStatusCancelled,
+		CarePlanActivityStatusStopped,
+		CarePlanActivityStatusUnknown,
+		CarePlanActivityStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CarePlanActivityStatus value in definition order.
+func (CarePlanActivityStatus) firstCode() string {
+	return string(CarePlanActivityStatusNotStarted)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CarePlanActivityStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CarePlanActivityStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CarePlanIntent represents Care Plan Intent.
 type CarePlanIntent string
 
@@ -521,6 +1363,33 @@ const (
 	CarePlanIntentOption   CarePlanIntent = "option"
 )
 
+// known reports whether c is one of the CarePlanIntent values.
+func (c CarePlanIntent) known() bool {
+	switch c {
+	case CarePlanIntentProposal,
+		CarePlanIntentPlan,
+		CarePlanIntentOrder,
+		CarePlanIntentOption:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CarePlanIntent value in definition order.
+func (CarePlanIntent) firstCode() string {
+	return string(CarePlanIntentProposal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CarePlanIntent) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CarePlanIntent) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CareTeamStatus represents CareTeamStatus.
 type CareTeamStatus string
 
@@ -538,6 +1407,34 @@ const (
 	CareTeamStatusEnteredInError CareTeamStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the CareTeamStatus values.
+func (c CareTeamStatus) known() bool {
+	switch c {
+	case CareTeamStatusProposed,
+		CareTeamStatusActive,
+		CareTeamStatusSuspended,
+		CareTeamStatusInactive,
+		CareTeamStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CareTeamStatus value in definition order.
+func (CareTeamStatus) firstCode() string {
+	return string(CareTeamStatusProposed)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CareTeamStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CareTeamStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ChargeItemStatus represents ChargeItemStatus.
 type ChargeItemStatus string
 
@@ -559,6 +1456,36 @@ const (
 	ChargeItemStatusUnknown ChargeItemStatus = "unknown"
 )
 
+// known reports whether c is one of the ChargeItemStatus values.
+func (c ChargeItemStatus) known() bool {
+	switch c {
+	case ChargeItemStatusPlanned,
+		ChargeItemStatusBillable,
+		ChargeItemStatusNotBillable,
+		ChargeItemStatusAborted,
+		ChargeItemStatusBilled,
+		ChargeItemStatusEnteredInError,
+		ChargeItemStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ChargeItemStatus value in definition order.
+func (ChargeItemStatus) firstCode() string {
+	return string(ChargeItemStatusPlanned)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ChargeItemStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ChargeItemStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // Use represents Use.
 type Use string
 
@@ -572,6 +1499,32 @@ const (
 	UsePredetermination Use = "predetermination"
 )
 
+// known reports whether c is one of the Use values.
+func (c Use) known() bool {
+	switch c {
+	case UseClaim,
+		UsePreauthorization,
+		UsePredetermination:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first Use value in definition order.
+func (Use) firstCode() string {
+	return string(UseClaim)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c Use) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *Use) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ClinicalImpressionStatus represents Clinical Impression Status.
 type ClinicalImpressionStatus string
 
@@ -582,6 +1535,32 @@ const (
 	ClinicalImpressionStatusEnteredInError ClinicalImpressionStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the ClinicalImpressionStatus values.
+func (c ClinicalImpressionStatus) known() bool {
+	switch c {
+	case ClinicalImpressionStatusInProgress,
+		ClinicalImpressionStatusCompleted,
+		ClinicalImpressionStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ClinicalImpressionStatus value in definition order.
+func (ClinicalImpressionStatus) firstCode() string {
+	return string(ClinicalImpressionStatusInProgress)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ClinicalImpressionStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ClinicalImpressionStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CodeSearchSupport represents CodeSearchSupport.
 type CodeSearchSupport string
 
@@ -593,6 +1572,31 @@ const (
 	CodeSearchSupportAll CodeSearchSupport = "all"
 )
 
+// known reports whether c is one of the CodeSearchSupport values.
+func (c CodeSearchSupport) known() bool {
+	switch c {
+	case CodeSearchSupportExplicit,
+		CodeSearchSupportAll:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CodeSearchSupport value in definition order.
+func (CodeSearchSupport) firstCode() string {
+	return string(CodeSearchSupportExplicit)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CodeSearchSupport) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CodeSearchSupport) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CodeSystemContentMode represents CodeSystemContentMode.
 type CodeSystemContentMode string
 
@@ -610,6 +1614,34 @@ const (
 	CodeSystemContentModeSupplement CodeSystemContentMode = "supplement"
 )
 
+// known reports whether c is one of the CodeSystemContentMode values.
+func (c CodeSystemContentMode) known() bool {
+	switch c {
+	case CodeSystemContentModeNotPresent,
+		CodeSystemContentModeExample,
+		CodeSystemContentModeFragment,
+		CodeSystemContentModeComplete,
+		CodeSystemContentModeSupplement:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CodeSystemContentMode value in definition order.
+func (CodeSystemContentMode) firstCode() string {
+	return string(CodeSystemContentModeNotPresent)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CodeSystemContentMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CodeSystemContentMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CodeSystemHierarchyMeaning represents CodeSystemHierarchyMeaning.
 type CodeSystemHierarchyMeaning string
 
@@ -625,6 +1657,33 @@ const (
 	CodeSystemHierarchyMeaningClassifiedWith CodeSystemHierarchyMeaning = "classified-with"
 )
 
+// known reports whether c is one of the CodeSystemHierarchyMeaning values.
+func (c CodeSystemHierarchyMeaning) known() bool {
+	switch c {
+	case CodeSystemHierarchyMeaningGroupedBy,
+		CodeSystemHierarchyMeaningIsA,
+		CodeSystemHierarchyMeaningPartOf,
+		CodeSystemHierarchyMeaningClassifiedWith:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CodeSystemHierarchyMeaning value in definition order.
+func (CodeSystemHierarchyMeaning) firstCode() string {
+	return string(CodeSystemHierarchyMeaningGroupedBy)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CodeSystemHierarchyMeaning) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CodeSystemHierarchyMeaning) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CompartmentType represents CompartmentType.
 type CompartmentType string
 
@@ -642,6 +1701,34 @@ const (
 	CompartmentTypeDevice CompartmentType = "Device"
 )
 
+// known reports whether c is one of the CompartmentType values.
+func (c CompartmentType) known() bool {
+	switch c {
+	case CompartmentTypePatient,
+		CompartmentTypeEncounter,
+		CompartmentTypeRelatedperson,
+		CompartmentTypePractitioner,
+		CompartmentTypeDevice:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CompartmentType value in definition order.
+func (CompartmentType) firstCode() string {
+	return string(CompartmentTypePatient)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CompartmentType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CompartmentType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CompositionAttestationMode represents CompositionAttestationMode.
 type CompositionAttestationMode string
 
@@ -657,6 +1744,33 @@ const (
 	CompositionAttestationModeOfficial CompositionAttestationMode = "official"
 )
 
+// known reports whether c is one of the CompositionAttestationMode values.
+func (c CompositionAttestationMode) known() bool {
+	switch c {
+	case CompositionAttestationModePersonal,
+		CompositionAttestationModeProfessional,
+		CompositionAttestationModeLegal,
+		CompositionAttestationModeOfficial:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CompositionAttestationMode value in definition order.
+func (CompositionAttestationMode) firstCode() string {
+	return string(CompositionAttestationModePersonal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CompositionAttestationMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CompositionAttestationMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CompositionStatus represents CompositionStatus.
 type CompositionStatus string
 
@@ -672,6 +1786,33 @@ const (
 	CompositionStatusEnteredInError CompositionStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the CompositionStatus values.
+func (c CompositionStatus) known() bool {
+	switch c {
+	case CompositionStatusPreliminary,
+		CompositionStatusFinal,
+		CompositionStatusAmended,
+		CompositionStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CompositionStatus value in definition order.
+func (CompositionStatus) firstCode() string {
+	return string(CompositionStatusPreliminary)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CompositionStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CompositionStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConceptMapEquivalence represents ConceptMapEquivalence.
 type ConceptMapEquivalence string
 
@@ -699,6 +1840,39 @@ const (
 	ConceptMapEquivalenceDisjoint ConceptMapEquivalence = "disjoint"
 )
 
+// known reports whether c is one of the ConceptMapEquivalence values.
+func (c ConceptMapEquivalence) known() bool {
+	switch c {
+	case ConceptMapEquivalenceRelatedto,
+		ConceptMapEquivalenceEquivalent,
+		ConceptMapEquivalenceEqual,
+		ConceptMapEquivalenceWider,
+		ConceptMapEquivalenceSubsumes,
+		ConceptMapEquivalenceNarrower,
+		ConceptMapEquivalenceSpecializes,
+		ConceptMapEquivalenceInexact,
+		ConceptMapEquivalenceUnmatched,
+		ConceptMapEquivalenceDisjoint:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConceptMapEquivalence value in definition order.
+func (ConceptMapEquivalence) firstCode() string {
+	return string(ConceptMapEquivalenceRelatedto)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConceptMapEquivalence) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConceptMapEquivalence) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // PropertyType represents PropertyType.
 type PropertyType string
 
@@ -720,6 +1894,36 @@ const (
 	PropertyTypeDecimal PropertyType = "decimal"
 )
 
+// known reports whether c is one of the PropertyType values.
+func (c PropertyType) known() bool {
+	switch c {
+	case PropertyTypeCode,
+		PropertyTypeCoding,
+		PropertyTypeString,
+		PropertyTypeInteger,
+		PropertyTypeBoolean,
+		PropertyTypeDatetime,
+		PropertyTypeDecimal:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first PropertyType value in definition order.
+func (PropertyType) firstCode() string {
+	return string(PropertyTypeCode)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c PropertyType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *PropertyType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConceptMapGroupUnmappedMode represents ConceptMapGroupUnmappedMode.
 type ConceptMapGroupUnmappedMode string
 
@@ -733,6 +1937,32 @@ const (
 	ConceptMapGroupUnmappedModeOtherMap ConceptMapGroupUnmappedMode = "other-map"
 )
 
+// known reports whether c is one of the ConceptMapGroupUnmappedMode values.
+func (c ConceptMapGroupUnmappedMode) known() bool {
+	switch c {
+	case ConceptMapGroupUnmappedModeProvided,
+		ConceptMapGroupUnmappedModeFixed,
+		ConceptMapGroupUnmappedModeOtherMap:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConceptMapGroupUnmappedMode value in definition order.
+func (ConceptMapGroupUnmappedMode) firstCode() string {
+	return string(ConceptMapGroupUnmappedModeProvided)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConceptMapGroupUnmappedMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConceptMapGroupUnmappedMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConditionalDeleteStatus represents ConditionalDeleteStatus.
 type ConditionalDeleteStatus string
 
@@ -746,6 +1976,32 @@ const (
 	ConditionalDeleteStatusMultiple ConditionalDeleteStatus = "multiple"
 )
 
+// known reports whether c is one of the ConditionalDeleteStatus values.
+func (c ConditionalDeleteStatus) known() bool {
+	switch c {
+	case ConditionalDeleteStatusNotSupported,
+		ConditionalDeleteStatusSingle,
+		ConditionalDeleteStatusMultiple:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConditionalDeleteStatus value in definition order.
+func (ConditionalDeleteStatus) firstCode() string {
+	return string(ConditionalDeleteStatusNotSupported)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConditionalDeleteStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConditionalDeleteStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConditionalReadStatus represents ConditionalReadStatus.
 type ConditionalReadStatus string
 
@@ -761,6 +2017,33 @@ const (
 	ConditionalReadStatusFullSupport ConditionalReadStatus = "full-support"
 )
 
+// known reports whether c is one of the ConditionalReadStatus values.
+func (c ConditionalReadStatus) known() bool {
+	switch c {
+	case ConditionalReadStatusNotSupported,
+		ConditionalReadStatusModifiedSince,
+		ConditionalReadStatusNotMatch,
+		ConditionalReadStatusFullSupport:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConditionalReadStatus value in definition order.
+func (ConditionalReadStatus) firstCode() string {
+	return string(ConditionalReadStatusNotSupported)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConditionalReadStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConditionalReadStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConsentDataMeaning represents ConsentDataMeaning.
 type ConsentDataMeaning string
 
@@ -776,6 +2059,33 @@ const (
 	ConsentDataMeaningAuthoredby ConsentDataMeaning = "authoredby"
 )
 
+// known reports whether c is one of the ConsentDataMeaning values.
+func (c ConsentDataMeaning) known() bool {
+	switch c {
+	case ConsentDataMeaningInstance,
+		ConsentDataMeaningRelated,
+		ConsentDataMeaningDependents,
+		ConsentDataMeaningAuthoredby:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConsentDataMeaning value in definition order.
+func (ConsentDataMeaning) firstCode() string {
+	return string(ConsentDataMeaningInstance)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConsentDataMeaning) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConsentDataMeaning) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConsentProvisionType represents ConsentProvisionType.
 type ConsentProvisionType string
 
@@ -787,6 +2097,31 @@ const (
 	ConsentProvisionTypePermit ConsentProvisionType = "permit"
 )
 
+// known reports whether c is one of the ConsentProvisionType values.
+func (c ConsentProvisionType) known() bool {
+	switch c {
+	case ConsentProvisionTypeDeny,
+		ConsentProvisionTypePermit:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConsentProvisionType value in definition order.
+func (ConsentProvisionType) firstCode() string {
+	return string(ConsentProvisionTypeDeny)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConsentProvisionType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConsentProvisionType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConsentState represents ConsentState.
 type ConsentState string
 
@@ -806,6 +2141,35 @@ const (
 	ConsentStateEnteredInError ConsentState = "entered-in-error"
 )
 
+// known reports whether c is one of the ConsentState values.
+func (c ConsentState) known() bool {
+	switch c {
+	case ConsentStateDraft,
+		ConsentStateProposed,
+		ConsentStateActive,
+		ConsentStateRejected,
+		ConsentStateInactive,
+		ConsentStateEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConsentState value in definition order.
+func (ConsentState) firstCode() string {
+	return string(ConsentStateDraft)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConsentState) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConsentState) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConstraintSeverity represents ConstraintSeverity.
 type ConstraintSeverity string
 
@@ -817,6 +2181,31 @@ const (
 	ConstraintSeverityWarning ConstraintSeverity = "warning"
 )
 
+// known reports whether c is one of the ConstraintSeverity values.
+func (c ConstraintSeverity) known() bool {
+	switch c {
+	case ConstraintSeverityError,
+		ConstraintSeverityWarning:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConstraintSeverity value in definition order.
+func (ConstraintSeverity) firstCode() string {
+	return string(ConstraintSeverityError)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConstraintSeverity) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConstraintSeverity) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ContactPointSystem represents ContactPointSystem.
 type ContactPointSystem string
 
@@ -838,6 +2227,36 @@ const (
 	ContactPointSystemOther ContactPointSystem = "other"
 )
 
+// known reports whether c is one of the ContactPointSystem values.
+func (c ContactPointSystem) known() bool {
+	switch c {
+	case ContactPointSystemPhone,
+		ContactPointSystemFax,
+		ContactPointSystemEmail,
+		ContactPointSystemPager,
+		ContactPointSystemUrl,
+		ContactPointSystemSms,
+		ContactPointSystemOther:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ContactPointSystem value in definition order.
+func (ContactPointSystem) firstCode() string {
+	return string(ContactPointSystemPhone)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ContactPointSystem) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ContactPointSystem) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ContactPointUse represents ContactPointUse.
 type ContactPointUse string
 
@@ -855,6 +2274,34 @@ const (
 	ContactPointUseMobile ContactPointUse = "mobile"
 )
 
+// known reports whether c is one of the ContactPointUse values.
+func (c ContactPointUse) known() bool {
+	switch c {
+	case ContactPointUseHome,
+		ContactPointUseWork,
+		ContactPointUseTemp,
+		ContactPointUseOld,
+		ContactPointUseMobile:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ContactPointUse value in definition order.
+func (ContactPointUse) firstCode() string {
+	return string(ContactPointUseHome)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ContactPointUse) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ContactPointUse) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ContractResourcePublicationStatusCodes represents Contract Resource Publication Status codes.
 type ContractResourcePublicationStatusCodes string
 
@@ -892,6 +2339,44 @@ const (
 	ContractResourcePublicationStatusCodesTerminated ContractResourcePublicationStatusCodes = "terminated"
 )
 
+// known reports whether c is one of the ContractResourcePublicationStatusCodes values.
+func (c ContractResourcePublicationStatusCodes) known() bool {
+	switch c {
+	case ContractResourcePublicationStatusCodesAmended,
+		ContractResourcePublicationStatusCodesAppended,
+		ContractResourcePublicationStatusCodesCancelled,
+		ContractResourcePublicationStatusCodesDisputed,
+		ContractResourcePublicationStatusCodesEnteredInError,
+		ContractResourcePublicationStatusCodesExecutable,
+		ContractResourcePublicationStatusCodesExecuted,
+		ContractResourcePublicationStatusCodesNegotiable,
+		ContractResourcePublicationStatusCodesOffered,
+		ContractResourcePublicationStatusCodesPolicy,
+		ContractResourcePublicationStatusCodesRejected,
+		ContractResourcePublicationStatusCodesRenewed,
+		ContractResourcePublicationStatusCodesRevoked,
+		ContractResourcePublicationStatusCodesResolved,
+		ContractResourcePublicationStatusCodesTerminated:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ContractResourcePublicationStatusCodes value in definition order.
+func (ContractResourcePublicationStatusCodes) firstCode() string {
+	return string(ContractResourcePublicationStatusCodesAmended)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ContractResourcePublicationStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ContractResourcePublicationStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ContractResourceStatusCodes represents Contract Resource Status Codes.
 type ContractResourceStatusCodes string
 
@@ -929,6 +2414,44 @@ const (
 	ContractResourceStatusCodesTerminated ContractResourceStatusCodes = "terminated"
 )
 
+// known reports whether c is one of the ContractResourceStatusCodes values.
+func (c ContractResourceStatusCodes) known() bool {
+	switch c {
+	case ContractResourceStatusCodesAmended,
+		ContractResourceStatusCodesAppended,
+		ContractResourceStatusCodesCancelled,
+		ContractResourceStatusCodesDisputed,
+		ContractResourceStatusCodesEnteredInError,
+		ContractResourceStatusCodesExecutable,
+		ContractResourceStatusCodesExecuted,
+		ContractResourceStatusCodesNegotiable,
+		ContractResourceStatusCodesOffered,
+		ContractResourceStatusCodesPolicy,
+		ContractResourceStatusCodesRejected,
+		ContractResourceStatusCodesRenewed,
+		ContractResourceStatusCodesRevoked,
+		ContractResourceStatusCodesResolved,
+		ContractResourceStatusCodesTerminated:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ContractResourceStatusCodes value in definition order.
+func (ContractResourceStatusCodes) firstCode() string {
+	return string(ContractResourceStatusCodesAmended)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ContractResourceStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ContractResourceStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ContributorType represents ContributorType.
 type ContributorType string
 
@@ -944,6 +2467,33 @@ const (
 	ContributorTypeEndorser ContributorType = "endorser"
 )
 
+// known reports whether c is one of the ContributorType values.
+func (c ContributorType) known() bool {
+	switch c {
+	case ContributorTypeAuthor,
+		ContributorTypeEditor,
+		ContributorTypeReviewer,
+		ContributorTypeEndorser:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ContributorType value in definition order.
+func (ContributorType) firstCode() string {
+	return string(ContributorTypeAuthor)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ContributorType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ContributorType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DaysOfWeek represents DaysOfWeek.
 type DaysOfWeek string
 
@@ -965,6 +2515,36 @@ const (
 	DaysOfWeekSun DaysOfWeek = "sun"
 )
 
+// known reports whether c is one of the DaysOfWeek values.
+func (c DaysOfWeek) known() bool {
+	switch c {
+	case DaysOfWeekMon,
+		DaysOfWeekTue,
+		DaysOfWeekWed,
+		DaysOfWeekThu,
+		DaysOfWeekFri,
+		DaysOfWeekSat,
+		DaysOfWeekSun:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DaysOfWeek value in definition order.
+func (DaysOfWeek) firstCode() string {
+	return string(DaysOfWeekMon)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DaysOfWeek) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DaysOfWeek) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DetectedIssueSeverity represents DetectedIssueSeverity.
 type DetectedIssueSeverity string
 
@@ -978,6 +2558,32 @@ const (
 	DetectedIssueSeverityLow DetectedIssueSeverity = "low"
 )
 
+// known reports whether c is one of the DetectedIssueSeverity values.
+func (c DetectedIssueSeverity) known() bool {
+	switch c {
+	case DetectedIssueSeverityHigh,
+		DetectedIssueSeverityModerate,
+		DetectedIssueSeverityLow:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DetectedIssueSeverity value in definition order.
+func (DetectedIssueSeverity) firstCode() string {
+	return string(DetectedIssueSeverityHigh)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DetectedIssueSeverity) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DetectedIssueSeverity) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceNameType represents DeviceNameType.
 type DeviceNameType string
 
@@ -997,6 +2603,35 @@ const (
 	DeviceNameTypeOther DeviceNameType = "other"
 )
 
+// known reports whether c is one of the DeviceNameType values.
+func (c DeviceNameType) known() bool {
+	switch c {
+	case DeviceNameTypeUdiLabelName,
+		DeviceNameTypeUserFriendlyName,
+		DeviceNameTypePatientReportedName,
+		DeviceNameTypeManufacturerName,
+		DeviceNameTypeModelName,
+		DeviceNameTypeOther:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceNameType value in definition order.
+func (DeviceNameType) firstCode() string {
+	return string(DeviceNameTypeUdiLabelName)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceNameType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceNameType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceUseStatementStatus represents DeviceUseStatementStatus.
 type DeviceUseStatementStatus string
 
@@ -1016,6 +2651,35 @@ const (
 	DeviceUseStatementStatusOnHold DeviceUseStatementStatus = "on-hold"
 )
 
+// known reports whether c is one of the DeviceUseStatementStatus values.
+func (c DeviceUseStatementStatus) known() bool {
+	switch c {
+	case DeviceUseStatementStatusActive,
+		DeviceUseStatementStatusCompleted,
+		DeviceUseStatementStatusEnteredInError,
+		DeviceUseStatementStatusIntended,
+		DeviceUseStatementStatusStopped,
+		DeviceUseStatementStatusOnHold:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceUseStatementStatus value in definition order.
+func (DeviceUseStatementStatus) firstCode() string {
+	return string(DeviceUseStatementStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceUseStatementStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceUseStatementStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // FHIRDeviceStatus represents FHIRDeviceStatus.
 type FHIRDeviceStatus string
 
@@ -1031,6 +2695,33 @@ const (
 	FHIRDeviceStatusUnknown FHIRDeviceStatus = "unknown"
 )
 
+// known reports whether c is one of the FHIRDeviceStatus values.
+func (c FHIRDeviceStatus) known() bool {
+	switch c {
+	case FHIRDeviceStatusActive,
+		FHIRDeviceStatusInactive,
+		FHIRDeviceStatusEnteredInError,
+		FHIRDeviceStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FHIRDeviceStatus value in definition order.
+func (FHIRDeviceStatus) firstCode() string {
+	return string(FHIRDeviceStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FHIRDeviceStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FHIRDeviceStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DiagnosticReportStatus represents DiagnosticReportStatus.
 type DiagnosticReportStatus string
 
@@ -1058,6 +2749,39 @@ const (
 	DiagnosticReportStatusUnknown DiagnosticReportStatus = "unknown"
 )
 
+// known reports whether c is one of the DiagnosticReportStatus values.
+func (c DiagnosticReportStatus) known() bool {
+	switch c {
+	case DiagnosticReportStatusRegistered,
+		DiagnosticReportStatusPartial,
+		DiagnosticReportStatusPreliminary,
+		DiagnosticReportStatusFinal,
+		DiagnosticReportStatusAmended,
+		DiagnosticReportStatusCorrected,
+		DiagnosticReportStatusAppended,
+		DiagnosticReportStatusCancelled,
+		DiagnosticReportStatusEnteredInError,
+		DiagnosticReportStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DiagnosticReportStatus value in definition order.
+func (DiagnosticReportStatus) firstCode() string {
+	return string(DiagnosticReportStatusRegistered)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DiagnosticReportStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DiagnosticReportStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DiscriminatorType represents DiscriminatorType.
 type DiscriminatorType string
 
@@ -1075,6 +2799,34 @@ const (
 	DiscriminatorTypeProfile DiscriminatorType = "profile"
 )
 
+// known reports whether c is one of the DiscriminatorType values.
+func (c DiscriminatorType) known() bool {
+	switch c {
+	case DiscriminatorTypeValue,
+		DiscriminatorTypeExists,
+		DiscriminatorTypePattern,
+		DiscriminatorTypeType,
+		DiscriminatorTypeProfile:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DiscriminatorType value in definition order.
+func (DiscriminatorType) firstCode() string {
+	return string(DiscriminatorTypeValue)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DiscriminatorType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DiscriminatorType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DocumentMode represents DocumentMode.
 type DocumentMode string
 
@@ -1086,6 +2838,31 @@ const (
 	DocumentModeConsumer DocumentMode = "consumer"
 )
 
+// known reports whether c is one of the DocumentMode values.
+func (c DocumentMode) known() bool {
+	switch c {
+	case DocumentModeProducer,
+		DocumentModeConsumer:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DocumentMode value in definition order.
+func (DocumentMode) firstCode() string {
+	return string(DocumentModeProducer)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DocumentMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DocumentMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DocumentReferenceStatus represents DocumentReferenceStatus.
 type DocumentReferenceStatus string
 
@@ -1099,6 +2876,32 @@ const (
 	DocumentReferenceStatusEnteredInError DocumentReferenceStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the DocumentReferenceStatus values.
+func (c DocumentReferenceStatus) known() bool {
+	switch c {
+	case DocumentReferenceStatusCurrent,
+		DocumentReferenceStatusSuperseded,
+		DocumentReferenceStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DocumentReferenceStatus value in definition order.
+func (DocumentReferenceStatus) firstCode() string {
+	return string(DocumentReferenceStatusCurrent)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DocumentReferenceStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DocumentReferenceStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DocumentRelationshipType represents DocumentRelationshipType.
 type DocumentRelationshipType string
 
@@ -1114,6 +2917,33 @@ const (
 	DocumentRelationshipTypeAppends DocumentRelationshipType = "appends"
 )
 
+// known reports whether c is one of the DocumentRelationshipType values.
+func (c DocumentRelationshipType) known() bool {
+	switch c {
+	case DocumentRelationshipTypeReplaces,
+		DocumentRelationshipTypeTransforms,
+		DocumentRelationshipTypeSigns,
+		DocumentRelationshipTypeAppends:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DocumentRelationshipType value in definition order.
+func (DocumentRelationshipType) firstCode() string {
+	return string(DocumentRelationshipTypeReplaces)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DocumentRelationshipType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DocumentRelationshipType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EligibilityRequestPurpose represents EligibilityRequestPurpose.
 type EligibilityRequestPurpose string
 
@@ -1129,6 +2959,33 @@ const (
 	EligibilityRequestPurposeValidation EligibilityRequestPurpose = "validation"
 )
 
+// known reports whether c is one of the EligibilityRequestPurpose values.
+func (c EligibilityRequestPurpose) known() bool {
+	switch c {
+	case EligibilityRequestPurposeAuthRequirements,
+		EligibilityRequestPurposeBenefits,
+		EligibilityRequestPurposeDiscovery,
+		EligibilityRequestPurposeValidation:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EligibilityRequestPurpose value in definition order.
+func (EligibilityRequestPurpose) firstCode() string {
+	return string(EligibilityRequestPurposeAuthRequirements)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EligibilityRequestPurpose) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EligibilityRequestPurpose) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EligibilityResponsePurpose represents EligibilityResponsePurpose.
 type EligibilityResponsePurpose string
 
@@ -1144,6 +3001,33 @@ const (
 	EligibilityResponsePurposeValidation EligibilityResponsePurpose = "validation"
 )
 
+// known reports whether c is one of the EligibilityResponsePurpose values.
+func (c EligibilityResponsePurpose) known() bool {
+	switch c {
+	case EligibilityResponsePurposeAuthRequirements,
+		EligibilityResponsePurposeBenefits,
+		EligibilityResponsePurposeDiscovery,
+		EligibilityResponsePurposeValidation:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EligibilityResponsePurpose value in definition order.
+func (EligibilityResponsePurpose) firstCode() string {
+	return string(EligibilityResponsePurposeAuthRequirements)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EligibilityResponsePurpose) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EligibilityResponsePurpose) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EncounterLocationStatus represents EncounterLocationStatus.
 type EncounterLocationStatus string
 
@@ -1159,6 +3043,33 @@ const (
 	EncounterLocationStatusCompleted EncounterLocationStatus = "completed"
 )
 
+// known reports whether c is one of the EncounterLocationStatus values.
+func (c EncounterLocationStatus) known() bool {
+	switch c {
+	case EncounterLocationStatusPlanned,
+		EncounterLocationStatusActive,
+		EncounterLocationStatusReserved,
+		EncounterLocationStatusCompleted:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EncounterLocationStatus value in definition order.
+func (EncounterLocationStatus) firstCode() string {
+	return string(EncounterLocationStatusPlanned)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EncounterLocationStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EncounterLocationStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EncounterStatus represents EncounterStatus.
 type EncounterStatus string
 
@@ -1184,6 +3095,38 @@ const (
 	EncounterStatusUnknown EncounterStatus = "unknown"
 )
 
+// known reports whether c is one of the EncounterStatus values.
+func (c EncounterStatus) known() bool {
+	switch c {
+	case EncounterStatusPlanned,
+		EncounterStatusArrived,
+		EncounterStatusTriaged,
+		EncounterStatusInProgress,
+		EncounterStatusOnleave,
+		EncounterStatusFinished,
+		EncounterStatusCancelled,
+		EncounterStatusEnteredInError,
+		EncounterStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EncounterStatus value in definition order.
+func (EncounterStatus) firstCode() string {
+	return string(EncounterStatusPlanned)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EncounterStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EncounterStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EndpointStatus represents EndpointStatus.
 type EndpointStatus string
 
@@ -1203,6 +3146,35 @@ const (
 	EndpointStatusTest EndpointStatus = "test"
 )
 
+// known reports whether c is one of the EndpointStatus values.
+func (c EndpointStatus) known() bool {
+	switch c {
+	case EndpointStatusActive,
+		EndpointStatusSuspended,
+		EndpointStatusError,
+		EndpointStatusOff,
+		EndpointStatusEnteredInError,
+		EndpointStatusTest:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EndpointStatus value in definition order.
+func (EndpointStatus) firstCode() string {
+	return string(EndpointStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EndpointStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EndpointStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EpisodeOfCareStatus represents EpisodeOfCareStatus.
 type EpisodeOfCareStatus string
 
@@ -1224,6 +3196,36 @@ const (
 	EpisodeOfCareStatusEnteredInError EpisodeOfCareStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the EpisodeOfCareStatus values.
+func (c EpisodeOfCareStatus) known() bool {
+	switch c {
+	case EpisodeOfCareStatusPlanned,
+		EpisodeOfCareStatusWaitlist,
+		EpisodeOfCareStatusActive,
+		EpisodeOfCareStatusOnhold,
+		EpisodeOfCareStatusFinished,
+		EpisodeOfCareStatusCancelled,
+		EpisodeOfCareStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EpisodeOfCareStatus value in definition order.
+func (EpisodeOfCareStatus) firstCode() string {
+	return string(EpisodeOfCareStatusPlanned)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EpisodeOfCareStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EpisodeOfCareStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EventCapabilityMode represents EventCapabilityMode.
 type EventCapabilityMode string
 
@@ -1235,6 +3237,31 @@ const (
 	EventCapabilityModeReceiver EventCapabilityMode = "receiver"
 )
 
+// known reports whether c is one of the EventCapabilityMode values.
+func (c EventCapabilityMode) known() bool {
+	switch c {
+	case EventCapabilityModeSender,
+		EventCapabilityModeReceiver:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EventCapabilityMode value in definition order.
+func (EventCapabilityMode) firstCode() string {
+	return string(EventCapabilityModeSender)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EventCapabilityMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EventCapabilityMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EventStatus represents EventStatus.
 type EventStatus string
 
@@ -1258,6 +3285,37 @@ const (
 	EventStatusUnknown EventStatus = "unknown"
 )
 
+// known reports whether c is one of the EventStatus values.
+func (c EventStatus) known() bool {
+	switch c {
+	case EventStatusPreparation,
+		EventStatusInProgress,
+		EventStatusNotDone,
+		EventStatusOnHold,
+		EventStatusStopped,
+		EventStatusCompleted,
+		EventStatusEnteredInError,
+		EventStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EventStatus value in definition order.
+func (EventStatus) firstCode() string {
+	return string(EventStatusPreparation)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EventStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EventStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EventTiming represents EventTiming.
 type EventTiming string
 
@@ -1303,6 +3361,55 @@ const (
 	EventTimingPcv  EventTiming = "PCV"
 )
 
+// known reports whether c is one of the EventTiming values.
+func (c EventTiming) known() bool {
+	switch c {
+	case EventTimingMorn,
+		EventTimingMornEarly,
+		EventTimingMornLate,
+		EventTimingNoon,
+		EventTimingAft,
+		EventTimingAftEarly,
+		EventTimingAftLate,
+		EventTimingEve,
+		EventTimingEveEarly,
+		EventTimingEveLate,
+		EventTimingNight,
+		EventTimingPhs,
+		EventTimingHs,
+		EventTimingWake,
+		EventTimingC,
+		EventTimingCm,
+		EventTimingCd,
+		EventTimingCv,
+		EventTimingAc,
+		EventTimingAcm,
+		EventTimingAcd,
+		EventTimingAcv,
+		EventTimingPc,
+		EventTimingPcm,
+		EventTimingPcd,
+		EventTimingPcv:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EventTiming value in definition order.
+func (EventTiming) firstCode() string {
+	return string(EventTimingMorn)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EventTiming) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EventTiming) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ExampleScenarioActorType represents ExampleScenarioActorType.
 type ExampleScenarioActorType string
 
@@ -1314,6 +3421,31 @@ const (
 	ExampleScenarioActorTypeEntity ExampleScenarioActorType = "entity"
 )
 
+// known reports whether c is one of the ExampleScenarioActorType values.
+func (c ExampleScenarioActorType) known() bool {
+	switch c {
+	case ExampleScenarioActorTypePerson,
+		ExampleScenarioActorTypeEntity:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ExampleScenarioActorType value in definition order.
+func (ExampleScenarioActorType) firstCode() string {
+	return string(ExampleScenarioActorTypePerson)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ExampleScenarioActorType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ExampleScenarioActorType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ExplanationOfBenefitStatus represents ExplanationOfBenefitStatus.
 type ExplanationOfBenefitStatus string
 
@@ -1329,6 +3461,33 @@ const (
 	ExplanationOfBenefitStatusEnteredInError ExplanationOfBenefitStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the ExplanationOfBenefitStatus values.
+func (c ExplanationOfBenefitStatus) known() bool {
+	switch c {
+	case ExplanationOfBenefitStatusActive,
+		ExplanationOfBenefitStatusCancelled,
+		ExplanationOfBenefitStatusDraft,
+		ExplanationOfBenefitStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ExplanationOfBenefitStatus value in definition order.
+func (ExplanationOfBenefitStatus) firstCode() string {
+	return string(ExplanationOfBenefitStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ExplanationOfBenefitStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ExplanationOfBenefitStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ExposureState represents ExposureState.
 type ExposureState string
 
@@ -1340,6 +3499,31 @@ const (
 	ExposureStateExposureAlternative ExposureState = "exposure-alternative"
 )
 
+// known reports whether c is one of the ExposureState values.
+func (c ExposureState) known() bool {
+	switch c {
+	case ExposureStateExposure,
+		ExposureStateExposureAlternative:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ExposureState value in definition order.
+func (ExposureState) firstCode() string {
+	return string(ExposureStateExposure)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ExposureState) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ExposureState) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ExtensionContextType represents ExtensionContextType.
 type ExtensionContextType string
 
@@ -1353,6 +3537,32 @@ const (
 	ExtensionContextTypeExtension ExtensionContextType = "extension"
 )
 
+// known reports whether c is one of the ExtensionContextType values.
+func (c ExtensionContextType) known() bool {
+	switch c {
+	case ExtensionContextTypeFhirpath,
+		ExtensionContextTypeElement,
+		ExtensionContextTypeExtension:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ExtensionContextType value in definition order.
+func (ExtensionContextType) firstCode() string {
+	return string(ExtensionContextTypeFhirpath)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ExtensionContextType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ExtensionContextType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // FilterOperator represents FilterOperator.
 type FilterOperator string
 
@@ -1378,6 +3588,38 @@ const (
 	FilterOperatorExists FilterOperator = "exists"
 )
 
+// known reports whether c is one of the FilterOperator values.
+func (c FilterOperator) known() bool {
+	switch c {
+	case FilterOperatorEqual,
+		FilterOperatorIsA,
+		FilterOperatorDescendentOf,
+		FilterOperatorIsNotA,
+		FilterOperatorRegex,
+		FilterOperatorIn,
+		FilterOperatorNotIn,
+		FilterOperatorGeneralizes,
+		FilterOperatorExists:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FilterOperator value in definition order.
+func (FilterOperator) firstCode() string {
+	return string(FilterOperatorEqual)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FilterOperator) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FilterOperator) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // FlagStatus represents FlagStatus.
 type FlagStatus string
 
@@ -1391,6 +3633,32 @@ const (
 	FlagStatusEnteredInError FlagStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the FlagStatus values.
+func (c FlagStatus) known() bool {
+	switch c {
+	case FlagStatusActive,
+		FlagStatusInactive,
+		FlagStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FlagStatus value in definition order.
+func (FlagStatus) firstCode() string {
+	return string(FlagStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FlagStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FlagStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // FinancialResourceStatusCodes represents Financial Resource Status Codes.
 type FinancialResourceStatusCodes string
 
@@ -1406,6 +3674,33 @@ const (
 	FinancialResourceStatusCodesEnteredInError FinancialResourceStatusCodes = "entered-in-error"
 )
 
+// known reports whether c is one of the FinancialResourceStatusCodes values.
+func (c FinancialResourceStatusCodes) known() bool {
+	switch c {
+	case FinancialResourceStatusCodesActive,
+		FinancialResourceStatusCodesCancelled,
+		FinancialResourceStatusCodesDraft,
+		FinancialResourceStatusCodesEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FinancialResourceStatusCodes value in definition order.
+func (FinancialResourceStatusCodes) firstCode() string {
+	return string(FinancialResourceStatusCodesActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FinancialResourceStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FinancialResourceStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GoalLifecycleStatus represents GoalLifecycleStatus.
 type GoalLifecycleStatus string
 
@@ -1431,6 +3726,38 @@ const (
 	GoalLifecycleStatusRejected GoalLifecycleStatus = "rejected"
 )
 
+// known reports whether c is one of the GoalLifecycleStatus values.
+func (c GoalLifecycleStatus) known() bool {
+	switch c {
+	case GoalLifecycleStatusProposed,
+		GoalLifecycleStatusPlanned,
+		GoalLifecycleStatusAccepted,
+		GoalLifecycleStatusActive,
+		GoalLifecycleStatusOnHold,
+		GoalLifecycleStatusCompleted,
+		GoalLifecycleStatusCancelled,
+		GoalLifecycleStatusEnteredInError,
+		GoalLifecycleStatusRejected:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GoalLifecycleStatus value in definition order.
+func (GoalLifecycleStatus) firstCode() string {
+	return string(GoalLifecycleStatusProposed)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GoalLifecycleStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GoalLifecycleStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GraphCompartmentRule represents GraphCompartmentRule.
 type GraphCompartmentRule string
 
@@ -1446,6 +3773,33 @@ const (
 	GraphCompartmentRuleCustom GraphCompartmentRule = "custom"
 )
 
+// known reports whether c is one of the GraphCompartmentRule values.
+func (c GraphCompartmentRule) known() bool {
+	switch c {
+	case GraphCompartmentRuleIdentical,
+		GraphCompartmentRuleMatching,
+		GraphCompartmentRuleDifferent,
+		GraphCompartmentRuleCustom:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GraphCompartmentRule value in definition order.
+func (GraphCompartmentRule) firstCode() string {
+	return string(GraphCompartmentRuleIdentical)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GraphCompartmentRule) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GraphCompartmentRule) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GraphCompartmentUse represents GraphCompartmentUse.
 type GraphCompartmentUse string
 
@@ -1457,6 +3811,31 @@ const (
 	GraphCompartmentUseRequirement GraphCompartmentUse = "requirement"
 )
 
+// known reports whether c is one of the GraphCompartmentUse values.
+func (c GraphCompartmentUse) known() bool {
+	switch c {
+	case GraphCompartmentUseCondition,
+		GraphCompartmentUseRequirement:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GraphCompartmentUse value in definition order.
+func (GraphCompartmentUse) firstCode() string {
+	return string(GraphCompartmentUseCondition)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GraphCompartmentUse) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GraphCompartmentUse) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GroupMeasure represents GroupMeasure.
 type GroupMeasure string
 
@@ -1476,6 +3855,35 @@ const (
 	GroupMeasureMedianOfMedian GroupMeasure = "median-of-median"
 )
 
+// known reports whether c is one of the GroupMeasure values.
+func (c GroupMeasure) known() bool {
+	switch c {
+	case GroupMeasureMean,
+		GroupMeasureMedian,
+		GroupMeasureMeanOfMean,
+		GroupMeasureMeanOfMedian,
+		GroupMeasureMedianOfMean,
+		GroupMeasureMedianOfMedian:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GroupMeasure value in definition order.
+func (GroupMeasure) firstCode() string {
+	return string(GroupMeasureMean)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GroupMeasure) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GroupMeasure) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GroupType represents GroupType.
 type GroupType string
 
@@ -1495,6 +3903,35 @@ const (
 	GroupTypeSubstance GroupType = "substance"
 )
 
+// known reports whether c is one of the GroupType values.
+func (c GroupType) known() bool {
+	switch c {
+	case GroupTypePerson,
+		GroupTypeAnimal,
+		GroupTypePractitioner,
+		GroupTypeDevice,
+		GroupTypeMedication,
+		GroupTypeSubstance:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GroupType value in definition order.
+func (GroupType) firstCode() string {
+	return string(GroupTypePerson)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GroupType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GroupType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GuidanceResponseStatus represents GuidanceResponseStatus.
 type GuidanceResponseStatus string
 
@@ -1514,6 +3951,35 @@ const (
 	GuidanceResponseStatusEnteredInError GuidanceResponseStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the GuidanceResponseStatus values.
+func (c GuidanceResponseStatus) known() bool {
+	switch c {
+	case GuidanceResponseStatusSuccess,
+		GuidanceResponseStatusDataRequested,
+		GuidanceResponseStatusDataRequired,
+		GuidanceResponseStatusInProgress,
+		GuidanceResponseStatusFailure,
+		GuidanceResponseStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GuidanceResponseStatus value in definition order.
+func (GuidanceResponseStatus) firstCode() string {
+	return string(GuidanceResponseStatusSuccess)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GuidanceResponseStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GuidanceResponseStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GuidePageGeneration represents GuidePageGeneration.
 type GuidePageGeneration string
 
@@ -1529,6 +3995,33 @@ const (
 	GuidePageGenerationGenerated GuidePageGeneration = "generated"
 )
 
+// known reports whether c is one of the GuidePageGeneration values.
+func (c GuidePageGeneration) known() bool {
+	switch c {
+	case GuidePageGenerationHtml,
+		GuidePageGenerationMarkdown,
+		GuidePageGenerationXml,
+		GuidePageGenerationGenerated:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GuidePageGeneration value in definition order.
+func (GuidePageGeneration) firstCode() string {
+	return string(GuidePageGenerationHtml)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GuidePageGeneration) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GuidePageGeneration) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GuideParameterCode represents GuideParameterCode.
 type GuideParameterCode string
 
@@ -1556,6 +4049,39 @@ const (
 	GuideParameterCodeHtmlTemplate GuideParameterCode = "html-template"
 )
 
+// known reports whether c is one of the GuideParameterCode values.
+func (c GuideParameterCode) known() bool {
+	switch c {
+	case GuideParameterCodeApply,
+		GuideParameterCodePathResource,
+		GuideParameterCodePathPages,
+		GuideParameterCodePathTxCache,
+		GuideParameterCodeExpansionParameter,
+		GuideParameterCodeRuleBrokenLinks,
+		GuideParameterCodeGenerateXml,
+		GuideParameterCodeGenerateJson,
+		GuideParameterCodeGenerateTurtle,
+		GuideParameterCodeHtmlTemplate:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GuideParameterCode value in definition order.
+func (GuideParameterCode) firstCode() string {
+	return string(GuideParameterCodeApply)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GuideParameterCode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GuideParameterCode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // FamilyHistoryStatus represents FamilyHistoryStatus.
 type FamilyHistoryStatus string
 
@@ -1571,6 +4097,33 @@ const (
 	FamilyHistoryStatusHealthUnknown FamilyHistoryStatus = "health-unknown"
 )
 
+// known reports whether c is one of the FamilyHistoryStatus values.
+func (c FamilyHistoryStatus) known() bool {
+	switch c {
+	case FamilyHistoryStatusPartial,
+		FamilyHistoryStatusCompleted,
+		FamilyHistoryStatusEnteredInError,
+		FamilyHistoryStatusHealthUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FamilyHistoryStatus value in definition order.
+func (FamilyHistoryStatus) firstCode() string {
+	return string(FamilyHistoryStatusPartial)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FamilyHistoryStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FamilyHistoryStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TestScriptRequestMethodCode represents TestScriptRequestMethodCode.
 type TestScriptRequestMethodCode string
 
@@ -1592,6 +4145,36 @@ const (
 	TestScriptRequestMethodCodeHead TestScriptRequestMethodCode = "head"
 )
 
+// known reports whether c is one of the TestScriptRequestMethodCode values.
+func (c TestScriptRequestMethodCode) known() bool {
+	switch c {
+	case TestScriptRequestMethodCodeDelete,
+		TestScriptRequestMethodCodeGet,
+		TestScriptRequestMethodCodeOptions,
+		TestScriptRequestMethodCodePatch,
+		TestScriptRequestMethodCodePost,
+		TestScriptRequestMethodCodePut,
+		TestScriptRequestMethodCodeHead:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TestScriptRequestMethodCode value in definition order.
+func (TestScriptRequestMethodCode) firstCode() string {
+	return string(TestScriptRequestMethodCodeDelete)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TestScriptRequestMethodCode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TestScriptRequestMethodCode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // HTTPVerb represents HTTPVerb.
 type HTTPVerb string
 
@@ -1611,6 +4194,35 @@ const (
 	HTTPVerbPatch HTTPVerb = "PATCH"
 )
 
+// known reports whether c is one of the HTTPVerb values.
+func (c HTTPVerb) known() bool {
+	switch c {
+	case HTTPVerbGet,
+		HTTPVerbHead,
+		HTTPVerbPost,
+		HTTPVerbPut,
+		HTTPVerbDelete,
+		HTTPVerbPatch:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first HTTPVerb value in definition order.
+func (HTTPVerb) firstCode() string {
+	return string(HTTPVerbGet)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c HTTPVerb) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *HTTPVerb) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // IdentifierUse represents IdentifierUse.
 type IdentifierUse string
 
@@ -1628,6 +4240,34 @@ const (
 	IdentifierUseOld IdentifierUse = "old"
 )
 
+// known reports whether c is one of the IdentifierUse values.
+func (c IdentifierUse) known() bool {
+	switch c {
+	case IdentifierUseUsual,
+		IdentifierUseOfficial,
+		IdentifierUseTemp,
+		IdentifierUseSecondary,
+		IdentifierUseOld:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first IdentifierUse value in definition order.
+func (IdentifierUse) firstCode() string {
+	return string(IdentifierUseUsual)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c IdentifierUse) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *IdentifierUse) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // IdentityAssuranceLevel represents IdentityAssuranceLevel.
 type IdentityAssuranceLevel string
 
@@ -1643,6 +4283,33 @@ const (
 	IdentityAssuranceLevelLevel4 IdentityAssuranceLevel = "level4"
 )
 
+// known reports whether c is one of the IdentityAssuranceLevel values.
+func (c IdentityAssuranceLevel) known() bool {
+	switch c {
+	case IdentityAssuranceLevelLevel1,
+		IdentityAssuranceLevelLevel2,
+		IdentityAssuranceLevelLevel3,
+		IdentityAssuranceLevelLevel4:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first IdentityAssuranceLevel value in definition order.
+func (IdentityAssuranceLevel) firstCode() string {
+	return string(IdentityAssuranceLevelLevel1)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c IdentityAssuranceLevel) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *IdentityAssuranceLevel) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ImagingStudyStatus represents ImagingStudyStatus.
 type ImagingStudyStatus string
 
@@ -1660,6 +4327,34 @@ const (
 	ImagingStudyStatusUnknown ImagingStudyStatus = "unknown"
 )
 
+// known reports whether c is one of the ImagingStudyStatus values.
+func (c ImagingStudyStatus) known() bool {
+	switch c {
+	case ImagingStudyStatusRegistered,
+		ImagingStudyStatusAvailable,
+		ImagingStudyStatusCancelled,
+		ImagingStudyStatusEnteredInError,
+		ImagingStudyStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ImagingStudyStatus value in definition order.
+func (ImagingStudyStatus) firstCode() string {
+	return string(ImagingStudyStatusRegistered)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ImagingStudyStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ImagingStudyStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ImmunizationEvaluationStatusCodes represents Immunization Evaluation Status Codes.
 type ImmunizationEvaluationStatusCodes string
 
@@ -1669,6 +4364,31 @@ const (
 	ImmunizationEvaluationStatusCodesEnteredInError ImmunizationEvaluationStatusCodes = "entered-in-error"
 )
 
+// known reports whether c is one of the ImmunizationEvaluationStatusCodes values.
+func (c ImmunizationEvaluationStatusCodes) known() bool {
+	switch c {
+	case ImmunizationEvaluationStatusCodesCompleted,
+		ImmunizationEvaluationStatusCodesEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ImmunizationEvaluationStatusCodes value in definition order.
+func (ImmunizationEvaluationStatusCodes) firstCode() string {
+	return string(ImmunizationEvaluationStatusCodesCompleted)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ImmunizationEvaluationStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ImmunizationEvaluationStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ImmunizationStatusCodes represents Immunization Status Codes.
 type ImmunizationStatusCodes string
 
@@ -1679,6 +4399,32 @@ const (
 	ImmunizationStatusCodesNotDone        ImmunizationStatusCodes = "not-done"
 )
 
+// known reports whether c is one of the ImmunizationStatusCodes values.
+func (c ImmunizationStatusCodes) known() bool {
+	switch c {
+	case ImmunizationStatusCodesCompleted,
+		ImmunizationStatusCodesEnteredInError,
+		ImmunizationStatusCodesNotDone:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ImmunizationStatusCodes value in definition order.
+func (ImmunizationStatusCodes) firstCode() string {
+	return string(ImmunizationStatusCodesCompleted)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ImmunizationStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ImmunizationStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // InvoicePriceComponentType represents InvoicePriceComponentType.
 type InvoicePriceComponentType string
 
@@ -1698,6 +4444,35 @@ const (
 	InvoicePriceComponentTypeInformational InvoicePriceComponentType = "informational"
 )
 
+// known reports whether c is one of the InvoicePriceComponentType values.
+func (c InvoicePriceComponentType) known() bool {
+	switch c {
+	case InvoicePriceComponentTypeBase,
+		InvoicePriceComponentTypeSurcharge,
+		InvoicePriceComponentTypeDeduction,
+		InvoicePriceComponentTypeDiscount,
+		InvoicePriceComponentTypeTax,
+		InvoicePriceComponentTypeInformational:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first InvoicePriceComponentType value in definition order.
+func (InvoicePriceComponentType) firstCode() string {
+	return string(InvoicePriceComponentTypeBase)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c InvoicePriceComponentType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *InvoicePriceComponentType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // InvoiceStatus represents InvoiceStatus.
 type InvoiceStatus string
 
@@ -1715,6 +4490,34 @@ const (
 	InvoiceStatusEnteredInError InvoiceStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the InvoiceStatus values.
+func (c InvoiceStatus) known() bool {
+	switch c {
+	case InvoiceStatusDraft,
+		InvoiceStatusIssued,
+		InvoiceStatusBalanced,
+		InvoiceStatusCancelled,
+		InvoiceStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first InvoiceStatus value in definition order.
+func (InvoiceStatus) firstCode() string {
+	return string(InvoiceStatusDraft)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c InvoiceStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *InvoiceStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // IssueSeverity represents IssueSeverity.
 type IssueSeverity string
 
@@ -1730,6 +4533,33 @@ const (
 	IssueSeverityInformation IssueSeverity = "information"
 )
 
+// known reports whether c is one of the IssueSeverity values.
+func (c IssueSeverity) known() bool {
+	switch c {
+	case IssueSeverityFatal,
+		IssueSeverityError,
+		IssueSeverityWarning,
+		IssueSeverityInformation:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first IssueSeverity value in definition order.
+func (IssueSeverity) firstCode() string {
+	return string(IssueSeverityFatal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c IssueSeverity) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *IssueSeverity) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // IssueType represents IssueType.
 type IssueType string
 
@@ -1799,6 +4629,60 @@ const (
 	IssueTypeInformational IssueType = "informational"
 )
 
+// known reports whether c is one of the IssueType values.
+func (c IssueType) known() bool {
+	switch c {
+	case IssueTypeInvalid,
+		IssueTypeStructure,
+		IssueTypeRequired,
+		IssueTypeValue,
+		IssueTypeInvariant,
+		IssueTypeSecurity,
+		IssueTypeLogin,
+		IssueTypeUnknown,
+		IssueTypeExpired,
+		IssueTypeForbidden,
+		IssueTypeSuppressed,
+		IssueTypeProcessing,
+		IssueTypeNotSupported,
+		IssueTypeDuplicate,
+		IssueTypeMultipleMatches,
+		IssueTypeNotFound,
+		IssueTypeDeleted,
+		IssueTypeTooLong,
+		IssueTypeCodeInvalid,
+		IssueTypeExtension,
+		IssueTypeTooCostly,
+		IssueTypeBusinessRule,
+		IssueTypeConflict,
+		IssueTypeTransient,
+		IssueTypeLockError,
+		IssueTypeNoStore,
+		IssueTypeException,
+		IssueTypeTimeout,
+		IssueTypeIncomplete,
+		IssueTypeThrottled,
+		IssueTypeInformational:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first IssueType value in definition order.
+func (IssueType) firstCode() string {
+	return string(IssueTypeInvalid)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c IssueType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *IssueType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // QuestionnaireItemType represents QuestionnaireItemType.
 type QuestionnaireItemType string
 
@@ -1840,6 +4724,46 @@ const (
 	QuestionnaireItemTypeQuantity QuestionnaireItemType = "quantity"
 )
 
+// known reports whether c is one of the QuestionnaireItemType values.
+func (c QuestionnaireItemType) known() bool {
+	switch c {
+	case QuestionnaireItemTypeGroup,
+		QuestionnaireItemTypeDisplay,
+		QuestionnaireItemTypeQuestion,
+		QuestionnaireItemTypeBoolean,
+		QuestionnaireItemTypeDecimal,
+		QuestionnaireItemTypeInteger,
+		QuestionnaireItemTypeDate,
+		QuestionnaireItemTypeDatetime,
+		QuestionnaireItemTypeTime,
+		QuestionnaireItemTypeString,
+		QuestionnaireItemTypeText,
+		QuestionnaireItemTypeUrl,
+		QuestionnaireItemTypeChoice,
+		QuestionnaireItemTypeOpenChoice,
+		QuestionnaireItemTypeAttachment,
+		QuestionnaireItemTypeReference,
+		QuestionnaireItemTypeQuantity:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first QuestionnaireItemType value in definition order.
+func (QuestionnaireItemType) firstCode() string {
+	return string(QuestionnaireItemTypeGroup)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c QuestionnaireItemType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *QuestionnaireItemType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // LinkType represents LinkType.
 type LinkType string
 
@@ -1855,6 +4779,33 @@ const (
 	LinkTypeSeealso LinkType = "seealso"
 )
 
+// known reports whether c is one of the LinkType values.
+func (c LinkType) known() bool {
+	switch c {
+	case LinkTypeReplacedBy,
+		LinkTypeReplaces,
+		LinkTypeRefer,
+		LinkTypeSeealso:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first LinkType value in definition order.
+func (LinkType) firstCode() string {
+	return string(LinkTypeReplacedBy)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c LinkType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *LinkType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // LinkageType represents LinkageType.
 type LinkageType string
 
@@ -1868,6 +4819,32 @@ const (
 	LinkageTypeHistorical LinkageType = "historical"
 )
 
+// known reports whether c is one of the LinkageType values.
+func (c LinkageType) known() bool {
+	switch c {
+	case LinkageTypeSource,
+		LinkageTypeAlternate,
+		LinkageTypeHistorical:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first LinkageType value in definition order.
+func (LinkageType) firstCode() string {
+	return string(LinkageTypeSource)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c LinkageType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *LinkageType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ListMode represents ListMode.
 type ListMode string
 
@@ -1881,6 +4858,32 @@ const (
 	ListModeChanges ListMode = "changes"
 )
 
+// known reports whether c is one of the ListMode values.
+func (c ListMode) known() bool {
+	switch c {
+	case ListModeWorking,
+		ListModeSnapshot,
+		ListModeChanges:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ListMode value in definition order.
+func (ListMode) firstCode() string {
+	return string(ListModeWorking)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ListMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ListMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ListStatus represents ListStatus.
 type ListStatus string
 
@@ -1894,6 +4897,32 @@ const (
 	ListStatusEnteredInError ListStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the ListStatus values.
+func (c ListStatus) known() bool {
+	switch c {
+	case ListStatusCurrent,
+		ListStatusRetired,
+		ListStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ListStatus value in definition order.
+func (ListStatus) firstCode() string {
+	return string(ListStatusCurrent)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ListStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ListStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // LocationMode represents LocationMode.
 type LocationMode string
 
@@ -1905,6 +4934,31 @@ const (
 	LocationModeKind LocationMode = "kind"
 )
 
+// known reports whether c is one of the LocationMode values.
+func (c LocationMode) known() bool {
+	switch c {
+	case LocationModeInstance,
+		LocationModeKind:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first LocationMode value in definition order.
+func (LocationMode) firstCode() string {
+	return string(LocationModeInstance)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c LocationMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *LocationMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // LocationStatus represents LocationStatus.
 type LocationStatus string
 
@@ -1918,6 +4972,32 @@ const (
 	LocationStatusInactive LocationStatus = "inactive"
 )
 
+// known reports whether c is one of the LocationStatus values.
+func (c LocationStatus) known() bool {
+	switch c {
+	case LocationStatusActive,
+		LocationStatusSuspended,
+		LocationStatusInactive:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first LocationStatus value in definition order.
+func (LocationStatus) firstCode() string {
+	return string(LocationStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c LocationStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *LocationStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapContextType represents StructureMapContextType.
 type StructureMapContextType string
 
@@ -1929,6 +5009,31 @@ const (
 	StructureMapContextTypeVariable StructureMapContextType = "variable"
 )
 
+// known reports whether c is one of the StructureMapContextType values.
+func (c StructureMapContextType) known() bool {
+	switch c {
+	case StructureMapContextTypeType,
+		StructureMapContextTypeVariable:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapContextType value in definition order.
+func (StructureMapContextType) firstCode() string {
+	return string(StructureMapContextTypeType)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapContextType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapContextType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapGroupTypeMode represents StructureMapGroupTypeMode.
 type StructureMapGroupTypeMode string
 
@@ -1942,6 +5047,32 @@ const (
 	StructureMapGroupTypeModeTypeAndTypes StructureMapGroupTypeMode = "type-and-types"
 )
 
+// known reports whether c is one of the StructureMapGroupTypeMode values.
+func (c StructureMapGroupTypeMode) known() bool {
+	switch c {
+	case StructureMapGroupTypeModeNone,
+		StructureMapGroupTypeModeTypes,
+		StructureMapGroupTypeModeTypeAndTypes:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapGroupTypeMode value in definition order.
+func (StructureMapGroupTypeMode) firstCode() string {
+	return string(StructureMapGroupTypeModeNone)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapGroupTypeMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapGroupTypeMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapInputMode represents StructureMapInputMode.
 type StructureMapInputMode string
 
@@ -1953,6 +5084,31 @@ const (
 	StructureMapInputModeTarget StructureMapInputMode = "target"
 )
 
+// known reports whether c is one of the StructureMapInputMode values.
+func (c StructureMapInputMode) known() bool {
+	switch c {
+	case StructureMapInputModeSource,
+		StructureMapInputModeTarget:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapInputMode value in definition order.
+func (StructureMapInputMode) firstCode() string {
+	return string(StructureMapInputModeSource)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapInputMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapInputMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapModelMode represents StructureMapModelMode.
 type StructureMapModelMode string
 
@@ -1968,6 +5124,33 @@ const (
 	StructureMapModelModeProduced StructureMapModelMode = "produced"
 )
 
+// known reports whether c is one of the StructureMapModelMode values.
+func (c StructureMapModelMode) known() bool {
+	switch c {
+	case StructureMapModelModeSource,
+		StructureMapModelModeQueried,
+		StructureMapModelModeTarget,
+		StructureMapModelModeProduced:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapModelMode value in definition order.
+func (StructureMapModelMode) firstCode() string {
+	return string(StructureMapModelModeSource)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapModelMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapModelMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapSourceListMode represents StructureMapSourceListMode.
 type StructureMapSourceListMode string
 
@@ -1985,6 +5168,34 @@ const (
 	StructureMapSourceListModeOnlyOne StructureMapSourceListMode = "only_one"
 )
 
+// known reports whether c is one of the StructureMapSourceListMode values.
+func (c StructureMapSourceListMode) known() bool {
+	switch c {
+	case StructureMapSourceListModeFirst,
+		StructureMapSourceListModeNotFirst,
+		StructureMapSourceListModeLast,
+		StructureMapSourceListModeNotLast,
+		StructureMapSourceListModeOnlyOne:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapSourceListMode value in definition order.
+func (StructureMapSourceListMode) firstCode() string {
+	return string(StructureMapSourceListModeFirst)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapSourceListMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapSourceListMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapTargetListMode represents StructureMapTargetListMode.
 type StructureMapTargetListMode string
 
@@ -2000,6 +5211,33 @@ const (
 	StructureMapTargetListModeCollate StructureMapTargetListMode = "collate"
 )
 
+// known reports whether c is one of the StructureMapTargetListMode values.
+func (c StructureMapTargetListMode) known() bool {
+	switch c {
+	case StructureMapTargetListModeFirst,
+		StructureMapTargetListModeShare,
+		StructureMapTargetListModeLast,
+		StructureMapTargetListModeCollate:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapTargetListMode value in definition order.
+func (StructureMapTargetListMode) firstCode() string {
+	return string(StructureMapTargetListModeFirst)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapTargetListMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapTargetListMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapTransform represents StructureMapTransform.
 type StructureMapTransform string
 
@@ -2041,6 +5279,46 @@ const (
 	StructureMapTransformCp StructureMapTransform = "cp"
 )
 
+// known reports whether c is one of the StructureMapTransform values.
+func (c StructureMapTransform) known() bool {
+	switch c {
+	case StructureMapTransformCreate,
+		StructureMapTransformCopy,
+		StructureMapTransformTruncate,
+		StructureMapTransformEscape,
+		StructureMapTransformCast,
+		StructureMapTransformAppend,
+		StructureMapTransformTranslate,
+		StructureMapTransformReference,
+		StructureMapTransformDateop,
+		StructureMapTransformUuid,
+		StructureMapTransformPointer,
+		StructureMapTransformEvaluate,
+		StructureMapTransformCc,
+		StructureMapTransformC,
+		StructureMapTransformQty,
+		StructureMapTransformId,
+		StructureMapTransformCp:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapTransform value in definition order.
+func (StructureMapTransform) firstCode() string {
+	return string(StructureMapTransformCreate)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapTransform) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapTransform) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MeasureReportStatus represents MeasureReportStatus.
 type MeasureReportStatus string
 
@@ -2054,6 +5332,32 @@ const (
 	MeasureReportStatusError MeasureReportStatus = "error"
 )
 
+// known reports whether c is one of the MeasureReportStatus values.
+func (c MeasureReportStatus) known() bool {
+	switch c {
+	case MeasureReportStatusComplete,
+		MeasureReportStatusPending,
+		MeasureReportStatusError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MeasureReportStatus value in definition order.
+func (MeasureReportStatus) firstCode() string {
+	return string(MeasureReportStatusComplete)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MeasureReportStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MeasureReportStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MeasureReportType represents MeasureReportType.
 type MeasureReportType string
 
@@ -2069,6 +5373,33 @@ const (
 	MeasureReportTypeDataCollection MeasureReportType = "data-collection"
 )
 
+// known reports whether c is one of the MeasureReportType values.
+func (c MeasureReportType) known() bool {
+	switch c {
+	case MeasureReportTypeIndividual,
+		MeasureReportTypeSubjectList,
+		MeasureReportTypeSummary,
+		MeasureReportTypeDataCollection:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MeasureReportType value in definition order.
+func (MeasureReportType) firstCode() string {
+	return string(MeasureReportTypeIndividual)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MeasureReportType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MeasureReportType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationAdministrationStatusCodes represents Medication administration  status  codes.
 type MedicationAdministrationStatusCodes string
 
@@ -2090,6 +5421,36 @@ const (
 	MedicationAdministrationStatusCodesUnknown MedicationAdministrationStatusCodes = "unknown"
 )
 
+// known reports whether c is one of the MedicationAdministrationStatusCodes values.
+func (c MedicationAdministrationStatusCodes) known() bool {
+	switch c {
+	case MedicationAdministrationStatusCodesInProgress,
+		MedicationAdministrationStatusCodesNotDone,
+		MedicationAdministrationStatusCodesOnHold,
+		MedicationAdministrationStatusCodesCompleted,
+		MedicationAdministrationStatusCodesEnteredInError,
+		MedicationAdministrationStatusCodesStopped,
+		MedicationAdministrationStatusCodesUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationAdministrationStatusCodes value in definition order.
+func (MedicationAdministrationStatusCodes) firstCode() string {
+	return string(MedicationAdministrationStatusCodesInProgress)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationAdministrationStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationAdministrationStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationStatusCodes represents Medication  status  codes.
 type MedicationStatusCodes string
 
@@ -2113,6 +5474,37 @@ const (
 	MedicationStatusCodesNotTaken MedicationStatusCodes = "not-taken"
 )
 
+// known reports whether c is one of the MedicationStatusCodes values.
+func (c MedicationStatusCodes) known() bool {
+	switch c {
+	case MedicationStatusCodesActive,
+		MedicationStatusCodesCompleted,
+		MedicationStatusCodesEnteredInError,
+		MedicationStatusCodesIntended,
+		MedicationStatusCodesStopped,
+		MedicationStatusCodesOnHold,
+		MedicationStatusCodesUnknown,
+		MedicationStatusCodesNotTaken:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationStatusCodes value in definition order.
+func (MedicationStatusCodes) firstCode() string {
+	return string(MedicationStatusCodesActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationDispenseStatusCodes represents Medication dispense  status  codes.
 type MedicationDispenseStatusCodes string
 
@@ -2138,6 +5530,38 @@ const (
 	MedicationDispenseStatusCodesUnknown MedicationDispenseStatusCodes = "unknown"
 )
 
+// known reports whether c is one of the MedicationDispenseStatusCodes values.
+func (c MedicationDispenseStatusCodes) known() bool {
+	switch c {
+	case MedicationDispenseStatusCodesPreparation,
+		MedicationDispenseStatusCodesInProgress,
+		MedicationDispenseStatusCodesCancelled,
+		MedicationDispenseStatusCodesOnHold,
+		MedicationDispenseStatusCodesCompleted,
+		MedicationDispenseStatusCodesEnteredInError,
+		MedicationDispenseStatusCodesStopped,
+		MedicationDispenseStatusCodesDeclined,
+		MedicationDispenseStatusCodesUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationDispenseStatusCodes value in definition order.
+func (MedicationDispenseStatusCodes) firstCode() string {
+	return string(MedicationDispenseStatusCodesPreparation)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationDispenseStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationDispenseStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationKnowledgeStatusCodes represents Medication knowledge  status  codes.
 type MedicationKnowledgeStatusCodes string
 
@@ -2151,6 +5575,32 @@ const (
 	MedicationKnowledgeStatusCodesEnteredInError MedicationKnowledgeStatusCodes = "entered-in-error"
 )
 
+// known reports whether c is one of the MedicationKnowledgeStatusCodes values.
+func (c MedicationKnowledgeStatusCodes) known() bool {
+	switch c {
+	case MedicationKnowledgeStatusCodesActive,
+		MedicationKnowledgeStatusCodesInactive,
+		MedicationKnowledgeStatusCodesEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationKnowledgeStatusCodes value in definition order.
+func (MedicationKnowledgeStatusCodes) firstCode() string {
+	return string(MedicationKnowledgeStatusCodesActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationKnowledgeStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationKnowledgeStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationRequestIntent represents Medication request  intent.
 type MedicationRequestIntent string
 
@@ -2174,6 +5624,37 @@ const (
 	MedicationRequestIntentOption MedicationRequestIntent = "option"
 )
 
+// known reports whether c is one of the MedicationRequestIntent values.
+func (c MedicationRequestIntent) known() bool {
+	switch c {
+	case MedicationRequestIntentProposal,
+		MedicationRequestIntentPlan,
+		MedicationRequestIntentOrder,
+		MedicationRequestIntentOriginalOrder,
+		MedicationRequestIntentReflexOrder,
+		MedicationRequestIntentFillerOrder,
+		MedicationRequestIntentInstanceOrder,
+		MedicationRequestIntentOption:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationRequestIntent value in definition order.
+func (MedicationRequestIntent) firstCode() string {
+	return string(MedicationRequestIntentProposal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationRequestIntent) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationRequestIntent) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationrequestStatus represents Medicationrequest  status.
 type MedicationrequestStatus string
 
@@ -2197,6 +5678,37 @@ const (
 	MedicationrequestStatusUnknown MedicationrequestStatus = "unknown"
 )
 
+// known reports whether c is one of the MedicationrequestStatus values.
+func (c MedicationrequestStatus) known() bool {
+	switch c {
+	case MedicationrequestStatusActive,
+		MedicationrequestStatusOnHold,
+		MedicationrequestStatusCancelled,
+		MedicationrequestStatusCompleted,
+		MedicationrequestStatusEnteredInError,
+		MedicationrequestStatusStopped,
+		MedicationrequestStatusDraft,
+		MedicationrequestStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationrequestStatus value in definition order.
+func (MedicationrequestStatus) firstCode() string {
+	return string(MedicationrequestStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationrequestStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationrequestStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MessageSignificanceCategory represents MessageSignificanceCategory.
 type MessageSignificanceCategory string
 
@@ -2210,6 +5722,32 @@ const (
 	MessageSignificanceCategoryNotification MessageSignificanceCategory = "notification"
 )
 
+// known reports whether c is one of the MessageSignificanceCategory values.
+func (c MessageSignificanceCategory) known() bool {
+	switch c {
+	case MessageSignificanceCategoryConsequence,
+		MessageSignificanceCategoryCurrency,
+		MessageSignificanceCategoryNotification:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MessageSignificanceCategory value in definition order.
+func (MessageSignificanceCategory) firstCode() string {
+	return string(MessageSignificanceCategoryConsequence)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MessageSignificanceCategory) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MessageSignificanceCategory) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // Messageheaderresponserequest represents messageheader-response-request.
 type Messageheaderresponserequest string
 
@@ -2225,6 +5763,33 @@ const (
 	MessageheaderresponserequestOnSuccess Messageheaderresponserequest = "on-success"
 )
 
+// known reports whether c is one of the Messageheaderresponserequest values.
+func (c Messageheaderresponserequest) known() bool {
+	switch c {
+	case MessageheaderresponserequestAlways,
+		MessageheaderresponserequestOnError,
+		MessageheaderresponserequestNever,
+		MessageheaderresponserequestOnSuccess:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first Messageheaderresponserequest value in definition order.
+func (Messageheaderresponserequest) firstCode() string {
+	return string(MessageheaderresponserequestAlways)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c Messageheaderresponserequest) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *Messageheaderresponserequest) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceMetricCalibrationState represents DeviceMetricCalibrationState.
 type DeviceMetricCalibrationState string
 
@@ -2240,6 +5805,33 @@ const (
 	DeviceMetricCalibrationStateUnspecified DeviceMetricCalibrationState = "unspecified"
 )
 
+// known reports whether c is one of the DeviceMetricCalibrationState values.
+func (c DeviceMetricCalibrationState) known() bool {
+	switch c {
+	case DeviceMetricCalibrationStateNotCalibrated,
+		DeviceMetricCalibrationStateCalibrationRequired,
+		DeviceMetricCalibrationStateCalibrated,
+		DeviceMetricCalibrationStateUnspecified:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceMetricCalibrationState value in definition order.
+func (DeviceMetricCalibrationState) firstCode() string {
+	return string(DeviceMetricCalibrationStateNotCalibrated)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceMetricCalibrationState) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceMetricCalibrationState) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceMetricCalibrationType represents DeviceMetricCalibrationType.
 type DeviceMetricCalibrationType string
 
@@ -2255,6 +5847,33 @@ const (
 	DeviceMetricCalibrationTypeTwoPoint DeviceMetricCalibrationType = "two-point"
 )
 
+// known reports whether c is one of the DeviceMetricCalibrationType values.
+func (c DeviceMetricCalibrationType) known() bool {
+	switch c {
+	case DeviceMetricCalibrationTypeUnspecified,
+		DeviceMetricCalibrationTypeOffset,
+		DeviceMetricCalibrationTypeGain,
+		DeviceMetricCalibrationTypeTwoPoint:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceMetricCalibrationType value in definition order.
+func (DeviceMetricCalibrationType) firstCode() string {
+	return string(DeviceMetricCalibrationTypeUnspecified)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceMetricCalibrationType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceMetricCalibrationType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceMetricCategory represents DeviceMetricCategory.
 type DeviceMetricCategory string
 
@@ -2270,6 +5889,33 @@ const (
 	DeviceMetricCategoryUnspecified DeviceMetricCategory = "unspecified"
 )
 
+// known reports whether c is one of the DeviceMetricCategory values.
+func (c DeviceMetricCategory) known() bool {
+	switch c {
+	case DeviceMetricCategoryMeasurement,
+		DeviceMetricCategorySetting,
+		DeviceMetricCategoryCalculation,
+		DeviceMetricCategoryUnspecified:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceMetricCategory value in definition order.
+func (DeviceMetricCategory) firstCode() string {
+	return string(DeviceMetricCategoryMeasurement)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceMetricCategory) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceMetricCategory) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceMetricColor represents DeviceMetricColor.
 type DeviceMetricColor string
 
@@ -2293,6 +5939,37 @@ const (
 	DeviceMetricColorWhite DeviceMetricColor = "white"
 )
 
+// known reports whether c is one of the DeviceMetricColor values.
+func (c DeviceMetricColor) known() bool {
+	switch c {
+	case DeviceMetricColorBlack,
+		DeviceMetricColorRed,
+		DeviceMetricColorGreen,
+		DeviceMetricColorYellow,
+		DeviceMetricColorBlue,
+		DeviceMetricColorMagenta,
+		DeviceMetricColorCyan,
+		DeviceMetricColorWhite:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceMetricColor value in definition order.
+func (DeviceMetricColor) firstCode() string {
+	return string(DeviceMetricColorBlack)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceMetricColor) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceMetricColor) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceMetricOperationalStatus represents DeviceMetricOperationalStatus.
 type DeviceMetricOperationalStatus string
 
@@ -2308,6 +5985,33 @@ const (
 	DeviceMetricOperationalStatusEnteredInError DeviceMetricOperationalStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the DeviceMetricOperationalStatus values.
+func (c DeviceMetricOperationalStatus) known() bool {
+	switch c {
+	case DeviceMetricOperationalStatusOn,
+		DeviceMetricOperationalStatusOff,
+		DeviceMetricOperationalStatusStandby,
+		DeviceMetricOperationalStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceMetricOperationalStatus value in definition order.
+func (DeviceMetricOperationalStatus) firstCode() string {
+	return string(DeviceMetricOperationalStatusOn)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceMetricOperationalStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceMetricOperationalStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // NameUse represents NameUse.
 type NameUse string
 
@@ -2329,6 +6033,36 @@ const (
 	NameUseMaiden NameUse = "maiden"
 )
 
+// known reports whether c is one of the NameUse values.
+func (c NameUse) known() bool {
+	switch c {
+	case NameUseUsual,
+		NameUseOfficial,
+		NameUseTemp,
+		NameUseNickname,
+		NameUseAnonymous,
+		NameUseOld,
+		NameUseMaiden:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first NameUse value in definition order.
+func (NameUse) firstCode() string {
+	return string(NameUseUsual)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c NameUse) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *NameUse) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // NamingSystemIdentifierType represents NamingSystemIdentifierType.
 type NamingSystemIdentifierType string
 
@@ -2344,6 +6078,33 @@ const (
 	NamingSystemIdentifierTypeOther NamingSystemIdentifierType = "other"
 )
 
+// known reports whether c is one of the NamingSystemIdentifierType values.
+func (c NamingSystemIdentifierType) known() bool {
+	switch c {
+	case NamingSystemIdentifierTypeOid,
+		NamingSystemIdentifierTypeUuid,
+		NamingSystemIdentifierTypeUri,
+		NamingSystemIdentifierTypeOther:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first NamingSystemIdentifierType value in definition order.
+func (NamingSystemIdentifierType) firstCode() string {
+	return string(NamingSystemIdentifierTypeOid)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c NamingSystemIdentifierType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *NamingSystemIdentifierType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // NamingSystemType represents NamingSystemType.
 type NamingSystemType string
 
@@ -2357,6 +6118,32 @@ const (
 	NamingSystemTypeRoot NamingSystemType = "root"
 )
 
+// known reports whether c is one of the NamingSystemType values.
+func (c NamingSystemType) known() bool {
+	switch c {
+	case NamingSystemTypeCodesystem,
+		NamingSystemTypeIdentifier,
+		NamingSystemTypeRoot:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first NamingSystemType value in definition order.
+func (NamingSystemType) firstCode() string {
+	return string(NamingSystemTypeCodesystem)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c NamingSystemType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *NamingSystemType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // NarrativeStatus represents NarrativeStatus.
 type NarrativeStatus string
 
@@ -2372,6 +6159,33 @@ const (
 	NarrativeStatusEmpty NarrativeStatus = "empty"
 )
 
+// known reports whether c is one of the NarrativeStatus values.
+func (c NarrativeStatus) known() bool {
+	switch c {
+	case NarrativeStatusGenerated,
+		NarrativeStatusExtensions,
+		NarrativeStatusAdditional,
+		NarrativeStatusEmpty:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first NarrativeStatus value in definition order.
+func (NarrativeStatus) firstCode() string {
+	return string(NarrativeStatusGenerated)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c NarrativeStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *NarrativeStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AuditEventAgentNetworkType represents AuditEventAgentNetworkType.
 type AuditEventAgentNetworkType string
 
@@ -2389,6 +6203,34 @@ const (
 	AuditEventAgentNetworkType5 AuditEventAgentNetworkType = "5"
 )
 
+// known reports whether c is one of the AuditEventAgentNetworkType values.
+func (c AuditEventAgentNetworkType) known() bool {
+	switch c {
+	case AuditEventAgentNetworkType1,
+		AuditEventAgentNetworkType2,
+		AuditEventAgentNetworkType3,
+		AuditEventAgentNetworkType4,
+		AuditEventAgentNetworkType5:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AuditEventAgentNetworkType value in definition order.
+func (AuditEventAgentNetworkType) firstCode() string {
+	return string(AuditEventAgentNetworkType1)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AuditEventAgentNetworkType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AuditEventAgentNetworkType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // NoteType represents NoteType.
 type NoteType string
 
@@ -2402,6 +6244,32 @@ const (
 	NoteTypePrintoper NoteType = "printoper"
 )
 
+// known reports whether c is one of the NoteType values.
+func (c NoteType) known() bool {
+	switch c {
+	case NoteTypeDisplay,
+		NoteTypePrint,
+		NoteTypePrintoper:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first NoteType value in definition order.
+func (NoteType) firstCode() string {
+	return string(NoteTypeDisplay)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c NoteType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *NoteType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ObservationRangeCategory represents ObservationRangeCategory.
 type ObservationRangeCategory string
 
@@ -2415,6 +6283,32 @@ const (
 	ObservationRangeCategoryAbsolute ObservationRangeCategory = "absolute"
 )
 
+// known reports whether c is one of the ObservationRangeCategory values.
+func (c ObservationRangeCategory) known() bool {
+	switch c {
+	case ObservationRangeCategoryReference,
+		ObservationRangeCategoryCritical,
+		ObservationRangeCategoryAbsolute:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ObservationRangeCategory value in definition order.
+func (ObservationRangeCategory) firstCode() string {
+	return string(ObservationRangeCategoryReference)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ObservationRangeCategory) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ObservationRangeCategory) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ObservationStatus represents ObservationStatus.
 type ObservationStatus string
 
@@ -2438,6 +6332,37 @@ const (
 	ObservationStatusUnknown ObservationStatus = "unknown"
 )
 
+// known reports whether c is one of the ObservationStatus values.
+func (c ObservationStatus) known() bool {
+	switch c {
+	case ObservationStatusRegistered,
+		ObservationStatusPreliminary,
+		ObservationStatusFinal,
+		ObservationStatusAmended,
+		ObservationStatusCorrected,
+		ObservationStatusCancelled,
+		ObservationStatusEnteredInError,
+		ObservationStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ObservationStatus value in definition order.
+func (ObservationStatus) firstCode() string {
+	return string(ObservationStatusRegistered)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ObservationStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ObservationStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // OperationKind represents OperationKind.
 type OperationKind string
 
@@ -2449,6 +6374,31 @@ const (
 	OperationKindQuery OperationKind = "query"
 )
 
+// known reports whether c is one of the OperationKind values.
+func (c OperationKind) known() bool {
+	switch c {
+	case OperationKindOperation,
+		OperationKindQuery:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first OperationKind value in definition order.
+func (OperationKind) firstCode() string {
+	return string(OperationKindOperation)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c OperationKind) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *OperationKind) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // OperationParameterUse represents OperationParameterUse.
 type OperationParameterUse string
 
@@ -2460,6 +6410,31 @@ const (
 	OperationParameterUseOut OperationParameterUse = "out"
 )
 
+// known reports whether c is one of the OperationParameterUse values.
+func (c OperationParameterUse) known() bool {
+	switch c {
+	case OperationParameterUseIn,
+		OperationParameterUseOut:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first OperationParameterUse value in definition order.
+func (OperationParameterUse) firstCode() string {
+	return string(OperationParameterUseIn)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c OperationParameterUse) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *OperationParameterUse) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // OrientationType represents orientationType.
 type OrientationType string
 
@@ -2471,6 +6446,31 @@ const (
 	OrientationTypeAntisense OrientationType = "antisense"
 )
 
+// known reports whether c is one of the OrientationType values.
+func (c OrientationType) known() bool {
+	switch c {
+	case OrientationTypeSense,
+		OrientationTypeAntisense:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first OrientationType value in definition order.
+func (OrientationType) firstCode() string {
+	return string(OrientationTypeSense)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c OrientationType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *OrientationType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ParticipantRequired represents ParticipantRequired.
 type ParticipantRequired string
 
@@ -2484,6 +6484,32 @@ const (
 	ParticipantRequiredInformationOnly ParticipantRequired = "information-only"
 )
 
+// known reports whether c is one of the ParticipantRequired values.
+func (c ParticipantRequired) known() bool {
+	switch c {
+	case ParticipantRequiredRequired,
+		ParticipantRequiredOptional,
+		ParticipantRequiredInformationOnly:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ParticipantRequired value in definition order.
+func (ParticipantRequired) firstCode() string {
+	return string(ParticipantRequiredRequired)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ParticipantRequired) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ParticipantRequired) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ParticipationStatus represents ParticipationStatus.
 type ParticipationStatus string
 
@@ -2499,6 +6525,33 @@ const (
 	ParticipationStatusNeedsAction ParticipationStatus = "needs-action"
 )
 
+// known reports whether c is one of the ParticipationStatus values.
+func (c ParticipationStatus) known() bool {
+	switch c {
+	case ParticipationStatusAccepted,
+		ParticipationStatusDeclined,
+		ParticipationStatusTentative,
+		ParticipationStatusNeedsAction:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ParticipationStatus value in definition order.
+func (ParticipationStatus) firstCode() string {
+	return string(ParticipationStatusAccepted)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ParticipationStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ParticipationStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ObservationDataType represents ObservationDataType.
 type ObservationDataType string
 
@@ -2528,6 +6581,40 @@ const (
 	ObservationDataTypePeriod ObservationDataType = "Period"
 )
 
+// known reports whether c is one of the ObservationDataType values.
+func (c ObservationDataType) known() bool {
+	switch c {
+	case ObservationDataTypeQuantity,
+		ObservationDataTypeCodeableconcept,
+		ObservationDataTypeString,
+		ObservationDataTypeBoolean,
+		ObservationDataTypeInteger,
+		ObservationDataTypeRange,
+		ObservationDataTypeRatio,
+		ObservationDataTypeSampleddata,
+		ObservationDataTypeTime,
+		ObservationDataTypeDatetime,
+		ObservationDataTypePeriod:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ObservationDataType value in definition order.
+func (ObservationDataType) firstCode() string {
+	return string(ObservationDataTypeQuantity)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ObservationDataType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ObservationDataType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // BiologicallyDerivedProductCategory represents BiologicallyDerivedProductCategory.
 type BiologicallyDerivedProductCategory string
 
@@ -2545,6 +6632,34 @@ const (
 	BiologicallyDerivedProductCategoryBiologicalagent BiologicallyDerivedProductCategory = "biologicalAgent"
 )
 
+// known reports whether c is one of the BiologicallyDerivedProductCategory values.
+func (c BiologicallyDerivedProductCategory) known() bool {
+	switch c {
+	case BiologicallyDerivedProductCategoryOrgan,
+		BiologicallyDerivedProductCategoryTissue,
+		BiologicallyDerivedProductCategoryFluid,
+		BiologicallyDerivedProductCategoryCells,
+		BiologicallyDerivedProductCategoryBiologicalagent:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first BiologicallyDerivedProductCategory value in definition order.
+func (BiologicallyDerivedProductCategory) firstCode() string {
+	return string(BiologicallyDerivedProductCategoryOrgan)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c BiologicallyDerivedProductCategory) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *BiologicallyDerivedProductCategory) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // BiologicallyDerivedProductStatus represents BiologicallyDerivedProductStatus.
 type BiologicallyDerivedProductStatus string
 
@@ -2556,6 +6671,31 @@ const (
 	BiologicallyDerivedProductStatusUnavailable BiologicallyDerivedProductStatus = "unavailable"
 )
 
+// known reports whether c is one of the BiologicallyDerivedProductStatus values.
+func (c BiologicallyDerivedProductStatus) known() bool {
+	switch c {
+	case BiologicallyDerivedProductStatusAvailable,
+		BiologicallyDerivedProductStatusUnavailable:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first BiologicallyDerivedProductStatus value in definition order.
+func (BiologicallyDerivedProductStatus) firstCode() string {
+	return string(BiologicallyDerivedProductStatusAvailable)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c BiologicallyDerivedProductStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *BiologicallyDerivedProductStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // BiologicallyDerivedProductStorageScale represents BiologicallyDerivedProductStorageScale.
 type BiologicallyDerivedProductStorageScale string
 
@@ -2569,6 +6709,32 @@ const (
 	BiologicallyDerivedProductStorageScaleKelvin BiologicallyDerivedProductStorageScale = "kelvin"
 )
 
+// known reports whether c is one of the BiologicallyDerivedProductStorageScale values.
+func (c BiologicallyDerivedProductStorageScale) known() bool {
+	switch c {
+	case BiologicallyDerivedProductStorageScaleFarenheit,
+		BiologicallyDerivedProductStorageScaleCelsius,
+		BiologicallyDerivedProductStorageScaleKelvin:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first BiologicallyDerivedProductStorageScale value in definition order.
+func (BiologicallyDerivedProductStorageScale) firstCode() string {
+	return string(BiologicallyDerivedProductStorageScaleFarenheit)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c BiologicallyDerivedProductStorageScale) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *BiologicallyDerivedProductStorageScale) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // PropertyRepresentation represents PropertyRepresentation.
 type PropertyRepresentation string
 
@@ -2586,6 +6752,34 @@ const (
 	PropertyRepresentationXhtml PropertyRepresentation = "xhtml"
 )
 
+// known reports whether c is one of the PropertyRepresentation values.
+func (c PropertyRepresentation) known() bool {
+	switch c {
+	case PropertyRepresentationXmlattr,
+		PropertyRepresentationXmltext,
+		PropertyRepresentationTypeattr,
+		PropertyRepresentationCdatext,
+		PropertyRepresentationXhtml:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first PropertyRepresentation value in definition order.
+func (PropertyRepresentation) firstCode() string {
+	return string(PropertyRepresentationXmlattr)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c PropertyRepresentation) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *PropertyRepresentation) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ProvenanceEntityRole represents ProvenanceEntityRole.
 type ProvenanceEntityRole string
 
@@ -2603,6 +6797,34 @@ const (
 	ProvenanceEntityRoleRemoval ProvenanceEntityRole = "removal"
 )
 
+// known reports whether c is one of the ProvenanceEntityRole values.
+func (c ProvenanceEntityRole) known() bool {
+	switch c {
+	case ProvenanceEntityRoleDerivation,
+		ProvenanceEntityRoleRevision,
+		ProvenanceEntityRoleQuotation,
+		ProvenanceEntityRoleSource,
+		ProvenanceEntityRoleRemoval:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ProvenanceEntityRole value in definition order.
+func (ProvenanceEntityRole) firstCode() string {
+	return string(ProvenanceEntityRoleDerivation)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ProvenanceEntityRole) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ProvenanceEntityRole) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // PublicationStatus represents PublicationStatus.
 type PublicationStatus string
 
@@ -2618,6 +6840,33 @@ const (
 	PublicationStatusUnknown PublicationStatus = "unknown"
 )
 
+// known reports whether c is one of the PublicationStatus values.
+func (c PublicationStatus) known() bool {
+	switch c {
+	case PublicationStatusDraft,
+		PublicationStatusActive,
+		PublicationStatusRetired,
+		PublicationStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first PublicationStatus value in definition order.
+func (PublicationStatus) firstCode() string {
+	return string(PublicationStatusDraft)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c PublicationStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *PublicationStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // QualityType represents qualityType.
 type QualityType string
 
@@ -2631,6 +6880,32 @@ const (
 	QualityTypeUnknown QualityType = "unknown"
 )
 
+// known reports whether c is one of the QualityType values.
+func (c QualityType) known() bool {
+	switch c {
+	case QualityTypeIndel,
+		QualityTypeSnp,
+		QualityTypeUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first QualityType value in definition order.
+func (QualityType) firstCode() string {
+	return string(QualityTypeIndel)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c QualityType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *QualityType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // QuantityComparator represents QuantityComparator.
 type QuantityComparator string
 
@@ -2646,6 +6921,33 @@ const (
 	QuantityComparatorGreaterThan QuantityComparator = ">"
 )
 
+// known reports whether c is one of the QuantityComparator values.
+func (c QuantityComparator) known() bool {
+	switch c {
+	case QuantityComparatorLessThan,
+		QuantityComparatorLessOrEqual,
+		QuantityComparatorGreaterOrEqual,
+		QuantityComparatorGreaterThan:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first QuantityComparator value in definition order.
+func (QuantityComparator) firstCode() string {
+	return string(QuantityComparatorLessThan)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c QuantityComparator) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *QuantityComparator) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // QuestionnaireResponseStatus represents QuestionnaireResponseStatus.
 type QuestionnaireResponseStatus string
 
@@ -2663,6 +6965,34 @@ const (
 	QuestionnaireResponseStatusStopped QuestionnaireResponseStatus = "stopped"
 )
 
+// known reports whether c is one of the QuestionnaireResponseStatus values.
+func (c QuestionnaireResponseStatus) known() bool {
+	switch c {
+	case QuestionnaireResponseStatusInProgress,
+		QuestionnaireResponseStatusCompleted,
+		QuestionnaireResponseStatusAmended,
+		QuestionnaireResponseStatusEnteredInError,
+		QuestionnaireResponseStatusStopped:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first QuestionnaireResponseStatus value in definition order.
+func (QuestionnaireResponseStatus) firstCode() string {
+	return string(QuestionnaireResponseStatusInProgress)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c QuestionnaireResponseStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *QuestionnaireResponseStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EnableWhenBehavior represents EnableWhenBehavior.
 type EnableWhenBehavior string
 
@@ -2674,6 +7004,31 @@ const (
 	EnableWhenBehaviorAny EnableWhenBehavior = "any"
 )
 
+// known reports whether c is one of the EnableWhenBehavior values.
+func (c EnableWhenBehavior) known() bool {
+	switch c {
+	case EnableWhenBehaviorAll,
+		EnableWhenBehaviorAny:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EnableWhenBehavior value in definition order.
+func (EnableWhenBehavior) firstCode() string {
+	return string(EnableWhenBehaviorAll)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EnableWhenBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EnableWhenBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // QuestionnaireItemOperator represents QuestionnaireItemOperator.
 type QuestionnaireItemOperator string
 
@@ -2695,6 +7050,36 @@ const (
 	QuestionnaireItemOperatorLessOrEqual QuestionnaireItemOperator = "<="
 )
 
+// known reports whether c is one of the QuestionnaireItemOperator values.
+func (c QuestionnaireItemOperator) known() bool {
+	switch c {
+	case QuestionnaireItemOperatorExists,
+		QuestionnaireItemOperatorEqual,
+		QuestionnaireItemOperatorNotEqual,
+		QuestionnaireItemOperatorGreaterThan,
+		QuestionnaireItemOperatorLessThan,
+		QuestionnaireItemOperatorGreaterOrEqual,
+		QuestionnaireItemOperatorLessOrEqual:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first QuestionnaireItemOperator value in definition order.
+func (QuestionnaireItemOperator) firstCode() string {
+	return string(QuestionnaireItemOperatorExists)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c QuestionnaireItemOperator) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *QuestionnaireItemOperator) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AllergyIntoleranceSeverity represents AllergyIntoleranceSeverity.
 type AllergyIntoleranceSeverity string
 
@@ -2708,6 +7093,32 @@ const (
 	AllergyIntoleranceSeveritySevere AllergyIntoleranceSeverity = "severe"
 )
 
+// known reports whether c is one of the AllergyIntoleranceSeverity values.
+func (c AllergyIntoleranceSeverity) known() bool {
+	switch c {
+	case AllergyIntoleranceSeverityMild,
+		AllergyIntoleranceSeverityModerate,
+		AllergyIntoleranceSeveritySevere:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AllergyIntoleranceSeverity value in definition order.
+func (AllergyIntoleranceSeverity) firstCode() string {
+	return string(AllergyIntoleranceSeverityMild)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AllergyIntoleranceSeverity) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AllergyIntoleranceSeverity) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ReferenceHandlingPolicy represents ReferenceHandlingPolicy.
 type ReferenceHandlingPolicy string
 
@@ -2725,6 +7136,34 @@ const (
 	ReferenceHandlingPolicyLocal ReferenceHandlingPolicy = "local"
 )
 
+// known reports whether c is one of the ReferenceHandlingPolicy values.
+func (c ReferenceHandlingPolicy) known() bool {
+	switch c {
+	case ReferenceHandlingPolicyLiteral,
+		ReferenceHandlingPolicyLogical,
+		ReferenceHandlingPolicyResolves,
+		ReferenceHandlingPolicyEnforced,
+		ReferenceHandlingPolicyLocal:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ReferenceHandlingPolicy value in definition order.
+func (ReferenceHandlingPolicy) firstCode() string {
+	return string(ReferenceHandlingPolicyLiteral)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ReferenceHandlingPolicy) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ReferenceHandlingPolicy) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ReferenceVersionRules represents ReferenceVersionRules.
 type ReferenceVersionRules string
 
@@ -2738,6 +7177,32 @@ const (
 	ReferenceVersionRulesSpecific ReferenceVersionRules = "specific"
 )
 
+// known reports whether c is one of the ReferenceVersionRules values.
+func (c ReferenceVersionRules) known() bool {
+	switch c {
+	case ReferenceVersionRulesEither,
+		ReferenceVersionRulesIndependent,
+		ReferenceVersionRulesSpecific:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ReferenceVersionRules value in definition order.
+func (ReferenceVersionRules) firstCode() string {
+	return string(ReferenceVersionRulesEither)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ReferenceVersionRules) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ReferenceVersionRules) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RelatedArtifactType represents RelatedArtifactType.
 type RelatedArtifactType string
 
@@ -2761,6 +7226,37 @@ const (
 	RelatedArtifactTypeComposedOf RelatedArtifactType = "composed-of"
 )
 
+// known reports whether c is one of the RelatedArtifactType values.
+func (c RelatedArtifactType) known() bool {
+	switch c {
+	case RelatedArtifactTypeDocumentation,
+		RelatedArtifactTypeJustification,
+		RelatedArtifactTypeCitation,
+		RelatedArtifactTypePredecessor,
+		RelatedArtifactTypeSuccessor,
+		RelatedArtifactTypeDerivedFrom,
+		RelatedArtifactTypeDependsOn,
+		RelatedArtifactTypeComposedOf:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RelatedArtifactType value in definition order.
+func (RelatedArtifactType) firstCode() string {
+	return string(RelatedArtifactTypeDocumentation)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RelatedArtifactType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RelatedArtifactType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CatalogEntryRelationType represents CatalogEntryRelationType.
 type CatalogEntryRelationType string
 
@@ -2772,6 +7268,31 @@ const (
 	CatalogEntryRelationTypeIsReplacedBy CatalogEntryRelationType = "is-replaced-by"
 )
 
+// known reports whether c is one of the CatalogEntryRelationType values.
+func (c CatalogEntryRelationType) known() bool {
+	switch c {
+	case CatalogEntryRelationTypeTriggers,
+		CatalogEntryRelationTypeIsReplacedBy:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CatalogEntryRelationType value in definition order.
+func (CatalogEntryRelationType) firstCode() string {
+	return string(CatalogEntryRelationTypeTriggers)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CatalogEntryRelationType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CatalogEntryRelationType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ClaimProcessingCodes represents Claim Processing Codes.
 type ClaimProcessingCodes string
 
@@ -2787,6 +7308,33 @@ const (
 	ClaimProcessingCodesPartial ClaimProcessingCodes = "partial"
 )
 
+// known reports whether c is one of the ClaimProcessingCodes values.
+func (c ClaimProcessingCodes) known() bool {
+	switch c {
+	case ClaimProcessingCodesQueued,
+		ClaimProcessingCodesComplete,
+		ClaimProcessingCodesError,
+		ClaimProcessingCodesPartial:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ClaimProcessingCodes value in definition order.
+func (ClaimProcessingCodes) firstCode() string {
+	return string(ClaimProcessingCodesQueued)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ClaimProcessingCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ClaimProcessingCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TestReportActionResult represents TestReportActionResult.
 type TestReportActionResult string
 
@@ -2804,6 +7352,34 @@ const (
 	TestReportActionResultError TestReportActionResult = "error"
 )
 
+// known reports whether c is one of the TestReportActionResult values.
+func (c TestReportActionResult) known() bool {
+	switch c {
+	case TestReportActionResultPass,
+		TestReportActionResultSkip,
+		TestReportActionResultFail,
+		TestReportActionResultWarning,
+		TestReportActionResultError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TestReportActionResult value in definition order.
+func (TestReportActionResult) firstCode() string {
+	return string(TestReportActionResultPass)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TestReportActionResult) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TestReportActionResult) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TestReportParticipantType represents TestReportParticipantType.
 type TestReportParticipantType string
 
@@ -2817,6 +7393,32 @@ const (
 	TestReportParticipantTypeServer TestReportParticipantType = "server"
 )
 
+// known reports whether c is one of the TestReportParticipantType values.
+func (c TestReportParticipantType) known() bool {
+	switch c {
+	case TestReportParticipantTypeTestEngine,
+		TestReportParticipantTypeClient,
+		TestReportParticipantTypeServer:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TestReportParticipantType value in definition order.
+func (TestReportParticipantType) firstCode() string {
+	return string(TestReportParticipantTypeTestEngine)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TestReportParticipantType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TestReportParticipantType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TestReportResult represents TestReportResult.
 type TestReportResult string
 
@@ -2830,6 +7432,32 @@ const (
 	TestReportResultPending TestReportResult = "pending"
 )
 
+// known reports whether c is one of the TestReportResult values.
+func (c TestReportResult) known() bool {
+	switch c {
+	case TestReportResultPass,
+		TestReportResultFail,
+		TestReportResultPending:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TestReportResult value in definition order.
+func (TestReportResult) firstCode() string {
+	return string(TestReportResultPass)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TestReportResult) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TestReportResult) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TestReportStatus represents TestReportStatus.
 type TestReportStatus string
 
@@ -2847,6 +7475,34 @@ const (
 	TestReportStatusEnteredInError TestReportStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the TestReportStatus values.
+func (c TestReportStatus) known() bool {
+	switch c {
+	case TestReportStatusCompleted,
+		TestReportStatusInProgress,
+		TestReportStatusWaiting,
+		TestReportStatusStopped,
+		TestReportStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TestReportStatus value in definition order.
+func (TestReportStatus) firstCode() string {
+	return string(TestReportStatusCompleted)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TestReportStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TestReportStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RepositoryType represents repositoryType.
 type RepositoryType string
 
@@ -2864,6 +7520,34 @@ const (
 	RepositoryTypeOther RepositoryType = "other"
 )
 
+// known reports whether c is one of the RepositoryType values.
+func (c RepositoryType) known() bool {
+	switch c {
+	case RepositoryTypeDirectlink,
+		RepositoryTypeOpenapi,
+		RepositoryTypeLogin,
+		RepositoryTypeOauth,
+		RepositoryTypeOther:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RepositoryType value in definition order.
+func (RepositoryType) firstCode() string {
+	return string(RepositoryTypeDirectlink)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RepositoryType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RepositoryType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RequestIntent represents RequestIntent.
 type RequestIntent string
 
@@ -2889,6 +7573,38 @@ const (
 	RequestIntentOption RequestIntent = "option"
 )
 
+// known reports whether c is one of the RequestIntent values.
+func (c RequestIntent) known() bool {
+	switch c {
+	case RequestIntentProposal,
+		RequestIntentPlan,
+		RequestIntentDirective,
+		RequestIntentOrder,
+		RequestIntentOriginalOrder,
+		RequestIntentReflexOrder,
+		RequestIntentFillerOrder,
+		RequestIntentInstanceOrder,
+		RequestIntentOption:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RequestIntent value in definition order.
+func (RequestIntent) firstCode() string {
+	return string(RequestIntentProposal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RequestIntent) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RequestIntent) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RequestPriority represents Request priority.
 type RequestPriority string
 
@@ -2904,6 +7620,33 @@ const (
 	RequestPriorityStat RequestPriority = "stat"
 )
 
+// known reports whether c is one of the RequestPriority values.
+func (c RequestPriority) known() bool {
+	switch c {
+	case RequestPriorityRoutine,
+		RequestPriorityUrgent,
+		RequestPriorityAsap,
+		RequestPriorityStat:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RequestPriority value in definition order.
+func (RequestPriority) firstCode() string {
+	return string(RequestPriorityRoutine)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RequestPriority) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RequestPriority) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RequestResourceType represents RequestResourceType.
 type RequestResourceType string
 
@@ -2941,6 +7684,44 @@ const (
 	RequestResourceTypeVisionprescription RequestResourceType = "VisionPrescription"
 )
 
+// known reports whether c is one of the RequestResourceType values.
+func (c RequestResourceType) known() bool {
+	switch c {
+	case RequestResourceTypeAppointment,
+		RequestResourceTypeAppointmentresponse,
+		RequestResourceTypeCareplan,
+		RequestResourceTypeClaim,
+		RequestResourceTypeCommunicationrequest,
+		RequestResourceTypeContract,
+		RequestResourceTypeDevicerequest,
+		RequestResourceTypeEnrollmentrequest,
+		RequestResourceTypeImmunizationrecommendation,
+		RequestResourceTypeMedicationrequest,
+		RequestResourceTypeNutritionorder,
+		RequestResourceTypeServicerequest,
+		RequestResourceTypeSupplyrequest,
+		RequestResourceTypeTask,
+		RequestResourceTypeVisionprescription:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RequestResourceType value in definition order.
+func (RequestResourceType) firstCode() string {
+	return string(RequestResourceTypeAppointment)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RequestResourceType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RequestResourceType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RequestStatus represents RequestStatus.
 type RequestStatus string
 
@@ -2962,6 +7743,36 @@ const (
 	RequestStatusUnknown RequestStatus = "unknown"
 )
 
+// known reports whether c is one of the RequestStatus values.
+func (c RequestStatus) known() bool {
+	switch c {
+	case RequestStatusDraft,
+		RequestStatusActive,
+		RequestStatusOnHold,
+		RequestStatusRevoked,
+		RequestStatusCompleted,
+		RequestStatusEnteredInError,
+		RequestStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RequestStatus value in definition order.
+func (RequestStatus) firstCode() string {
+	return string(RequestStatusDraft)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RequestStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RequestStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ResearchElementType represents ResearchElementType.
 type ResearchElementType string
 
@@ -2975,6 +7786,32 @@ const (
 	ResearchElementTypeOutcome ResearchElementType = "outcome"
 )
 
+// known reports whether c is one of the ResearchElementType values.
+func (c ResearchElementType) known() bool {
+	switch c {
+	case ResearchElementTypePopulation,
+		ResearchElementTypeExposure,
+		ResearchElementTypeOutcome:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ResearchElementType value in definition order.
+func (ResearchElementType) firstCode() string {
+	return string(ResearchElementTypePopulation)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ResearchElementType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ResearchElementType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ResearchStudyStatus represents ResearchStudyStatus.
 type ResearchStudyStatus string
 
@@ -3004,6 +7841,40 @@ const (
 	ResearchStudyStatusWithdrawn ResearchStudyStatus = "withdrawn"
 )
 
+// known reports whether c is one of the ResearchStudyStatus values.
+func (c ResearchStudyStatus) known() bool {
+	switch c {
+	case ResearchStudyStatusActive,
+		ResearchStudyStatusAdministrativelyCompleted,
+		ResearchStudyStatusApproved,
+		ResearchStudyStatusClosedToAccrual,
+		ResearchStudyStatusClosedToAccrualAndIntervention,
+		ResearchStudyStatusCompleted,
+		ResearchStudyStatusDisapproved,
+		ResearchStudyStatusInReview,
+		ResearchStudyStatusTemporarilyClosedToAccrual,
+		ResearchStudyStatusTemporarilyClosedToAccrualAndIntervention,
+		ResearchStudyStatusWithdrawn:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ResearchStudyStatus value in definition order.
+func (ResearchStudyStatus) firstCode() string {
+	return string(ResearchStudyStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ResearchStudyStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ResearchStudyStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ResearchSubjectStatus represents ResearchSubjectStatus.
 type ResearchSubjectStatus string
 
@@ -3037,6 +7908,42 @@ const (
 	ResearchSubjectStatusWithdrawn ResearchSubjectStatus = "withdrawn"
 )
 
+// known reports whether c is one of the ResearchSubjectStatus values.
+func (c ResearchSubjectStatus) known() bool {
+	switch c {
+	case ResearchSubjectStatusCandidate,
+		ResearchSubjectStatusEligible,
+		ResearchSubjectStatusFollowUp,
+		ResearchSubjectStatusIneligible,
+		ResearchSubjectStatusNotRegistered,
+		ResearchSubjectStatusOffStudy,
+		ResearchSubjectStatusOnStudy,
+		ResearchSubjectStatusOnStudyIntervention,
+		ResearchSubjectStatusOnStudyObservation,
+		ResearchSubjectStatusPendingOnStudy,
+		ResearchSubjectStatusPotentialCandidate,
+		ResearchSubjectStatusScreening,
+		ResearchSubjectStatusWithdrawn:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ResearchSubjectStatus value in definition order.
+func (ResearchSubjectStatus) firstCode() string {
+	return string(ResearchSubjectStatusCandidate)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ResearchSubjectStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ResearchSubjectStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AggregationMode represents AggregationMode.
 type AggregationMode string
 
@@ -3050,6 +7957,32 @@ const (
 	AggregationModeBundled AggregationMode = "bundled"
 )
 
+// known reports whether c is one of the AggregationMode values.
+func (c AggregationMode) known() bool {
+	switch c {
+	case AggregationModeContained,
+		AggregationModeReferenced,
+		AggregationModeBundled:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AggregationMode value in definition order.
+func (AggregationMode) firstCode() string {
+	return string(AggregationModeContained)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AggregationMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AggregationMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SlicingRules represents SlicingRules.
 type SlicingRules string
 
@@ -3063,6 +7996,32 @@ const (
 	SlicingRulesOpenatend SlicingRules = "openAtEnd"
 )
 
+// known reports whether c is one of the SlicingRules values.
+func (c SlicingRules) known() bool {
+	switch c {
+	case SlicingRulesClosed,
+		SlicingRulesOpen,
+		SlicingRulesOpenatend:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SlicingRules value in definition order.
+func (SlicingRules) firstCode() string {
+	return string(SlicingRulesClosed)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SlicingRules) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SlicingRules) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ResponseType represents ResponseType.
 type ResponseType string
 
@@ -3076,6 +8035,32 @@ const (
 	ResponseTypeFatalError ResponseType = "fatal-error"
 )
 
+// known reports whether c is one of the ResponseType values.
+func (c ResponseType) known() bool {
+	switch c {
+	case ResponseTypeOk,
+		ResponseTypeTransientError,
+		ResponseTypeFatalError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ResponseType value in definition order.
+func (ResponseType) firstCode() string {
+	return string(ResponseTypeOk)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ResponseType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ResponseType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RestfulCapabilityMode represents RestfulCapabilityMode.
 type RestfulCapabilityMode string
 
@@ -3087,6 +8072,31 @@ const (
 	RestfulCapabilityModeServer RestfulCapabilityMode = "server"
 )
 
+// known reports whether c is one of the RestfulCapabilityMode values.
+func (c RestfulCapabilityMode) known() bool {
+	switch c {
+	case RestfulCapabilityModeClient,
+		RestfulCapabilityModeServer:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RestfulCapabilityMode value in definition order.
+func (RestfulCapabilityMode) firstCode() string {
+	return string(RestfulCapabilityModeClient)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RestfulCapabilityMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RestfulCapabilityMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SearchComparator represents SearchComparator.
 type SearchComparator string
 
@@ -3112,6 +8122,38 @@ const (
 	SearchComparatorAp SearchComparator = "ap"
 )
 
+// known reports whether c is one of the SearchComparator values.
+func (c SearchComparator) known() bool {
+	switch c {
+	case SearchComparatorEq,
+		SearchComparatorNe,
+		SearchComparatorGt,
+		SearchComparatorLt,
+		SearchComparatorGe,
+		SearchComparatorLe,
+		SearchComparatorSa,
+		SearchComparatorEb,
+		SearchComparatorAp:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SearchComparator value in definition order.
+func (SearchComparator) firstCode() string {
+	return string(SearchComparatorEq)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SearchComparator) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SearchComparator) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SearchEntryMode represents SearchEntryMode.
 type SearchEntryMode string
 
@@ -3125,6 +8167,32 @@ const (
 	SearchEntryModeOutcome SearchEntryMode = "outcome"
 )
 
+// known reports whether c is one of the SearchEntryMode values.
+func (c SearchEntryMode) known() bool {
+	switch c {
+	case SearchEntryModeMatch,
+		SearchEntryModeInclude,
+		SearchEntryModeOutcome:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SearchEntryMode value in definition order.
+func (SearchEntryMode) firstCode() string {
+	return string(SearchEntryModeMatch)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SearchEntryMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SearchEntryMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SearchModifierCode represents SearchModifierCode.
 type SearchModifierCode string
 
@@ -3156,6 +8224,41 @@ const (
 	SearchModifierCodeOftype SearchModifierCode = "ofType"
 )
 
+// known reports whether c is one of the SearchModifierCode values.
+func (c SearchModifierCode) known() bool {
+	switch c {
+	case SearchModifierCodeMissing,
+		SearchModifierCodeExact,
+		SearchModifierCodeContains,
+		SearchModifierCodeNot,
+		SearchModifierCodeText,
+		SearchModifierCodeIn,
+		SearchModifierCodeNotIn,
+		SearchModifierCodeBelow,
+		SearchModifierCodeAbove,
+		SearchModifierCodeType,
+		SearchModifierCodeIdentifier,
+		SearchModifierCodeOftype:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SearchModifierCode value in definition order.
+func (SearchModifierCode) firstCode() string {
+	return string(SearchModifierCodeMissing)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SearchModifierCode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SearchModifierCode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SearchParamType represents SearchParamType.
 type SearchParamType string
 
@@ -3181,6 +8284,38 @@ const (
 	SearchParamTypeSpecial SearchParamType = "special"
 )
 
+// known reports whether c is one of the SearchParamType values.
+func (c SearchParamType) known() bool {
+	switch c {
+	case SearchParamTypeNumber,
+		SearchParamTypeDate,
+		SearchParamTypeString,
+		SearchParamTypeToken,
+		SearchParamTypeReference,
+		SearchParamTypeComposite,
+		SearchParamTypeQuantity,
+		SearchParamTypeUri,
+		SearchParamTypeSpecial:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SearchParamType value in definition order.
+func (SearchParamType) firstCode() string {
+	return string(SearchParamTypeNumber)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SearchParamType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SearchParamType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // XPathUsageType represents XPathUsageType.
 type XPathUsageType string
 
@@ -3198,6 +8333,34 @@ const (
 	XPathUsageTypeOther XPathUsageType = "other"
 )
 
+// known reports whether c is one of the XPathUsageType values.
+func (c XPathUsageType) known() bool {
+	switch c {
+	case XPathUsageTypeNormal,
+		XPathUsageTypePhonetic,
+		XPathUsageTypeNearby,
+		XPathUsageTypeDistance,
+		XPathUsageTypeOther:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first XPathUsageType value in definition order.
+func (XPathUsageType) firstCode() string {
+	return string(XPathUsageTypeNormal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c XPathUsageType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *XPathUsageType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SequenceType represents sequenceType.
 type SequenceType string
 
@@ -3211,6 +8374,32 @@ const (
 	SequenceTypeRna SequenceType = "rna"
 )
 
+// known reports whether c is one of the SequenceType values.
+func (c SequenceType) known() bool {
+	switch c {
+	case SequenceTypeAa,
+		SequenceTypeDna,
+		SequenceTypeRna:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SequenceType value in definition order.
+func (SequenceType) firstCode() string {
+	return string(SequenceTypeAa)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SequenceType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SequenceType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SlotStatus represents SlotStatus.
 type SlotStatus string
 
@@ -3228,6 +8417,34 @@ const (
 	SlotStatusEnteredInError SlotStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the SlotStatus values.
+func (c SlotStatus) known() bool {
+	switch c {
+	case SlotStatusBusy,
+		SlotStatusFree,
+		SlotStatusBusyUnavailable,
+		SlotStatusBusyTentative,
+		SlotStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SlotStatus value in definition order.
+func (SlotStatus) firstCode() string {
+	return string(SlotStatusBusy)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SlotStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SlotStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SortDirection represents SortDirection.
 type SortDirection string
 
@@ -3239,6 +8456,31 @@ const (
 	SortDirectionDescending SortDirection = "descending"
 )
 
+// known reports whether c is one of the SortDirection values.
+func (c SortDirection) known() bool {
+	switch c {
+	case SortDirectionAscending,
+		SortDirectionDescending:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SortDirection value in definition order.
+func (SortDirection) firstCode() string {
+	return string(SortDirectionAscending)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SortDirection) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SortDirection) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SpecimenContainedPreference represents SpecimenContainedPreference.
 type SpecimenContainedPreference string
 
@@ -3250,6 +8492,31 @@ const (
 	SpecimenContainedPreferenceAlternate SpecimenContainedPreference = "alternate"
 )
 
+// known reports whether c is one of the SpecimenContainedPreference values.
+func (c SpecimenContainedPreference) known() bool {
+	switch c {
+	case SpecimenContainedPreferencePreferred,
+		SpecimenContainedPreferenceAlternate:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SpecimenContainedPreference value in definition order.
+func (SpecimenContainedPreference) firstCode() string {
+	return string(SpecimenContainedPreferencePreferred)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SpecimenContainedPreference) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SpecimenContainedPreference) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SpecimenStatus represents SpecimenStatus.
 type SpecimenStatus string
 
@@ -3265,6 +8532,33 @@ const (
 	SpecimenStatusEnteredInError SpecimenStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the SpecimenStatus values.
+func (c SpecimenStatus) known() bool {
+	switch c {
+	case SpecimenStatusAvailable,
+		SpecimenStatusUnavailable,
+		SpecimenStatusUnsatisfactory,
+		SpecimenStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SpecimenStatus value in definition order.
+func (SpecimenStatus) firstCode() string {
+	return string(SpecimenStatusAvailable)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SpecimenStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SpecimenStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StrandType represents strandType.
 type StrandType string
 
@@ -3276,6 +8570,31 @@ const (
 	StrandTypeCrick StrandType = "crick"
 )
 
+// known reports whether c is one of the StrandType values.
+func (c StrandType) known() bool {
+	switch c {
+	case StrandTypeWatson,
+		StrandTypeCrick:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StrandType value in definition order.
+func (StrandType) firstCode() string {
+	return string(StrandTypeWatson)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StrandType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StrandType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureDefinitionKind represents StructureDefinitionKind.
 type StructureDefinitionKind string
 
@@ -3291,6 +8610,33 @@ const (
 	StructureDefinitionKindLogical StructureDefinitionKind = "logical"
 )
 
+// known reports whether c is one of the StructureDefinitionKind values.
+func (c StructureDefinitionKind) known() bool {
+	switch c {
+	case StructureDefinitionKindPrimitiveType,
+		StructureDefinitionKindComplexType,
+		StructureDefinitionKindResource,
+		StructureDefinitionKindLogical:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureDefinitionKind value in definition order.
+func (StructureDefinitionKind) firstCode() string {
+	return string(StructureDefinitionKindPrimitiveType)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureDefinitionKind) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureDefinitionKind) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SubscriptionChannelType represents SubscriptionChannelType.
 type SubscriptionChannelType string
 
@@ -3308,6 +8654,34 @@ const (
 	SubscriptionChannelTypeMessage SubscriptionChannelType = "message"
 )
 
+// known reports whether c is one of the SubscriptionChannelType values.
+func (c SubscriptionChannelType) known() bool {
+	switch c {
+	case SubscriptionChannelTypeRestHook,
+		SubscriptionChannelTypeWebsocket,
+		SubscriptionChannelTypeEmail,
+		SubscriptionChannelTypeSms,
+		SubscriptionChannelTypeMessage:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SubscriptionChannelType value in definition order.
+func (SubscriptionChannelType) firstCode() string {
+	return string(SubscriptionChannelTypeRestHook)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SubscriptionChannelType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SubscriptionChannelType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SubscriptionStatus represents SubscriptionStatus.
 type SubscriptionStatus string
 
@@ -3323,6 +8697,33 @@ const (
 	SubscriptionStatusOff SubscriptionStatus = "off"
 )
 
+// known reports whether c is one of the SubscriptionStatus values.
+func (c SubscriptionStatus) known() bool {
+	switch c {
+	case SubscriptionStatusRequested,
+		SubscriptionStatusActive,
+		SubscriptionStatusError,
+		SubscriptionStatusOff:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SubscriptionStatus value in definition order.
+func (SubscriptionStatus) firstCode() string {
+	return string(SubscriptionStatusRequested)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SubscriptionStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SubscriptionStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // FHIRSubstanceStatus represents FHIRSubstanceStatus.
 type FHIRSubstanceStatus string
 
@@ -3336,6 +8737,32 @@ const (
 	FHIRSubstanceStatusEnteredInError FHIRSubstanceStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the FHIRSubstanceStatus values.
+func (c FHIRSubstanceStatus) known() bool {
+	switch c {
+	case FHIRSubstanceStatusActive,
+		FHIRSubstanceStatusInactive,
+		FHIRSubstanceStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FHIRSubstanceStatus value in definition order.
+func (FHIRSubstanceStatus) firstCode() string {
+	return string(FHIRSubstanceStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FHIRSubstanceStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FHIRSubstanceStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SupplyDeliveryStatus represents SupplyDeliveryStatus.
 type SupplyDeliveryStatus string
 
@@ -3351,6 +8778,33 @@ const (
 	SupplyDeliveryStatusEnteredInError SupplyDeliveryStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the SupplyDeliveryStatus values.
+func (c SupplyDeliveryStatus) known() bool {
+	switch c {
+	case SupplyDeliveryStatusInProgress,
+		SupplyDeliveryStatusCompleted,
+		SupplyDeliveryStatusAbandoned,
+		SupplyDeliveryStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SupplyDeliveryStatus value in definition order.
+func (SupplyDeliveryStatus) firstCode() string {
+	return string(SupplyDeliveryStatusInProgress)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SupplyDeliveryStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SupplyDeliveryStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SupplyRequestStatus represents SupplyRequestStatus.
 type SupplyRequestStatus string
 
@@ -3372,6 +8826,36 @@ const (
 	SupplyRequestStatusUnknown SupplyRequestStatus = "unknown"
 )
 
+// known reports whether c is one of the SupplyRequestStatus values.
+func (c SupplyRequestStatus) known() bool {
+	switch c {
+	case SupplyRequestStatusDraft,
+		SupplyRequestStatusActive,
+		SupplyRequestStatusSuspended,
+		SupplyRequestStatusCancelled,
+		SupplyRequestStatusCompleted,
+		SupplyRequestStatusEnteredInError,
+		SupplyRequestStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SupplyRequestStatus value in definition order.
+func (SupplyRequestStatus) firstCode() string {
+	return string(SupplyRequestStatusDraft)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SupplyRequestStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SupplyRequestStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SystemRestfulInteraction represents SystemRestfulInteraction.
 type SystemRestfulInteraction string
 
@@ -3383,6 +8867,33 @@ const (
 	SystemRestfulInteractionHistorySystem SystemRestfulInteraction = "history-system"
 )
 
+// known reports whether c is one of the SystemRestfulInteraction values.
+func (c SystemRestfulInteraction) known() bool {
+	switch c {
+	case SystemRestfulInteractionTransaction,
+		SystemRestfulInteractionBatch,
+		SystemRestfulInteractionSearchSystem,
+		SystemRestfulInteractionHistorySystem:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SystemRestfulInteraction value in definition order.
+func (SystemRestfulInteraction) firstCode() string {
+	return string(SystemRestfulInteractionTransaction)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SystemRestfulInteraction) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SystemRestfulInteraction) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TaskIntent represents TaskIntent.
 type TaskIntent string
 
@@ -3400,6 +8911,38 @@ const (
 	TaskIntentOption        TaskIntent = "option"
 )
 
+// known reports whether c is one of the TaskIntent values.
+func (c TaskIntent) known() bool {
+	switch c {
+	case TaskIntentUnknown,
+		TaskIntentProposal,
+		TaskIntentPlan,
+		TaskIntentOrder,
+		TaskIntentOriginalOrder,
+		TaskIntentReflexOrder,
+		TaskIntentFillerOrder,
+		TaskIntentInstanceOrder,
+		TaskIntentOption:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TaskIntent value in definition order.
+func (TaskIntent) firstCode() string {
+	return string(TaskIntentUnknown)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TaskIntent) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TaskIntent) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TaskStatus represents TaskStatus.
 type TaskStatus string
 
@@ -3431,6 +8974,41 @@ const (
 	TaskStatusEnteredInError TaskStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the TaskStatus values.
+func (c TaskStatus) known() bool {
+	switch c {
+	case TaskStatusDraft,
+		TaskStatusRequested,
+		TaskStatusReceived,
+		TaskStatusAccepted,
+		TaskStatusRejected,
+		TaskStatusReady,
+		TaskStatusCancelled,
+		TaskStatusInProgress,
+		TaskStatusOnHold,
+		TaskStatusFailed,
+		TaskStatusCompleted,
+		TaskStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TaskStatus value in definition order.
+func (TaskStatus) firstCode() string {
+	return string(TaskStatusDraft)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TaskStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TaskStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TriggerType represents TriggerType.
 type TriggerType string
 
@@ -3454,6 +9032,37 @@ const (
 	TriggerTypeDataAccessEnded TriggerType = "data-access-ended"
 )
 
+// known reports whether c is one of the TriggerType values.
+func (c TriggerType) known() bool {
+	switch c {
+	case TriggerTypeNamedEvent,
+		TriggerTypePeriodic,
+		TriggerTypeDataChanged,
+		TriggerTypeDataAdded,
+		TriggerTypeDataModified,
+		TriggerTypeDataRemoved,
+		TriggerTypeDataAccessed,
+		TriggerTypeDataAccessEnded:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TriggerType value in definition order.
+func (TriggerType) firstCode() string {
+	return string(TriggerTypeNamedEvent)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TriggerType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TriggerType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TypeDerivationRule represents TypeDerivationRule.
 type TypeDerivationRule string
 
@@ -3465,6 +9074,31 @@ const (
 	TypeDerivationRuleConstraint TypeDerivationRule = "constraint"
 )
 
+// known reports whether c is one of the TypeDerivationRule values.
+func (c TypeDerivationRule) known() bool {
+	switch c {
+	case TypeDerivationRuleSpecialization,
+		TypeDerivationRuleConstraint:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TypeDerivationRule value in definition order.
+func (TypeDerivationRule) firstCode() string {
+	return string(TypeDerivationRuleSpecialization)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TypeDerivationRule) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TypeDerivationRule) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TypeRestfulInteraction represents TypeRestfulInteraction.
 type TypeRestfulInteraction string
 
@@ -3481,6 +9115,38 @@ const (
 	TypeRestfulInteractionSearchType      TypeRestfulInteraction = "search-type"
 )
 
+// known reports whether c is one of the TypeRestfulInteraction values.
+func (c TypeRestfulInteraction) known() bool {
+	switch c {
+	case TypeRestfulInteractionRead,
+		TypeRestfulInteractionVread,
+		TypeRestfulInteractionUpdate,
+		TypeRestfulInteractionPatch,
+		TypeRestfulInteractionDelete,
+		TypeRestfulInteractionHistoryInstance,
+		TypeRestfulInteractionHistoryType,
+		TypeRestfulInteractionCreate,
+		TypeRestfulInteractionSearchType:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TypeRestfulInteraction value in definition order.
+func (TypeRestfulInteraction) firstCode() string {
+	return string(TypeRestfulInteractionRead)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TypeRestfulInteraction) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TypeRestfulInteraction) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // UDIEntryType represents UDIEntryType.
 type UDIEntryType string
 
@@ -3500,6 +9166,35 @@ const (
 	UDIEntryTypeUnknown UDIEntryType = "unknown"
 )
 
+// known reports whether c is one of the UDIEntryType values.
+func (c UDIEntryType) known() bool {
+	switch c {
+	case UDIEntryTypeBarcode,
+		UDIEntryTypeRfid,
+		UDIEntryTypeManual,
+		UDIEntryTypeCard,
+		UDIEntryTypeSelfReported,
+		UDIEntryTypeUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first UDIEntryType value in definition order.
+func (UDIEntryType) firstCode() string {
+	return string(UDIEntryTypeBarcode)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c UDIEntryType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *UDIEntryType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // UnitsOfTime represents UnitsOfTime.
 type UnitsOfTime string
 
@@ -3521,6 +9216,36 @@ const (
 	UnitsOfTimeA UnitsOfTime = "a"
 )
 
+// known reports whether c is one of the UnitsOfTime values.
+func (c UnitsOfTime) known() bool {
+	switch c {
+	case UnitsOfTimeS,
+		UnitsOfTimeMin,
+		UnitsOfTimeH,
+		UnitsOfTimeD,
+		UnitsOfTimeWk,
+		UnitsOfTimeMo,
+		UnitsOfTimeA:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first UnitsOfTime value in definition order.
+func (UnitsOfTime) firstCode() string {
+	return string(UnitsOfTimeS)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c UnitsOfTime) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *UnitsOfTime) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EvidenceVariableType represents EvidenceVariableType.
 type EvidenceVariableType string
 
@@ -3534,6 +9259,32 @@ const (
 	EvidenceVariableTypeDescriptive EvidenceVariableType = "descriptive"
 )
 
+// known reports whether c is one of the EvidenceVariableType values.
+func (c EvidenceVariableType) known() bool {
+	switch c {
+	case EvidenceVariableTypeDichotomous,
+		EvidenceVariableTypeContinuous,
+		EvidenceVariableTypeDescriptive:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EvidenceVariableType value in definition order.
+func (EvidenceVariableType) firstCode() string {
+	return string(EvidenceVariableTypeDichotomous)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EvidenceVariableType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EvidenceVariableType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // Status represents Status.
 type Status string
 
@@ -3553,6 +9304,35 @@ const (
 	StatusRevalFail Status = "reval-fail"
 )
 
+// known reports whether c is one of the Status values.
+func (c Status) known() bool {
+	switch c {
+	case StatusAttested,
+		StatusValidated,
+		StatusInProcess,
+		StatusReqRevalid,
+		StatusValFail,
+		StatusRevalFail:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first Status value in definition order.
+func (Status) firstCode() string {
+	return string(StatusAttested)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c Status) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *Status) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ResourceVersionPolicy represents ResourceVersionPolicy.
 type ResourceVersionPolicy string
 
@@ -3566,6 +9346,32 @@ const (
 	ResourceVersionPolicyVersionedUpdate ResourceVersionPolicy = "versioned-update"
 )
 
+// known reports whether c is one of the ResourceVersionPolicy values.
+func (c ResourceVersionPolicy) known() bool {
+	switch c {
+	case ResourceVersionPolicyNoVersion,
+		ResourceVersionPolicyVersioned,
+		ResourceVersionPolicyVersionedUpdate:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ResourceVersionPolicy value in definition order.
+func (ResourceVersionPolicy) firstCode() string {
+	return string(ResourceVersionPolicyNoVersion)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ResourceVersionPolicy) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ResourceVersionPolicy) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // VisionBase represents VisionBase.
 type VisionBase string
 
@@ -3581,6 +9387,33 @@ const (
 	VisionBaseOut VisionBase = "out"
 )
 
+// known reports whether c is one of the VisionBase values.
+func (c VisionBase) known() bool {
+	switch c {
+	case VisionBaseUp,
+		VisionBaseDown,
+		VisionBaseIn,
+		VisionBaseOut:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first VisionBase value in definition order.
+func (VisionBase) firstCode() string {
+	return string(VisionBaseUp)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c VisionBase) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *VisionBase) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // VisionEyes represents VisionEyes.
 type VisionEyes string
 
@@ -3591,3 +9424,28 @@ const (
 	// VisionEyesLeft - Left Eye
 	VisionEyesLeft VisionEyes = "left"
 )
+
+// known reports whether c is one of the VisionEyes values.
+func (c VisionEyes) known() bool {
+	switch c {
+	case VisionEyesRight,
+		VisionEyesLeft:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first VisionEyes value in definition order.
+func (VisionEyes) firstCode() string {
+	return string(VisionEyesRight)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c VisionEyes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *VisionEyes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
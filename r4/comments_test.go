@@ -0,0 +1,77 @@
+package r4_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4"
+)
+
+func TestCommentedResource(t *testing.T) {
+	data := []byte(`{
+		"resourceType": "Observation",
+		"fhir_comments": ["imported from legacy lab system"],
+		"status": "final",
+		"_status": {"fhir_comments": ["verified by phone"]},
+		"code": {
+			"fhir_comments": ["local code mapped to LOINC"],
+			"coding": [{"system": "http://loinc.org", "code": "29463-7"}]
+		},
+		"valueQuantity": {"value": 72.50, "unit": "kg"}
+	}`)
+
+	t.Run("round trip", func(t *testing.T) {
+		var c r4.CommentedResource
+		require.NoError(t, json.Unmarshal(data, &c))
+
+		obs, ok := c.Resource.(*r4.Observation)
+		require.True(t, ok)
+		assert.Equal(t, r4.ObservationStatusFinal, *obs.Status)
+		assert.Equal(t, map[string][]string{
+			"Observation":         {"imported from legacy lab system"},
+			"Observation._status": {"verified by phone"},
+			"Observation.code":    {"local code mapped to LOINC"},
+		}, c.Comments)
+
+		out, err := r4.Marshal(c)
+		require.NoError(t, err)
+		assert.JSONEq(t, string(data), string(out))
+		assert.Contains(t, string(out), `"value":72.50`)
+	})
+
+	t.Run("dropped by default", func(t *testing.T) {
+		r, err := r4.UnmarshalResource(data)
+		require.NoError(t, err)
+
+		out, err := r4.Marshal(r)
+		require.NoError(t, err)
+		assert.NotContains(t, string(out), "fhir_comments")
+	})
+
+	t.Run("companion created for primitive", func(t *testing.T) {
+		c := r4.CommentedResource{
+			Resource: &r4.Patient{
+				BirthDate: ptrString("1970-01-01"),
+				Name:      []r4.HumanName{{Given: []string{"Ann", "Marie"}}},
+				Text:      &r4.Narrative{Div: ptrString(`<div xmlns="http://www.w3.org/1999/xhtml">A &amp; B</div>`)},
+			},
+			Comments: map[string][]string{
+				"Patient._birthDate":        {"estimated"},
+				"Patient.name[0]._given[1]": {"middle name"},
+			},
+		}
+
+		out, err := r4.Marshal(c)
+		require.NoError(t, err)
+		assert.Contains(t, string(out), `"birthDate":"1970-01-01","_birthDate":{"fhir_comments":["estimated"]}`)
+		assert.Contains(t, string(out), `"_given":[null,{"fhir_comments":["middle name"]}]`)
+		assert.Contains(t, string(out), `A &amp; B</div>`)
+
+		var back r4.CommentedResource
+		require.NoError(t, json.Unmarshal(out, &back))
+		assert.Equal(t, c.Comments, back.Comments)
+	})
+}
@@ -0,0 +1,77 @@
+package r4
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Change describes a primitive element that differs between two resources.
+type Change struct {
+	Path string // FHIRPath of the element, as used by Walk
+	Old  any    // value in the first resource, or nil if absent
+	New  any    // value in the second resource, or nil if absent
+}
+
+// String formats the change for display, e.g.
+// `Patient.name[0].family: "Doe" -> "Smith"`.
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Path, formatChangeValue(c.Old), formatChangeValue(c.New))
+}
+
+// formatChangeValue formats one side of a Change.
+func formatChangeValue(v any) string {
+	if v == nil {
+		return "(absent)"
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.String {
+		return fmt.Sprintf("%q", rv.String())
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// DiffReport compares the primitive elements of a and b, as visited by Walk,
+// and returns one Change per path whose value differs, was added, or was
+// removed. Values are the dereferenced primitives (e.g. string, bool,
+// ObservationStatus, Decimal). Changes follow a's element order, followed by
+// the elements present only in b in b's order.
+//
+// Since paths carry list indexes, inserting an entry at the front of a list
+// reports every later entry as changed.
+func DiffReport(a, b Resource) []Change {
+	oldPaths, oldValues := primitiveValues(a)
+	newPaths, newValues := primitiveValues(b)
+
+	var changes []Change
+	for _, path := range oldPaths {
+		oldValue := oldValues[path]
+		newValue, ok := newValues[path]
+		if !ok {
+			changes = append(changes, Change{Path: path, Old: oldValue})
+		} else if !reflect.DeepEqual(oldValue, newValue) {
+			changes = append(changes, Change{Path: path, Old: oldValue, New: newValue})
+		}
+	}
+	for _, path := range newPaths {
+		if _, ok := oldValues[path]; !ok {
+			changes = append(changes, Change{Path: path, New: newValues[path]})
+		}
+	}
+	return changes
+}
+
+// primitiveValues returns the paths of the primitive elements of r in visit
+// order, and their dereferenced values.
+func primitiveValues(r Resource) ([]string, map[string]any) {
+	var paths []string
+	values := make(map[string]any)
+	_ = Walk(r, func(path string, node any) error {
+		v := reflect.ValueOf(node)
+		if v.Kind() != reflect.Ptr || isCompositeStruct(v.Type().Elem()) {
+			return nil
+		}
+		paths = append(paths, path)
+		values[path] = v.Elem().Interface()
+		return nil
+	})
+	return paths, values
+}
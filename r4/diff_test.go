@@ -0,0 +1,49 @@
+package r4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4"
+)
+
+func TestDiffReport(t *testing.T) {
+	t.Run("changed primitives", func(t *testing.T) {
+		a := &r4.Patient{
+			Id:     ptrString("p1"),
+			Active: ptrBool(true),
+			Name:   []r4.HumanName{{Family: ptrString("Doe"), Given: []string{"John"}}},
+		}
+		b := &r4.Patient{
+			Id:     ptrString("p1"),
+			Active: ptrBool(false),
+			Name:   []r4.HumanName{{Family: ptrString("Smith"), Given: []string{"John"}}},
+		}
+
+		changes := r4.DiffReport(a, b)
+		require.Len(t, changes, 2)
+		assert.Equal(t, r4.Change{Path: "Patient.active", Old: true, New: false}, changes[0])
+		assert.Equal(t, r4.Change{Path: "Patient.name[0].family", Old: "Doe", New: "Smith"}, changes[1])
+		assert.Equal(t, `Patient.name[0].family: "Doe" -> "Smith"`, changes[1].String())
+	})
+
+	t.Run("added and removed elements", func(t *testing.T) {
+		gender := r4.AdministrativeGenderMale
+		a := &r4.Patient{Gender: &gender}
+		b := &r4.Patient{BirthDate: ptrString("1970-01-01")}
+
+		changes := r4.DiffReport(a, b)
+		require.Len(t, changes, 2)
+		assert.Equal(t, r4.Change{Path: "Patient.gender", Old: r4.AdministrativeGenderMale}, changes[0])
+		assert.Equal(t, r4.Change{Path: "Patient.birthDate", New: "1970-01-01"}, changes[1])
+		assert.Equal(t, `Patient.gender: "male" -> (absent)`, changes[0].String())
+	})
+
+	t.Run("identical resources", func(t *testing.T) {
+		a := &r4.Patient{Active: ptrBool(true), Name: []r4.HumanName{{Family: ptrString("Doe")}}}
+		b := &r4.Patient{Active: ptrBool(true), Name: []r4.HumanName{{Family: ptrString("Doe")}}}
+		assert.Empty(t, r4.DiffReport(a, b))
+	})
+}
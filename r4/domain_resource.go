@@ -0,0 +1,10 @@
+package r4
+
+// AsDomainResource returns r as a DomainResource, for middleware that sets
+// narrative or extensions uniformly across resource types. It returns false
+// for a nil r and for the resources that are not domain resources: Bundle,
+// Binary and Parameters.
+func AsDomainResource(r Resource) (DomainResource, bool) {
+	dr, ok := r.(DomainResource)
+	return dr, ok
+}
@@ -0,0 +1,71 @@
+package r4
+
+import "time"
+
+// dateTimeLayouts are the forms a FHIR dateTime may take, from most to least
+// precise. time.RFC3339 also accepts fractional seconds.
+var dateTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02",
+	"2006-01",
+	"2006",
+}
+
+// EffectiveTime returns the clinically relevant time of r, for ordering
+// resources on a timeline. It reads the effective[x], performed[x] or
+// occurrence[x] choice element of the common clinical resources (e.g.
+// Observation.effective[x], Procedure.performed[x]) and the period of an
+// Encounter. A dateTime or instant is returned as is and a Period yields its
+// start. Partial dates such as "2024-03" resolve to their first instant in
+// UTC.
+//
+// It returns false if r is not one of those resources, the element is absent
+// or its value cannot be parsed.
+func EffectiveTime(r Resource) (time.Time, bool) {
+	switch r := r.(type) {
+	case *Observation:
+		if r.EffectiveInstant != nil {
+			return parseDateTime(r.EffectiveInstant)
+		}
+		return effectiveTime(r.EffectiveDateTime, r.EffectivePeriod)
+	case *DiagnosticReport:
+		return effectiveTime(r.EffectiveDateTime, r.EffectivePeriod)
+	case *Procedure:
+		return effectiveTime(r.PerformedDateTime, r.PerformedPeriod)
+	case *Immunization:
+		return effectiveTime(r.OccurrenceDateTime, nil)
+	case *MedicationAdministration:
+		return effectiveTime(r.EffectiveDateTime, r.EffectivePeriod)
+	case *MedicationStatement:
+		return effectiveTime(r.EffectiveDateTime, r.EffectivePeriod)
+	case *ClinicalImpression:
+		return effectiveTime(r.EffectiveDateTime, r.EffectivePeriod)
+	case *Encounter:
+		return effectiveTime(nil, r.Period)
+	}
+	return time.Time{}, false
+}
+
+// effectiveTime returns the parsed dateTime, or else the start of period.
+func effectiveTime(dateTime *string, period *Period) (time.Time, bool) {
+	if dateTime != nil {
+		return parseDateTime(dateTime)
+	}
+	if period != nil {
+		return parseDateTime(period.Start)
+	}
+	return time.Time{}, false
+}
+
+// parseDateTime parses s in any of the dateTimeLayouts.
+func parseDateTime(s *string) (time.Time, bool) {
+	if s == nil {
+		return time.Time{}, false
+	}
+	for _, layout := range dateTimeLayouts {
+		if t, err := time.Parse(layout, *s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
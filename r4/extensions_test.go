@@ -0,0 +1,154 @@
+package r4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4"
+)
+
+func TestRemoveExtensions(t *testing.T) {
+	const vendor = "http://vendor.example.org/fhir/"
+
+	newPatient := func() *r4.Patient {
+		return &r4.Patient{
+			Extension: []r4.Extension{
+				{Url: vendor + "internal-id", ValueString: ptrString("123")},
+				{Url: "http://hl7.org/fhir/StructureDefinition/patient-birthPlace", ValueString: ptrString("Paris")},
+			},
+			BirthDate: ptrString("1970-01-01"),
+			BirthDateExt: &r4.Element{Extension: []r4.Extension{
+				{Url: vendor + "birth-date-source", ValueString: ptrString("registry")},
+			}},
+			Name: []r4.HumanName{{
+				Family: ptrString("Doe"),
+				FamilyExt: &r4.Element{Extension: []r4.Extension{
+					{Url: vendor + "family-source", ValueString: ptrString("import")},
+					{Url: "http://hl7.org/fhir/StructureDefinition/humanname-own-prefix", ValueString: ptrString("van")},
+				}},
+			}},
+		}
+	}
+
+	t.Run("removes matching extensions", func(t *testing.T) {
+		patient := newPatient()
+
+		got, ok := r4.RemoveExtensions(patient, vendor).(*r4.Patient)
+		require.True(t, ok)
+		require.Len(t, got.Extension, 1)
+		assert.Equal(t, "http://hl7.org/fhir/StructureDefinition/patient-birthPlace", got.Extension[0].Url)
+		assert.Nil(t, got.BirthDateExt, "emptied companion is removed")
+		assert.Equal(t, "1970-01-01", *got.BirthDate)
+		require.NotNil(t, got.Name[0].FamilyExt)
+		require.Len(t, got.Name[0].FamilyExt.Extension, 1)
+		assert.Equal(t, "http://hl7.org/fhir/StructureDefinition/humanname-own-prefix", got.Name[0].FamilyExt.Extension[0].Url)
+
+		data, err := r4.Marshal(got)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), vendor)
+		assert.NotContains(t, string(data), "_birthDate")
+	})
+
+	t.Run("original is unchanged", func(t *testing.T) {
+		patient := newPatient()
+
+		r4.RemoveExtensions(patient, vendor)
+		assert.Len(t, patient.Extension, 2)
+		require.NotNil(t, patient.BirthDateExt)
+		assert.Len(t, patient.BirthDateExt.Extension, 1)
+	})
+
+	t.Run("nested extensions", func(t *testing.T) {
+		patient := &r4.Patient{Extension: []r4.Extension{{
+			Url: "http://example.org/complex",
+			Extension: []r4.Extension{
+				{Url: vendor + "part", ValueString: ptrString("x")},
+				{Url: "part", ValueString: ptrString("y")},
+			},
+		}}}
+
+		got := r4.RemoveExtensions(patient, vendor).(*r4.Patient)
+		require.Len(t, got.Extension, 1)
+		require.Len(t, got.Extension[0].Extension, 1)
+		assert.Equal(t, "part", got.Extension[0].Extension[0].Url)
+	})
+
+	t.Run("empty prefix removes all extensions", func(t *testing.T) {
+		patient := newPatient()
+		patient.ModifierExtension = []r4.Extension{{Url: "http://example.org/modifier", ValueBoolean: ptrBool(true)}}
+
+		got := r4.RemoveExtensions(patient, "").(*r4.Patient)
+		assert.Empty(t, got.Extension)
+		assert.Empty(t, got.ModifierExtension)
+		assert.Nil(t, got.BirthDateExt)
+		assert.Nil(t, got.Name[0].FamilyExt)
+		assert.Equal(t, "Doe", *got.Name[0].Family)
+	})
+}
+
+func TestCheckModifierExtensions(t *testing.T) {
+	const (
+		knownURL   = "http://example.org/fhir/StructureDefinition/known-modifier"
+		unknownURL = "http://example.org/fhir/StructureDefinition/unknown-modifier"
+	)
+	known := map[string]bool{knownURL: true}
+
+	t.Run("known", func(t *testing.T) {
+		patient := &r4.Patient{
+			ModifierExtension: []r4.Extension{{Url: knownURL, ValueBoolean: ptrBool(true)}},
+			Extension:         []r4.Extension{{Url: unknownURL, ValueBoolean: ptrBool(true)}},
+		}
+		assert.NoError(t, r4.CheckModifierExtensions(patient, known))
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		patient := &r4.Patient{
+			ModifierExtension: []r4.Extension{{Url: knownURL, ValueBoolean: ptrBool(true)}},
+			Contact: []r4.PatientContact{{
+				ModifierExtension: []r4.Extension{{Url: unknownURL, ValueBoolean: ptrBool(true)}},
+			}},
+		}
+		err := r4.CheckModifierExtensions(patient, known)
+		assert.Equal(t, []string{"Patient.contact[0].modifierExtension[0]"}, validationPaths(t, err))
+		assert.ErrorContains(t, err, unknownURL)
+	})
+}
+
+func TestExtensionURLs(t *testing.T) {
+	patient := &r4.Patient{
+		Id: ptrString("p1"),
+		Extension: []r4.Extension{
+			{Url: "http://example.org/race", Extension: []r4.Extension{
+				{Url: "ombCategory"},
+				{Url: "text", ValueString: ptrString("x")},
+			}},
+		},
+		ModifierExtension: []r4.Extension{{Url: "http://example.org/modifier"}},
+		BirthDate:         ptrString("1970-01-01"),
+		BirthDateExt: &r4.Element{Extension: []r4.Extension{
+			{Url: "http://example.org/birthTime"},
+		}},
+		Name: []r4.HumanName{{
+			Family:    ptrString("Doe"),
+			Extension: []r4.Extension{{Url: "http://example.org/race"}},
+		}},
+		Contained: []r4.Resource{&r4.Organization{
+			Id:        ptrString("o1"),
+			Extension: []r4.Extension{{Url: "http://example.org/contained"}},
+		}},
+	}
+
+	assert.ElementsMatch(t, []string{
+		"http://example.org/race",
+		"ombCategory",
+		"text",
+		"http://example.org/modifier",
+		"http://example.org/birthTime",
+		"http://example.org/contained",
+	}, r4.ExtensionURLs(patient))
+
+	assert.Empty(t, r4.ExtensionURLs(&r4.Patient{Id: ptrString("p2")}))
+	assert.Empty(t, r4.ExtensionURLs(nil))
+}
@@ -0,0 +1,89 @@
+package r4
+
+import "bytes"
+
+// UnmarshalResourceJSONLenient is like UnmarshalResource but accepts the
+// relaxations common in hand-edited fixtures: trailing commas before a
+// closing brace or bracket, and // line and /* block */ comments. They are
+// blanked out with spaces before decoding, so error offsets still point into
+// data. The input must otherwise be valid JSON.
+//
+// Use it for fixtures and tooling only; FHIR payloads exchanged between
+// systems should be decoded strictly with UnmarshalResource.
+func UnmarshalResourceJSONLenient(data []byte) (Resource, error) {
+	return UnmarshalResource(relaxJSON(data))
+}
+
+// relaxJSON returns a copy of data with comments and trailing commas outside
+// string literals replaced by spaces.
+func relaxJSON(data []byte) []byte {
+	out := bytes.Clone(data)
+	blank := func(from, to int) {
+		for i := from; i < to; i++ {
+			if out[i] != '\n' {
+				out[i] = ' '
+			}
+		}
+	}
+
+	// Comments first, so a comment between a comma and the closing bracket
+	// does not hide the trailing comma.
+	inString := false
+	for i := 0; i < len(out); i++ {
+		c := out[i]
+		switch {
+		case inString:
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == '/' && i+1 < len(out) && out[i+1] == '/':
+			end := bytes.IndexByte(out[i:], '\n')
+			if end < 0 {
+				end = len(out) - i
+			}
+			blank(i, i+end)
+			i += end
+		case c == '/' && i+1 < len(out) && out[i+1] == '*':
+			end := bytes.Index(out[i+2:], []byte("*/"))
+			if end < 0 {
+				// Unterminated: leave it for the decoder to reject.
+				return out
+			}
+			blank(i, i+2+end+2)
+			i += 2 + end + 1
+		}
+	}
+
+	inString = false
+	for i := 0; i < len(out); i++ {
+		c := out[i]
+		switch {
+		case inString:
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == ',':
+			j := i + 1
+			for j < len(out) && isJSONSpace(out[j]) {
+				j++
+			}
+			if j < len(out) && (out[j] == '}' || out[j] == ']') {
+				out[i] = ' '
+			}
+		}
+	}
+	return out
+}
+
+// isJSONSpace reports whether c is JSON insignificant whitespace.
+func isJSONSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
@@ -40,3 +40,26 @@ func MarshalIndent(v interface{}, prefix, indent string) ([]byte, error) {
 	}
 	return buf.Bytes(), nil
 }
+
+// MustMarshal is like Marshal but panics if v cannot be serialized.
+//
+// It is intended for tests and fixtures, where a marshal failure is a bug:
+//
+//	data := r4.MustMarshal(patient)
+func MustMarshal(v interface{}) []byte {
+	b, err := Marshal(v)
+	if err != nil {
+		panic("r4: MustMarshal: " + err.Error())
+	}
+	return b
+}
+
+// MustMarshalXML is like MarshalResourceXML but panics if r cannot be
+// serialized. Like MustMarshal, it is intended for tests and fixtures.
+func MustMarshalXML(r Resource) []byte {
+	b, err := MarshalResourceXML(r)
+	if err != nil {
+		panic("r4: MustMarshalXML: " + err.Error())
+	}
+	return b
+}
@@ -0,0 +1,50 @@
+package r4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gofhir/models/r4"
+)
+
+// jsonOnlyResource implements Resource but not MarshalXML.
+type jsonOnlyResource struct{}
+
+func (jsonOnlyResource) GetResourceType() string { return "Custom" }
+func (jsonOnlyResource) GetId() *string          { return nil }
+func (jsonOnlyResource) SetId(string)            {}
+func (jsonOnlyResource) GetMeta() *r4.Meta       { return nil }
+func (jsonOnlyResource) SetMeta(*r4.Meta)        {}
+
+func TestMustMarshal(t *testing.T) {
+	t.Run("returns JSON", func(t *testing.T) {
+		patient := &r4.Patient{Id: ptrString("p1")}
+
+		assert.JSONEq(t, `{"resourceType":"Patient","id":"p1"}`, string(r4.MustMarshal(patient)))
+	})
+
+	t.Run("panics on error", func(t *testing.T) {
+		assert.Panics(t, func() {
+			r4.MustMarshal(make(chan int))
+		})
+	})
+}
+
+func TestMustMarshalXML(t *testing.T) {
+	t.Run("returns XML", func(t *testing.T) {
+		patient := &r4.Patient{Id: ptrString("p1")}
+
+		want, err := r4.MarshalResourceXML(patient)
+		assert.NoError(t, err)
+		assert.Equal(t, want, r4.MustMarshalXML(patient))
+	})
+
+	t.Run("panics on error", func(t *testing.T) {
+		patient := &r4.Patient{Contained: []r4.Resource{jsonOnlyResource{}}}
+
+		assert.Panics(t, func() {
+			r4.MustMarshalXML(patient)
+		})
+	})
+}
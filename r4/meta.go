@@ -0,0 +1,40 @@
+package r4
+
+import "strconv"
+
+// Version returns Meta.versionId as an integer. It reports false when the
+// versionId is unset or not a non-negative integer written in plain digits
+// (a sign, as in "+5", is rejected).
+func (m *Meta) Version() (int, bool) {
+	if m == nil || m.VersionId == nil {
+		return 0, false
+	}
+	s := *m.VersionId
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return 0, false
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// IncrementVersion advances a numeric Meta.versionId by one, setting it to
+// "1" when unset, as needed for optimistic concurrency (If-Match). A
+// non-numeric versionId cannot be advanced and is left unchanged; callers
+// can detect this case with Version. It does nothing on a nil Meta.
+func (m *Meta) IncrementVersion() {
+	if m == nil {
+		return
+	}
+	if m.VersionId == nil {
+		v := "1"
+		m.VersionId = &v
+		return
+	}
+	if n, ok := m.Version(); ok {
+		v := strconv.Itoa(n + 1)
+		m.VersionId = &v
+	}
+}
@@ -0,0 +1,117 @@
+package r4
+
+import (
+	"reflect"
+	"strings"
+)
+
+// placeholderValues holds the fixed values MinimalResource assigns to
+// required primitives, keyed by FHIR type. Other string types get
+// "placeholder".
+var placeholderValues = map[string]string{
+	"base64Binary": "AA==",
+	"canonical":    "http://example.org/placeholder",
+	"date":         "1970-01-01",
+	"dateTime":     "1970-01-01T00:00:00Z",
+	"instant":      "1970-01-01T00:00:00Z",
+	"oid":          "urn:oid:1.2.3",
+	"time":         "00:00:00",
+	"uri":          "http://example.org/placeholder",
+	"url":          "http://example.org/placeholder",
+	"uuid":         "urn:uuid:00000000-0000-0000-0000-000000000000",
+	"xhtml":        `<div xmlns="http://www.w3.org/1999/xhtml">placeholder</div>`,
+}
+
+var decimalType = reflect.TypeOf(Decimal{})
+
+// MinimalResource returns a new resource of the given type in which only the
+// required elements (minimum cardinality 1) are populated, recursively, so
+// that Validate accepts it. Values are fixed placeholders: codes take the
+// first value of their value set, choice elements their first allowed type,
+// and required complex elements with no required children of their own get
+// their first primitive element set.
+//
+// Unlike randomized fixtures, the result is identical on every call.
+func MinimalResource(resourceType string) (Resource, error) {
+	r, err := NewResource(resourceType)
+	if err != nil {
+		return nil, err
+	}
+	fillRequired(reflect.ValueOf(r).Elem())
+	return r, nil
+}
+
+// fillRequired populates the required elements of struct s.
+func fillRequired(s reflect.Value) {
+	for _, req := range requiredElements[s.Type().Name()] {
+		name := req.name
+		if req.choice {
+			name += strings.ToUpper(req.fhirType[:1]) + req.fhirType[1:]
+		}
+		if f, ok := fieldByJSONName(s, name); ok && f.IsZero() {
+			fillPlaceholder(f, req.fhirType)
+		}
+	}
+}
+
+// fillPlaceholder sets f to a placeholder value of the given FHIR type.
+func fillPlaceholder(f reflect.Value, fhirType string) {
+	switch f.Kind() {
+	case reflect.Slice:
+		f.Set(reflect.MakeSlice(f.Type(), 1, 1))
+		fillPlaceholder(f.Index(0), fhirType)
+	case reflect.Ptr:
+		f.Set(reflect.New(f.Type().Elem()))
+		fillPlaceholder(f.Elem(), fhirType)
+	case reflect.String:
+		if c, ok := f.Interface().(interface{ firstCode() string }); ok {
+			f.SetString(c.firstCode())
+			return
+		}
+		if s, ok := placeholderValues[fhirType]; ok {
+			f.SetString(s)
+			return
+		}
+		f.SetString("placeholder")
+	case reflect.Int, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		if fhirType == "positiveInt" {
+			f.Set(reflect.ValueOf(1).Convert(f.Type()))
+		}
+	case reflect.Struct:
+		if f.Type() == decimalType {
+			f.Set(reflect.ValueOf(*MustDecimal("0")))
+			return
+		}
+		fillRequired(f)
+		if f.IsZero() {
+			fillFirstElement(f)
+		}
+	}
+}
+
+// fillFirstElement populates the first primitive element of struct s, other
+// than id, so that an otherwise empty required element is present. Structs
+// without primitives, such as CodeableReference, get their first complex
+// element filled instead.
+func fillFirstElement(s reflect.Value) {
+	t := s.Type()
+	first := -1
+	for i := 0; i < t.NumField(); i++ {
+		name := jsonFieldName(t.Field(i))
+		ft := t.Field(i).Type
+		if name == "id" || strings.HasPrefix(name, "_") || ft.Kind() != reflect.Ptr {
+			continue
+		}
+		if ft.Elem().Kind() == reflect.Struct && ft.Elem() != decimalType {
+			if first < 0 {
+				first = i
+			}
+			continue
+		}
+		fillPlaceholder(s.Field(i), FHIRPathModel().TypeOf(t.Name()+"."+name))
+		return
+	}
+	if first >= 0 {
+		fillPlaceholder(s.Field(first), "")
+	}
+}
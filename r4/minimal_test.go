@@ -0,0 +1,49 @@
+package r4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4"
+)
+
+func TestMinimalResource(t *testing.T) {
+	t.Run("Observation", func(t *testing.T) {
+		r, err := r4.MinimalResource("Observation")
+		require.NoError(t, err)
+		require.NoError(t, r4.Validate(r))
+
+		obs, ok := r.(*r4.Observation)
+		require.True(t, ok)
+		require.NotNil(t, obs.Status)
+		assert.Equal(t, r4.ObservationStatusRegistered, *obs.Status)
+		assert.Equal(t, "placeholder", *obs.Code.Text)
+		assert.Nil(t, obs.Id)
+		assert.Nil(t, obs.Subject)
+		assert.Empty(t, obs.Component)
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		a, err := r4.MinimalResource("MedicationRequest")
+		require.NoError(t, err)
+		b, err := r4.MinimalResource("MedicationRequest")
+		require.NoError(t, err)
+		assert.Equal(t, a, b)
+		assert.NotNil(t, a.(*r4.MedicationRequest).MedicationCodeableConcept)
+	})
+
+	t.Run("all resource types validate", func(t *testing.T) {
+		for _, rt := range r4.AllResourceTypes() {
+			r, err := r4.MinimalResource(rt)
+			require.NoError(t, err, rt)
+			assert.NoError(t, r4.Validate(r), rt)
+		}
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		_, err := r4.MinimalResource("NotAResource")
+		assert.Error(t, err)
+	})
+}
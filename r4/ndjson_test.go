@@ -0,0 +1,48 @@
+package r4_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4"
+)
+
+func TestValidateNDJSON(t *testing.T) {
+	stream := `{"resourceType":"Patient","id":"p1"}
+{"resourceType":"Patient","id":
+{"resourceType":"Observation","id":"o1"}
+
+{"resourceType":"Observation","id":"o2","status":"final","code":{"text":"hr"}}`
+
+	errs := r4.ValidateNDJSON(strings.NewReader(stream), nil)
+	require.Len(t, errs, 2)
+
+	assert.Equal(t, 2, errs[0].Line)
+	assert.Contains(t, errs[0].Error(), "line 2: ")
+
+	assert.Equal(t, 3, errs[1].Line)
+	var verr *r4.ValidationError
+	assert.True(t, errors.As(errs[1], &verr), "validation error is unwrapped")
+	assert.Equal(t, "Observation.status", verr.Path)
+}
+
+func TestValidateNDJSON_CustomValidator(t *testing.T) {
+	stream := "{\"resourceType\":\"Patient\",\"id\":\"p1\"}\n{\"resourceType\":\"Patient\"}\n"
+	errNoID := errors.New("missing id")
+
+	errs := r4.ValidateNDJSON(strings.NewReader(stream), func(r r4.Resource) error {
+		if r.GetId() == nil {
+			return errNoID
+		}
+		return nil
+	})
+	require.Len(t, errs, 1)
+	assert.Equal(t, 2, errs[0].Line)
+	assert.ErrorIs(t, errs[0], errNoID)
+
+	assert.Nil(t, r4.ValidateNDJSON(strings.NewReader(""), nil))
+}
@@ -0,0 +1,113 @@
+package r4
+
+import "reflect"
+
+// Prune returns a deep copy of r with empty content removed. The original
+// resource is left untouched.
+//
+// The following are considered empty and removed:
+//   - nil pointers and nil or zero-length slices
+//   - structs (datatypes and backbone elements) whose fields are all empty
+//     after pruning; pointers to them are set to nil and slice entries
+//     holding them are dropped
+//   - empty strings in non-pointer fields (e.g. Extension.url)
+//
+// A non-nil pointer to a primitive (*string, *bool, *Decimal, code types)
+// is a value and is always kept, as are the entries of primitive slices.
+// Contained and Bundle entry resources are pruned but never removed.
+// Required non-pointer datatypes (e.g. Observation.code) are pruned but
+// cannot be removed, so an empty one remains the zero value.
+func Prune(r Resource) Resource {
+	if r == nil {
+		return nil
+	}
+	c := cloneResource(r)
+	pruneValue(reflect.ValueOf(c))
+	return c
+}
+
+// pruneValue removes empty content from v in place and reports whether v
+// is empty afterwards. v must be settable unless it is a pointer or interface.
+func pruneValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return true
+		}
+		if !isCompositeStruct(v.Type().Elem()) {
+			return false
+		}
+		if pruneValue(v.Elem()) {
+			if v.CanSet() {
+				v.Set(reflect.Zero(v.Type()))
+			}
+			return true
+		}
+		return false
+	case reflect.Interface:
+		if v.IsNil() {
+			return true
+		}
+		// Resources carried in interfaces are pruned but always kept.
+		pruneValue(v.Elem())
+		return false
+	case reflect.Slice:
+		if v.Len() == 0 {
+			v.Set(reflect.Zero(v.Type()))
+			return true
+		}
+		if !isCompositeStruct(v.Type().Elem()) && v.Type().Elem().Kind() != reflect.Interface {
+			return false
+		}
+		n := 0
+		for i := 0; i < v.Len(); i++ {
+			e := v.Index(i)
+			if pruneValue(e) {
+				continue
+			}
+			v.Index(n).Set(e)
+			n++
+		}
+		if n == 0 {
+			v.Set(reflect.Zero(v.Type()))
+			return true
+		}
+		v.Set(v.Slice(0, n))
+		return false
+	case reflect.Struct:
+		if !isCompositeStruct(v.Type()) {
+			return v.IsZero()
+		}
+		empty := true
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Field(i)
+			if !f.CanSet() {
+				continue
+			}
+			// A resource's own type discriminator is not content.
+			if f.Kind() == reflect.String && v.Type().Field(i).Name == "ResourceType" {
+				continue
+			}
+			if !pruneValue(f) {
+				empty = false
+			}
+		}
+		return empty
+	default:
+		return v.IsZero()
+	}
+}
+
+// isCompositeStruct reports whether t is a generated datatype, backbone, or
+// resource struct, as opposed to a primitive wrapper such as Decimal.
+func isCompositeStruct(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package r4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4"
+)
+
+func TestPrune(t *testing.T) {
+	t.Run("removes empty contact entry", func(t *testing.T) {
+		patient := &r4.Patient{
+			Id: ptrString("p1"),
+			Contact: []r4.PatientContact{
+				{},
+				{Name: &r4.HumanName{Family: ptrString("Doe")}},
+			},
+		}
+
+		pruned := r4.Prune(patient).(*r4.Patient)
+
+		require.Len(t, pruned.Contact, 1)
+		assert.Equal(t, "Doe", *pruned.Contact[0].Name.Family)
+		assert.Len(t, patient.Contact, 2, "original must be left untouched")
+	})
+
+	t.Run("removes all-empty slice", func(t *testing.T) {
+		patient := &r4.Patient{
+			Contact: []r4.PatientContact{{}, {Telecom: []r4.ContactPoint{{}}}},
+		}
+
+		pruned := r4.Prune(patient).(*r4.Patient)
+
+		assert.Nil(t, pruned.Contact)
+	})
+
+	t.Run("nils out empty pointer datatypes", func(t *testing.T) {
+		patient := &r4.Patient{
+			Meta:          &r4.Meta{},
+			MaritalStatus: &r4.CodeableConcept{Coding: []r4.Coding{{}}},
+			BirthDateExt:  &r4.Element{},
+		}
+
+		pruned := r4.Prune(patient).(*r4.Patient)
+
+		assert.Nil(t, pruned.Meta)
+		assert.Nil(t, pruned.MaritalStatus)
+		assert.Nil(t, pruned.BirthDateExt)
+		assert.NotNil(t, patient.Meta)
+	})
+
+	t.Run("keeps primitive values", func(t *testing.T) {
+		patient := &r4.Patient{
+			Active: ptrBool(false),
+			Name:   []r4.HumanName{{Given: []string{""}}},
+		}
+
+		pruned := r4.Prune(patient).(*r4.Patient)
+
+		require.NotNil(t, pruned.Active)
+		assert.False(t, *pruned.Active)
+		require.Len(t, pruned.Name, 1)
+	})
+
+	t.Run("prunes contained resources without removing them", func(t *testing.T) {
+		patient := &r4.Patient{
+			Contained: []r4.Resource{
+				&r4.Organization{Id: ptrString("org1"), Identifier: []r4.Identifier{{}}},
+			},
+		}
+
+		pruned := r4.Prune(patient).(*r4.Patient)
+
+		require.Len(t, pruned.Contained, 1)
+		org := pruned.Contained[0].(*r4.Organization)
+		assert.Nil(t, org.Identifier)
+		assert.Len(t, patient.Contained[0].(*r4.Organization).Identifier, 1)
+	})
+
+	t.Run("nil resource", func(t *testing.T) {
+		assert.Nil(t, r4.Prune(nil))
+	})
+}
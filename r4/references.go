@@ -0,0 +1,99 @@
+package r4
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// pathIndexRe matches the list indexes and contained resource ids in a Walk path.
+var pathIndexRe = regexp.MustCompile(`\[[^\]]*\]`)
+
+// validateReferences implements the generated ValidateReferences methods.
+// Each *Reference found by Walk is checked against the targets declared for
+// its element. References whose target type cannot be determined (e.g.
+// "#id" or urn:uuid references) and elements allowing any Resource are
+// accepted, as are references in choice elements (e.g.
+// "MedicationRequest.medicationReference"), for which the FHIRPath model
+// records no targets.
+func validateReferences(r Resource) error {
+	type root struct{ path, resourceType string }
+	var errs []error
+	var roots []root // r and its nested resources, in Walk order
+	_ = Walk(r, func(path string, node any) error {
+		if res, ok := node.(Resource); ok {
+			roots = append(roots, root{path, res.GetResourceType()})
+			return nil
+		}
+		ref, ok := node.(*Reference)
+		if !ok {
+			return nil
+		}
+		target := referenceType(ref)
+		if target == "" {
+			return nil
+		}
+		// Resolve the element path relative to the innermost enclosing resource.
+		elementPath := path
+		for i := len(roots) - 1; i >= 0; i-- {
+			if rest, ok := strings.CutPrefix(path, roots[i].path+"."); ok {
+				elementPath = roots[i].resourceType + "." + rest
+				break
+			}
+		}
+		elementPath = pathIndexRe.ReplaceAllString(elementPath, "")
+		allowed := FHIRPathModel().ReferenceTargets(elementPath)
+		if len(allowed) == 0 || slices.Contains(allowed, "Resource") || slices.Contains(allowed, target) {
+			return nil
+		}
+		errs = append(errs, &ValidationError{
+			Path:    path,
+			Message: fmt.Sprintf("reference to %s is not allowed, expected one of %s", target, strings.Join(allowed, ", ")),
+		})
+		return nil
+	})
+	return errors.Join(errs...)
+}
+
+// referenceType returns the resource type targeted by ref, taken from its
+// type element or parsed from a relative or absolute literal reference such
+// as "Patient/123" or "https://example.org/fhir/Patient/123/_history/2".
+// It returns "" if the type cannot be determined.
+func referenceType(ref *Reference) string {
+	if ref.Type != nil && *ref.Type != "" {
+		return (*ref.Type)[strings.LastIndex(*ref.Type, "/")+1:]
+	}
+	if ref.Reference == nil {
+		return ""
+	}
+	s, _, _ := strings.Cut(*ref.Reference, "?")
+	parts := strings.Split(s, "/")
+	if n := len(parts); n >= 4 && parts[n-2] == "_history" {
+		parts = parts[:n-2]
+	}
+	if n := len(parts); n >= 2 && IsKnownResourceType(parts[n-2]) {
+		return parts[n-2]
+	}
+	return ""
+}
+
+// Relativize returns a copy of r whose literal reference is made relative if
+// it is an absolute URL under baseURL, e.g. with base
+// "https://our.server/fhir", "https://our.server/fhir/Patient/1" becomes
+// "Patient/1". References to other servers, and relative, fragment or urn
+// references, are left unchanged. A trailing slash on baseURL is ignored.
+// The result shares no memory with r.
+func (r Reference) Relativize(baseURL string) Reference {
+	c := deepCopy(reflect.ValueOf(r)).Interface().(Reference)
+	if c.Reference == nil || baseURL == "" {
+		return c
+	}
+	rel, ok := strings.CutPrefix(*c.Reference, strings.TrimSuffix(baseURL, "/")+"/")
+	if ok && rel != "" {
+		c.Reference = &rel
+	}
+	return c
+}
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -119,6 +118,84 @@ func (r *Account) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *Account) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Account) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Account) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *Account) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddNameExtension appends ext to the extensions of the name
+// primitive (JSON "_name"), allocating NameExt if needed.
+func (r *Account) AddNameExtension(ext Extension) {
+	if r.NameExt == nil {
+		r.NameExt = &Element{}
+	}
+	r.NameExt.Extension = append(r.NameExt.Extension, ext)
+}
+
+// AddDescriptionExtension appends ext to the extensions of the description
+// primitive (JSON "_description"), allocating DescriptionExt if needed.
+func (r *Account) AddDescriptionExtension(ext Extension) {
+	if r.DescriptionExt == nil {
+		r.DescriptionExt = &Element{}
+	}
+	r.DescriptionExt.Extension = append(r.DescriptionExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *Account) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the Account, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Account) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the Account,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Account) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -159,9 +236,9 @@ func (r *Account) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -243,6 +242,274 @@ func (r *ActivityDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *ActivityDefinition) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// PrimaryCode returns the resource's code element, or nil if it is unset,
+// implementing CodeHolder.
+func (r *ActivityDefinition) PrimaryCode() *CodeableConcept {
+	return r.Code
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *ActivityDefinition) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *ActivityDefinition) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddUrlExtension appends ext to the extensions of the url
+// primitive (JSON "_url"), allocating UrlExt if needed.
+func (r *ActivityDefinition) AddUrlExtension(ext Extension) {
+	if r.UrlExt == nil {
+		r.UrlExt = &Element{}
+	}
+	r.UrlExt.Extension = append(r.UrlExt.Extension, ext)
+}
+
+// AddVersionExtension appends ext to the extensions of the version
+// primitive (JSON "_version"), allocating VersionExt if needed.
+func (r *ActivityDefinition) AddVersionExtension(ext Extension) {
+	if r.VersionExt == nil {
+		r.VersionExt = &Element{}
+	}
+	r.VersionExt.Extension = append(r.VersionExt.Extension, ext)
+}
+
+// AddNameExtension appends ext to the extensions of the name
+// primitive (JSON "_name"), allocating NameExt if needed.
+func (r *ActivityDefinition) AddNameExtension(ext Extension) {
+	if r.NameExt == nil {
+		r.NameExt = &Element{}
+	}
+	r.NameExt.Extension = append(r.NameExt.Extension, ext)
+}
+
+// AddTitleExtension appends ext to the extensions of the title
+// primitive (JSON "_title"), allocating TitleExt if needed.
+func (r *ActivityDefinition) AddTitleExtension(ext Extension) {
+	if r.TitleExt == nil {
+		r.TitleExt = &Element{}
+	}
+	r.TitleExt.Extension = append(r.TitleExt.Extension, ext)
+}
+
+// AddSubtitleExtension appends ext to the extensions of the subtitle
+// primitive (JSON "_subtitle"), allocating SubtitleExt if needed.
+func (r *ActivityDefinition) AddSubtitleExtension(ext Extension) {
+	if r.SubtitleExt == nil {
+		r.SubtitleExt = &Element{}
+	}
+	r.SubtitleExt.Extension = append(r.SubtitleExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *ActivityDefinition) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddExperimentalExtension appends ext to the extensions of the experimental
+// primitive (JSON "_experimental"), allocating ExperimentalExt if needed.
+func (r *ActivityDefinition) AddExperimentalExtension(ext Extension) {
+	if r.ExperimentalExt == nil {
+		r.ExperimentalExt = &Element{}
+	}
+	r.ExperimentalExt.Extension = append(r.ExperimentalExt.Extension, ext)
+}
+
+// AddDateExtension appends ext to the extensions of the date
+// primitive (JSON "_date"), allocating DateExt if needed.
+func (r *ActivityDefinition) AddDateExtension(ext Extension) {
+	if r.DateExt == nil {
+		r.DateExt = &Element{}
+	}
+	r.DateExt.Extension = append(r.DateExt.Extension, ext)
+}
+
+// AddPublisherExtension appends ext to the extensions of the publisher
+// primitive (JSON "_publisher"), allocating PublisherExt if needed.
+func (r *ActivityDefinition) AddPublisherExtension(ext Extension) {
+	if r.PublisherExt == nil {
+		r.PublisherExt = &Element{}
+	}
+	r.PublisherExt.Extension = append(r.PublisherExt.Extension, ext)
+}
+
+// AddDescriptionExtension appends ext to the extensions of the description
+// primitive (JSON "_description"), allocating DescriptionExt if needed.
+func (r *ActivityDefinition) AddDescriptionExtension(ext Extension) {
+	if r.DescriptionExt == nil {
+		r.DescriptionExt = &Element{}
+	}
+	r.DescriptionExt.Extension = append(r.DescriptionExt.Extension, ext)
+}
+
+// AddPurposeExtension appends ext to the extensions of the purpose
+// primitive (JSON "_purpose"), allocating PurposeExt if needed.
+func (r *ActivityDefinition) AddPurposeExtension(ext Extension) {
+	if r.PurposeExt == nil {
+		r.PurposeExt = &Element{}
+	}
+	r.PurposeExt.Extension = append(r.PurposeExt.Extension, ext)
+}
+
+// AddUsageExtension appends ext to the extensions of the usage
+// primitive (JSON "_usage"), allocating UsageExt if needed.
+func (r *ActivityDefinition) AddUsageExtension(ext Extension) {
+	if r.UsageExt == nil {
+		r.UsageExt = &Element{}
+	}
+	r.UsageExt.Extension = append(r.UsageExt.Extension, ext)
+}
+
+// AddCopyrightExtension appends ext to the extensions of the copyright
+// primitive (JSON "_copyright"), allocating CopyrightExt if needed.
+func (r *ActivityDefinition) AddCopyrightExtension(ext Extension) {
+	if r.CopyrightExt == nil {
+		r.CopyrightExt = &Element{}
+	}
+	r.CopyrightExt.Extension = append(r.CopyrightExt.Extension, ext)
+}
+
+// AddApprovalDateExtension appends ext to the extensions of the approvalDate
+// primitive (JSON "_approvalDate"), allocating ApprovalDateExt if needed.
+func (r *ActivityDefinition) AddApprovalDateExtension(ext Extension) {
+	if r.ApprovalDateExt == nil {
+		r.ApprovalDateExt = &Element{}
+	}
+	r.ApprovalDateExt.Extension = append(r.ApprovalDateExt.Extension, ext)
+}
+
+// AddLastReviewDateExtension appends ext to the extensions of the lastReviewDate
+// primitive (JSON "_lastReviewDate"), allocating LastReviewDateExt if needed.
+func (r *ActivityDefinition) AddLastReviewDateExtension(ext Extension) {
+	if r.LastReviewDateExt == nil {
+		r.LastReviewDateExt = &Element{}
+	}
+	r.LastReviewDateExt.Extension = append(r.LastReviewDateExt.Extension, ext)
+}
+
+// AddKindExtension appends ext to the extensions of the kind
+// primitive (JSON "_kind"), allocating KindExt if needed.
+func (r *ActivityDefinition) AddKindExtension(ext Extension) {
+	if r.KindExt == nil {
+		r.KindExt = &Element{}
+	}
+	r.KindExt.Extension = append(r.KindExt.Extension, ext)
+}
+
+// AddProfileExtension appends ext to the extensions of the profile
+// primitive (JSON "_profile"), allocating ProfileExt if needed.
+func (r *ActivityDefinition) AddProfileExtension(ext Extension) {
+	if r.ProfileExt == nil {
+		r.ProfileExt = &Element{}
+	}
+	r.ProfileExt.Extension = append(r.ProfileExt.Extension, ext)
+}
+
+// AddIntentExtension appends ext to the extensions of the intent
+// primitive (JSON "_intent"), allocating IntentExt if needed.
+func (r *ActivityDefinition) AddIntentExtension(ext Extension) {
+	if r.IntentExt == nil {
+		r.IntentExt = &Element{}
+	}
+	r.IntentExt.Extension = append(r.IntentExt.Extension, ext)
+}
+
+// AddPriorityExtension appends ext to the extensions of the priority
+// primitive (JSON "_priority"), allocating PriorityExt if needed.
+func (r *ActivityDefinition) AddPriorityExtension(ext Extension) {
+	if r.PriorityExt == nil {
+		r.PriorityExt = &Element{}
+	}
+	r.PriorityExt.Extension = append(r.PriorityExt.Extension, ext)
+}
+
+// AddDoNotPerformExtension appends ext to the extensions of the doNotPerform
+// primitive (JSON "_doNotPerform"), allocating DoNotPerformExt if needed.
+func (r *ActivityDefinition) AddDoNotPerformExtension(ext Extension) {
+	if r.DoNotPerformExt == nil {
+		r.DoNotPerformExt = &Element{}
+	}
+	r.DoNotPerformExt.Extension = append(r.DoNotPerformExt.Extension, ext)
+}
+
+// AddTransformExtension appends ext to the extensions of the transform
+// primitive (JSON "_transform"), allocating TransformExt if needed.
+func (r *ActivityDefinition) AddTransformExtension(ext Extension) {
+	if r.TransformExt == nil {
+		r.TransformExt = &Element{}
+	}
+	r.TransformExt.Extension = append(r.TransformExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *ActivityDefinition) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the ActivityDefinition, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *ActivityDefinition) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"subject": {"SubjectCodeableConcept", "SubjectReference"},
+		"timing":  {"TimingTiming", "TimingDateTime", "TimingAge", "TimingPeriod", "TimingRange", "TimingDuration"},
+		"product": {"ProductReference", "ProductCodeableConcept"},
+	}
+}
+
+// HasSubject reports whether any subject[x] variant of the
+// ActivityDefinition is set.
+func (r *ActivityDefinition) HasSubject() bool {
+	return r.SubjectCodeableConcept != nil || r.SubjectReference != nil
+}
+
+// HasTiming reports whether any timing[x] variant of the
+// ActivityDefinition is set.
+func (r *ActivityDefinition) HasTiming() bool {
+	return r.TimingTiming != nil || r.TimingDateTime != nil || r.TimingAge != nil || r.TimingPeriod != nil || r.TimingRange != nil || r.TimingDuration != nil
+}
+
+// HasProduct reports whether any product[x] variant of the
+// ActivityDefinition is set.
+func (r *ActivityDefinition) HasProduct() bool {
+	return r.ProductReference != nil || r.ProductCodeableConcept != nil
+}
+
+// ValidateReferences checks that every populated reference in the ActivityDefinition,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *ActivityDefinition) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -283,9 +550,9 @@ func (r *ActivityDefinition) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
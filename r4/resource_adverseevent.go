@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -139,6 +138,85 @@ func (r *AdverseEvent) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *AdverseEvent) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *AdverseEvent) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddActualityExtension appends ext to the extensions of the actuality
+// primitive (JSON "_actuality"), allocating ActualityExt if needed.
+func (r *AdverseEvent) AddActualityExtension(ext Extension) {
+	if r.ActualityExt == nil {
+		r.ActualityExt = &Element{}
+	}
+	r.ActualityExt.Extension = append(r.ActualityExt.Extension, ext)
+}
+
+// AddDateExtension appends ext to the extensions of the date
+// primitive (JSON "_date"), allocating DateExt if needed.
+func (r *AdverseEvent) AddDateExtension(ext Extension) {
+	if r.DateExt == nil {
+		r.DateExt = &Element{}
+	}
+	r.DateExt.Extension = append(r.DateExt.Extension, ext)
+}
+
+// AddDetectedExtension appends ext to the extensions of the detected
+// primitive (JSON "_detected"), allocating DetectedExt if needed.
+func (r *AdverseEvent) AddDetectedExtension(ext Extension) {
+	if r.DetectedExt == nil {
+		r.DetectedExt = &Element{}
+	}
+	r.DetectedExt.Extension = append(r.DetectedExt.Extension, ext)
+}
+
+// AddRecordedDateExtension appends ext to the extensions of the recordedDate
+// primitive (JSON "_recordedDate"), allocating RecordedDateExt if needed.
+func (r *AdverseEvent) AddRecordedDateExtension(ext Extension) {
+	if r.RecordedDateExt == nil {
+		r.RecordedDateExt = &Element{}
+	}
+	r.RecordedDateExt.Extension = append(r.RecordedDateExt.Extension, ext)
+}
+
+// FirstCategory returns a pointer to the first category entry, or nil
+// if there is none.
+func (r *AdverseEvent) FirstCategory() *CodeableConcept {
+	if r == nil || len(r.Category) == 0 {
+		return nil
+	}
+	return &r.Category[0]
+}
+
+// ChoiceGroups returns the choice elements of the AdverseEvent, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *AdverseEvent) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the AdverseEvent,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *AdverseEvent) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -179,9 +257,9 @@ func (r *AdverseEvent) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -145,6 +144,108 @@ func (r *AllergyIntolerance) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// PrimaryCode returns the resource's code element, or nil if it is unset,
+// implementing CodeHolder.
+func (r *AllergyIntolerance) PrimaryCode() *CodeableConcept {
+	return r.Code
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *AllergyIntolerance) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *AllergyIntolerance) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddTypeExtension appends ext to the extensions of the type
+// primitive (JSON "_type"), allocating TypeExt if needed.
+func (r *AllergyIntolerance) AddTypeExtension(ext Extension) {
+	if r.TypeExt == nil {
+		r.TypeExt = &Element{}
+	}
+	r.TypeExt.Extension = append(r.TypeExt.Extension, ext)
+}
+
+// AddCriticalityExtension appends ext to the extensions of the criticality
+// primitive (JSON "_criticality"), allocating CriticalityExt if needed.
+func (r *AllergyIntolerance) AddCriticalityExtension(ext Extension) {
+	if r.CriticalityExt == nil {
+		r.CriticalityExt = &Element{}
+	}
+	r.CriticalityExt.Extension = append(r.CriticalityExt.Extension, ext)
+}
+
+// AddRecordedDateExtension appends ext to the extensions of the recordedDate
+// primitive (JSON "_recordedDate"), allocating RecordedDateExt if needed.
+func (r *AllergyIntolerance) AddRecordedDateExtension(ext Extension) {
+	if r.RecordedDateExt == nil {
+		r.RecordedDateExt = &Element{}
+	}
+	r.RecordedDateExt.Extension = append(r.RecordedDateExt.Extension, ext)
+}
+
+// AddLastOccurrenceExtension appends ext to the extensions of the lastOccurrence
+// primitive (JSON "_lastOccurrence"), allocating LastOccurrenceExt if needed.
+func (r *AllergyIntolerance) AddLastOccurrenceExtension(ext Extension) {
+	if r.LastOccurrenceExt == nil {
+		r.LastOccurrenceExt = &Element{}
+	}
+	r.LastOccurrenceExt.Extension = append(r.LastOccurrenceExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *AllergyIntolerance) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *AllergyIntolerance) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the AllergyIntolerance, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *AllergyIntolerance) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"onset": {"OnsetDateTime", "OnsetAge", "OnsetPeriod", "OnsetRange", "OnsetString"},
+	}
+}
+
+// HasOnset reports whether any onset[x] variant of the
+// AllergyIntolerance is set.
+func (r *AllergyIntolerance) HasOnset() bool {
+	return r.OnsetDateTime != nil || r.OnsetAge != nil || r.OnsetPeriod != nil || r.OnsetRange != nil || r.OnsetString != nil
+}
+
+// ValidateReferences checks that every populated reference in the AllergyIntolerance,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *AllergyIntolerance) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -185,9 +286,9 @@ func (r *AllergyIntolerance) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -121,6 +120,76 @@ func (r *AuditEvent) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *AuditEvent) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *AuditEvent) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddActionExtension appends ext to the extensions of the action
+// primitive (JSON "_action"), allocating ActionExt if needed.
+func (r *AuditEvent) AddActionExtension(ext Extension) {
+	if r.ActionExt == nil {
+		r.ActionExt = &Element{}
+	}
+	r.ActionExt.Extension = append(r.ActionExt.Extension, ext)
+}
+
+// AddRecordedExtension appends ext to the extensions of the recorded
+// primitive (JSON "_recorded"), allocating RecordedExt if needed.
+func (r *AuditEvent) AddRecordedExtension(ext Extension) {
+	if r.RecordedExt == nil {
+		r.RecordedExt = &Element{}
+	}
+	r.RecordedExt.Extension = append(r.RecordedExt.Extension, ext)
+}
+
+// AddOutcomeExtension appends ext to the extensions of the outcome
+// primitive (JSON "_outcome"), allocating OutcomeExt if needed.
+func (r *AuditEvent) AddOutcomeExtension(ext Extension) {
+	if r.OutcomeExt == nil {
+		r.OutcomeExt = &Element{}
+	}
+	r.OutcomeExt.Extension = append(r.OutcomeExt.Extension, ext)
+}
+
+// AddOutcomeDescExtension appends ext to the extensions of the outcomeDesc
+// primitive (JSON "_outcomeDesc"), allocating OutcomeDescExt if needed.
+func (r *AuditEvent) AddOutcomeDescExtension(ext Extension) {
+	if r.OutcomeDescExt == nil {
+		r.OutcomeDescExt = &Element{}
+	}
+	r.OutcomeDescExt.Extension = append(r.OutcomeDescExt.Extension, ext)
+}
+
+// ChoiceGroups returns the choice elements of the AuditEvent, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *AuditEvent) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the AuditEvent,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *AuditEvent) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -161,9 +230,9 @@ func (r *AuditEvent) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -930,6 +999,12 @@ type AuditEventEntityDetail struct {
 	ValueBase64BinaryExt *Element `json:"_valueBase64Binary,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// AuditEventEntityDetail is set.
+func (b *AuditEventEntityDetail) HasValue() bool {
+	return b.ValueString != nil || b.ValueBase64Binary != nil
+}
+
 // MarshalXML serializes AuditEventEntityDetail to FHIR-conformant XML.
 func (b AuditEventEntityDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
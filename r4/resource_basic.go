@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -103,6 +102,74 @@ func (r *Basic) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *Basic) Performers() []Reference {
+	var refs []Reference
+	if r.Author != nil {
+		refs = append(refs, *r.Author)
+	}
+	return refs
+}
+
+// PrimaryCode returns the resource's code element, or nil if it is unset,
+// implementing CodeHolder.
+func (r *Basic) PrimaryCode() *CodeableConcept {
+	return codeOrNil(&r.Code)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Basic) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Basic) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddCreatedExtension appends ext to the extensions of the created
+// primitive (JSON "_created"), allocating CreatedExt if needed.
+func (r *Basic) AddCreatedExtension(ext Extension) {
+	if r.CreatedExt == nil {
+		r.CreatedExt = &Element{}
+	}
+	r.CreatedExt.Extension = append(r.CreatedExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *Basic) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the Basic, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Basic) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the Basic,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Basic) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -143,9 +210,9 @@ func (r *Basic) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -78,6 +78,67 @@ func (r *Bundle) SetMeta(m *Meta) {
 	r.Meta = m
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Bundle) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Bundle) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddTypeExtension appends ext to the extensions of the type
+// primitive (JSON "_type"), allocating TypeExt if needed.
+func (r *Bundle) AddTypeExtension(ext Extension) {
+	if r.TypeExt == nil {
+		r.TypeExt = &Element{}
+	}
+	r.TypeExt.Extension = append(r.TypeExt.Extension, ext)
+}
+
+// AddTimestampExtension appends ext to the extensions of the timestamp
+// primitive (JSON "_timestamp"), allocating TimestampExt if needed.
+func (r *Bundle) AddTimestampExtension(ext Extension) {
+	if r.TimestampExt == nil {
+		r.TimestampExt = &Element{}
+	}
+	r.TimestampExt.Extension = append(r.TimestampExt.Extension, ext)
+}
+
+// AddTotalExtension appends ext to the extensions of the total
+// primitive (JSON "_total"), allocating TotalExt if needed.
+func (r *Bundle) AddTotalExtension(ext Extension) {
+	if r.TotalExt == nil {
+		r.TotalExt = &Element{}
+	}
+	r.TotalExt.Extension = append(r.TotalExt.Extension, ext)
+}
+
+// ChoiceGroups returns the choice elements of the Bundle, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Bundle) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the Bundle,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Bundle) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
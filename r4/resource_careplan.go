@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -151,6 +150,130 @@ func (r *CarePlan) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *CarePlan) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *CarePlan) Performers() []Reference {
+	var refs []Reference
+	if r.Author != nil {
+		refs = append(refs, *r.Author)
+	}
+	return refs
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *CarePlan) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *CarePlan) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *CarePlan) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddIntentExtension appends ext to the extensions of the intent
+// primitive (JSON "_intent"), allocating IntentExt if needed.
+func (r *CarePlan) AddIntentExtension(ext Extension) {
+	if r.IntentExt == nil {
+		r.IntentExt = &Element{}
+	}
+	r.IntentExt.Extension = append(r.IntentExt.Extension, ext)
+}
+
+// AddTitleExtension appends ext to the extensions of the title
+// primitive (JSON "_title"), allocating TitleExt if needed.
+func (r *CarePlan) AddTitleExtension(ext Extension) {
+	if r.TitleExt == nil {
+		r.TitleExt = &Element{}
+	}
+	r.TitleExt.Extension = append(r.TitleExt.Extension, ext)
+}
+
+// AddDescriptionExtension appends ext to the extensions of the description
+// primitive (JSON "_description"), allocating DescriptionExt if needed.
+func (r *CarePlan) AddDescriptionExtension(ext Extension) {
+	if r.DescriptionExt == nil {
+		r.DescriptionExt = &Element{}
+	}
+	r.DescriptionExt.Extension = append(r.DescriptionExt.Extension, ext)
+}
+
+// AddCreatedExtension appends ext to the extensions of the created
+// primitive (JSON "_created"), allocating CreatedExt if needed.
+func (r *CarePlan) AddCreatedExtension(ext Extension) {
+	if r.CreatedExt == nil {
+		r.CreatedExt = &Element{}
+	}
+	r.CreatedExt.Extension = append(r.CreatedExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *CarePlan) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstCategory returns a pointer to the first category entry, or nil
+// if there is none.
+func (r *CarePlan) FirstCategory() *CodeableConcept {
+	if r == nil || len(r.Category) == 0 {
+		return nil
+	}
+	return &r.Category[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *CarePlan) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the CarePlan, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *CarePlan) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the CarePlan,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *CarePlan) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -191,9 +314,9 @@ func (r *CarePlan) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -764,6 +887,18 @@ type CarePlanActivityDetail struct {
 	Description *string `json:"description,omitempty"`
 }
 
+// HasScheduled reports whether any scheduled[x] variant of the
+// CarePlanActivityDetail is set.
+func (b *CarePlanActivityDetail) HasScheduled() bool {
+	return b.ScheduledTiming != nil || b.ScheduledPeriod != nil || b.ScheduledString != nil
+}
+
+// HasProduct reports whether any product[x] variant of the
+// CarePlanActivityDetail is set.
+func (b *CarePlanActivityDetail) HasProduct() bool {
+	return b.ProductCodeableConcept != nil || b.ProductReference != nil
+}
+
 // MarshalXML serializes CarePlanActivityDetail to FHIR-conformant XML.
 func (b CarePlanActivityDetail) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
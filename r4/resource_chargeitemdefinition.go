@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -165,6 +164,162 @@ func (r *ChargeItemDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *ChargeItemDefinition) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// PrimaryCode returns the resource's code element, or nil if it is unset,
+// implementing CodeHolder.
+func (r *ChargeItemDefinition) PrimaryCode() *CodeableConcept {
+	return r.Code
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *ChargeItemDefinition) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *ChargeItemDefinition) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddUrlExtension appends ext to the extensions of the url
+// primitive (JSON "_url"), allocating UrlExt if needed.
+func (r *ChargeItemDefinition) AddUrlExtension(ext Extension) {
+	if r.UrlExt == nil {
+		r.UrlExt = &Element{}
+	}
+	r.UrlExt.Extension = append(r.UrlExt.Extension, ext)
+}
+
+// AddVersionExtension appends ext to the extensions of the version
+// primitive (JSON "_version"), allocating VersionExt if needed.
+func (r *ChargeItemDefinition) AddVersionExtension(ext Extension) {
+	if r.VersionExt == nil {
+		r.VersionExt = &Element{}
+	}
+	r.VersionExt.Extension = append(r.VersionExt.Extension, ext)
+}
+
+// AddTitleExtension appends ext to the extensions of the title
+// primitive (JSON "_title"), allocating TitleExt if needed.
+func (r *ChargeItemDefinition) AddTitleExtension(ext Extension) {
+	if r.TitleExt == nil {
+		r.TitleExt = &Element{}
+	}
+	r.TitleExt.Extension = append(r.TitleExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *ChargeItemDefinition) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddExperimentalExtension appends ext to the extensions of the experimental
+// primitive (JSON "_experimental"), allocating ExperimentalExt if needed.
+func (r *ChargeItemDefinition) AddExperimentalExtension(ext Extension) {
+	if r.ExperimentalExt == nil {
+		r.ExperimentalExt = &Element{}
+	}
+	r.ExperimentalExt.Extension = append(r.ExperimentalExt.Extension, ext)
+}
+
+// AddDateExtension appends ext to the extensions of the date
+// primitive (JSON "_date"), allocating DateExt if needed.
+func (r *ChargeItemDefinition) AddDateExtension(ext Extension) {
+	if r.DateExt == nil {
+		r.DateExt = &Element{}
+	}
+	r.DateExt.Extension = append(r.DateExt.Extension, ext)
+}
+
+// AddPublisherExtension appends ext to the extensions of the publisher
+// primitive (JSON "_publisher"), allocating PublisherExt if needed.
+func (r *ChargeItemDefinition) AddPublisherExtension(ext Extension) {
+	if r.PublisherExt == nil {
+		r.PublisherExt = &Element{}
+	}
+	r.PublisherExt.Extension = append(r.PublisherExt.Extension, ext)
+}
+
+// AddDescriptionExtension appends ext to the extensions of the description
+// primitive (JSON "_description"), allocating DescriptionExt if needed.
+func (r *ChargeItemDefinition) AddDescriptionExtension(ext Extension) {
+	if r.DescriptionExt == nil {
+		r.DescriptionExt = &Element{}
+	}
+	r.DescriptionExt.Extension = append(r.DescriptionExt.Extension, ext)
+}
+
+// AddCopyrightExtension appends ext to the extensions of the copyright
+// primitive (JSON "_copyright"), allocating CopyrightExt if needed.
+func (r *ChargeItemDefinition) AddCopyrightExtension(ext Extension) {
+	if r.CopyrightExt == nil {
+		r.CopyrightExt = &Element{}
+	}
+	r.CopyrightExt.Extension = append(r.CopyrightExt.Extension, ext)
+}
+
+// AddApprovalDateExtension appends ext to the extensions of the approvalDate
+// primitive (JSON "_approvalDate"), allocating ApprovalDateExt if needed.
+func (r *ChargeItemDefinition) AddApprovalDateExtension(ext Extension) {
+	if r.ApprovalDateExt == nil {
+		r.ApprovalDateExt = &Element{}
+	}
+	r.ApprovalDateExt.Extension = append(r.ApprovalDateExt.Extension, ext)
+}
+
+// AddLastReviewDateExtension appends ext to the extensions of the lastReviewDate
+// primitive (JSON "_lastReviewDate"), allocating LastReviewDateExt if needed.
+func (r *ChargeItemDefinition) AddLastReviewDateExtension(ext Extension) {
+	if r.LastReviewDateExt == nil {
+		r.LastReviewDateExt = &Element{}
+	}
+	r.LastReviewDateExt.Extension = append(r.LastReviewDateExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *ChargeItemDefinition) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the ChargeItemDefinition, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *ChargeItemDefinition) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the ChargeItemDefinition,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *ChargeItemDefinition) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -205,9 +360,9 @@ func (r *ChargeItemDefinition) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
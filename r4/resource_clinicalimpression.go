@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -145,6 +144,116 @@ func (r *ClinicalImpression) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *ClinicalImpression) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// PrimaryCode returns the resource's code element, or nil if it is unset,
+// implementing CodeHolder.
+func (r *ClinicalImpression) PrimaryCode() *CodeableConcept {
+	return r.Code
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *ClinicalImpression) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *ClinicalImpression) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *ClinicalImpression) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddDescriptionExtension appends ext to the extensions of the description
+// primitive (JSON "_description"), allocating DescriptionExt if needed.
+func (r *ClinicalImpression) AddDescriptionExtension(ext Extension) {
+	if r.DescriptionExt == nil {
+		r.DescriptionExt = &Element{}
+	}
+	r.DescriptionExt.Extension = append(r.DescriptionExt.Extension, ext)
+}
+
+// AddDateExtension appends ext to the extensions of the date
+// primitive (JSON "_date"), allocating DateExt if needed.
+func (r *ClinicalImpression) AddDateExtension(ext Extension) {
+	if r.DateExt == nil {
+		r.DateExt = &Element{}
+	}
+	r.DateExt.Extension = append(r.DateExt.Extension, ext)
+}
+
+// AddSummaryExtension appends ext to the extensions of the summary
+// primitive (JSON "_summary"), allocating SummaryExt if needed.
+func (r *ClinicalImpression) AddSummaryExtension(ext Extension) {
+	if r.SummaryExt == nil {
+		r.SummaryExt = &Element{}
+	}
+	r.SummaryExt.Extension = append(r.SummaryExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *ClinicalImpression) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *ClinicalImpression) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the ClinicalImpression, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *ClinicalImpression) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"effective": {"EffectiveDateTime", "EffectivePeriod"},
+	}
+}
+
+// HasEffective reports whether any effective[x] variant of the
+// ClinicalImpression is set.
+func (r *ClinicalImpression) HasEffective() bool {
+	return r.EffectiveDateTime != nil || r.EffectivePeriod != nil
+}
+
+// ValidateReferences checks that every populated reference in the ClinicalImpression,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *ClinicalImpression) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -185,9 +294,9 @@ func (r *ClinicalImpression) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -141,6 +140,147 @@ func (r *CompartmentDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *CompartmentDefinition) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *CompartmentDefinition) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *CompartmentDefinition) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddUrlExtension appends ext to the extensions of the url
+// primitive (JSON "_url"), allocating UrlExt if needed.
+func (r *CompartmentDefinition) AddUrlExtension(ext Extension) {
+	if r.UrlExt == nil {
+		r.UrlExt = &Element{}
+	}
+	r.UrlExt.Extension = append(r.UrlExt.Extension, ext)
+}
+
+// AddVersionExtension appends ext to the extensions of the version
+// primitive (JSON "_version"), allocating VersionExt if needed.
+func (r *CompartmentDefinition) AddVersionExtension(ext Extension) {
+	if r.VersionExt == nil {
+		r.VersionExt = &Element{}
+	}
+	r.VersionExt.Extension = append(r.VersionExt.Extension, ext)
+}
+
+// AddNameExtension appends ext to the extensions of the name
+// primitive (JSON "_name"), allocating NameExt if needed.
+func (r *CompartmentDefinition) AddNameExtension(ext Extension) {
+	if r.NameExt == nil {
+		r.NameExt = &Element{}
+	}
+	r.NameExt.Extension = append(r.NameExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *CompartmentDefinition) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddExperimentalExtension appends ext to the extensions of the experimental
+// primitive (JSON "_experimental"), allocating ExperimentalExt if needed.
+func (r *CompartmentDefinition) AddExperimentalExtension(ext Extension) {
+	if r.ExperimentalExt == nil {
+		r.ExperimentalExt = &Element{}
+	}
+	r.ExperimentalExt.Extension = append(r.ExperimentalExt.Extension, ext)
+}
+
+// AddDateExtension appends ext to the extensions of the date
+// primitive (JSON "_date"), allocating DateExt if needed.
+func (r *CompartmentDefinition) AddDateExtension(ext Extension) {
+	if r.DateExt == nil {
+		r.DateExt = &Element{}
+	}
+	r.DateExt.Extension = append(r.DateExt.Extension, ext)
+}
+
+// AddPublisherExtension appends ext to the extensions of the publisher
+// primitive (JSON "_publisher"), allocating PublisherExt if needed.
+func (r *CompartmentDefinition) AddPublisherExtension(ext Extension) {
+	if r.PublisherExt == nil {
+		r.PublisherExt = &Element{}
+	}
+	r.PublisherExt.Extension = append(r.PublisherExt.Extension, ext)
+}
+
+// AddDescriptionExtension appends ext to the extensions of the description
+// primitive (JSON "_description"), allocating DescriptionExt if needed.
+func (r *CompartmentDefinition) AddDescriptionExtension(ext Extension) {
+	if r.DescriptionExt == nil {
+		r.DescriptionExt = &Element{}
+	}
+	r.DescriptionExt.Extension = append(r.DescriptionExt.Extension, ext)
+}
+
+// AddPurposeExtension appends ext to the extensions of the purpose
+// primitive (JSON "_purpose"), allocating PurposeExt if needed.
+func (r *CompartmentDefinition) AddPurposeExtension(ext Extension) {
+	if r.PurposeExt == nil {
+		r.PurposeExt = &Element{}
+	}
+	r.PurposeExt.Extension = append(r.PurposeExt.Extension, ext)
+}
+
+// AddCodeExtension appends ext to the extensions of the code
+// primitive (JSON "_code"), allocating CodeExt if needed.
+func (r *CompartmentDefinition) AddCodeExtension(ext Extension) {
+	if r.CodeExt == nil {
+		r.CodeExt = &Element{}
+	}
+	r.CodeExt.Extension = append(r.CodeExt.Extension, ext)
+}
+
+// AddSearchExtension appends ext to the extensions of the search
+// primitive (JSON "_search"), allocating SearchExt if needed.
+func (r *CompartmentDefinition) AddSearchExtension(ext Extension) {
+	if r.SearchExt == nil {
+		r.SearchExt = &Element{}
+	}
+	r.SearchExt.Extension = append(r.SearchExt.Extension, ext)
+}
+
+// ChoiceGroups returns the choice elements of the CompartmentDefinition, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *CompartmentDefinition) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the CompartmentDefinition,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *CompartmentDefinition) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -181,9 +321,9 @@ func (r *CompartmentDefinition) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -129,6 +128,101 @@ func (r *Composition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *Composition) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *Composition) Performers() []Reference {
+	var refs []Reference
+	refs = append(refs, r.Author...)
+	return refs
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Composition) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Composition) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *Composition) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddDateExtension appends ext to the extensions of the date
+// primitive (JSON "_date"), allocating DateExt if needed.
+func (r *Composition) AddDateExtension(ext Extension) {
+	if r.DateExt == nil {
+		r.DateExt = &Element{}
+	}
+	r.DateExt.Extension = append(r.DateExt.Extension, ext)
+}
+
+// AddTitleExtension appends ext to the extensions of the title
+// primitive (JSON "_title"), allocating TitleExt if needed.
+func (r *Composition) AddTitleExtension(ext Extension) {
+	if r.TitleExt == nil {
+		r.TitleExt = &Element{}
+	}
+	r.TitleExt.Extension = append(r.TitleExt.Extension, ext)
+}
+
+// AddConfidentialityExtension appends ext to the extensions of the confidentiality
+// primitive (JSON "_confidentiality"), allocating ConfidentialityExt if needed.
+func (r *Composition) AddConfidentialityExtension(ext Extension) {
+	if r.ConfidentialityExt == nil {
+		r.ConfidentialityExt = &Element{}
+	}
+	r.ConfidentialityExt.Extension = append(r.ConfidentialityExt.Extension, ext)
+}
+
+// FirstCategory returns a pointer to the first category entry, or nil
+// if there is none.
+func (r *Composition) FirstCategory() *CodeableConcept {
+	if r == nil || len(r.Category) == 0 {
+		return nil
+	}
+	return &r.Category[0]
+}
+
+// ChoiceGroups returns the choice elements of the Composition, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Composition) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the Composition,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Composition) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -169,9 +263,9 @@ func (r *Composition) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -705,6 +799,12 @@ type CompositionRelatesTo struct {
 	TargetReference *Reference `json:"targetReference,omitempty"`
 }
 
+// HasTarget reports whether any target[x] variant of the
+// CompositionRelatesTo is set.
+func (b *CompositionRelatesTo) HasTarget() bool {
+	return b.TargetIdentifier != nil || b.TargetReference != nil
+}
+
 // MarshalXML serializes CompositionRelatesTo to FHIR-conformant XML.
 func (b CompositionRelatesTo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
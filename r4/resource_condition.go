@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -151,6 +150,97 @@ func (r *Condition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// PrimaryCode returns the resource's code element, or nil if it is unset,
+// implementing CodeHolder.
+func (r *Condition) PrimaryCode() *CodeableConcept {
+	return r.Code
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Condition) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Condition) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddRecordedDateExtension appends ext to the extensions of the recordedDate
+// primitive (JSON "_recordedDate"), allocating RecordedDateExt if needed.
+func (r *Condition) AddRecordedDateExtension(ext Extension) {
+	if r.RecordedDateExt == nil {
+		r.RecordedDateExt = &Element{}
+	}
+	r.RecordedDateExt.Extension = append(r.RecordedDateExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *Condition) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstCategory returns a pointer to the first category entry, or nil
+// if there is none.
+func (r *Condition) FirstCategory() *CodeableConcept {
+	if r == nil || len(r.Category) == 0 {
+		return nil
+	}
+	return &r.Category[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *Condition) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the Condition, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Condition) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"onset":     {"OnsetDateTime", "OnsetAge", "OnsetPeriod", "OnsetRange", "OnsetString"},
+		"abatement": {"AbatementDateTime", "AbatementAge", "AbatementPeriod", "AbatementRange", "AbatementString"},
+	}
+}
+
+// HasOnset reports whether any onset[x] variant of the
+// Condition is set.
+func (r *Condition) HasOnset() bool {
+	return r.OnsetDateTime != nil || r.OnsetAge != nil || r.OnsetPeriod != nil || r.OnsetRange != nil || r.OnsetString != nil
+}
+
+// HasAbatement reports whether any abatement[x] variant of the
+// Condition is set.
+func (r *Condition) HasAbatement() bool {
+	return r.AbatementDateTime != nil || r.AbatementAge != nil || r.AbatementPeriod != nil || r.AbatementRange != nil || r.AbatementString != nil
+}
+
+// ValidateReferences checks that every populated reference in the Condition,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Condition) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -191,9 +281,9 @@ func (r *Condition) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -129,6 +128,83 @@ func (r *CoverageEligibilityRequest) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *CoverageEligibilityRequest) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *CoverageEligibilityRequest) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *CoverageEligibilityRequest) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *CoverageEligibilityRequest) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddCreatedExtension appends ext to the extensions of the created
+// primitive (JSON "_created"), allocating CreatedExt if needed.
+func (r *CoverageEligibilityRequest) AddCreatedExtension(ext Extension) {
+	if r.CreatedExt == nil {
+		r.CreatedExt = &Element{}
+	}
+	r.CreatedExt.Extension = append(r.CreatedExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *CoverageEligibilityRequest) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the CoverageEligibilityRequest, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *CoverageEligibilityRequest) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"serviced": {"ServicedDate", "ServicedPeriod"},
+	}
+}
+
+// HasServiced reports whether any serviced[x] variant of the
+// CoverageEligibilityRequest is set.
+func (r *CoverageEligibilityRequest) HasServiced() bool {
+	return r.ServicedDate != nil || r.ServicedPeriod != nil
+}
+
+// ValidateReferences checks that every populated reference in the CoverageEligibilityRequest,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *CoverageEligibilityRequest) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -169,9 +245,9 @@ func (r *CoverageEligibilityRequest) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -787,6 +863,12 @@ type CoverageEligibilityRequestItemDiagnosis struct {
 	DiagnosisReference *Reference `json:"diagnosisReference,omitempty"`
 }
 
+// HasDiagnosis reports whether any diagnosis[x] variant of the
+// CoverageEligibilityRequestItemDiagnosis is set.
+func (b *CoverageEligibilityRequestItemDiagnosis) HasDiagnosis() bool {
+	return b.DiagnosisCodeableConcept != nil || b.DiagnosisReference != nil
+}
+
 // MarshalXML serializes CoverageEligibilityRequestItemDiagnosis to FHIR-conformant XML.
 func (b CoverageEligibilityRequestItemDiagnosis) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
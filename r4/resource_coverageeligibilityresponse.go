@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -137,6 +136,110 @@ func (r *CoverageEligibilityResponse) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *CoverageEligibilityResponse) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *CoverageEligibilityResponse) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *CoverageEligibilityResponse) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *CoverageEligibilityResponse) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddCreatedExtension appends ext to the extensions of the created
+// primitive (JSON "_created"), allocating CreatedExt if needed.
+func (r *CoverageEligibilityResponse) AddCreatedExtension(ext Extension) {
+	if r.CreatedExt == nil {
+		r.CreatedExt = &Element{}
+	}
+	r.CreatedExt.Extension = append(r.CreatedExt.Extension, ext)
+}
+
+// AddOutcomeExtension appends ext to the extensions of the outcome
+// primitive (JSON "_outcome"), allocating OutcomeExt if needed.
+func (r *CoverageEligibilityResponse) AddOutcomeExtension(ext Extension) {
+	if r.OutcomeExt == nil {
+		r.OutcomeExt = &Element{}
+	}
+	r.OutcomeExt.Extension = append(r.OutcomeExt.Extension, ext)
+}
+
+// AddDispositionExtension appends ext to the extensions of the disposition
+// primitive (JSON "_disposition"), allocating DispositionExt if needed.
+func (r *CoverageEligibilityResponse) AddDispositionExtension(ext Extension) {
+	if r.DispositionExt == nil {
+		r.DispositionExt = &Element{}
+	}
+	r.DispositionExt.Extension = append(r.DispositionExt.Extension, ext)
+}
+
+// AddPreAuthRefExtension appends ext to the extensions of the preAuthRef
+// primitive (JSON "_preAuthRef"), allocating PreAuthRefExt if needed.
+func (r *CoverageEligibilityResponse) AddPreAuthRefExtension(ext Extension) {
+	if r.PreAuthRefExt == nil {
+		r.PreAuthRefExt = &Element{}
+	}
+	r.PreAuthRefExt.Extension = append(r.PreAuthRefExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *CoverageEligibilityResponse) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the CoverageEligibilityResponse, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *CoverageEligibilityResponse) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"serviced": {"ServicedDate", "ServicedPeriod"},
+	}
+}
+
+// HasServiced reports whether any serviced[x] variant of the
+// CoverageEligibilityResponse is set.
+func (r *CoverageEligibilityResponse) HasServiced() bool {
+	return r.ServicedDate != nil || r.ServicedPeriod != nil
+}
+
+// ValidateReferences checks that every populated reference in the CoverageEligibilityResponse,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *CoverageEligibilityResponse) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -177,9 +280,9 @@ func (r *CoverageEligibilityResponse) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -960,6 +1063,18 @@ type CoverageEligibilityResponseInsuranceItemBenefit struct {
 	UsedMoney *Money `json:"usedMoney,omitempty"`
 }
 
+// HasAllowed reports whether any allowed[x] variant of the
+// CoverageEligibilityResponseInsuranceItemBenefit is set.
+func (b *CoverageEligibilityResponseInsuranceItemBenefit) HasAllowed() bool {
+	return b.AllowedUnsignedInt != nil || b.AllowedString != nil || b.AllowedMoney != nil
+}
+
+// HasUsed reports whether any used[x] variant of the
+// CoverageEligibilityResponseInsuranceItemBenefit is set.
+func (b *CoverageEligibilityResponseInsuranceItemBenefit) HasUsed() bool {
+	return b.UsedUnsignedInt != nil || b.UsedString != nil || b.UsedMoney != nil
+}
+
 // MarshalXML serializes CoverageEligibilityResponseInsuranceItemBenefit to FHIR-conformant XML.
 func (b CoverageEligibilityResponseInsuranceItemBenefit) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -159,6 +158,127 @@ func (r *DeviceRequest) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *DeviceRequest) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *DeviceRequest) Performers() []Reference {
+	var refs []Reference
+	if r.Performer != nil {
+		refs = append(refs, *r.Performer)
+	}
+	return refs
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *DeviceRequest) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *DeviceRequest) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *DeviceRequest) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddIntentExtension appends ext to the extensions of the intent
+// primitive (JSON "_intent"), allocating IntentExt if needed.
+func (r *DeviceRequest) AddIntentExtension(ext Extension) {
+	if r.IntentExt == nil {
+		r.IntentExt = &Element{}
+	}
+	r.IntentExt.Extension = append(r.IntentExt.Extension, ext)
+}
+
+// AddPriorityExtension appends ext to the extensions of the priority
+// primitive (JSON "_priority"), allocating PriorityExt if needed.
+func (r *DeviceRequest) AddPriorityExtension(ext Extension) {
+	if r.PriorityExt == nil {
+		r.PriorityExt = &Element{}
+	}
+	r.PriorityExt.Extension = append(r.PriorityExt.Extension, ext)
+}
+
+// AddAuthoredOnExtension appends ext to the extensions of the authoredOn
+// primitive (JSON "_authoredOn"), allocating AuthoredOnExt if needed.
+func (r *DeviceRequest) AddAuthoredOnExtension(ext Extension) {
+	if r.AuthoredOnExt == nil {
+		r.AuthoredOnExt = &Element{}
+	}
+	r.AuthoredOnExt.Extension = append(r.AuthoredOnExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *DeviceRequest) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *DeviceRequest) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the DeviceRequest, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *DeviceRequest) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"code":       {"CodeReference", "CodeCodeableConcept"},
+		"occurrence": {"OccurrenceDateTime", "OccurrencePeriod", "OccurrenceTiming"},
+	}
+}
+
+// HasCode reports whether any code[x] variant of the
+// DeviceRequest is set.
+func (r *DeviceRequest) HasCode() bool {
+	return r.CodeReference != nil || r.CodeCodeableConcept != nil
+}
+
+// HasOccurrence reports whether any occurrence[x] variant of the
+// DeviceRequest is set.
+func (r *DeviceRequest) HasOccurrence() bool {
+	return r.OccurrenceDateTime != nil || r.OccurrencePeriod != nil || r.OccurrenceTiming != nil
+}
+
+// ValidateReferences checks that every populated reference in the DeviceRequest,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *DeviceRequest) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -199,9 +319,9 @@ func (r *DeviceRequest) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -644,6 +764,12 @@ type DeviceRequestParameter struct {
 	ValueBooleanExt *Element `json:"_valueBoolean,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// DeviceRequestParameter is set.
+func (b *DeviceRequestParameter) HasValue() bool {
+	return b.ValueCodeableConcept != nil || b.ValueQuantity != nil || b.ValueRange != nil || b.ValueBoolean != nil
+}
+
 // MarshalXML serializes DeviceRequestParameter to FHIR-conformant XML.
 func (b DeviceRequestParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
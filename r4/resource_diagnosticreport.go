@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -137,6 +136,116 @@ func (r *DiagnosticReport) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *DiagnosticReport) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *DiagnosticReport) Performers() []Reference {
+	var refs []Reference
+	refs = append(refs, r.Performer...)
+	refs = append(refs, r.ResultsInterpreter...)
+	return refs
+}
+
+// PrimaryCode returns the resource's code element, or nil if it is unset,
+// implementing CodeHolder.
+func (r *DiagnosticReport) PrimaryCode() *CodeableConcept {
+	return codeOrNil(&r.Code)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *DiagnosticReport) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *DiagnosticReport) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *DiagnosticReport) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddIssuedExtension appends ext to the extensions of the issued
+// primitive (JSON "_issued"), allocating IssuedExt if needed.
+func (r *DiagnosticReport) AddIssuedExtension(ext Extension) {
+	if r.IssuedExt == nil {
+		r.IssuedExt = &Element{}
+	}
+	r.IssuedExt.Extension = append(r.IssuedExt.Extension, ext)
+}
+
+// AddConclusionExtension appends ext to the extensions of the conclusion
+// primitive (JSON "_conclusion"), allocating ConclusionExt if needed.
+func (r *DiagnosticReport) AddConclusionExtension(ext Extension) {
+	if r.ConclusionExt == nil {
+		r.ConclusionExt = &Element{}
+	}
+	r.ConclusionExt.Extension = append(r.ConclusionExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *DiagnosticReport) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstCategory returns a pointer to the first category entry, or nil
+// if there is none.
+func (r *DiagnosticReport) FirstCategory() *CodeableConcept {
+	if r == nil || len(r.Category) == 0 {
+		return nil
+	}
+	return &r.Category[0]
+}
+
+// ChoiceGroups returns the choice elements of the DiagnosticReport, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *DiagnosticReport) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"effective": {"EffectiveDateTime", "EffectivePeriod"},
+	}
+}
+
+// HasEffective reports whether any effective[x] variant of the
+// DiagnosticReport is set.
+func (r *DiagnosticReport) HasEffective() bool {
+	return r.EffectiveDateTime != nil || r.EffectivePeriod != nil
+}
+
+// ValidateReferences checks that every populated reference in the DiagnosticReport,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *DiagnosticReport) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -177,9 +286,9 @@ func (r *DiagnosticReport) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
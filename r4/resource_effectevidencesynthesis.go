@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -179,6 +178,165 @@ func (r *EffectEvidenceSynthesis) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *EffectEvidenceSynthesis) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *EffectEvidenceSynthesis) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *EffectEvidenceSynthesis) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddUrlExtension appends ext to the extensions of the url
+// primitive (JSON "_url"), allocating UrlExt if needed.
+func (r *EffectEvidenceSynthesis) AddUrlExtension(ext Extension) {
+	if r.UrlExt == nil {
+		r.UrlExt = &Element{}
+	}
+	r.UrlExt.Extension = append(r.UrlExt.Extension, ext)
+}
+
+// AddVersionExtension appends ext to the extensions of the version
+// primitive (JSON "_version"), allocating VersionExt if needed.
+func (r *EffectEvidenceSynthesis) AddVersionExtension(ext Extension) {
+	if r.VersionExt == nil {
+		r.VersionExt = &Element{}
+	}
+	r.VersionExt.Extension = append(r.VersionExt.Extension, ext)
+}
+
+// AddNameExtension appends ext to the extensions of the name
+// primitive (JSON "_name"), allocating NameExt if needed.
+func (r *EffectEvidenceSynthesis) AddNameExtension(ext Extension) {
+	if r.NameExt == nil {
+		r.NameExt = &Element{}
+	}
+	r.NameExt.Extension = append(r.NameExt.Extension, ext)
+}
+
+// AddTitleExtension appends ext to the extensions of the title
+// primitive (JSON "_title"), allocating TitleExt if needed.
+func (r *EffectEvidenceSynthesis) AddTitleExtension(ext Extension) {
+	if r.TitleExt == nil {
+		r.TitleExt = &Element{}
+	}
+	r.TitleExt.Extension = append(r.TitleExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *EffectEvidenceSynthesis) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddDateExtension appends ext to the extensions of the date
+// primitive (JSON "_date"), allocating DateExt if needed.
+func (r *EffectEvidenceSynthesis) AddDateExtension(ext Extension) {
+	if r.DateExt == nil {
+		r.DateExt = &Element{}
+	}
+	r.DateExt.Extension = append(r.DateExt.Extension, ext)
+}
+
+// AddPublisherExtension appends ext to the extensions of the publisher
+// primitive (JSON "_publisher"), allocating PublisherExt if needed.
+func (r *EffectEvidenceSynthesis) AddPublisherExtension(ext Extension) {
+	if r.PublisherExt == nil {
+		r.PublisherExt = &Element{}
+	}
+	r.PublisherExt.Extension = append(r.PublisherExt.Extension, ext)
+}
+
+// AddDescriptionExtension appends ext to the extensions of the description
+// primitive (JSON "_description"), allocating DescriptionExt if needed.
+func (r *EffectEvidenceSynthesis) AddDescriptionExtension(ext Extension) {
+	if r.DescriptionExt == nil {
+		r.DescriptionExt = &Element{}
+	}
+	r.DescriptionExt.Extension = append(r.DescriptionExt.Extension, ext)
+}
+
+// AddCopyrightExtension appends ext to the extensions of the copyright
+// primitive (JSON "_copyright"), allocating CopyrightExt if needed.
+func (r *EffectEvidenceSynthesis) AddCopyrightExtension(ext Extension) {
+	if r.CopyrightExt == nil {
+		r.CopyrightExt = &Element{}
+	}
+	r.CopyrightExt.Extension = append(r.CopyrightExt.Extension, ext)
+}
+
+// AddApprovalDateExtension appends ext to the extensions of the approvalDate
+// primitive (JSON "_approvalDate"), allocating ApprovalDateExt if needed.
+func (r *EffectEvidenceSynthesis) AddApprovalDateExtension(ext Extension) {
+	if r.ApprovalDateExt == nil {
+		r.ApprovalDateExt = &Element{}
+	}
+	r.ApprovalDateExt.Extension = append(r.ApprovalDateExt.Extension, ext)
+}
+
+// AddLastReviewDateExtension appends ext to the extensions of the lastReviewDate
+// primitive (JSON "_lastReviewDate"), allocating LastReviewDateExt if needed.
+func (r *EffectEvidenceSynthesis) AddLastReviewDateExtension(ext Extension) {
+	if r.LastReviewDateExt == nil {
+		r.LastReviewDateExt = &Element{}
+	}
+	r.LastReviewDateExt.Extension = append(r.LastReviewDateExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *EffectEvidenceSynthesis) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *EffectEvidenceSynthesis) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the EffectEvidenceSynthesis, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *EffectEvidenceSynthesis) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the EffectEvidenceSynthesis,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *EffectEvidenceSynthesis) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -219,9 +377,9 @@ func (r *EffectEvidenceSynthesis) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
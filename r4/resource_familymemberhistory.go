@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -167,6 +166,124 @@ func (r *FamilyMemberHistory) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *FamilyMemberHistory) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *FamilyMemberHistory) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *FamilyMemberHistory) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *FamilyMemberHistory) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddDateExtension appends ext to the extensions of the date
+// primitive (JSON "_date"), allocating DateExt if needed.
+func (r *FamilyMemberHistory) AddDateExtension(ext Extension) {
+	if r.DateExt == nil {
+		r.DateExt = &Element{}
+	}
+	r.DateExt.Extension = append(r.DateExt.Extension, ext)
+}
+
+// AddNameExtension appends ext to the extensions of the name
+// primitive (JSON "_name"), allocating NameExt if needed.
+func (r *FamilyMemberHistory) AddNameExtension(ext Extension) {
+	if r.NameExt == nil {
+		r.NameExt = &Element{}
+	}
+	r.NameExt.Extension = append(r.NameExt.Extension, ext)
+}
+
+// AddEstimatedAgeExtension appends ext to the extensions of the estimatedAge
+// primitive (JSON "_estimatedAge"), allocating EstimatedAgeExt if needed.
+func (r *FamilyMemberHistory) AddEstimatedAgeExtension(ext Extension) {
+	if r.EstimatedAgeExt == nil {
+		r.EstimatedAgeExt = &Element{}
+	}
+	r.EstimatedAgeExt.Extension = append(r.EstimatedAgeExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *FamilyMemberHistory) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *FamilyMemberHistory) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the FamilyMemberHistory, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *FamilyMemberHistory) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"born":     {"BornPeriod", "BornDate", "BornString"},
+		"age":      {"AgeAge", "AgeRange", "AgeString"},
+		"deceased": {"DeceasedBoolean", "DeceasedAge", "DeceasedRange", "DeceasedDate", "DeceasedString"},
+	}
+}
+
+// HasBorn reports whether any born[x] variant of the
+// FamilyMemberHistory is set.
+func (r *FamilyMemberHistory) HasBorn() bool {
+	return r.BornPeriod != nil || r.BornDate != nil || r.BornString != nil
+}
+
+// HasAge reports whether any age[x] variant of the
+// FamilyMemberHistory is set.
+func (r *FamilyMemberHistory) HasAge() bool {
+	return r.AgeAge != nil || r.AgeRange != nil || r.AgeString != nil
+}
+
+// HasDeceased reports whether any deceased[x] variant of the
+// FamilyMemberHistory is set.
+func (r *FamilyMemberHistory) HasDeceased() bool {
+	return r.DeceasedBoolean != nil || r.DeceasedAge != nil || r.DeceasedRange != nil || r.DeceasedDate != nil || r.DeceasedString != nil
+}
+
+// ValidateReferences checks that every populated reference in the FamilyMemberHistory,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *FamilyMemberHistory) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -207,9 +324,9 @@ func (r *FamilyMemberHistory) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -638,6 +755,12 @@ type FamilyMemberHistoryCondition struct {
 	Note []Annotation `json:"note,omitempty"`
 }
 
+// HasOnset reports whether any onset[x] variant of the
+// FamilyMemberHistoryCondition is set.
+func (b *FamilyMemberHistoryCondition) HasOnset() bool {
+	return b.OnsetAge != nil || b.OnsetRange != nil || b.OnsetPeriod != nil || b.OnsetString != nil
+}
+
 // MarshalXML serializes FamilyMemberHistoryCondition to FHIR-conformant XML.
 func (b FamilyMemberHistoryCondition) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
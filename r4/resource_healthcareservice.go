@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -151,6 +150,121 @@ func (r *HealthcareService) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *HealthcareService) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *HealthcareService) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddActiveExtension appends ext to the extensions of the active
+// primitive (JSON "_active"), allocating ActiveExt if needed.
+func (r *HealthcareService) AddActiveExtension(ext Extension) {
+	if r.ActiveExt == nil {
+		r.ActiveExt = &Element{}
+	}
+	r.ActiveExt.Extension = append(r.ActiveExt.Extension, ext)
+}
+
+// AddNameExtension appends ext to the extensions of the name
+// primitive (JSON "_name"), allocating NameExt if needed.
+func (r *HealthcareService) AddNameExtension(ext Extension) {
+	if r.NameExt == nil {
+		r.NameExt = &Element{}
+	}
+	r.NameExt.Extension = append(r.NameExt.Extension, ext)
+}
+
+// AddCommentExtension appends ext to the extensions of the comment
+// primitive (JSON "_comment"), allocating CommentExt if needed.
+func (r *HealthcareService) AddCommentExtension(ext Extension) {
+	if r.CommentExt == nil {
+		r.CommentExt = &Element{}
+	}
+	r.CommentExt.Extension = append(r.CommentExt.Extension, ext)
+}
+
+// AddExtraDetailsExtension appends ext to the extensions of the extraDetails
+// primitive (JSON "_extraDetails"), allocating ExtraDetailsExt if needed.
+func (r *HealthcareService) AddExtraDetailsExtension(ext Extension) {
+	if r.ExtraDetailsExt == nil {
+		r.ExtraDetailsExt = &Element{}
+	}
+	r.ExtraDetailsExt.Extension = append(r.ExtraDetailsExt.Extension, ext)
+}
+
+// AddAppointmentRequiredExtension appends ext to the extensions of the appointmentRequired
+// primitive (JSON "_appointmentRequired"), allocating AppointmentRequiredExt if needed.
+func (r *HealthcareService) AddAppointmentRequiredExtension(ext Extension) {
+	if r.AppointmentRequiredExt == nil {
+		r.AppointmentRequiredExt = &Element{}
+	}
+	r.AppointmentRequiredExt.Extension = append(r.AppointmentRequiredExt.Extension, ext)
+}
+
+// AddAvailabilityExceptionsExtension appends ext to the extensions of the availabilityExceptions
+// primitive (JSON "_availabilityExceptions"), allocating AvailabilityExceptionsExt if needed.
+func (r *HealthcareService) AddAvailabilityExceptionsExtension(ext Extension) {
+	if r.AvailabilityExceptionsExt == nil {
+		r.AvailabilityExceptionsExt = &Element{}
+	}
+	r.AvailabilityExceptionsExt.Extension = append(r.AvailabilityExceptionsExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *HealthcareService) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstCategory returns a pointer to the first category entry, or nil
+// if there is none.
+func (r *HealthcareService) FirstCategory() *CodeableConcept {
+	if r == nil || len(r.Category) == 0 {
+		return nil
+	}
+	return &r.Category[0]
+}
+
+// FirstTelecom returns a pointer to the first telecom entry, or nil
+// if there is none.
+func (r *HealthcareService) FirstTelecom() *ContactPoint {
+	if r == nil || len(r.Telecom) == 0 {
+		return nil
+	}
+	return &r.Telecom[0]
+}
+
+// ChoiceGroups returns the choice elements of the HealthcareService, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *HealthcareService) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the HealthcareService,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *HealthcareService) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -191,9 +305,9 @@ func (r *HealthcareService) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -165,6 +164,138 @@ func (r *Immunization) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *Immunization) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *Immunization) Performers() []Reference {
+	var refs []Reference
+	for _, p := range r.Performer {
+		refs = append(refs, p.Actor)
+	}
+	return refs
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Immunization) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Immunization) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *Immunization) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddRecordedExtension appends ext to the extensions of the recorded
+// primitive (JSON "_recorded"), allocating RecordedExt if needed.
+func (r *Immunization) AddRecordedExtension(ext Extension) {
+	if r.RecordedExt == nil {
+		r.RecordedExt = &Element{}
+	}
+	r.RecordedExt.Extension = append(r.RecordedExt.Extension, ext)
+}
+
+// AddPrimarySourceExtension appends ext to the extensions of the primarySource
+// primitive (JSON "_primarySource"), allocating PrimarySourceExt if needed.
+func (r *Immunization) AddPrimarySourceExtension(ext Extension) {
+	if r.PrimarySourceExt == nil {
+		r.PrimarySourceExt = &Element{}
+	}
+	r.PrimarySourceExt.Extension = append(r.PrimarySourceExt.Extension, ext)
+}
+
+// AddLotNumberExtension appends ext to the extensions of the lotNumber
+// primitive (JSON "_lotNumber"), allocating LotNumberExt if needed.
+func (r *Immunization) AddLotNumberExtension(ext Extension) {
+	if r.LotNumberExt == nil {
+		r.LotNumberExt = &Element{}
+	}
+	r.LotNumberExt.Extension = append(r.LotNumberExt.Extension, ext)
+}
+
+// AddExpirationDateExtension appends ext to the extensions of the expirationDate
+// primitive (JSON "_expirationDate"), allocating ExpirationDateExt if needed.
+func (r *Immunization) AddExpirationDateExtension(ext Extension) {
+	if r.ExpirationDateExt == nil {
+		r.ExpirationDateExt = &Element{}
+	}
+	r.ExpirationDateExt.Extension = append(r.ExpirationDateExt.Extension, ext)
+}
+
+// AddIsSubpotentExtension appends ext to the extensions of the isSubpotent
+// primitive (JSON "_isSubpotent"), allocating IsSubpotentExt if needed.
+func (r *Immunization) AddIsSubpotentExtension(ext Extension) {
+	if r.IsSubpotentExt == nil {
+		r.IsSubpotentExt = &Element{}
+	}
+	r.IsSubpotentExt.Extension = append(r.IsSubpotentExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *Immunization) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *Immunization) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the Immunization, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Immunization) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"occurrence": {"OccurrenceDateTime", "OccurrenceString"},
+	}
+}
+
+// HasOccurrence reports whether any occurrence[x] variant of the
+// Immunization is set.
+func (r *Immunization) HasOccurrence() bool {
+	return r.OccurrenceDateTime != nil || r.OccurrenceString != nil
+}
+
+// ValidateReferences checks that every populated reference in the Immunization,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Immunization) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -205,9 +336,9 @@ func (r *Immunization) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -895,6 +1026,18 @@ type ImmunizationProtocolApplied struct {
 	SeriesDosesStringExt *Element `json:"_seriesDosesString,omitempty"`
 }
 
+// HasDoseNumber reports whether any doseNumber[x] variant of the
+// ImmunizationProtocolApplied is set.
+func (b *ImmunizationProtocolApplied) HasDoseNumber() bool {
+	return b.DoseNumberPositiveInt != nil || b.DoseNumberString != nil
+}
+
+// HasSeriesDoses reports whether any seriesDoses[x] variant of the
+// ImmunizationProtocolApplied is set.
+func (b *ImmunizationProtocolApplied) HasSeriesDoses() bool {
+	return b.SeriesDosesPositiveInt != nil || b.SeriesDosesString != nil
+}
+
 // MarshalXML serializes ImmunizationProtocolApplied to FHIR-conformant XML.
 func (b ImmunizationProtocolApplied) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
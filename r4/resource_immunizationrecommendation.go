@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -103,6 +102,58 @@ func (r *ImmunizationRecommendation) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *ImmunizationRecommendation) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *ImmunizationRecommendation) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddDateExtension appends ext to the extensions of the date
+// primitive (JSON "_date"), allocating DateExt if needed.
+func (r *ImmunizationRecommendation) AddDateExtension(ext Extension) {
+	if r.DateExt == nil {
+		r.DateExt = &Element{}
+	}
+	r.DateExt.Extension = append(r.DateExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *ImmunizationRecommendation) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the ImmunizationRecommendation, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *ImmunizationRecommendation) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the ImmunizationRecommendation,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *ImmunizationRecommendation) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -143,9 +194,9 @@ func (r *ImmunizationRecommendation) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -374,6 +425,18 @@ type ImmunizationRecommendationRecommendation struct {
 	SupportingPatientInformation []Reference `json:"supportingPatientInformation,omitempty"`
 }
 
+// HasDoseNumber reports whether any doseNumber[x] variant of the
+// ImmunizationRecommendationRecommendation is set.
+func (b *ImmunizationRecommendationRecommendation) HasDoseNumber() bool {
+	return b.DoseNumberPositiveInt != nil || b.DoseNumberString != nil
+}
+
+// HasSeriesDoses reports whether any seriesDoses[x] variant of the
+// ImmunizationRecommendationRecommendation is set.
+func (b *ImmunizationRecommendationRecommendation) HasSeriesDoses() bool {
+	return b.SeriesDosesPositiveInt != nil || b.SeriesDosesString != nil
+}
+
 // MarshalXML serializes ImmunizationRecommendationRecommendation to FHIR-conformant XML.
 func (b ImmunizationRecommendationRecommendation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
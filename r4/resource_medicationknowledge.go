@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -141,6 +140,72 @@ func (r *MedicationKnowledge) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *MedicationKnowledge) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// PrimaryCode returns the resource's code element, or nil if it is unset,
+// implementing CodeHolder.
+func (r *MedicationKnowledge) PrimaryCode() *CodeableConcept {
+	return r.Code
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *MedicationKnowledge) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *MedicationKnowledge) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *MedicationKnowledge) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddPreparationInstructionExtension appends ext to the extensions of the preparationInstruction
+// primitive (JSON "_preparationInstruction"), allocating PreparationInstructionExt if needed.
+func (r *MedicationKnowledge) AddPreparationInstructionExtension(ext Extension) {
+	if r.PreparationInstructionExt == nil {
+		r.PreparationInstructionExt = &Element{}
+	}
+	r.PreparationInstructionExt.Extension = append(r.PreparationInstructionExt.Extension, ext)
+}
+
+// ChoiceGroups returns the choice elements of the MedicationKnowledge, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *MedicationKnowledge) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the MedicationKnowledge,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *MedicationKnowledge) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -181,9 +246,9 @@ func (r *MedicationKnowledge) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -574,6 +639,12 @@ type MedicationKnowledgeAdministrationGuidelines struct {
 	PatientCharacteristics []MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics `json:"patientCharacteristics,omitempty"`
 }
 
+// HasIndication reports whether any indication[x] variant of the
+// MedicationKnowledgeAdministrationGuidelines is set.
+func (b *MedicationKnowledgeAdministrationGuidelines) HasIndication() bool {
+	return b.IndicationCodeableConcept != nil || b.IndicationReference != nil
+}
+
 // MarshalXML serializes MedicationKnowledgeAdministrationGuidelines to FHIR-conformant XML.
 func (b MedicationKnowledgeAdministrationGuidelines) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -800,6 +871,12 @@ type MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics struct {
 	Value []string `json:"value,omitempty"`
 }
 
+// HasCharacteristic reports whether any characteristic[x] variant of the
+// MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics is set.
+func (b *MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) HasCharacteristic() bool {
+	return b.CharacteristicCodeableConcept != nil || b.CharacteristicQuantity != nil
+}
+
 // MarshalXML serializes MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics to FHIR-conformant XML.
 func (b MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1030,6 +1107,12 @@ type MedicationKnowledgeDrugCharacteristic struct {
 	ValueBase64BinaryExt *Element `json:"_valueBase64Binary,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// MedicationKnowledgeDrugCharacteristic is set.
+func (b *MedicationKnowledgeDrugCharacteristic) HasValue() bool {
+	return b.ValueCodeableConcept != nil || b.ValueString != nil || b.ValueQuantity != nil || b.ValueBase64Binary != nil
+}
+
 // MarshalXML serializes MedicationKnowledgeDrugCharacteristic to FHIR-conformant XML.
 func (b MedicationKnowledgeDrugCharacteristic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1166,6 +1249,12 @@ type MedicationKnowledgeIngredient struct {
 	Strength *Ratio `json:"strength,omitempty"`
 }
 
+// HasItem reports whether any item[x] variant of the
+// MedicationKnowledgeIngredient is set.
+func (b *MedicationKnowledgeIngredient) HasItem() bool {
+	return b.ItemCodeableConcept != nil || b.ItemReference != nil
+}
+
 // MarshalXML serializes MedicationKnowledgeIngredient to FHIR-conformant XML.
 func (b MedicationKnowledgeIngredient) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
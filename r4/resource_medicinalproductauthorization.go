@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -131,6 +130,85 @@ func (r *MedicinalProductAuthorization) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *MedicinalProductAuthorization) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *MedicinalProductAuthorization) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusDateExtension appends ext to the extensions of the statusDate
+// primitive (JSON "_statusDate"), allocating StatusDateExt if needed.
+func (r *MedicinalProductAuthorization) AddStatusDateExtension(ext Extension) {
+	if r.StatusDateExt == nil {
+		r.StatusDateExt = &Element{}
+	}
+	r.StatusDateExt.Extension = append(r.StatusDateExt.Extension, ext)
+}
+
+// AddRestoreDateExtension appends ext to the extensions of the restoreDate
+// primitive (JSON "_restoreDate"), allocating RestoreDateExt if needed.
+func (r *MedicinalProductAuthorization) AddRestoreDateExtension(ext Extension) {
+	if r.RestoreDateExt == nil {
+		r.RestoreDateExt = &Element{}
+	}
+	r.RestoreDateExt.Extension = append(r.RestoreDateExt.Extension, ext)
+}
+
+// AddDateOfFirstAuthorizationExtension appends ext to the extensions of the dateOfFirstAuthorization
+// primitive (JSON "_dateOfFirstAuthorization"), allocating DateOfFirstAuthorizationExt if needed.
+func (r *MedicinalProductAuthorization) AddDateOfFirstAuthorizationExtension(ext Extension) {
+	if r.DateOfFirstAuthorizationExt == nil {
+		r.DateOfFirstAuthorizationExt = &Element{}
+	}
+	r.DateOfFirstAuthorizationExt.Extension = append(r.DateOfFirstAuthorizationExt.Extension, ext)
+}
+
+// AddInternationalBirthDateExtension appends ext to the extensions of the internationalBirthDate
+// primitive (JSON "_internationalBirthDate"), allocating InternationalBirthDateExt if needed.
+func (r *MedicinalProductAuthorization) AddInternationalBirthDateExtension(ext Extension) {
+	if r.InternationalBirthDateExt == nil {
+		r.InternationalBirthDateExt = &Element{}
+	}
+	r.InternationalBirthDateExt.Extension = append(r.InternationalBirthDateExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *MedicinalProductAuthorization) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the MedicinalProductAuthorization, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *MedicinalProductAuthorization) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the MedicinalProductAuthorization,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *MedicinalProductAuthorization) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -171,9 +249,9 @@ func (r *MedicinalProductAuthorization) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -642,6 +720,12 @@ type MedicinalProductAuthorizationProcedure struct {
 	Application []MedicinalProductAuthorizationProcedure `json:"application,omitempty"`
 }
 
+// HasDate reports whether any date[x] variant of the
+// MedicinalProductAuthorizationProcedure is set.
+func (b *MedicinalProductAuthorizationProcedure) HasDate() bool {
+	return b.DatePeriod != nil || b.DateDateTime != nil
+}
+
 // MarshalXML serializes MedicinalProductAuthorizationProcedure to FHIR-conformant XML.
 func (b MedicinalProductAuthorizationProcedure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
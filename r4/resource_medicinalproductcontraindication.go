@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -105,6 +104,40 @@ func (r *MedicinalProductContraindication) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *MedicinalProductContraindication) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *MedicinalProductContraindication) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// ChoiceGroups returns the choice elements of the MedicinalProductContraindication, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *MedicinalProductContraindication) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the MedicinalProductContraindication,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *MedicinalProductContraindication) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -145,9 +178,9 @@ func (r *MedicinalProductContraindication) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -373,6 +406,12 @@ type MedicinalProductContraindicationOtherTherapy struct {
 	MedicationReference *Reference `json:"medicationReference,omitempty"`
 }
 
+// HasMedication reports whether any medication[x] variant of the
+// MedicinalProductContraindicationOtherTherapy is set.
+func (b *MedicinalProductContraindicationOtherTherapy) HasMedication() bool {
+	return b.MedicationCodeableConcept != nil || b.MedicationReference != nil
+}
+
 // MarshalXML serializes MedicinalProductContraindicationOtherTherapy to FHIR-conformant XML.
 func (b MedicinalProductContraindicationOtherTherapy) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
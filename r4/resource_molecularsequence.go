@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -131,6 +130,95 @@ func (r *MolecularSequence) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *MolecularSequence) Performers() []Reference {
+	var refs []Reference
+	if r.Performer != nil {
+		refs = append(refs, *r.Performer)
+	}
+	return refs
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *MolecularSequence) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *MolecularSequence) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddTypeExtension appends ext to the extensions of the type
+// primitive (JSON "_type"), allocating TypeExt if needed.
+func (r *MolecularSequence) AddTypeExtension(ext Extension) {
+	if r.TypeExt == nil {
+		r.TypeExt = &Element{}
+	}
+	r.TypeExt.Extension = append(r.TypeExt.Extension, ext)
+}
+
+// AddCoordinateSystemExtension appends ext to the extensions of the coordinateSystem
+// primitive (JSON "_coordinateSystem"), allocating CoordinateSystemExt if needed.
+func (r *MolecularSequence) AddCoordinateSystemExtension(ext Extension) {
+	if r.CoordinateSystemExt == nil {
+		r.CoordinateSystemExt = &Element{}
+	}
+	r.CoordinateSystemExt.Extension = append(r.CoordinateSystemExt.Extension, ext)
+}
+
+// AddObservedSeqExtension appends ext to the extensions of the observedSeq
+// primitive (JSON "_observedSeq"), allocating ObservedSeqExt if needed.
+func (r *MolecularSequence) AddObservedSeqExtension(ext Extension) {
+	if r.ObservedSeqExt == nil {
+		r.ObservedSeqExt = &Element{}
+	}
+	r.ObservedSeqExt.Extension = append(r.ObservedSeqExt.Extension, ext)
+}
+
+// AddReadCoverageExtension appends ext to the extensions of the readCoverage
+// primitive (JSON "_readCoverage"), allocating ReadCoverageExt if needed.
+func (r *MolecularSequence) AddReadCoverageExtension(ext Extension) {
+	if r.ReadCoverageExt == nil {
+		r.ReadCoverageExt = &Element{}
+	}
+	r.ReadCoverageExt.Extension = append(r.ReadCoverageExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *MolecularSequence) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the MolecularSequence, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *MolecularSequence) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the MolecularSequence,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *MolecularSequence) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -171,9 +259,9 @@ func (r *MolecularSequence) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
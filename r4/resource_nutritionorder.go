@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -137,6 +136,93 @@ func (r *NutritionOrder) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *NutritionOrder) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *NutritionOrder) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *NutritionOrder) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *NutritionOrder) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddIntentExtension appends ext to the extensions of the intent
+// primitive (JSON "_intent"), allocating IntentExt if needed.
+func (r *NutritionOrder) AddIntentExtension(ext Extension) {
+	if r.IntentExt == nil {
+		r.IntentExt = &Element{}
+	}
+	r.IntentExt.Extension = append(r.IntentExt.Extension, ext)
+}
+
+// AddDateTimeExtension appends ext to the extensions of the dateTime
+// primitive (JSON "_dateTime"), allocating DateTimeExt if needed.
+func (r *NutritionOrder) AddDateTimeExtension(ext Extension) {
+	if r.DateTimeExt == nil {
+		r.DateTimeExt = &Element{}
+	}
+	r.DateTimeExt.Extension = append(r.DateTimeExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *NutritionOrder) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *NutritionOrder) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the NutritionOrder, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *NutritionOrder) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the NutritionOrder,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *NutritionOrder) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -177,9 +263,9 @@ func (r *NutritionOrder) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -698,6 +784,12 @@ type NutritionOrderEnteralFormulaAdministration struct {
 	RateRatio *Ratio `json:"rateRatio,omitempty"`
 }
 
+// HasRate reports whether any rate[x] variant of the
+// NutritionOrderEnteralFormulaAdministration is set.
+func (b *NutritionOrderEnteralFormulaAdministration) HasRate() bool {
+	return b.RateQuantity != nil || b.RateRatio != nil
+}
+
 // MarshalXML serializes NutritionOrderEnteralFormulaAdministration to FHIR-conformant XML.
 func (b NutritionOrderEnteralFormulaAdministration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -123,6 +122,82 @@ func (r *ObservationDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// PrimaryCode returns the resource's code element, or nil if it is unset,
+// implementing CodeHolder.
+func (r *ObservationDefinition) PrimaryCode() *CodeableConcept {
+	return codeOrNil(&r.Code)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *ObservationDefinition) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *ObservationDefinition) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddMultipleResultsAllowedExtension appends ext to the extensions of the multipleResultsAllowed
+// primitive (JSON "_multipleResultsAllowed"), allocating MultipleResultsAllowedExt if needed.
+func (r *ObservationDefinition) AddMultipleResultsAllowedExtension(ext Extension) {
+	if r.MultipleResultsAllowedExt == nil {
+		r.MultipleResultsAllowedExt = &Element{}
+	}
+	r.MultipleResultsAllowedExt.Extension = append(r.MultipleResultsAllowedExt.Extension, ext)
+}
+
+// AddPreferredReportNameExtension appends ext to the extensions of the preferredReportName
+// primitive (JSON "_preferredReportName"), allocating PreferredReportNameExt if needed.
+func (r *ObservationDefinition) AddPreferredReportNameExtension(ext Extension) {
+	if r.PreferredReportNameExt == nil {
+		r.PreferredReportNameExt = &Element{}
+	}
+	r.PreferredReportNameExt.Extension = append(r.PreferredReportNameExt.Extension, ext)
+}
+
+// FirstCategory returns a pointer to the first category entry, or nil
+// if there is none.
+func (r *ObservationDefinition) FirstCategory() *CodeableConcept {
+	if r == nil || len(r.Category) == 0 {
+		return nil
+	}
+	return &r.Category[0]
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *ObservationDefinition) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the ObservationDefinition, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *ObservationDefinition) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the ObservationDefinition,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *ObservationDefinition) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -163,9 +238,9 @@ func (r *ObservationDefinition) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
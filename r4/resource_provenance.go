@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -119,6 +118,57 @@ func (r *Provenance) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Provenance) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Provenance) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddRecordedExtension appends ext to the extensions of the recorded
+// primitive (JSON "_recorded"), allocating RecordedExt if needed.
+func (r *Provenance) AddRecordedExtension(ext Extension) {
+	if r.RecordedExt == nil {
+		r.RecordedExt = &Element{}
+	}
+	r.RecordedExt.Extension = append(r.RecordedExt.Extension, ext)
+}
+
+// ChoiceGroups returns the choice elements of the Provenance, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Provenance) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"occurred": {"OccurredPeriod", "OccurredDateTime"},
+	}
+}
+
+// HasOccurred reports whether any occurred[x] variant of the
+// Provenance is set.
+func (r *Provenance) HasOccurred() bool {
+	return r.OccurredPeriod != nil || r.OccurredDateTime != nil
+}
+
+// ValidateReferences checks that every populated reference in the Provenance,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Provenance) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -159,9 +209,9 @@ func (r *Provenance) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -187,6 +186,165 @@ func (r *ServiceRequest) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *ServiceRequest) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *ServiceRequest) Performers() []Reference {
+	var refs []Reference
+	refs = append(refs, r.Performer...)
+	return refs
+}
+
+// PrimaryCode returns the resource's code element, or nil if it is unset,
+// implementing CodeHolder.
+func (r *ServiceRequest) PrimaryCode() *CodeableConcept {
+	return r.Code
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *ServiceRequest) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *ServiceRequest) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *ServiceRequest) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddIntentExtension appends ext to the extensions of the intent
+// primitive (JSON "_intent"), allocating IntentExt if needed.
+func (r *ServiceRequest) AddIntentExtension(ext Extension) {
+	if r.IntentExt == nil {
+		r.IntentExt = &Element{}
+	}
+	r.IntentExt.Extension = append(r.IntentExt.Extension, ext)
+}
+
+// AddPriorityExtension appends ext to the extensions of the priority
+// primitive (JSON "_priority"), allocating PriorityExt if needed.
+func (r *ServiceRequest) AddPriorityExtension(ext Extension) {
+	if r.PriorityExt == nil {
+		r.PriorityExt = &Element{}
+	}
+	r.PriorityExt.Extension = append(r.PriorityExt.Extension, ext)
+}
+
+// AddDoNotPerformExtension appends ext to the extensions of the doNotPerform
+// primitive (JSON "_doNotPerform"), allocating DoNotPerformExt if needed.
+func (r *ServiceRequest) AddDoNotPerformExtension(ext Extension) {
+	if r.DoNotPerformExt == nil {
+		r.DoNotPerformExt = &Element{}
+	}
+	r.DoNotPerformExt.Extension = append(r.DoNotPerformExt.Extension, ext)
+}
+
+// AddAuthoredOnExtension appends ext to the extensions of the authoredOn
+// primitive (JSON "_authoredOn"), allocating AuthoredOnExt if needed.
+func (r *ServiceRequest) AddAuthoredOnExtension(ext Extension) {
+	if r.AuthoredOnExt == nil {
+		r.AuthoredOnExt = &Element{}
+	}
+	r.AuthoredOnExt.Extension = append(r.AuthoredOnExt.Extension, ext)
+}
+
+// AddPatientInstructionExtension appends ext to the extensions of the patientInstruction
+// primitive (JSON "_patientInstruction"), allocating PatientInstructionExt if needed.
+func (r *ServiceRequest) AddPatientInstructionExtension(ext Extension) {
+	if r.PatientInstructionExt == nil {
+		r.PatientInstructionExt = &Element{}
+	}
+	r.PatientInstructionExt.Extension = append(r.PatientInstructionExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *ServiceRequest) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstCategory returns a pointer to the first category entry, or nil
+// if there is none.
+func (r *ServiceRequest) FirstCategory() *CodeableConcept {
+	if r == nil || len(r.Category) == 0 {
+		return nil
+	}
+	return &r.Category[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *ServiceRequest) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the ServiceRequest, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *ServiceRequest) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"quantity":   {"QuantityQuantity", "QuantityRatio", "QuantityRange"},
+		"occurrence": {"OccurrenceDateTime", "OccurrencePeriod", "OccurrenceTiming"},
+		"asNeeded":   {"AsNeededBoolean", "AsNeededCodeableConcept"},
+	}
+}
+
+// HasQuantity reports whether any quantity[x] variant of the
+// ServiceRequest is set.
+func (r *ServiceRequest) HasQuantity() bool {
+	return r.QuantityQuantity != nil || r.QuantityRatio != nil || r.QuantityRange != nil
+}
+
+// HasOccurrence reports whether any occurrence[x] variant of the
+// ServiceRequest is set.
+func (r *ServiceRequest) HasOccurrence() bool {
+	return r.OccurrenceDateTime != nil || r.OccurrencePeriod != nil || r.OccurrenceTiming != nil
+}
+
+// HasAsNeeded reports whether any asNeeded[x] variant of the
+// ServiceRequest is set.
+func (r *ServiceRequest) HasAsNeeded() bool {
+	return r.AsNeededBoolean != nil || r.AsNeededCodeableConcept != nil
+}
+
+// ValidateReferences checks that every populated reference in the ServiceRequest,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *ServiceRequest) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -227,9 +385,9 @@ func (r *ServiceRequest) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
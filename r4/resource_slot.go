@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -123,6 +122,102 @@ func (r *Slot) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *Slot) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Slot) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Slot) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *Slot) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddStartExtension appends ext to the extensions of the start
+// primitive (JSON "_start"), allocating StartExt if needed.
+func (r *Slot) AddStartExtension(ext Extension) {
+	if r.StartExt == nil {
+		r.StartExt = &Element{}
+	}
+	r.StartExt.Extension = append(r.StartExt.Extension, ext)
+}
+
+// AddEndExtension appends ext to the extensions of the end
+// primitive (JSON "_end"), allocating EndExt if needed.
+func (r *Slot) AddEndExtension(ext Extension) {
+	if r.EndExt == nil {
+		r.EndExt = &Element{}
+	}
+	r.EndExt.Extension = append(r.EndExt.Extension, ext)
+}
+
+// AddOverbookedExtension appends ext to the extensions of the overbooked
+// primitive (JSON "_overbooked"), allocating OverbookedExt if needed.
+func (r *Slot) AddOverbookedExtension(ext Extension) {
+	if r.OverbookedExt == nil {
+		r.OverbookedExt = &Element{}
+	}
+	r.OverbookedExt.Extension = append(r.OverbookedExt.Extension, ext)
+}
+
+// AddCommentExtension appends ext to the extensions of the comment
+// primitive (JSON "_comment"), allocating CommentExt if needed.
+func (r *Slot) AddCommentExtension(ext Extension) {
+	if r.CommentExt == nil {
+		r.CommentExt = &Element{}
+	}
+	r.CommentExt.Extension = append(r.CommentExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *Slot) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the Slot, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Slot) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the Slot,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Slot) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -163,9 +258,9 @@ func (r *Slot) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
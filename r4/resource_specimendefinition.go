@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -105,6 +104,49 @@ func (r *SpecimenDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *SpecimenDefinition) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *SpecimenDefinition) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddTimeAspectExtension appends ext to the extensions of the timeAspect
+// primitive (JSON "_timeAspect"), allocating TimeAspectExt if needed.
+func (r *SpecimenDefinition) AddTimeAspectExtension(ext Extension) {
+	if r.TimeAspectExt == nil {
+		r.TimeAspectExt = &Element{}
+	}
+	r.TimeAspectExt.Extension = append(r.TimeAspectExt.Extension, ext)
+}
+
+// ChoiceGroups returns the choice elements of the SpecimenDefinition, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *SpecimenDefinition) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the SpecimenDefinition,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *SpecimenDefinition) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -145,9 +187,9 @@ func (r *SpecimenDefinition) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -550,6 +592,12 @@ type SpecimenDefinitionTypeTestedContainer struct {
 	Preparation *string `json:"preparation,omitempty"`
 }
 
+// HasMinimumVolume reports whether any minimumVolume[x] variant of the
+// SpecimenDefinitionTypeTestedContainer is set.
+func (b *SpecimenDefinitionTypeTestedContainer) HasMinimumVolume() bool {
+	return b.MinimumVolumeQuantity != nil || b.MinimumVolumeString != nil
+}
+
 // MarshalXML serializes SpecimenDefinitionTypeTestedContainer to FHIR-conformant XML.
 func (b SpecimenDefinitionTypeTestedContainer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -724,6 +772,12 @@ type SpecimenDefinitionTypeTestedContainerAdditive struct {
 	AdditiveReference *Reference `json:"additiveReference,omitempty"`
 }
 
+// HasAdditive reports whether any additive[x] variant of the
+// SpecimenDefinitionTypeTestedContainerAdditive is set.
+func (b *SpecimenDefinitionTypeTestedContainerAdditive) HasAdditive() bool {
+	return b.AdditiveCodeableConcept != nil || b.AdditiveReference != nil
+}
+
 // MarshalXML serializes SpecimenDefinitionTypeTestedContainerAdditive to FHIR-conformant XML.
 func (b SpecimenDefinitionTypeTestedContainerAdditive) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -151,6 +150,156 @@ func (r *StructureMap) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *StructureMap) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *StructureMap) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *StructureMap) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddUrlExtension appends ext to the extensions of the url
+// primitive (JSON "_url"), allocating UrlExt if needed.
+func (r *StructureMap) AddUrlExtension(ext Extension) {
+	if r.UrlExt == nil {
+		r.UrlExt = &Element{}
+	}
+	r.UrlExt.Extension = append(r.UrlExt.Extension, ext)
+}
+
+// AddVersionExtension appends ext to the extensions of the version
+// primitive (JSON "_version"), allocating VersionExt if needed.
+func (r *StructureMap) AddVersionExtension(ext Extension) {
+	if r.VersionExt == nil {
+		r.VersionExt = &Element{}
+	}
+	r.VersionExt.Extension = append(r.VersionExt.Extension, ext)
+}
+
+// AddNameExtension appends ext to the extensions of the name
+// primitive (JSON "_name"), allocating NameExt if needed.
+func (r *StructureMap) AddNameExtension(ext Extension) {
+	if r.NameExt == nil {
+		r.NameExt = &Element{}
+	}
+	r.NameExt.Extension = append(r.NameExt.Extension, ext)
+}
+
+// AddTitleExtension appends ext to the extensions of the title
+// primitive (JSON "_title"), allocating TitleExt if needed.
+func (r *StructureMap) AddTitleExtension(ext Extension) {
+	if r.TitleExt == nil {
+		r.TitleExt = &Element{}
+	}
+	r.TitleExt.Extension = append(r.TitleExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *StructureMap) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddExperimentalExtension appends ext to the extensions of the experimental
+// primitive (JSON "_experimental"), allocating ExperimentalExt if needed.
+func (r *StructureMap) AddExperimentalExtension(ext Extension) {
+	if r.ExperimentalExt == nil {
+		r.ExperimentalExt = &Element{}
+	}
+	r.ExperimentalExt.Extension = append(r.ExperimentalExt.Extension, ext)
+}
+
+// AddDateExtension appends ext to the extensions of the date
+// primitive (JSON "_date"), allocating DateExt if needed.
+func (r *StructureMap) AddDateExtension(ext Extension) {
+	if r.DateExt == nil {
+		r.DateExt = &Element{}
+	}
+	r.DateExt.Extension = append(r.DateExt.Extension, ext)
+}
+
+// AddPublisherExtension appends ext to the extensions of the publisher
+// primitive (JSON "_publisher"), allocating PublisherExt if needed.
+func (r *StructureMap) AddPublisherExtension(ext Extension) {
+	if r.PublisherExt == nil {
+		r.PublisherExt = &Element{}
+	}
+	r.PublisherExt.Extension = append(r.PublisherExt.Extension, ext)
+}
+
+// AddDescriptionExtension appends ext to the extensions of the description
+// primitive (JSON "_description"), allocating DescriptionExt if needed.
+func (r *StructureMap) AddDescriptionExtension(ext Extension) {
+	if r.DescriptionExt == nil {
+		r.DescriptionExt = &Element{}
+	}
+	r.DescriptionExt.Extension = append(r.DescriptionExt.Extension, ext)
+}
+
+// AddPurposeExtension appends ext to the extensions of the purpose
+// primitive (JSON "_purpose"), allocating PurposeExt if needed.
+func (r *StructureMap) AddPurposeExtension(ext Extension) {
+	if r.PurposeExt == nil {
+		r.PurposeExt = &Element{}
+	}
+	r.PurposeExt.Extension = append(r.PurposeExt.Extension, ext)
+}
+
+// AddCopyrightExtension appends ext to the extensions of the copyright
+// primitive (JSON "_copyright"), allocating CopyrightExt if needed.
+func (r *StructureMap) AddCopyrightExtension(ext Extension) {
+	if r.CopyrightExt == nil {
+		r.CopyrightExt = &Element{}
+	}
+	r.CopyrightExt.Extension = append(r.CopyrightExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *StructureMap) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the StructureMap, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *StructureMap) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the StructureMap,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *StructureMap) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -191,9 +340,9 @@ func (r *StructureMap) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -1201,6 +1350,12 @@ type StructureMapGroupRuleSource struct {
 	LogMessage *string `json:"logMessage,omitempty"`
 }
 
+// HasDefaultValue reports whether any defaultValue[x] variant of the
+// StructureMapGroupRuleSource is set.
+func (b *StructureMapGroupRuleSource) HasDefaultValue() bool {
+	return b.DefaultValueBase64Binary != nil || b.DefaultValueBoolean != nil || b.DefaultValueCanonical != nil || b.DefaultValueCode != nil || b.DefaultValueDate != nil || b.DefaultValueDateTime != nil || b.DefaultValueDecimal != nil || b.DefaultValueId != nil || b.DefaultValueInstant != nil || b.DefaultValueInteger != nil || b.DefaultValueMarkdown != nil || b.DefaultValueOid != nil || b.DefaultValuePositiveInt != nil || b.DefaultValueString != nil || b.DefaultValueTime != nil || b.DefaultValueUnsignedInt != nil || b.DefaultValueUri != nil || b.DefaultValueUrl != nil || b.DefaultValueUuid != nil || b.DefaultValueAddress != nil || b.DefaultValueAge != nil || b.DefaultValueAnnotation != nil || b.DefaultValueAttachment != nil || b.DefaultValueCodeableConcept != nil || b.DefaultValueCoding != nil || b.DefaultValueContactPoint != nil || b.DefaultValueCount != nil || b.DefaultValueDistance != nil || b.DefaultValueDuration != nil || b.DefaultValueHumanName != nil || b.DefaultValueIdentifier != nil || b.DefaultValueMoney != nil || b.DefaultValuePeriod != nil || b.DefaultValueQuantity != nil || b.DefaultValueRange != nil || b.DefaultValueRatio != nil || b.DefaultValueReference != nil || b.DefaultValueSampledData != nil || b.DefaultValueSignature != nil || b.DefaultValueTiming != nil || b.DefaultValueContactDetail != nil || b.DefaultValueContributor != nil || b.DefaultValueDataRequirement != nil || b.DefaultValueExpression != nil || b.DefaultValueParameterDefinition != nil || b.DefaultValueRelatedArtifact != nil || b.DefaultValueTriggerDefinition != nil || b.DefaultValueUsageContext != nil || b.DefaultValueDosage != nil || b.DefaultValueMeta != nil
+}
+
 // MarshalXML serializes StructureMapGroupRuleSource to FHIR-conformant XML.
 func (b StructureMapGroupRuleSource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -2069,6 +2224,12 @@ type StructureMapGroupRuleTargetParameter struct {
 	ValueDecimalExt *Element `json:"_valueDecimal,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// StructureMapGroupRuleTargetParameter is set.
+func (b *StructureMapGroupRuleTargetParameter) HasValue() bool {
+	return b.ValueId != nil || b.ValueString != nil || b.ValueBoolean != nil || b.ValueInteger != nil || b.ValueDecimal != nil
+}
+
 // MarshalXML serializes StructureMapGroupRuleTargetParameter to FHIR-conformant XML.
 func (b StructureMapGroupRuleTargetParameter) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
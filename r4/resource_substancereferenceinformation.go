@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -103,6 +102,49 @@ func (r *SubstanceReferenceInformation) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *SubstanceReferenceInformation) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *SubstanceReferenceInformation) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddCommentExtension appends ext to the extensions of the comment
+// primitive (JSON "_comment"), allocating CommentExt if needed.
+func (r *SubstanceReferenceInformation) AddCommentExtension(ext Extension) {
+	if r.CommentExt == nil {
+		r.CommentExt = &Element{}
+	}
+	r.CommentExt.Extension = append(r.CommentExt.Extension, ext)
+}
+
+// ChoiceGroups returns the choice elements of the SubstanceReferenceInformation, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *SubstanceReferenceInformation) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the SubstanceReferenceInformation,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *SubstanceReferenceInformation) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -143,9 +185,9 @@ func (r *SubstanceReferenceInformation) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -725,6 +767,12 @@ type SubstanceReferenceInformationTarget struct {
 	Source []Reference `json:"source,omitempty"`
 }
 
+// HasAmount reports whether any amount[x] variant of the
+// SubstanceReferenceInformationTarget is set.
+func (b *SubstanceReferenceInformationTarget) HasAmount() bool {
+	return b.AmountQuantity != nil || b.AmountRange != nil || b.AmountString != nil
+}
+
 // MarshalXML serializes SubstanceReferenceInformationTarget to FHIR-conformant XML.
 func (b SubstanceReferenceInformationTarget) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
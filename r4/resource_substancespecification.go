@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -133,6 +132,67 @@ func (r *SubstanceSpecification) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *SubstanceSpecification) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *SubstanceSpecification) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddDescriptionExtension appends ext to the extensions of the description
+// primitive (JSON "_description"), allocating DescriptionExt if needed.
+func (r *SubstanceSpecification) AddDescriptionExtension(ext Extension) {
+	if r.DescriptionExt == nil {
+		r.DescriptionExt = &Element{}
+	}
+	r.DescriptionExt.Extension = append(r.DescriptionExt.Extension, ext)
+}
+
+// AddCommentExtension appends ext to the extensions of the comment
+// primitive (JSON "_comment"), allocating CommentExt if needed.
+func (r *SubstanceSpecification) AddCommentExtension(ext Extension) {
+	if r.CommentExt == nil {
+		r.CommentExt = &Element{}
+	}
+	r.CommentExt.Extension = append(r.CommentExt.Extension, ext)
+}
+
+// FirstName returns a pointer to the first name entry, or nil
+// if there is none.
+func (r *SubstanceSpecification) FirstName() *SubstanceSpecificationName {
+	if r == nil || len(r.Name) == 0 {
+		return nil
+	}
+	return &r.Name[0]
+}
+
+// ChoiceGroups returns the choice elements of the SubstanceSpecification, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *SubstanceSpecification) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the SubstanceSpecification,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *SubstanceSpecification) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -173,9 +233,9 @@ func (r *SubstanceSpecification) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -681,6 +741,12 @@ type SubstanceSpecificationMoiety struct {
 	AmountStringExt *Element `json:"_amountString,omitempty"`
 }
 
+// HasAmount reports whether any amount[x] variant of the
+// SubstanceSpecificationMoiety is set.
+func (b *SubstanceSpecificationMoiety) HasAmount() bool {
+	return b.AmountQuantity != nil || b.AmountString != nil
+}
+
 // MarshalXML serializes SubstanceSpecificationMoiety to FHIR-conformant XML.
 func (b SubstanceSpecificationMoiety) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1186,6 +1252,18 @@ type SubstanceSpecificationProperty struct {
 	AmountStringExt *Element `json:"_amountString,omitempty"`
 }
 
+// HasDefiningSubstance reports whether any definingSubstance[x] variant of the
+// SubstanceSpecificationProperty is set.
+func (b *SubstanceSpecificationProperty) HasDefiningSubstance() bool {
+	return b.DefiningSubstanceReference != nil || b.DefiningSubstanceCodeableConcept != nil
+}
+
+// HasAmount reports whether any amount[x] variant of the
+// SubstanceSpecificationProperty is set.
+func (b *SubstanceSpecificationProperty) HasAmount() bool {
+	return b.AmountQuantity != nil || b.AmountString != nil
+}
+
 // MarshalXML serializes SubstanceSpecificationProperty to FHIR-conformant XML.
 func (b SubstanceSpecificationProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1360,6 +1438,18 @@ type SubstanceSpecificationRelationship struct {
 	Source []Reference `json:"source,omitempty"`
 }
 
+// HasSubstance reports whether any substance[x] variant of the
+// SubstanceSpecificationRelationship is set.
+func (b *SubstanceSpecificationRelationship) HasSubstance() bool {
+	return b.SubstanceReference != nil || b.SubstanceCodeableConcept != nil
+}
+
+// HasAmount reports whether any amount[x] variant of the
+// SubstanceSpecificationRelationship is set.
+func (b *SubstanceSpecificationRelationship) HasAmount() bool {
+	return b.AmountQuantity != nil || b.AmountRange != nil || b.AmountRatio != nil || b.AmountString != nil
+}
+
 // MarshalXML serializes SubstanceSpecificationRelationship to FHIR-conformant XML.
 func (b SubstanceSpecificationRelationship) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -121,6 +120,74 @@ func (r *SupplyDelivery) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *SupplyDelivery) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *SupplyDelivery) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *SupplyDelivery) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *SupplyDelivery) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *SupplyDelivery) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the SupplyDelivery, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *SupplyDelivery) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"occurrence": {"OccurrenceDateTime", "OccurrencePeriod", "OccurrenceTiming"},
+	}
+}
+
+// HasOccurrence reports whether any occurrence[x] variant of the
+// SupplyDelivery is set.
+func (r *SupplyDelivery) HasOccurrence() bool {
+	return r.OccurrenceDateTime != nil || r.OccurrencePeriod != nil || r.OccurrenceTiming != nil
+}
+
+// ValidateReferences checks that every populated reference in the SupplyDelivery,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *SupplyDelivery) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -161,9 +228,9 @@ func (r *SupplyDelivery) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -453,6 +520,12 @@ type SupplyDeliverySuppliedItem struct {
 	ItemReference *Reference `json:"itemReference,omitempty"`
 }
 
+// HasItem reports whether any item[x] variant of the
+// SupplyDeliverySuppliedItem is set.
+func (b *SupplyDeliverySuppliedItem) HasItem() bool {
+	return b.ItemCodeableConcept != nil || b.ItemReference != nil
+}
+
 // MarshalXML serializes SupplyDeliverySuppliedItem to FHIR-conformant XML.
 func (b SupplyDeliverySuppliedItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -129,6 +128,84 @@ func (r *VerificationResult) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *VerificationResult) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *VerificationResult) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *VerificationResult) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *VerificationResult) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddStatusDateExtension appends ext to the extensions of the statusDate
+// primitive (JSON "_statusDate"), allocating StatusDateExt if needed.
+func (r *VerificationResult) AddStatusDateExtension(ext Extension) {
+	if r.StatusDateExt == nil {
+		r.StatusDateExt = &Element{}
+	}
+	r.StatusDateExt.Extension = append(r.StatusDateExt.Extension, ext)
+}
+
+// AddLastPerformedExtension appends ext to the extensions of the lastPerformed
+// primitive (JSON "_lastPerformed"), allocating LastPerformedExt if needed.
+func (r *VerificationResult) AddLastPerformedExtension(ext Extension) {
+	if r.LastPerformedExt == nil {
+		r.LastPerformedExt = &Element{}
+	}
+	r.LastPerformedExt.Extension = append(r.LastPerformedExt.Extension, ext)
+}
+
+// AddNextScheduledExtension appends ext to the extensions of the nextScheduled
+// primitive (JSON "_nextScheduled"), allocating NextScheduledExt if needed.
+func (r *VerificationResult) AddNextScheduledExtension(ext Extension) {
+	if r.NextScheduledExt == nil {
+		r.NextScheduledExt = &Element{}
+	}
+	r.NextScheduledExt.Extension = append(r.NextScheduledExt.Extension, ext)
+}
+
+// ChoiceGroups returns the choice elements of the VerificationResult, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *VerificationResult) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the VerificationResult,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *VerificationResult) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -169,9 +246,9 @@ func (r *VerificationResult) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
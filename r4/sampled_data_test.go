@@ -0,0 +1,56 @@
+package r4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4"
+)
+
+func TestSampledDataValues(t *testing.T) {
+	t.Run("decimals and special tokens", func(t *testing.T) {
+		s := r4.SampledData{Data: ptrString("1.50 2.00 E 3.1")}
+
+		values, err := s.Values()
+		require.NoError(t, err)
+		require.Len(t, values, 4)
+		assert.Equal(t, "1.50", values[0].String())
+		assert.Equal(t, "2.00", values[1].String())
+		assert.Nil(t, values[2])
+		assert.Equal(t, "3.1", values[3].String())
+
+		var out r4.SampledData
+		out.SetValues(values)
+		assert.Equal(t, "1.50 2.00 E 3.1", *out.Data)
+	})
+
+	t.Run("detection limit tokens", func(t *testing.T) {
+		s := r4.SampledData{Data: ptrString("U  0.5\nL")}
+
+		values, err := s.Values()
+		require.NoError(t, err)
+		require.Len(t, values, 3)
+		assert.Nil(t, values[0])
+		assert.Equal(t, "0.5", values[1].String())
+		assert.Nil(t, values[2])
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		s := r4.SampledData{Data: ptrString("1.0 abc")}
+
+		_, err := s.Values()
+		assert.ErrorContains(t, err, `"abc"`)
+	})
+
+	t.Run("no data", func(t *testing.T) {
+		values, err := r4.SampledData{}.Values()
+		require.NoError(t, err)
+		assert.Nil(t, values)
+
+		s := r4.SampledData{Data: ptrString("1")}
+		s.SetValues(nil)
+		assert.Nil(t, s.Data)
+	})
+}
@@ -0,0 +1,34 @@
+package r4
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// StorageKey returns the "Type/id" key of r, e.g. "Patient/123", for use in
+// key-value stores. It returns an error if r is nil or has no id.
+func StorageKey(r Resource) (string, error) {
+	if r == nil {
+		return "", errors.New("resource is nil")
+	}
+	id := r.GetId()
+	if id == nil || *id == "" {
+		return "", fmt.Errorf("%s has no id", r.GetResourceType())
+	}
+	return r.GetResourceType() + "/" + *id, nil
+}
+
+// ParseStorageKey splits a key produced by StorageKey into its resource type
+// and id. It returns an error if key is not of the form "Type/id" or names
+// an unknown resource type.
+func ParseStorageKey(key string) (resourceType, id string, err error) {
+	resourceType, id, ok := strings.Cut(key, "/")
+	if !ok || resourceType == "" || id == "" || strings.Contains(id, "/") {
+		return "", "", fmt.Errorf("invalid storage key %q: expected Type/id", key)
+	}
+	if !IsKnownResourceType(resourceType) {
+		return "", "", fmt.Errorf("invalid storage key %q: unknown resource type %s", key, resourceType)
+	}
+	return resourceType, id, nil
+}
@@ -0,0 +1,16 @@
+package r4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gofhir/models/r4"
+)
+
+func TestIsSummaryField(t *testing.T) {
+	assert.True(t, r4.IsSummaryField("Patient", "name"))
+	assert.True(t, r4.IsSummaryField("Observation", "status"))
+	assert.False(t, r4.IsSummaryField("Patient", "contact"))
+	assert.False(t, r4.IsSummaryField("Unknown", "id"))
+}
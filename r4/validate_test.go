@@ -0,0 +1,118 @@
+package r4_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4"
+)
+
+func validationPaths(t *testing.T, err error) []string {
+	t.Helper()
+	require.Error(t, err)
+	var paths []string
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var ve *r4.ValidationError
+		require.True(t, errors.As(e, &ve), "unexpected error %v", e)
+		paths = append(paths, ve.Path)
+	}
+	return paths
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		status := r4.ObservationStatusFinal
+		obs := &r4.Observation{
+			Status: &status,
+			Code:   r4.CodeableConcept{Text: ptrString("weight")},
+		}
+		assert.NoError(t, r4.Validate(obs))
+	})
+
+	t.Run("missing required elements", func(t *testing.T) {
+		obs := &r4.Observation{
+			Component: []r4.ObservationComponent{{ValueString: ptrString("n/a")}},
+		}
+		assert.Equal(t, []string{
+			"Observation.status",
+			"Observation.code",
+			"Observation.component[0].code",
+		}, validationPaths(t, r4.Validate(obs)))
+	})
+
+	t.Run("contained resources", func(t *testing.T) {
+		status := r4.ObservationStatusFinal
+		obs := &r4.Observation{
+			Status:    &status,
+			Code:      r4.CodeableConcept{Text: ptrString("weight")},
+			Contained: []r4.Resource{&r4.Bundle{Id: ptrString("b1")}},
+		}
+		assert.Equal(t, []string{"Observation.contained[#b1].type"}, validationPaths(t, r4.Validate(obs)))
+	})
+
+	t.Run("nested contained resources", func(t *testing.T) {
+		nested := func(depth int) *r4.Basic {
+			root := &r4.Basic{Code: r4.CodeableConcept{Text: ptrString("root")}}
+			parent := root
+			for i := 1; i <= depth; i++ {
+				child := &r4.Basic{
+					Id:   ptrString("c" + strconv.Itoa(i)),
+					Code: r4.CodeableConcept{Text: ptrString("child")},
+				}
+				parent.Contained = []r4.Resource{child}
+				parent = child
+			}
+			return root
+		}
+
+		assert.NoError(t, r4.Validate(nested(r4.MaxContainedDepth)))
+
+		err := r4.Validate(nested(r4.MaxContainedDepth + 2))
+		assert.Equal(t, []string{"Basic.contained[#c1].contained[#c2].contained[#c3].contained[#c4]"}, validationPaths(t, err))
+		assert.ErrorContains(t, err, "nesting depth 4 exceeds the maximum of 3")
+
+		defer func(limit int) { r4.MaxContainedDepth = limit }(r4.MaxContainedDepth)
+		r4.MaxContainedDepth = 1
+		assert.Equal(t, []string{"Basic.contained[#c1].contained[#c2]"}, validationPaths(t, r4.Validate(nested(2))))
+		r4.MaxContainedDepth = 0
+		assert.NoError(t, r4.Validate(nested(5)))
+	})
+
+	t.Run("choice element", func(t *testing.T) {
+		status := r4.MedicationrequestStatusActive
+		intent := r4.MedicationRequestIntentOrder
+		req := &r4.MedicationRequest{
+			Status:  &status,
+			Intent:  &intent,
+			Subject: r4.Reference{Reference: ptrString("Patient/1")},
+		}
+		assert.Equal(t, []string{"MedicationRequest.medication"}, validationPaths(t, r4.Validate(req)))
+
+		req.MedicationReference = &r4.Reference{Reference: ptrString("Medication/1")}
+		assert.NoError(t, r4.Validate(req))
+	})
+
+	t.Run("empty primitive companion", func(t *testing.T) {
+		patient := &r4.Patient{BirthDateExt: &r4.Element{Id: ptrString("bd")}}
+		assert.Equal(t, []string{"Patient.birthDate"}, validationPaths(t, r4.Validate(patient)))
+
+		patient.BirthDate = ptrString("1970-01-01")
+		assert.NoError(t, r4.Validate(patient))
+	})
+
+	t.Run("primitive with only extensions", func(t *testing.T) {
+		patient := &r4.Patient{BirthDateExt: &r4.Element{Extension: []r4.Extension{{
+			Url:       "http://hl7.org/fhir/StructureDefinition/data-absent-reason",
+			ValueCode: ptrString("unknown"),
+		}}}}
+		assert.NoError(t, r4.Validate(patient))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Error(t, r4.Validate(nil))
+	})
+}
@@ -0,0 +1,204 @@
+package r4_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4"
+)
+
+func TestWalk(t *testing.T) {
+	t.Run("visits populated elements in order", func(t *testing.T) {
+		patient := &r4.Patient{
+			Id:           ptrString("p1"),
+			BirthDateExt: &r4.Element{Id: ptrString("bd")},
+			Name: []r4.HumanName{
+				{Family: ptrString("Doe"), Given: []string{"John"}},
+			},
+			Contained: []r4.Resource{
+				&r4.Organization{Name: ptrString("Acme")},
+			},
+		}
+
+		var paths []string
+		err := r4.Walk(patient, func(path string, _ any) error {
+			paths = append(paths, path)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"Patient",
+			"Patient.id",
+			"Patient.contained[0]",
+			"Patient.contained[0].name",
+			"Patient.name[0]",
+			"Patient.name[0].family",
+			"Patient.name[0].given[0]",
+			"Patient._birthDate",
+			"Patient._birthDate.id",
+		}, paths)
+	})
+
+	t.Run("contained resources are indexed by id", func(t *testing.T) {
+		patient := &r4.Patient{
+			Contained: []r4.Resource{
+				&r4.Organization{Id: ptrString("org1"), Name: ptrString("Acme")},
+				&r4.Practitioner{Active: ptrBool(true)},
+			},
+		}
+
+		var paths []string
+		err := r4.Walk(patient, func(path string, _ any) error {
+			paths = append(paths, path)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"Patient",
+			"Patient.contained[#org1]",
+			"Patient.contained[#org1].id",
+			"Patient.contained[#org1].name",
+			"Patient.contained[1]",
+			"Patient.contained[1].active",
+		}, paths)
+
+		node, ok := r4.NodeAt(patient, "Patient.contained[#org1].name")
+		require.True(t, ok)
+		assert.Equal(t, "Acme", *node.(*string))
+	})
+
+	t.Run("paths resolve with NodeAt", func(t *testing.T) {
+		// The first contained id looks like the second one's index.
+		patient := &r4.Patient{
+			Id:           ptrString("p1"),
+			BirthDateExt: &r4.Element{Id: ptrString("bd")},
+			Name:         []r4.HumanName{{Family: ptrString("Doe"), Given: []string{"John", "Q"}}},
+			Contained: []r4.Resource{
+				&r4.Organization{Id: ptrString("1"), Name: ptrString("Acme")},
+				&r4.Practitioner{Active: ptrBool(true)},
+			},
+		}
+
+		var paths int
+		err := r4.Walk(patient, func(path string, node any) error {
+			paths++
+			got, ok := r4.NodeAt(patient, path)
+			if assert.True(t, ok, path) {
+				assert.True(t, got == node, "%s resolved to %#v", path, got)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 13, paths)
+	})
+
+	t.Run("nodes are pointers into the resource", func(t *testing.T) {
+		patient := &r4.Patient{Name: []r4.HumanName{{Family: ptrString("Doe")}}}
+
+		err := r4.Walk(patient, func(path string, node any) error {
+			if path == "Patient.name[0].family" {
+				*node.(*string) = "Smith"
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Smith", *patient.Name[0].Family)
+	})
+
+	t.Run("stops on first error", func(t *testing.T) {
+		patient := &r4.Patient{Id: ptrString("p1"), Active: ptrBool(true)}
+		stop := errors.New("stop")
+
+		var visited int
+		err := r4.Walk(patient, func(path string, _ any) error {
+			visited++
+			if path == "Patient.id" {
+				return stop
+			}
+			return nil
+		})
+		assert.ErrorIs(t, err, stop)
+		assert.Equal(t, 2, visited)
+	})
+
+	t.Run("nil resource", func(t *testing.T) {
+		err := r4.Walk(nil, func(string, any) error {
+			t.Fatal("callback should not be called")
+			return nil
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestWalkAll(t *testing.T) {
+	patient := &r4.Patient{
+		Id:     ptrString("p1"),
+		Active: ptrBool(true),
+		Name:   []r4.HumanName{{Given: []string{"?", "Jane", "?"}}},
+	}
+
+	var visited int
+	errs := r4.WalkAll(patient, func(path string, node any) error {
+		visited++
+		if s, ok := node.(*string); ok && *s == "?" {
+			return errors.New(path + ": placeholder name")
+		}
+		return nil
+	})
+	require.Len(t, errs, 2)
+	assert.EqualError(t, errs[0], "Patient.name[0].given[0]: placeholder name")
+	assert.EqualError(t, errs[1], "Patient.name[0].given[2]: placeholder name")
+	assert.Equal(t, 7, visited, "every node is visited")
+
+	assert.Nil(t, r4.WalkAll(patient, func(string, any) error { return nil }))
+}
+
+func TestPopulatedPaths(t *testing.T) {
+	gender := r4.AdministrativeGenderOther
+	patient := &r4.Patient{
+		Id:     ptrString("p1"),
+		Active: ptrBool(false),
+		Name: []r4.HumanName{
+			{Family: ptrString("Doe"), Given: []string{"Jane", "Q"}},
+			{Given: []string{"JD"}},
+		},
+		Gender:    &gender,
+		Telecom:   []r4.ContactPoint{},
+		Address:   []r4.Address{{}},
+		BirthDate: nil,
+	}
+
+	assert.Equal(t, []string{
+		"Patient.id",
+		"Patient.active",
+		"Patient.name",
+		"Patient.name.family",
+		"Patient.name.given",
+		"Patient.gender",
+	}, r4.PopulatedPaths(patient), "empty lists and elements are not populated")
+
+	assert.Empty(t, r4.PopulatedPaths(&r4.Patient{}))
+	assert.Empty(t, r4.PopulatedPaths(nil))
+}
+
+func TestIsEmpty(t *testing.T) {
+	assert.True(t, r4.IsEmpty(nil))
+	assert.True(t, r4.IsEmpty(&r4.Patient{}))
+	assert.True(t, r4.IsEmpty(&r4.Patient{Id: ptrString("p1")}))
+	assert.True(t, r4.IsEmpty(&r4.Patient{
+		Id:   ptrString("p1"),
+		Meta: &r4.Meta{VersionId: ptrString("2"), Tag: []r4.Coding{{Code: ptrString("t")}}},
+	}))
+
+	assert.False(t, r4.IsEmpty(&r4.Patient{Id: ptrString("p1"), Name: []r4.HumanName{{Family: ptrString("Doe")}}}))
+	assert.False(t, r4.IsEmpty(&r4.Patient{Id: ptrString("p1"), Language: ptrString("en")}))
+	assert.False(t, r4.IsEmpty(&r4.Patient{Contained: []r4.Resource{&r4.Organization{}}}))
+
+	// An empty datatype counts as data until pruned.
+	p := &r4.Patient{Id: ptrString("p1"), MaritalStatus: &r4.CodeableConcept{}}
+	assert.False(t, r4.IsEmpty(p))
+	assert.True(t, r4.IsEmpty(r4.Prune(p)))
+}
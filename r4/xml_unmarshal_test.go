@@ -247,3 +247,70 @@ func TestUnmarshalResourceXML_UnknownType(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "UnknownResource")
 }
+
+func TestUnmarshalResourceXMLStrict_InOrder(t *testing.T) {
+	xmlData := []byte(`<Patient xmlns="http://hl7.org/fhir"><id value="123"/><contained><Organization><id value="org1"/><name value="Acme"/></Organization></contained><active value="true"/><name><family value="Doe"/><given value="John"/><given value="Q"/></name><gender value="male"/><birthDate value="1974-12-25"/></Patient>`)
+
+	resource, err := UnmarshalResourceXMLStrict(xmlData)
+	require.NoError(t, err)
+
+	patient, ok := resource.(*Patient)
+	require.True(t, ok)
+	assert.Equal(t, "123", *patient.Id)
+	require.Len(t, patient.Contained, 1)
+	assert.Equal(t, []string{"John", "Q"}, patient.Name[0].Given)
+}
+
+func TestUnmarshalResourceXMLStrict_OutOfOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		xml     string
+		wantErr string
+	}{
+		{
+			name:    "top level",
+			xml:     `<Patient xmlns="http://hl7.org/fhir"><id value="123"/><birthDate value="1974-12-25"/><gender value="male"/></Patient>`,
+			wantErr: "Patient: element <gender> out of order, must precede <birthDate>",
+		},
+		{
+			name:    "nested datatype",
+			xml:     `<Patient xmlns="http://hl7.org/fhir"><name><given value="John"/><family value="Doe"/></name></Patient>`,
+			wantErr: "Patient.name: element <family> out of order, must precede <given>",
+		},
+		{
+			name:    "contained resource",
+			xml:     `<Patient xmlns="http://hl7.org/fhir"><contained><Organization><name value="Acme"/><id value="org1"/></Organization></contained></Patient>`,
+			wantErr: "Patient.contained: element <id> out of order, must precede <name>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The lenient decoder accepts the same document.
+			_, err := UnmarshalResourceXML([]byte(tt.xml))
+			require.NoError(t, err)
+
+			_, err = UnmarshalResourceXMLStrict([]byte(tt.xml))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestUnmarshalResourceXMLStrict_MarshalRoundTrip(t *testing.T) {
+	obs := &Observation{
+		Id:     ptr("obs1"),
+		Status: ptr(ObservationStatusFinal),
+		Code: CodeableConcept{
+			Coding: []Coding{{System: ptr("http://loinc.org"), Code: ptr("8867-4")}},
+		},
+		ValueQuantity: &Quantity{Value: NewDecimalFromFloat64(72), Unit: ptr("bpm")},
+		Extension:     []Extension{{Url: "urn:ext", ValueString: ptr("x")}},
+	}
+
+	data, err := MarshalResourceXML(obs)
+	require.NoError(t, err)
+
+	_, err = UnmarshalResourceXMLStrict(data)
+	require.NoError(t, err)
+}
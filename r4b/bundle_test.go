@@ -0,0 +1,379 @@
+package r4b_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4b"
+)
+
+func TestMergeBundles(t *testing.T) {
+	searchset := r4b.BundleTypeSearchset
+	entry := func(fullURL, id string) r4b.BundleEntry {
+		return r4b.BundleEntry{
+			FullUrl:  ptrString(fullURL),
+			Resource: &r4b.Patient{Id: ptrString(id)},
+		}
+	}
+	total := func(n uint32) *uint32 { return &n }
+
+	t.Run("searchset pages with overlapping entry", func(t *testing.T) {
+		page1 := &r4b.Bundle{
+			Type:  &searchset,
+			Total: total(2),
+			Link:  []r4b.BundleLink{{Relation: ptrString("next"), Url: ptrString("http://x/page2")}},
+			Entry: []r4b.BundleEntry{entry("http://x/Patient/1", "1"), entry("http://x/Patient/2", "2")},
+		}
+		page2 := &r4b.Bundle{
+			Type:  &searchset,
+			Total: total(2),
+			Entry: []r4b.BundleEntry{entry("http://x/Patient/2", "2-dup"), entry("http://x/Patient/3", "3")},
+		}
+
+		merged := r4b.MergeBundles(page1, page2)
+		require.NotNil(t, merged)
+		assert.Equal(t, r4b.BundleTypeSearchset, *merged.Type)
+		require.NotNil(t, merged.Total)
+		assert.Equal(t, uint32(4), *merged.Total)
+		assert.Empty(t, merged.Link)
+
+		var ids []string
+		for _, e := range merged.Entry {
+			ids = append(ids, *e.Resource.GetId())
+		}
+		assert.Equal(t, []string{"1", "2", "3"}, ids)
+
+		// inputs are untouched
+		assert.Len(t, page1.Entry, 2)
+		assert.Len(t, page2.Entry, 2)
+	})
+
+	t.Run("entries without fullUrl are kept", func(t *testing.T) {
+		collection := r4b.BundleTypeCollection
+		a := &r4b.Bundle{Type: &collection, Total: total(1), Entry: []r4b.BundleEntry{{Resource: &r4b.Patient{}}}}
+		b := &r4b.Bundle{Entry: []r4b.BundleEntry{{Resource: &r4b.Patient{}}}}
+
+		merged := r4b.MergeBundles(a, b)
+		assert.Equal(t, r4b.BundleTypeCollection, *merged.Type)
+		assert.Nil(t, merged.Total)
+		assert.Len(t, merged.Entry, 2)
+	})
+
+	t.Run("nil bundles", func(t *testing.T) {
+		assert.Nil(t, r4b.MergeBundles())
+		assert.Nil(t, r4b.MergeBundles(nil, nil))
+
+		merged := r4b.MergeBundles(nil, &r4b.Bundle{Type: &searchset})
+		require.NotNil(t, merged)
+		assert.Equal(t, r4b.BundleTypeSearchset, *merged.Type)
+	})
+}
+
+func TestBundleSearchEntries(t *testing.T) {
+	searchset := r4b.BundleTypeSearchset
+	severity := r4b.IssueSeverityWarning
+	code := r4b.IssueTypeNotSupported
+	oo := &r4b.OperationOutcome{Issue: []r4b.OperationOutcomeIssue{{
+		Severity:    &severity,
+		Code:        &code,
+		Diagnostics: ptrString("unknown search parameter: foo"),
+	}}}
+
+	b := &r4b.Bundle{Type: &searchset}
+	b.AddMatch(&r4b.Patient{Id: ptrString("p1")})
+	b.AddInclude(&r4b.Organization{Id: ptrString("o1")})
+	b.AddOutcome(oo)
+
+	require.Len(t, b.Entry, 3)
+	var modes []r4b.SearchEntryMode
+	for _, e := range b.Entry {
+		require.NotNil(t, e.Search)
+		modes = append(modes, *e.Search.Mode)
+	}
+	assert.Equal(t, []r4b.SearchEntryMode{
+		r4b.SearchEntryModeMatch, r4b.SearchEntryModeInclude, r4b.SearchEntryModeOutcome,
+	}, modes)
+	assert.Same(t, oo, b.Entry[2].Resource)
+
+	data, err := r4b.Marshal(b)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"search":{"mode":"outcome"}`)
+}
+
+func TestFlattenBundle(t *testing.T) {
+	newBundle := func() *r4b.Bundle {
+		return &r4b.Bundle{Entry: []r4b.BundleEntry{
+			{Resource: &r4b.Patient{
+				Id:                   ptrString("p1"),
+				ManagingOrganization: &r4b.Reference{Reference: ptrString("#org1")},
+				Contained: []r4b.Resource{&r4b.Organization{
+					Id:   ptrString("org1"),
+					Name: ptrString("Acme Clinic"),
+				}},
+			}},
+			{Request: &r4b.BundleEntryRequest{Url: ptrString("Patient/p1")}},
+			{Resource: &r4b.Practitioner{
+				Id: ptrString("pr1"),
+				Contained: []r4b.Resource{
+					&r4b.Organization{Id: ptrString("org2"), PartOf: &r4b.Reference{Reference: ptrString("#org3")}},
+					&r4b.Organization{Id: ptrString("org3")},
+				},
+			}},
+		}}
+	}
+
+	t.Run("contained organizations", func(t *testing.T) {
+		b := newBundle()
+
+		resources, err := r4b.FlattenBundle(b)
+		require.NoError(t, err)
+
+		var keys []string
+		for _, r := range resources {
+			key, err := r4b.StorageKey(r)
+			require.NoError(t, err)
+			keys = append(keys, key)
+		}
+		assert.Equal(t, []string{
+			"Patient/p1", "Organization/p1-org1",
+			"Practitioner/pr1", "Organization/pr1-org2", "Organization/pr1-org3",
+		}, keys)
+
+		patient := resources[0].(*r4b.Patient)
+		assert.Equal(t, "Organization/p1-org1", *patient.ManagingOrganization.Reference)
+		assert.Empty(t, patient.Contained)
+		assert.Equal(t, "Acme Clinic", *resources[1].(*r4b.Organization).Name)
+		assert.Equal(t, "Organization/pr1-org3", *resources[3].(*r4b.Organization).PartOf.Reference)
+
+		original := b.Entry[0].Resource.(*r4b.Patient)
+		assert.Equal(t, "#org1", *original.ManagingOrganization.Reference, "bundle is not modified")
+		assert.Len(t, original.Contained, 1)
+	})
+
+	t.Run("contained ids shared across entries", func(t *testing.T) {
+		b := newBundle()
+		practitioner := b.Entry[2].Resource.(*r4b.Practitioner)
+		practitioner.Contained[0].SetId("org1")
+		practitioner.Qualification = []r4b.PractitionerQualification{{Issuer: &r4b.Reference{Reference: ptrString("#org1")}}}
+
+		resources, err := r4b.FlattenBundle(b)
+		require.NoError(t, err)
+
+		assert.Equal(t, "p1-org1", *resources[1].GetId())
+		assert.Equal(t, "pr1-org1", *resources[3].GetId())
+		assert.Equal(t, "Organization/p1-org1", *resources[0].(*r4b.Patient).ManagingOrganization.Reference)
+		assert.Equal(t, "Organization/pr1-org1", *resources[2].(*r4b.Practitioner).Qualification[0].Issuer.Reference)
+	})
+
+	t.Run("container without id", func(t *testing.T) {
+		b := newBundle()
+		b.Entry[0].Resource.(*r4b.Patient).Id = nil
+		b.Entry[0].FullUrl = ptrString("urn:uuid:0c3a6a8e-5f4b-4c1e-9d2a-7b8e1f2a3c4d")
+
+		resources, err := r4b.FlattenBundle(b)
+		require.NoError(t, err)
+
+		id := *resources[1].GetId()
+		assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-5[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, id)
+		assert.Equal(t, "Organization/"+id, *resources[0].(*r4b.Patient).ManagingOrganization.Reference)
+
+		again, err := r4b.FlattenBundle(b)
+		require.NoError(t, err)
+		assert.Equal(t, id, *again[1].GetId(), "ids are deterministic")
+
+		b.Entry[0].FullUrl = ptrString("urn:uuid:9e1d2c3b-4a5f-4e6d-8c7b-6a5f4e3d2c1b")
+		other, err := r4b.FlattenBundle(b)
+		require.NoError(t, err)
+		assert.NotEqual(t, id, *other[1].GetId(), "ids depend on the fullUrl")
+
+		b.Entry[0].FullUrl = nil
+		byIndex, err := r4b.FlattenBundle(b)
+		require.NoError(t, err)
+		again, err = r4b.FlattenBundle(b)
+		require.NoError(t, err)
+		assert.Equal(t, *byIndex[1].GetId(), *again[1].GetId())
+	})
+
+	t.Run("nested contained resources", func(t *testing.T) {
+		b := newBundle()
+		org2 := b.Entry[2].Resource.(*r4b.Practitioner).Contained[0].(*r4b.Organization)
+		org2.Contained = []r4b.Resource{&r4b.Endpoint{Id: ptrString("ep1")}}
+		org2.Endpoint = []r4b.Reference{{Reference: ptrString("#ep1")}}
+
+		resources, err := r4b.FlattenBundle(b)
+		require.NoError(t, err)
+
+		require.Len(t, resources, 6)
+		key, err := r4b.StorageKey(resources[5])
+		require.NoError(t, err)
+		assert.Equal(t, "Endpoint/pr1-org2-ep1", key)
+		flat := resources[3].(*r4b.Organization)
+		assert.Empty(t, flat.Contained)
+		assert.Equal(t, "Endpoint/pr1-org2-ep1", *flat.Endpoint[0].Reference)
+		assert.Equal(t, "Organization/pr1-org3", *flat.PartOf.Reference)
+	})
+
+	t.Run("duplicate resource", func(t *testing.T) {
+		b := newBundle()
+		b.Entry = append(b.Entry, r4b.BundleEntry{Resource: &r4b.Organization{Id: ptrString("p1-org1")}})
+
+		_, err := r4b.FlattenBundle(b)
+		assert.EqualError(t, err, "entry 3: duplicate resource Organization/p1-org1")
+	})
+
+	t.Run("contained without id", func(t *testing.T) {
+		b := newBundle()
+		b.Entry[0].Resource.(*r4b.Patient).Contained[0].(*r4b.Organization).Id = nil
+
+		_, err := r4b.FlattenBundle(b)
+		assert.ErrorContains(t, err, "has no id")
+	})
+}
+
+func TestReferenceGraph(t *testing.T) {
+	ref := func(s string) r4b.Reference { return r4b.Reference{Reference: ptrString(s)} }
+	subject := ref("Patient/p1")
+	bundle := &r4b.Bundle{
+		Entry: []r4b.BundleEntry{
+			{
+				FullUrl:  ptrString("http://example.org/fhir/Patient/p1"),
+				Resource: &r4b.Patient{Id: ptrString("p1")},
+			},
+			{
+				FullUrl: ptrString("http://example.org/fhir/Observation/o1"),
+				Resource: &r4b.Observation{
+					Id:      ptrString("o1"),
+					Subject: &subject,
+					Performer: []r4b.Reference{
+						ref("http://example.org/fhir/Patient/p1/_history/2"),
+						ref("Practitioner/elsewhere"),
+						ref("#local"),
+					},
+				},
+			},
+			{
+				FullUrl: ptrString("urn:uuid:2b1e"),
+				Resource: &r4b.Observation{
+					Subject:   &subject,
+					Performer: []r4b.Reference{ref("Observation/o1")},
+				},
+			},
+			{FullUrl: ptrString("urn:uuid:empty")},
+		},
+	}
+
+	assert.Equal(t, map[string][]string{
+		"Patient/p1":     nil,
+		"Observation/o1": {"Patient/p1", "Practitioner/elsewhere"},
+		"urn:uuid:2b1e":  {"Patient/p1", "Observation/o1"},
+	}, r4b.ReferenceGraph(bundle))
+
+	assert.Nil(t, r4b.ReferenceGraph(nil))
+}
+
+func TestCorrelateTransaction(t *testing.T) {
+	post := r4b.HTTPVerbPost
+	put := r4b.HTTPVerbPut
+	transaction := r4b.BundleTypeTransaction
+	transactionResponse := r4b.BundleTypeTransactionResponse
+	request := &r4b.Bundle{
+		Type: &transaction,
+		Entry: []r4b.BundleEntry{
+			{
+				FullUrl:  ptrString("urn:uuid:1"),
+				Resource: &r4b.Patient{Active: ptrBool(true)},
+				Request:  &r4b.BundleEntryRequest{Method: &post, Url: ptrString("Patient")},
+			},
+			{
+				Resource: &r4b.Patient{Id: ptrString("p2")},
+				Request:  &r4b.BundleEntryRequest{Method: &put, Url: ptrString("Patient/p2")},
+			},
+		},
+	}
+	outcome := &r4b.OperationOutcome{}
+	response := &r4b.Bundle{
+		Type: &transactionResponse,
+		Entry: []r4b.BundleEntry{
+			{Response: &r4b.BundleEntryResponse{
+				Status:   ptrString("201 Created"),
+				Location: ptrString("Patient/p1/_history/1"),
+			}},
+			{
+				Resource: &r4b.Patient{Id: ptrString("p2")},
+				Response: &r4b.BundleEntryResponse{Status: ptrString("200 OK"), Outcome: outcome},
+			},
+		},
+	}
+
+	results, err := r4b.CorrelateTransaction(request, response)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	assert.Same(t, request.Entry[0].Resource, results[0].Request.Resource)
+	assert.Equal(t, "201 Created", results[0].Status)
+	assert.Equal(t, "Patient/p1/_history/1", results[0].Location)
+	assert.Nil(t, results[0].Resource)
+
+	assert.Equal(t, "Patient/p2", *results[1].Request.Request.Url)
+	assert.Equal(t, "200 OK", results[1].Status)
+	assert.Empty(t, results[1].Location)
+	assert.Same(t, response.Entry[1].Resource, results[1].Resource)
+	assert.Same(t, outcome, results[1].Outcome)
+
+	_, err = r4b.CorrelateTransaction(request, &r4b.Bundle{Entry: response.Entry[:1]})
+	assert.EqualError(t, err, "request has 2 entries but response has 1")
+	_, err = r4b.CorrelateTransaction(request, nil)
+	assert.Error(t, err)
+}
+
+func TestValidateBundle(t *testing.T) {
+	final := r4b.ObservationStatusFinal
+	observation := func(subject string) *r4b.Observation {
+		return &r4b.Observation{
+			Status:  &final,
+			Code:    r4b.CodeableConcept{Text: ptrString("Heart rate")},
+			Subject: &r4b.Reference{Reference: ptrString(subject)},
+		}
+	}
+	bundle := &r4b.Bundle{
+		Entry: []r4b.BundleEntry{
+			{FullUrl: ptrString("urn:uuid:61ebe359-bfdc-4613-8bf2-c5e300945f0a"), Resource: &r4b.Patient{
+				ManagingOrganization: &r4b.Reference{Reference: ptrString("#org1")},
+				Contained:            []r4b.Resource{&r4b.Organization{Id: ptrString("org1")}},
+			}},
+			{FullUrl: ptrString("urn:uuid:1"), Resource: observation("urn:uuid:61ebe359-bfdc-4613-8bf2-c5e300945f0a")},
+			{FullUrl: ptrString("urn:uuid:2"), Resource: observation("Patient/on-server")},
+		},
+	}
+	assert.Nil(t, r4b.ValidateBundle(bundle))
+
+	bundle.Entry = append(bundle.Entry, r4b.BundleEntry{
+		FullUrl:  ptrString("urn:uuid:3"),
+		Resource: observation("urn:uuid:dangling"),
+	}, r4b.BundleEntry{
+		FullUrl:  ptrString("urn:uuid:4"),
+		Resource: observation("#org1"),
+	})
+	bundle.Entry[2].Resource.(*r4b.Observation).Status = nil
+
+	oo := r4b.ValidateBundle(bundle)
+	require.NotNil(t, oo)
+	require.Len(t, oo.Issue, 3)
+
+	assert.Equal(t, r4b.IssueTypeInvalid, *oo.Issue[0].Code)
+	assert.Equal(t, []string{"Bundle.entry[2].resource.status"}, oo.Issue[0].Expression)
+
+	assert.Equal(t, r4b.IssueSeverityError, *oo.Issue[1].Severity)
+	assert.Equal(t, r4b.IssueTypeNotFound, *oo.Issue[1].Code)
+	assert.Equal(t, []string{"Bundle.entry[3].resource.subject"}, oo.Issue[1].Expression)
+	assert.Contains(t, *oo.Issue[1].Diagnostics, "urn:uuid:dangling")
+
+	// Contained resources are local to their entry.
+	assert.Equal(t, r4b.IssueTypeNotFound, *oo.Issue[2].Code)
+	assert.Equal(t, []string{"Bundle.entry[4].resource.subject"}, oo.Issue[2].Expression)
+	assert.Equal(t, "reference #org1 does not resolve to a contained resource", *oo.Issue[2].Diagnostics)
+
+	assert.Nil(t, r4b.ValidateBundle(nil))
+}
@@ -0,0 +1,67 @@
+package r4b_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4b"
+)
+
+func TestMarshalCanonical(t *testing.T) {
+	mrn := r4b.Identifier{System: ptrString("urn:mrn"), Value: ptrString("123")}
+	ssn := r4b.Identifier{System: ptrString("urn:ssn"), Value: ptrString("999")}
+	name := r4b.HumanName{Family: ptrString("Doe"), Given: []string{"Mary", "Anne"}}
+
+	t.Run("normalizes identifier order", func(t *testing.T) {
+		a, err := r4b.MarshalCanonical(&r4b.Patient{Identifier: []r4b.Identifier{mrn, ssn}})
+		require.NoError(t, err)
+		b, err := r4b.MarshalCanonical(&r4b.Patient{Identifier: []r4b.Identifier{ssn, mrn}})
+		require.NoError(t, err)
+		assert.Equal(t, string(a), string(b))
+		assert.Equal(t, `{"resourceType":"Patient","identifier":[`+
+			`{"system":"urn:mrn","value":"123"},{"system":"urn:ssn","value":"999"}]}`, string(a))
+
+		x, err := r4b.MarshalCanonicalXML(&r4b.Patient{Identifier: []r4b.Identifier{ssn, mrn}})
+		require.NoError(t, err)
+		y, err := r4b.MarshalCanonicalXML(&r4b.Patient{Identifier: []r4b.Identifier{mrn, ssn}})
+		require.NoError(t, err)
+		assert.Equal(t, string(x), string(y))
+	})
+
+	t.Run("keeps given name order", func(t *testing.T) {
+		data, err := r4b.MarshalCanonical(&r4b.Patient{Name: []r4b.HumanName{name}})
+		require.NoError(t, err)
+		assert.Equal(t, `{"resourceType":"Patient","name":[{"family":"Doe","given":["Mary","Anne"]}]}`, string(data))
+
+		reversed := r4b.HumanName{Family: ptrString("Doe"), Given: []string{"Anne", "Mary"}}
+		other, err := r4b.MarshalCanonical(&r4b.Patient{Name: []r4b.HumanName{reversed}})
+		require.NoError(t, err)
+		assert.NotEqual(t, string(data), string(other))
+	})
+
+	t.Run("sorts nested unordered elements", func(t *testing.T) {
+		a := &r4b.Patient{Contact: []r4b.PatientContact{{Telecom: []r4b.ContactPoint{
+			{Value: ptrString("555-2")}, {Value: ptrString("555-1")},
+		}}}}
+		b := &r4b.Patient{Contact: []r4b.PatientContact{{Telecom: []r4b.ContactPoint{
+			{Value: ptrString("555-1")}, {Value: ptrString("555-2")},
+		}}}}
+		x, err := r4b.MarshalCanonical(a)
+		require.NoError(t, err)
+		y, err := r4b.MarshalCanonical(b)
+		require.NoError(t, err)
+		assert.Equal(t, string(x), string(y))
+		assert.Equal(t, "555-2", *a.Contact[0].Telecom[0].Value, "input must not be modified")
+	})
+
+	t.Run("configurable", func(t *testing.T) {
+		r4b.CanonicalUnorderedElements["HumanName.given"] = true
+		defer delete(r4b.CanonicalUnorderedElements, "HumanName.given")
+
+		data, err := r4b.MarshalCanonical(&r4b.Patient{Name: []r4b.HumanName{name}})
+		require.NoError(t, err)
+		assert.Equal(t, `{"resourceType":"Patient","name":[{"family":"Doe","given":["Anne","Mary"]}]}`, string(data))
+	})
+}
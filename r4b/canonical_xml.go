@@ -0,0 +1,26 @@
+package r4b
+
+import (
+	"bytes"
+	"encoding/xml"
+)
+
+// MarshalCanonicalXML serializes a FHIR resource to a canonical XML form
+// suitable for signing and byte-wise comparison.
+//
+// The output has elements in StructureDefinition order (checked against
+// ElementOrder), double-quoted attributes, no XML declaration and no
+// insignificant whitespace. Extensions and the repeating elements listed in
+// CanonicalUnorderedElements are sorted as for MarshalCanonical. r is not
+// modified.
+func MarshalCanonicalXML(r Resource) ([]byte, error) {
+	data, err := MarshalResourceXML(canonicalize(r))
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimPrefix(data, []byte(xml.Header))
+	if err := xmlCheckDocumentOrder(data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
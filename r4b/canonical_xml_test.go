@@ -0,0 +1,78 @@
+package r4b_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4b"
+)
+
+func TestMarshalCanonicalXML(t *testing.T) {
+	t.Run("differently built identical resources", func(t *testing.T) {
+		gender := r4b.AdministrativeGenderFemale
+		literal := &r4b.Patient{
+			Id:     ptrString("p1"),
+			Active: ptrBool(true),
+			Extension: []r4b.Extension{
+				{Url: "urn:a", ValueString: ptrString("1")},
+				{Url: "urn:b", ValueString: ptrString("2")},
+			},
+			Name:   []r4b.HumanName{{Family: ptrString("Doe"), Given: []string{"Jane"}}},
+			Gender: &gender,
+			BirthDateExt: &r4b.Element{Extension: []r4b.Extension{
+				{Url: "urn:y", ValueString: ptrString("y")},
+				{Url: "urn:x", ValueString: ptrString("x")},
+			}},
+		}
+
+		built := r4b.NewPatientBuilder().
+			SetGender(r4b.AdministrativeGenderFemale).
+			AddName(r4b.HumanName{Given: []string{"Jane"}, Family: ptrString("Doe")}).
+			AddExtension(r4b.Extension{Url: "urn:b", ValueString: ptrString("2")}).
+			AddExtension(r4b.Extension{Url: "urn:a", ValueString: ptrString("1")}).
+			SetActive(true).
+			SetId("p1").
+			Build()
+		built.BirthDateExt = &r4b.Element{Extension: []r4b.Extension{
+			{Url: "urn:x", ValueString: ptrString("x")},
+			{Url: "urn:y", ValueString: ptrString("y")},
+		}}
+
+		a, err := r4b.MarshalCanonicalXML(literal)
+		require.NoError(t, err)
+		b, err := r4b.MarshalCanonicalXML(built)
+		require.NoError(t, err)
+		assert.Equal(t, string(a), string(b))
+
+		assert.Equal(t, `<Patient xmlns="http://hl7.org/fhir"><id value="p1"/>`+
+			`<extension url="urn:a"><valueString value="1"/></extension>`+
+			`<extension url="urn:b"><valueString value="2"/></extension>`+
+			`<active value="true"/><name><family value="Doe"/><given value="Jane"/></name>`+
+			`<gender value="female"/>`+
+			`<birthDate><extension url="urn:x"><valueString value="x"/></extension>`+
+			`<extension url="urn:y"><valueString value="y"/></extension></birthDate></Patient>`, string(a))
+	})
+
+	t.Run("does not modify the resource", func(t *testing.T) {
+		patient := &r4b.Patient{Extension: []r4b.Extension{{Url: "urn:b"}, {Url: "urn:a"}}}
+
+		_, err := r4b.MarshalCanonicalXML(patient)
+		require.NoError(t, err)
+		assert.Equal(t, "urn:b", patient.Extension[0].Url)
+	})
+
+	t.Run("decodes strictly", func(t *testing.T) {
+		patient := &r4b.Patient{
+			Id:        ptrString("p1"),
+			Contained: []r4b.Resource{&r4b.Organization{Id: ptrString("o1"), Name: ptrString("Acme")}},
+			Active:    ptrBool(true),
+		}
+
+		data, err := r4b.MarshalCanonicalXML(patient)
+		require.NoError(t, err)
+		_, err = r4b.UnmarshalResourceXMLStrict(data)
+		assert.NoError(t, err)
+	})
+}
@@ -4,6 +4,45 @@
 
 package r4b
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// StrictCodes controls how code values are decoded into the generated code
+// types below, from both JSON and XML. When false (the default), unknown
+// codes pass through as the raw string for forward compatibility; when true,
+// they are rejected with an error. Set it once during initialization.
+var StrictCodes bool
+
+// knownCode is implemented by the generated code types.
+type knownCode interface {
+	~string
+	known() bool
+}
+
+// checkCode returns an error for an unknown code when StrictCodes is set.
+func checkCode[T knownCode](c T) error {
+	if StrictCodes && !c.known() {
+		return fmt.Errorf("unknown %T code %q", c, string(c))
+	}
+	return nil
+}
+
+// unmarshalCode decodes a JSON string into a generated code type.
+func unmarshalCode[T knownCode](data []byte, c *T) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v := T(s)
+	if err := checkCode(v); err != nil {
+		return err
+	}
+	*c = v
+	return nil
+}
+
 // FHIRVersion represents FHIRVersion.
 type FHIRVersion string
 
@@ -65,6 +104,56 @@ const (
 	FHIRVersion430 FHIRVersion = "4.3.0"
 )
 
+// known reports whether c is one of the FHIRVersion values.
+func (c FHIRVersion) known() bool {
+	switch c {
+	case FHIRVersion001,
+		FHIRVersion005,
+		FHIRVersion006,
+		FHIRVersion011,
+		FHIRVersion0080,
+		FHIRVersion0081,
+		FHIRVersion0082,
+		FHIRVersion040,
+		FHIRVersion050,
+		FHIRVersion100,
+		FHIRVersion101,
+		FHIRVersion102,
+		FHIRVersion110,
+		FHIRVersion140,
+		FHIRVersion160,
+		FHIRVersion180,
+		FHIRVersion300,
+		FHIRVersion301,
+		FHIRVersion302,
+		FHIRVersion330,
+		FHIRVersion350,
+		FHIRVersion400,
+		FHIRVersion401,
+		FHIRVersion410,
+		FHIRVersion430Cibuild,
+		FHIRVersion430Snapshot1,
+		FHIRVersion430:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FHIRVersion value in definition order.
+func (FHIRVersion) firstCode() string {
+	return string(FHIRVersion001)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FHIRVersion) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FHIRVersion) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AccountStatus represents AccountStatus.
 type AccountStatus string
 
@@ -82,6 +171,34 @@ const (
 	AccountStatusUnknown AccountStatus = "unknown"
 )
 
+// known reports whether c is one of the AccountStatus values.
+func (c AccountStatus) known() bool {
+	switch c {
+	case AccountStatusActive,
+		AccountStatusInactive,
+		AccountStatusEnteredInError,
+		AccountStatusOnHold,
+		AccountStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AccountStatus value in definition order.
+func (AccountStatus) firstCode() string {
+	return string(AccountStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AccountStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AccountStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionCardinalityBehavior represents ActionCardinalityBehavior.
 type ActionCardinalityBehavior string
 
@@ -93,6 +210,31 @@ const (
 	ActionCardinalityBehaviorMultiple ActionCardinalityBehavior = "multiple"
 )
 
+// known reports whether c is one of the ActionCardinalityBehavior values.
+func (c ActionCardinalityBehavior) known() bool {
+	switch c {
+	case ActionCardinalityBehaviorSingle,
+		ActionCardinalityBehaviorMultiple:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionCardinalityBehavior value in definition order.
+func (ActionCardinalityBehavior) firstCode() string {
+	return string(ActionCardinalityBehaviorSingle)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionCardinalityBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionCardinalityBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionConditionKind represents ActionConditionKind.
 type ActionConditionKind string
 
@@ -106,6 +248,32 @@ const (
 	ActionConditionKindStop ActionConditionKind = "stop"
 )
 
+// known reports whether c is one of the ActionConditionKind values.
+func (c ActionConditionKind) known() bool {
+	switch c {
+	case ActionConditionKindApplicability,
+		ActionConditionKindStart,
+		ActionConditionKindStop:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionConditionKind value in definition order.
+func (ActionConditionKind) firstCode() string {
+	return string(ActionConditionKindApplicability)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionConditionKind) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionConditionKind) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionGroupingBehavior represents ActionGroupingBehavior.
 type ActionGroupingBehavior string
 
@@ -119,6 +287,32 @@ const (
 	ActionGroupingBehaviorSentenceGroup ActionGroupingBehavior = "sentence-group"
 )
 
+// known reports whether c is one of the ActionGroupingBehavior values.
+func (c ActionGroupingBehavior) known() bool {
+	switch c {
+	case ActionGroupingBehaviorVisualGroup,
+		ActionGroupingBehaviorLogicalGroup,
+		ActionGroupingBehaviorSentenceGroup:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionGroupingBehavior value in definition order.
+func (ActionGroupingBehavior) firstCode() string {
+	return string(ActionGroupingBehaviorVisualGroup)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionGroupingBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionGroupingBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionParticipantType represents ActionParticipantType.
 type ActionParticipantType string
 
@@ -134,6 +328,33 @@ const (
 	ActionParticipantTypeDevice ActionParticipantType = "device"
 )
 
+// known reports whether c is one of the ActionParticipantType values.
+func (c ActionParticipantType) known() bool {
+	switch c {
+	case ActionParticipantTypePatient,
+		ActionParticipantTypePractitioner,
+		ActionParticipantTypeRelatedPerson,
+		ActionParticipantTypeDevice:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionParticipantType value in definition order.
+func (ActionParticipantType) firstCode() string {
+	return string(ActionParticipantTypePatient)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionParticipantType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionParticipantType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionPrecheckBehavior represents ActionPrecheckBehavior.
 type ActionPrecheckBehavior string
 
@@ -145,6 +366,31 @@ const (
 	ActionPrecheckBehaviorNo ActionPrecheckBehavior = "no"
 )
 
+// known reports whether c is one of the ActionPrecheckBehavior values.
+func (c ActionPrecheckBehavior) known() bool {
+	switch c {
+	case ActionPrecheckBehaviorYes,
+		ActionPrecheckBehaviorNo:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionPrecheckBehavior value in definition order.
+func (ActionPrecheckBehavior) firstCode() string {
+	return string(ActionPrecheckBehaviorYes)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionPrecheckBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionPrecheckBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionRelationshipType represents ActionRelationshipType.
 type ActionRelationshipType string
 
@@ -170,6 +416,38 @@ const (
 	ActionRelationshipTypeAfterEnd ActionRelationshipType = "after-end"
 )
 
+// known reports whether c is one of the ActionRelationshipType values.
+func (c ActionRelationshipType) known() bool {
+	switch c {
+	case ActionRelationshipTypeBeforeStart,
+		ActionRelationshipTypeBefore,
+		ActionRelationshipTypeBeforeEnd,
+		ActionRelationshipTypeConcurrentWithStart,
+		ActionRelationshipTypeConcurrent,
+		ActionRelationshipTypeConcurrentWithEnd,
+		ActionRelationshipTypeAfterStart,
+		ActionRelationshipTypeAfter,
+		ActionRelationshipTypeAfterEnd:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionRelationshipType value in definition order.
+func (ActionRelationshipType) firstCode() string {
+	return string(ActionRelationshipTypeBeforeStart)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionRelationshipType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionRelationshipType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionRequiredBehavior represents ActionRequiredBehavior.
 type ActionRequiredBehavior string
 
@@ -183,6 +461,32 @@ const (
 	ActionRequiredBehaviorMustUnlessDocumented ActionRequiredBehavior = "must-unless-documented"
 )
 
+// known reports whether c is one of the ActionRequiredBehavior values.
+func (c ActionRequiredBehavior) known() bool {
+	switch c {
+	case ActionRequiredBehaviorMust,
+		ActionRequiredBehaviorCould,
+		ActionRequiredBehaviorMustUnlessDocumented:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionRequiredBehavior value in definition order.
+func (ActionRequiredBehavior) firstCode() string {
+	return string(ActionRequiredBehaviorMust)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionRequiredBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionRequiredBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionSelectionBehavior represents ActionSelectionBehavior.
 type ActionSelectionBehavior string
 
@@ -202,6 +506,35 @@ const (
 	ActionSelectionBehaviorOneOrMore ActionSelectionBehavior = "one-or-more"
 )
 
+// known reports whether c is one of the ActionSelectionBehavior values.
+func (c ActionSelectionBehavior) known() bool {
+	switch c {
+	case ActionSelectionBehaviorAny,
+		ActionSelectionBehaviorAll,
+		ActionSelectionBehaviorAllOrNone,
+		ActionSelectionBehaviorExactlyOne,
+		ActionSelectionBehaviorAtMostOne,
+		ActionSelectionBehaviorOneOrMore:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionSelectionBehavior value in definition order.
+func (ActionSelectionBehavior) firstCode() string {
+	return string(ActionSelectionBehaviorAny)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionSelectionBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionSelectionBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AddressType represents AddressType.
 type AddressType string
 
@@ -215,6 +548,32 @@ const (
 	AddressTypeBoth AddressType = "both"
 )
 
+// known reports whether c is one of the AddressType values.
+func (c AddressType) known() bool {
+	switch c {
+	case AddressTypePostal,
+		AddressTypePhysical,
+		AddressTypeBoth:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AddressType value in definition order.
+func (AddressType) firstCode() string {
+	return string(AddressTypePostal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AddressType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AddressType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AddressUse represents AddressUse.
 type AddressUse string
 
@@ -232,6 +591,34 @@ const (
 	AddressUseBilling AddressUse = "billing"
 )
 
+// known reports whether c is one of the AddressUse values.
+func (c AddressUse) known() bool {
+	switch c {
+	case AddressUseHome,
+		AddressUseWork,
+		AddressUseTemp,
+		AddressUseOld,
+		AddressUseBilling:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AddressUse value in definition order.
+func (AddressUse) firstCode() string {
+	return string(AddressUseHome)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AddressUse) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AddressUse) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AdministrativeGender represents AdministrativeGender.
 type AdministrativeGender string
 
@@ -247,6 +634,33 @@ const (
 	AdministrativeGenderUnknown AdministrativeGender = "unknown"
 )
 
+// known reports whether c is one of the AdministrativeGender values.
+func (c AdministrativeGender) known() bool {
+	switch c {
+	case AdministrativeGenderMale,
+		AdministrativeGenderFemale,
+		AdministrativeGenderOther,
+		AdministrativeGenderUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AdministrativeGender value in definition order.
+func (AdministrativeGender) firstCode() string {
+	return string(AdministrativeGenderMale)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AdministrativeGender) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AdministrativeGender) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AdverseEventActuality represents AdverseEventActuality.
 type AdverseEventActuality string
 
@@ -258,6 +672,31 @@ const (
 	AdverseEventActualityPotential AdverseEventActuality = "potential"
 )
 
+// known reports whether c is one of the AdverseEventActuality values.
+func (c AdverseEventActuality) known() bool {
+	switch c {
+	case AdverseEventActualityActual,
+		AdverseEventActualityPotential:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AdverseEventActuality value in definition order.
+func (AdverseEventActuality) firstCode() string {
+	return string(AdverseEventActualityActual)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AdverseEventActuality) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AdverseEventActuality) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AllergyIntoleranceCategory represents AllergyIntoleranceCategory.
 type AllergyIntoleranceCategory string
 
@@ -273,6 +712,33 @@ const (
 	AllergyIntoleranceCategoryBiologic AllergyIntoleranceCategory = "biologic"
 )
 
+// known reports whether c is one of the AllergyIntoleranceCategory values.
+func (c AllergyIntoleranceCategory) known() bool {
+	switch c {
+	case AllergyIntoleranceCategoryFood,
+		AllergyIntoleranceCategoryMedication,
+		AllergyIntoleranceCategoryEnvironment,
+		AllergyIntoleranceCategoryBiologic:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AllergyIntoleranceCategory value in definition order.
+func (AllergyIntoleranceCategory) firstCode() string {
+	return string(AllergyIntoleranceCategoryFood)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AllergyIntoleranceCategory) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AllergyIntoleranceCategory) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AllergyIntoleranceCriticality represents AllergyIntoleranceCriticality.
 type AllergyIntoleranceCriticality string
 
@@ -286,6 +752,32 @@ const (
 	AllergyIntoleranceCriticalityUnableToAssess AllergyIntoleranceCriticality = "unable-to-assess"
 )
 
+// known reports whether c is one of the AllergyIntoleranceCriticality values.
+func (c AllergyIntoleranceCriticality) known() bool {
+	switch c {
+	case AllergyIntoleranceCriticalityLow,
+		AllergyIntoleranceCriticalityHigh,
+		AllergyIntoleranceCriticalityUnableToAssess:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AllergyIntoleranceCriticality value in definition order.
+func (AllergyIntoleranceCriticality) firstCode() string {
+	return string(AllergyIntoleranceCriticalityLow)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AllergyIntoleranceCriticality) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AllergyIntoleranceCriticality) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AllergyIntoleranceType represents AllergyIntoleranceType.
 type AllergyIntoleranceType string
 
@@ -297,6 +789,31 @@ const (
 	AllergyIntoleranceTypeIntolerance AllergyIntoleranceType = "intolerance"
 )
 
+// known reports whether c is one of the AllergyIntoleranceType values.
+func (c AllergyIntoleranceType) known() bool {
+	switch c {
+	case AllergyIntoleranceTypeAllergy,
+		AllergyIntoleranceTypeIntolerance:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AllergyIntoleranceType value in definition order.
+func (AllergyIntoleranceType) firstCode() string {
+	return string(AllergyIntoleranceTypeAllergy)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AllergyIntoleranceType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AllergyIntoleranceType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AppointmentStatus represents AppointmentStatus.
 type AppointmentStatus string
 
@@ -324,6 +841,39 @@ const (
 	AppointmentStatusWaitlist AppointmentStatus = "waitlist"
 )
 
+// known reports whether c is one of the AppointmentStatus values.
+func (c AppointmentStatus) known() bool {
+	switch c {
+	case AppointmentStatusProposed,
+		AppointmentStatusPending,
+		AppointmentStatusBooked,
+		AppointmentStatusArrived,
+		AppointmentStatusFulfilled,
+		AppointmentStatusCancelled,
+		AppointmentStatusNoshow,
+		AppointmentStatusEnteredInError,
+		AppointmentStatusCheckedIn,
+		AppointmentStatusWaitlist:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AppointmentStatus value in definition order.
+func (AppointmentStatus) firstCode() string {
+	return string(AppointmentStatusProposed)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AppointmentStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AppointmentStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AssertionDirectionType represents AssertionDirectionType.
 type AssertionDirectionType string
 
@@ -335,6 +885,31 @@ const (
 	AssertionDirectionTypeRequest AssertionDirectionType = "request"
 )
 
+// known reports whether c is one of the AssertionDirectionType values.
+func (c AssertionDirectionType) known() bool {
+	switch c {
+	case AssertionDirectionTypeResponse,
+		AssertionDirectionTypeRequest:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AssertionDirectionType value in definition order.
+func (AssertionDirectionType) firstCode() string {
+	return string(AssertionDirectionTypeResponse)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AssertionDirectionType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AssertionDirectionType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AssertionOperatorType represents AssertionOperatorType.
 type AssertionOperatorType string
 
@@ -364,6 +939,40 @@ const (
 	AssertionOperatorTypeEval AssertionOperatorType = "eval"
 )
 
+// known reports whether c is one of the AssertionOperatorType values.
+func (c AssertionOperatorType) known() bool {
+	switch c {
+	case AssertionOperatorTypeEquals,
+		AssertionOperatorTypeNotequals,
+		AssertionOperatorTypeIn,
+		AssertionOperatorTypeNotin,
+		AssertionOperatorTypeGreaterthan,
+		AssertionOperatorTypeLessthan,
+		AssertionOperatorTypeEmpty,
+		AssertionOperatorTypeNotempty,
+		AssertionOperatorTypeContains,
+		AssertionOperatorTypeNotcontains,
+		AssertionOperatorTypeEval:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AssertionOperatorType value in definition order.
+func (AssertionOperatorType) firstCode() string {
+	return string(AssertionOperatorTypeEquals)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AssertionOperatorType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AssertionOperatorType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AssertionResponseTypes represents AssertionResponseTypes.
 type AssertionResponseTypes string
 
@@ -395,6 +1004,41 @@ const (
 	AssertionResponseTypesUnprocessable AssertionResponseTypes = "unprocessable"
 )
 
+// known reports whether c is one of the AssertionResponseTypes values.
+func (c AssertionResponseTypes) known() bool {
+	switch c {
+	case AssertionResponseTypesOkay,
+		AssertionResponseTypesCreated,
+		AssertionResponseTypesNocontent,
+		AssertionResponseTypesNotmodified,
+		AssertionResponseTypesBad,
+		AssertionResponseTypesForbidden,
+		AssertionResponseTypesNotfound,
+		AssertionResponseTypesMethodnotallowed,
+		AssertionResponseTypesConflict,
+		AssertionResponseTypesGone,
+		AssertionResponseTypesPreconditionfailed,
+		AssertionResponseTypesUnprocessable:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AssertionResponseTypes value in definition order.
+func (AssertionResponseTypes) firstCode() string {
+	return string(AssertionResponseTypesOkay)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AssertionResponseTypes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AssertionResponseTypes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AuditEventAction represents AuditEventAction.
 type AuditEventAction string
 
@@ -412,6 +1056,34 @@ const (
 	AuditEventActionE AuditEventAction = "E"
 )
 
+// known reports whether c is one of the AuditEventAction values.
+func (c AuditEventAction) known() bool {
+	switch c {
+	case AuditEventActionC,
+		AuditEventActionR,
+		AuditEventActionU,
+		AuditEventActionD,
+		AuditEventActionE:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AuditEventAction value in definition order.
+func (AuditEventAction) firstCode() string {
+	return string(AuditEventActionC)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AuditEventAction) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AuditEventAction) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AuditEventOutcome represents AuditEventOutcome.
 type AuditEventOutcome string
 
@@ -427,6 +1099,33 @@ const (
 	AuditEventOutcome12 AuditEventOutcome = "12"
 )
 
+// known reports whether c is one of the AuditEventOutcome values.
+func (c AuditEventOutcome) known() bool {
+	switch c {
+	case AuditEventOutcome0,
+		AuditEventOutcome4,
+		AuditEventOutcome8,
+		AuditEventOutcome12:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AuditEventOutcome value in definition order.
+func (AuditEventOutcome) firstCode() string {
+	return string(AuditEventOutcome0)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AuditEventOutcome) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AuditEventOutcome) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // BindingStrength represents BindingStrength.
 type BindingStrength string
 
@@ -442,6 +1141,33 @@ const (
 	BindingStrengthExample BindingStrength = "example"
 )
 
+// known reports whether c is one of the BindingStrength values.
+func (c BindingStrength) known() bool {
+	switch c {
+	case BindingStrengthRequired,
+		BindingStrengthExtensible,
+		BindingStrengthPreferred,
+		BindingStrengthExample:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first BindingStrength value in definition order.
+func (BindingStrength) firstCode() string {
+	return string(BindingStrengthRequired)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c BindingStrength) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *BindingStrength) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // BundleType represents BundleType.
 type BundleType string
 
@@ -467,6 +1193,38 @@ const (
 	BundleTypeCollection BundleType = "collection"
 )
 
+// known reports whether c is one of the BundleType values.
+func (c BundleType) known() bool {
+	switch c {
+	case BundleTypeDocument,
+		BundleTypeMessage,
+		BundleTypeTransaction,
+		BundleTypeTransactionResponse,
+		BundleTypeBatch,
+		BundleTypeBatchResponse,
+		BundleTypeHistory,
+		BundleTypeSearchset,
+		BundleTypeCollection:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first BundleType value in definition order.
+func (BundleType) firstCode() string {
+	return string(BundleTypeDocument)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c BundleType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *BundleType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CapabilityStatementKind represents CapabilityStatementKind.
 type CapabilityStatementKind string
 
@@ -480,6 +1238,32 @@ const (
 	CapabilityStatementKindRequirements CapabilityStatementKind = "requirements"
 )
 
+// known reports whether c is one of the CapabilityStatementKind values.
+func (c CapabilityStatementKind) known() bool {
+	switch c {
+	case CapabilityStatementKindInstance,
+		CapabilityStatementKindCapability,
+		CapabilityStatementKindRequirements:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CapabilityStatementKind value in definition order.
+func (CapabilityStatementKind) firstCode() string {
+	return string(CapabilityStatementKindInstance)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CapabilityStatementKind) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CapabilityStatementKind) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CarePlanActivityKind represents Care Plan Activity Kind.
 type CarePlanActivityKind string
 
@@ -495,6 +1279,37 @@ const (
 	CarePlanActivityKindVisionprescription   CarePlanActivityKind = "VisionPrescription"
 )
 
+// known reports whether c is one of the CarePlanActivityKind values.
+func (c CarePlanActivityKind) known() bool {
+	switch c {
+	case CarePlanActivityKindAppointment,
+		CarePlanActivityKindCommunicationrequest,
+		CarePlanActivityKindDevicerequest,
+		CarePlanActivityKindMedicationrequest,
+		CarePlanActivityKindNutritionorder,
+		CarePlanActivityKindTask,
+		CarePlanActivityKindServicerequest,
+		CarePlanActivityKindVisionprescription:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CarePlanActivityKind value in definition order.
+func (CarePlanActivityKind) firstCode() string {
+	return string(CarePlanActivityKindAppointment)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CarePlanActivityKind) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CarePlanActivityKind) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CarePlanActivityStatus represents CarePlanActivityStatus.
 type CarePlanActivityStatus string
 
@@ -520,6 +1335,38 @@ const (
 	CarePlanActivityStatusEnteredInError CarePlanActivityStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the CarePlanActivityStatus values.
+func (c CarePlanActivityStatus) known() bool {
+	switch c {
+	case CarePlanActivityStatusNotStarted,
+		CarePlanActivityStatusScheduled,
+		CarePlanActivityStatusInProgress,
+		CarePlanActivityStatusOnHold,
+		CarePlanActivityStatusCompleted,
+		CarePlanActivityStatusCancelled,
+		CarePlanActivityStatusStopped,
+		CarePlanActivityStatusUnknown,
+		CarePlanActivityStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CarePlanActivityStatus value in definition order.
+func (CarePlanActivityStatus) firstCode() string {
+	return string(CarePlanActivityStatusNotStarted)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CarePlanActivityStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CarePlanActivityStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CarePlanIntent represents Care Plan Intent.
 type CarePlanIntent string
 
@@ -531,6 +1378,33 @@ const (
 	CarePlanIntentOption   CarePlanIntent = "option"
 )
 
+// known reports whether c is one of the CarePlanIntent values.
+func (c CarePlanIntent) known() bool {
+	switch c {
+	case CarePlanIntentProposal,
+		CarePlanIntentPlan,
+		CarePlanIntentOrder,
+		CarePlanIntentOption:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CarePlanIntent value in definition order.
+func (CarePlanIntent) firstCode() string {
+	return string(CarePlanIntentProposal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CarePlanIntent) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CarePlanIntent) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CareTeamStatus represents CareTeamStatus.
 type CareTeamStatus string
 
@@ -548,6 +1422,34 @@ const (
 	CareTeamStatusEnteredInError CareTeamStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the CareTeamStatus values.
+func (c CareTeamStatus) known() bool {
+	switch c {
+	case CareTeamStatusProposed,
+		CareTeamStatusActive,
+		CareTeamStatusSuspended,
+		CareTeamStatusInactive,
+		CareTeamStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CareTeamStatus value in definition order.
+func (CareTeamStatus) firstCode() string {
+	return string(CareTeamStatusProposed)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CareTeamStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CareTeamStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CharacteristicCombination represents CharacteristicCombination.
 type CharacteristicCombination string
 
@@ -559,6 +1461,31 @@ const (
 	CharacteristicCombinationUnion CharacteristicCombination = "union"
 )
 
+// known reports whether c is one of the CharacteristicCombination values.
+func (c CharacteristicCombination) known() bool {
+	switch c {
+	case CharacteristicCombinationIntersection,
+		CharacteristicCombinationUnion:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CharacteristicCombination value in definition order.
+func (CharacteristicCombination) firstCode() string {
+	return string(CharacteristicCombinationIntersection)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CharacteristicCombination) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CharacteristicCombination) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ChargeItemStatus represents ChargeItemStatus.
 type ChargeItemStatus string
 
@@ -580,6 +1507,36 @@ const (
 	ChargeItemStatusUnknown ChargeItemStatus = "unknown"
 )
 
+// known reports whether c is one of the ChargeItemStatus values.
+func (c ChargeItemStatus) known() bool {
+	switch c {
+	case ChargeItemStatusPlanned,
+		ChargeItemStatusBillable,
+		ChargeItemStatusNotBillable,
+		ChargeItemStatusAborted,
+		ChargeItemStatusBilled,
+		ChargeItemStatusEnteredInError,
+		ChargeItemStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ChargeItemStatus value in definition order.
+func (ChargeItemStatus) firstCode() string {
+	return string(ChargeItemStatusPlanned)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ChargeItemStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ChargeItemStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // Use represents Use.
 type Use string
 
@@ -593,6 +1550,32 @@ const (
 	UsePredetermination Use = "predetermination"
 )
 
+// known reports whether c is one of the Use values.
+func (c Use) known() bool {
+	switch c {
+	case UseClaim,
+		UsePreauthorization,
+		UsePredetermination:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first Use value in definition order.
+func (Use) firstCode() string {
+	return string(UseClaim)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c Use) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *Use) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ClinicalUseDefinitionType represents ClinicalUseDefinitionType.
 type ClinicalUseDefinitionType string
 
@@ -610,6 +1593,34 @@ const (
 	ClinicalUseDefinitionTypeWarning ClinicalUseDefinitionType = "warning"
 )
 
+// known reports whether c is one of the ClinicalUseDefinitionType values.
+func (c ClinicalUseDefinitionType) known() bool {
+	switch c {
+	case ClinicalUseDefinitionTypeIndication,
+		ClinicalUseDefinitionTypeContraindication,
+		ClinicalUseDefinitionTypeInteraction,
+		ClinicalUseDefinitionTypeUndesirableEffect,
+		ClinicalUseDefinitionTypeWarning:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ClinicalUseDefinitionType value in definition order.
+func (ClinicalUseDefinitionType) firstCode() string {
+	return string(ClinicalUseDefinitionTypeIndication)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ClinicalUseDefinitionType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ClinicalUseDefinitionType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ClinicalImpressionStatus represents Clinical Impression Status.
 type ClinicalImpressionStatus string
 
@@ -620,6 +1631,32 @@ const (
 	ClinicalImpressionStatusEnteredInError ClinicalImpressionStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the ClinicalImpressionStatus values.
+func (c ClinicalImpressionStatus) known() bool {
+	switch c {
+	case ClinicalImpressionStatusInProgress,
+		ClinicalImpressionStatusCompleted,
+		ClinicalImpressionStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ClinicalImpressionStatus value in definition order.
+func (ClinicalImpressionStatus) firstCode() string {
+	return string(ClinicalImpressionStatusInProgress)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ClinicalImpressionStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ClinicalImpressionStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CodeSearchSupport represents CodeSearchSupport.
 type CodeSearchSupport string
 
@@ -631,6 +1668,31 @@ const (
 	CodeSearchSupportAll CodeSearchSupport = "all"
 )
 
+// known reports whether c is one of the CodeSearchSupport values.
+func (c CodeSearchSupport) known() bool {
+	switch c {
+	case CodeSearchSupportExplicit,
+		CodeSearchSupportAll:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CodeSearchSupport value in definition order.
+func (CodeSearchSupport) firstCode() string {
+	return string(CodeSearchSupportExplicit)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CodeSearchSupport) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CodeSearchSupport) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CodeSystemContentMode represents CodeSystemContentMode.
 type CodeSystemContentMode string
 
@@ -648,6 +1710,34 @@ const (
 	CodeSystemContentModeSupplement CodeSystemContentMode = "supplement"
 )
 
+// known reports whether c is one of the CodeSystemContentMode values.
+func (c CodeSystemContentMode) known() bool {
+	switch c {
+	case CodeSystemContentModeNotPresent,
+		CodeSystemContentModeExample,
+		CodeSystemContentModeFragment,
+		CodeSystemContentModeComplete,
+		CodeSystemContentModeSupplement:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CodeSystemContentMode value in definition order.
+func (CodeSystemContentMode) firstCode() string {
+	return string(CodeSystemContentModeNotPresent)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CodeSystemContentMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CodeSystemContentMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CodeSystemHierarchyMeaning represents CodeSystemHierarchyMeaning.
 type CodeSystemHierarchyMeaning string
 
@@ -663,6 +1753,33 @@ const (
 	CodeSystemHierarchyMeaningClassifiedWith CodeSystemHierarchyMeaning = "classified-with"
 )
 
+// known reports whether c is one of the CodeSystemHierarchyMeaning values.
+func (c CodeSystemHierarchyMeaning) known() bool {
+	switch c {
+	case CodeSystemHierarchyMeaningGroupedBy,
+		CodeSystemHierarchyMeaningIsA,
+		CodeSystemHierarchyMeaningPartOf,
+		CodeSystemHierarchyMeaningClassifiedWith:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CodeSystemHierarchyMeaning value in definition order.
+func (CodeSystemHierarchyMeaning) firstCode() string {
+	return string(CodeSystemHierarchyMeaningGroupedBy)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CodeSystemHierarchyMeaning) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CodeSystemHierarchyMeaning) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CompartmentType represents CompartmentType.
 type CompartmentType string
 
@@ -680,6 +1797,34 @@ const (
 	CompartmentTypeDevice CompartmentType = "Device"
 )
 
+// known reports whether c is one of the CompartmentType values.
+func (c CompartmentType) known() bool {
+	switch c {
+	case CompartmentTypePatient,
+		CompartmentTypeEncounter,
+		CompartmentTypeRelatedperson,
+		CompartmentTypePractitioner,
+		CompartmentTypeDevice:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CompartmentType value in definition order.
+func (CompartmentType) firstCode() string {
+	return string(CompartmentTypePatient)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CompartmentType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CompartmentType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CompositionAttestationMode represents CompositionAttestationMode.
 type CompositionAttestationMode string
 
@@ -695,6 +1840,33 @@ const (
 	CompositionAttestationModeOfficial CompositionAttestationMode = "official"
 )
 
+// known reports whether c is one of the CompositionAttestationMode values.
+func (c CompositionAttestationMode) known() bool {
+	switch c {
+	case CompositionAttestationModePersonal,
+		CompositionAttestationModeProfessional,
+		CompositionAttestationModeLegal,
+		CompositionAttestationModeOfficial:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CompositionAttestationMode value in definition order.
+func (CompositionAttestationMode) firstCode() string {
+	return string(CompositionAttestationModePersonal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CompositionAttestationMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CompositionAttestationMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CompositionStatus represents CompositionStatus.
 type CompositionStatus string
 
@@ -710,6 +1882,33 @@ const (
 	CompositionStatusEnteredInError CompositionStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the CompositionStatus values.
+func (c CompositionStatus) known() bool {
+	switch c {
+	case CompositionStatusPreliminary,
+		CompositionStatusFinal,
+		CompositionStatusAmended,
+		CompositionStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CompositionStatus value in definition order.
+func (CompositionStatus) firstCode() string {
+	return string(CompositionStatusPreliminary)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CompositionStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CompositionStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConceptMapEquivalence represents ConceptMapEquivalence.
 type ConceptMapEquivalence string
 
@@ -737,6 +1936,39 @@ const (
 	ConceptMapEquivalenceDisjoint ConceptMapEquivalence = "disjoint"
 )
 
+// known reports whether c is one of the ConceptMapEquivalence values.
+func (c ConceptMapEquivalence) known() bool {
+	switch c {
+	case ConceptMapEquivalenceRelatedto,
+		ConceptMapEquivalenceEquivalent,
+		ConceptMapEquivalenceEqual,
+		ConceptMapEquivalenceWider,
+		ConceptMapEquivalenceSubsumes,
+		ConceptMapEquivalenceNarrower,
+		ConceptMapEquivalenceSpecializes,
+		ConceptMapEquivalenceInexact,
+		ConceptMapEquivalenceUnmatched,
+		ConceptMapEquivalenceDisjoint:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConceptMapEquivalence value in definition order.
+func (ConceptMapEquivalence) firstCode() string {
+	return string(ConceptMapEquivalenceRelatedto)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConceptMapEquivalence) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConceptMapEquivalence) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // PropertyType represents PropertyType.
 type PropertyType string
 
@@ -758,6 +1990,36 @@ const (
 	PropertyTypeDecimal PropertyType = "decimal"
 )
 
+// known reports whether c is one of the PropertyType values.
+func (c PropertyType) known() bool {
+	switch c {
+	case PropertyTypeCode,
+		PropertyTypeCoding,
+		PropertyTypeString,
+		PropertyTypeInteger,
+		PropertyTypeBoolean,
+		PropertyTypeDatetime,
+		PropertyTypeDecimal:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first PropertyType value in definition order.
+func (PropertyType) firstCode() string {
+	return string(PropertyTypeCode)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c PropertyType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *PropertyType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConceptMapGroupUnmappedMode represents ConceptMapGroupUnmappedMode.
 type ConceptMapGroupUnmappedMode string
 
@@ -771,6 +2033,32 @@ const (
 	ConceptMapGroupUnmappedModeOtherMap ConceptMapGroupUnmappedMode = "other-map"
 )
 
+// known reports whether c is one of the ConceptMapGroupUnmappedMode values.
+func (c ConceptMapGroupUnmappedMode) known() bool {
+	switch c {
+	case ConceptMapGroupUnmappedModeProvided,
+		ConceptMapGroupUnmappedModeFixed,
+		ConceptMapGroupUnmappedModeOtherMap:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConceptMapGroupUnmappedMode value in definition order.
+func (ConceptMapGroupUnmappedMode) firstCode() string {
+	return string(ConceptMapGroupUnmappedModeProvided)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConceptMapGroupUnmappedMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConceptMapGroupUnmappedMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConditionalDeleteStatus represents ConditionalDeleteStatus.
 type ConditionalDeleteStatus string
 
@@ -784,6 +2072,32 @@ const (
 	ConditionalDeleteStatusMultiple ConditionalDeleteStatus = "multiple"
 )
 
+// known reports whether c is one of the ConditionalDeleteStatus values.
+func (c ConditionalDeleteStatus) known() bool {
+	switch c {
+	case ConditionalDeleteStatusNotSupported,
+		ConditionalDeleteStatusSingle,
+		ConditionalDeleteStatusMultiple:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConditionalDeleteStatus value in definition order.
+func (ConditionalDeleteStatus) firstCode() string {
+	return string(ConditionalDeleteStatusNotSupported)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConditionalDeleteStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConditionalDeleteStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConditionalReadStatus represents ConditionalReadStatus.
 type ConditionalReadStatus string
 
@@ -799,6 +2113,33 @@ const (
 	ConditionalReadStatusFullSupport ConditionalReadStatus = "full-support"
 )
 
+// known reports whether c is one of the ConditionalReadStatus values.
+func (c ConditionalReadStatus) known() bool {
+	switch c {
+	case ConditionalReadStatusNotSupported,
+		ConditionalReadStatusModifiedSince,
+		ConditionalReadStatusNotMatch,
+		ConditionalReadStatusFullSupport:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConditionalReadStatus value in definition order.
+func (ConditionalReadStatus) firstCode() string {
+	return string(ConditionalReadStatusNotSupported)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConditionalReadStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConditionalReadStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConsentDataMeaning represents ConsentDataMeaning.
 type ConsentDataMeaning string
 
@@ -814,6 +2155,33 @@ const (
 	ConsentDataMeaningAuthoredby ConsentDataMeaning = "authoredby"
 )
 
+// known reports whether c is one of the ConsentDataMeaning values.
+func (c ConsentDataMeaning) known() bool {
+	switch c {
+	case ConsentDataMeaningInstance,
+		ConsentDataMeaningRelated,
+		ConsentDataMeaningDependents,
+		ConsentDataMeaningAuthoredby:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConsentDataMeaning value in definition order.
+func (ConsentDataMeaning) firstCode() string {
+	return string(ConsentDataMeaningInstance)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConsentDataMeaning) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConsentDataMeaning) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConsentProvisionType represents ConsentProvisionType.
 type ConsentProvisionType string
 
@@ -825,6 +2193,31 @@ const (
 	ConsentProvisionTypePermit ConsentProvisionType = "permit"
 )
 
+// known reports whether c is one of the ConsentProvisionType values.
+func (c ConsentProvisionType) known() bool {
+	switch c {
+	case ConsentProvisionTypeDeny,
+		ConsentProvisionTypePermit:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConsentProvisionType value in definition order.
+func (ConsentProvisionType) firstCode() string {
+	return string(ConsentProvisionTypeDeny)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConsentProvisionType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConsentProvisionType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConsentState represents ConsentState.
 type ConsentState string
 
@@ -844,6 +2237,35 @@ const (
 	ConsentStateEnteredInError ConsentState = "entered-in-error"
 )
 
+// known reports whether c is one of the ConsentState values.
+func (c ConsentState) known() bool {
+	switch c {
+	case ConsentStateDraft,
+		ConsentStateProposed,
+		ConsentStateActive,
+		ConsentStateRejected,
+		ConsentStateInactive,
+		ConsentStateEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConsentState value in definition order.
+func (ConsentState) firstCode() string {
+	return string(ConsentStateDraft)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConsentState) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConsentState) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConstraintSeverity represents ConstraintSeverity.
 type ConstraintSeverity string
 
@@ -855,6 +2277,31 @@ const (
 	ConstraintSeverityWarning ConstraintSeverity = "warning"
 )
 
+// known reports whether c is one of the ConstraintSeverity values.
+func (c ConstraintSeverity) known() bool {
+	switch c {
+	case ConstraintSeverityError,
+		ConstraintSeverityWarning:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConstraintSeverity value in definition order.
+func (ConstraintSeverity) firstCode() string {
+	return string(ConstraintSeverityError)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConstraintSeverity) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConstraintSeverity) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ContactPointSystem represents ContactPointSystem.
 type ContactPointSystem string
 
@@ -876,6 +2323,36 @@ const (
 	ContactPointSystemOther ContactPointSystem = "other"
 )
 
+// known reports whether c is one of the ContactPointSystem values.
+func (c ContactPointSystem) known() bool {
+	switch c {
+	case ContactPointSystemPhone,
+		ContactPointSystemFax,
+		ContactPointSystemEmail,
+		ContactPointSystemPager,
+		ContactPointSystemUrl,
+		ContactPointSystemSms,
+		ContactPointSystemOther:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ContactPointSystem value in definition order.
+func (ContactPointSystem) firstCode() string {
+	return string(ContactPointSystemPhone)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ContactPointSystem) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ContactPointSystem) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ContactPointUse represents ContactPointUse.
 type ContactPointUse string
 
@@ -893,6 +2370,34 @@ const (
 	ContactPointUseMobile ContactPointUse = "mobile"
 )
 
+// known reports whether c is one of the ContactPointUse values.
+func (c ContactPointUse) known() bool {
+	switch c {
+	case ContactPointUseHome,
+		ContactPointUseWork,
+		ContactPointUseTemp,
+		ContactPointUseOld,
+		ContactPointUseMobile:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ContactPointUse value in definition order.
+func (ContactPointUse) firstCode() string {
+	return string(ContactPointUseHome)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ContactPointUse) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ContactPointUse) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ContractResourcePublicationStatusCodes represents Contract Resource Publication Status codes.
 type ContractResourcePublicationStatusCodes string
 
@@ -930,6 +2435,44 @@ const (
 	ContractResourcePublicationStatusCodesTerminated ContractResourcePublicationStatusCodes = "terminated"
 )
 
+// known reports whether c is one of the ContractResourcePublicationStatusCodes values.
+func (c ContractResourcePublicationStatusCodes) known() bool {
+	switch c {
+	case ContractResourcePublicationStatusCodesAmended,
+		ContractResourcePublicationStatusCodesAppended,
+		ContractResourcePublicationStatusCodesCancelled,
+		ContractResourcePublicationStatusCodesDisputed,
+		ContractResourcePublicationStatusCodesEnteredInError,
+		ContractResourcePublicationStatusCodesExecutable,
+		ContractResourcePublicationStatusCodesExecuted,
+		ContractResourcePublicationStatusCodesNegotiable,
+		ContractResourcePublicationStatusCodesOffered,
+		ContractResourcePublicationStatusCodesPolicy,
+		ContractResourcePublicationStatusCodesRejected,
+		ContractResourcePublicationStatusCodesRenewed,
+		ContractResourcePublicationStatusCodesRevoked,
+		ContractResourcePublicationStatusCodesResolved,
+		ContractResourcePublicationStatusCodesTerminated:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ContractResourcePublicationStatusCodes value in definition order.
+func (ContractResourcePublicationStatusCodes) firstCode() string {
+	return string(ContractResourcePublicationStatusCodesAmended)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ContractResourcePublicationStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ContractResourcePublicationStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ContractResourceStatusCodes represents Contract Resource Status Codes.
 type ContractResourceStatusCodes string
 
@@ -967,6 +2510,44 @@ const (
 	ContractResourceStatusCodesTerminated ContractResourceStatusCodes = "terminated"
 )
 
+// known reports whether c is one of the ContractResourceStatusCodes values.
+func (c ContractResourceStatusCodes) known() bool {
+	switch c {
+	case ContractResourceStatusCodesAmended,
+		ContractResourceStatusCodesAppended,
+		ContractResourceStatusCodesCancelled,
+		ContractResourceStatusCodesDisputed,
+		ContractResourceStatusCodesEnteredInError,
+		ContractResourceStatusCodesExecutable,
+		ContractResourceStatusCodesExecuted,
+		ContractResourceStatusCodesNegotiable,
+		ContractResourceStatusCodesOffered,
+		ContractResourceStatusCodesPolicy,
+		ContractResourceStatusCodesRejected,
+		ContractResourceStatusCodesRenewed,
+		ContractResourceStatusCodesRevoked,
+		ContractResourceStatusCodesResolved,
+		ContractResourceStatusCodesTerminated:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ContractResourceStatusCodes value in definition order.
+func (ContractResourceStatusCodes) firstCode() string {
+	return string(ContractResourceStatusCodesAmended)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ContractResourceStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ContractResourceStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ContributorType represents ContributorType.
 type ContributorType string
 
@@ -982,6 +2563,33 @@ const (
 	ContributorTypeEndorser ContributorType = "endorser"
 )
 
+// known reports whether c is one of the ContributorType values.
+func (c ContributorType) known() bool {
+	switch c {
+	case ContributorTypeAuthor,
+		ContributorTypeEditor,
+		ContributorTypeReviewer,
+		ContributorTypeEndorser:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ContributorType value in definition order.
+func (ContributorType) firstCode() string {
+	return string(ContributorTypeAuthor)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ContributorType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ContributorType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DaysOfWeek represents DaysOfWeek.
 type DaysOfWeek string
 
@@ -1003,6 +2611,36 @@ const (
 	DaysOfWeekSun DaysOfWeek = "sun"
 )
 
+// known reports whether c is one of the DaysOfWeek values.
+func (c DaysOfWeek) known() bool {
+	switch c {
+	case DaysOfWeekMon,
+		DaysOfWeekTue,
+		DaysOfWeekWed,
+		DaysOfWeekThu,
+		DaysOfWeekFri,
+		DaysOfWeekSat,
+		DaysOfWeekSun:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DaysOfWeek value in definition order.
+func (DaysOfWeek) firstCode() string {
+	return string(DaysOfWeekMon)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DaysOfWeek) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DaysOfWeek) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DetectedIssueSeverity represents DetectedIssueSeverity.
 type DetectedIssueSeverity string
 
@@ -1016,6 +2654,32 @@ const (
 	DetectedIssueSeverityLow DetectedIssueSeverity = "low"
 )
 
+// known reports whether c is one of the DetectedIssueSeverity values.
+func (c DetectedIssueSeverity) known() bool {
+	switch c {
+	case DetectedIssueSeverityHigh,
+		DetectedIssueSeverityModerate,
+		DetectedIssueSeverityLow:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DetectedIssueSeverity value in definition order.
+func (DetectedIssueSeverity) firstCode() string {
+	return string(DetectedIssueSeverityHigh)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DetectedIssueSeverity) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DetectedIssueSeverity) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceNameType represents DeviceNameType.
 type DeviceNameType string
 
@@ -1035,6 +2699,35 @@ const (
 	DeviceNameTypeOther DeviceNameType = "other"
 )
 
+// known reports whether c is one of the DeviceNameType values.
+func (c DeviceNameType) known() bool {
+	switch c {
+	case DeviceNameTypeUdiLabelName,
+		DeviceNameTypeUserFriendlyName,
+		DeviceNameTypePatientReportedName,
+		DeviceNameTypeManufacturerName,
+		DeviceNameTypeModelName,
+		DeviceNameTypeOther:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceNameType value in definition order.
+func (DeviceNameType) firstCode() string {
+	return string(DeviceNameTypeUdiLabelName)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceNameType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceNameType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceUseStatementStatus represents DeviceUseStatementStatus.
 type DeviceUseStatementStatus string
 
@@ -1054,6 +2747,35 @@ const (
 	DeviceUseStatementStatusOnHold DeviceUseStatementStatus = "on-hold"
 )
 
+// known reports whether c is one of the DeviceUseStatementStatus values.
+func (c DeviceUseStatementStatus) known() bool {
+	switch c {
+	case DeviceUseStatementStatusActive,
+		DeviceUseStatementStatusCompleted,
+		DeviceUseStatementStatusEnteredInError,
+		DeviceUseStatementStatusIntended,
+		DeviceUseStatementStatusStopped,
+		DeviceUseStatementStatusOnHold:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceUseStatementStatus value in definition order.
+func (DeviceUseStatementStatus) firstCode() string {
+	return string(DeviceUseStatementStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceUseStatementStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceUseStatementStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // FHIRDeviceStatus represents FHIRDeviceStatus.
 type FHIRDeviceStatus string
 
@@ -1069,6 +2791,33 @@ const (
 	FHIRDeviceStatusUnknown FHIRDeviceStatus = "unknown"
 )
 
+// known reports whether c is one of the FHIRDeviceStatus values.
+func (c FHIRDeviceStatus) known() bool {
+	switch c {
+	case FHIRDeviceStatusActive,
+		FHIRDeviceStatusInactive,
+		FHIRDeviceStatusEnteredInError,
+		FHIRDeviceStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FHIRDeviceStatus value in definition order.
+func (FHIRDeviceStatus) firstCode() string {
+	return string(FHIRDeviceStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FHIRDeviceStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FHIRDeviceStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DiagnosticReportStatus represents DiagnosticReportStatus.
 type DiagnosticReportStatus string
 
@@ -1096,6 +2845,39 @@ const (
 	DiagnosticReportStatusUnknown DiagnosticReportStatus = "unknown"
 )
 
+// known reports whether c is one of the DiagnosticReportStatus values.
+func (c DiagnosticReportStatus) known() bool {
+	switch c {
+	case DiagnosticReportStatusRegistered,
+		DiagnosticReportStatusPartial,
+		DiagnosticReportStatusPreliminary,
+		DiagnosticReportStatusFinal,
+		DiagnosticReportStatusAmended,
+		DiagnosticReportStatusCorrected,
+		DiagnosticReportStatusAppended,
+		DiagnosticReportStatusCancelled,
+		DiagnosticReportStatusEnteredInError,
+		DiagnosticReportStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DiagnosticReportStatus value in definition order.
+func (DiagnosticReportStatus) firstCode() string {
+	return string(DiagnosticReportStatusRegistered)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DiagnosticReportStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DiagnosticReportStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DiscriminatorType represents DiscriminatorType.
 type DiscriminatorType string
 
@@ -1113,6 +2895,34 @@ const (
 	DiscriminatorTypeProfile DiscriminatorType = "profile"
 )
 
+// known reports whether c is one of the DiscriminatorType values.
+func (c DiscriminatorType) known() bool {
+	switch c {
+	case DiscriminatorTypeValue,
+		DiscriminatorTypeExists,
+		DiscriminatorTypePattern,
+		DiscriminatorTypeType,
+		DiscriminatorTypeProfile:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DiscriminatorType value in definition order.
+func (DiscriminatorType) firstCode() string {
+	return string(DiscriminatorTypeValue)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DiscriminatorType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DiscriminatorType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DocumentMode represents DocumentMode.
 type DocumentMode string
 
@@ -1124,6 +2934,31 @@ const (
 	DocumentModeConsumer DocumentMode = "consumer"
 )
 
+// known reports whether c is one of the DocumentMode values.
+func (c DocumentMode) known() bool {
+	switch c {
+	case DocumentModeProducer,
+		DocumentModeConsumer:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DocumentMode value in definition order.
+func (DocumentMode) firstCode() string {
+	return string(DocumentModeProducer)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DocumentMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DocumentMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DocumentReferenceStatus represents DocumentReferenceStatus.
 type DocumentReferenceStatus string
 
@@ -1137,6 +2972,32 @@ const (
 	DocumentReferenceStatusEnteredInError DocumentReferenceStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the DocumentReferenceStatus values.
+func (c DocumentReferenceStatus) known() bool {
+	switch c {
+	case DocumentReferenceStatusCurrent,
+		DocumentReferenceStatusSuperseded,
+		DocumentReferenceStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DocumentReferenceStatus value in definition order.
+func (DocumentReferenceStatus) firstCode() string {
+	return string(DocumentReferenceStatusCurrent)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DocumentReferenceStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DocumentReferenceStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DocumentRelationshipType represents DocumentRelationshipType.
 type DocumentRelationshipType string
 
@@ -1152,6 +3013,33 @@ const (
 	DocumentRelationshipTypeAppends DocumentRelationshipType = "appends"
 )
 
+// known reports whether c is one of the DocumentRelationshipType values.
+func (c DocumentRelationshipType) known() bool {
+	switch c {
+	case DocumentRelationshipTypeReplaces,
+		DocumentRelationshipTypeTransforms,
+		DocumentRelationshipTypeSigns,
+		DocumentRelationshipTypeAppends:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DocumentRelationshipType value in definition order.
+func (DocumentRelationshipType) firstCode() string {
+	return string(DocumentRelationshipTypeReplaces)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DocumentRelationshipType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DocumentRelationshipType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EligibilityRequestPurpose represents EligibilityRequestPurpose.
 type EligibilityRequestPurpose string
 
@@ -1167,6 +3055,33 @@ const (
 	EligibilityRequestPurposeValidation EligibilityRequestPurpose = "validation"
 )
 
+// known reports whether c is one of the EligibilityRequestPurpose values.
+func (c EligibilityRequestPurpose) known() bool {
+	switch c {
+	case EligibilityRequestPurposeAuthRequirements,
+		EligibilityRequestPurposeBenefits,
+		EligibilityRequestPurposeDiscovery,
+		EligibilityRequestPurposeValidation:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EligibilityRequestPurpose value in definition order.
+func (EligibilityRequestPurpose) firstCode() string {
+	return string(EligibilityRequestPurposeAuthRequirements)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EligibilityRequestPurpose) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EligibilityRequestPurpose) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EligibilityResponsePurpose represents EligibilityResponsePurpose.
 type EligibilityResponsePurpose string
 
@@ -1182,6 +3097,33 @@ const (
 	EligibilityResponsePurposeValidation EligibilityResponsePurpose = "validation"
 )
 
+// known reports whether c is one of the EligibilityResponsePurpose values.
+func (c EligibilityResponsePurpose) known() bool {
+	switch c {
+	case EligibilityResponsePurposeAuthRequirements,
+		EligibilityResponsePurposeBenefits,
+		EligibilityResponsePurposeDiscovery,
+		EligibilityResponsePurposeValidation:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EligibilityResponsePurpose value in definition order.
+func (EligibilityResponsePurpose) firstCode() string {
+	return string(EligibilityResponsePurposeAuthRequirements)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EligibilityResponsePurpose) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EligibilityResponsePurpose) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EncounterLocationStatus represents EncounterLocationStatus.
 type EncounterLocationStatus string
 
@@ -1197,6 +3139,33 @@ const (
 	EncounterLocationStatusCompleted EncounterLocationStatus = "completed"
 )
 
+// known reports whether c is one of the EncounterLocationStatus values.
+func (c EncounterLocationStatus) known() bool {
+	switch c {
+	case EncounterLocationStatusPlanned,
+		EncounterLocationStatusActive,
+		EncounterLocationStatusReserved,
+		EncounterLocationStatusCompleted:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EncounterLocationStatus value in definition order.
+func (EncounterLocationStatus) firstCode() string {
+	return string(EncounterLocationStatusPlanned)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EncounterLocationStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EncounterLocationStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EncounterStatus represents EncounterStatus.
 type EncounterStatus string
 
@@ -1222,6 +3191,38 @@ const (
 	EncounterStatusUnknown EncounterStatus = "unknown"
 )
 
+// known reports whether c is one of the EncounterStatus values.
+func (c EncounterStatus) known() bool {
+	switch c {
+	case EncounterStatusPlanned,
+		EncounterStatusArrived,
+		EncounterStatusTriaged,
+		EncounterStatusInProgress,
+		EncounterStatusOnleave,
+		EncounterStatusFinished,
+		EncounterStatusCancelled,
+		EncounterStatusEnteredInError,
+		EncounterStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EncounterStatus value in definition order.
+func (EncounterStatus) firstCode() string {
+	return string(EncounterStatusPlanned)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EncounterStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EncounterStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EndpointStatus represents EndpointStatus.
 type EndpointStatus string
 
@@ -1241,6 +3242,35 @@ const (
 	EndpointStatusTest EndpointStatus = "test"
 )
 
+// known reports whether c is one of the EndpointStatus values.
+func (c EndpointStatus) known() bool {
+	switch c {
+	case EndpointStatusActive,
+		EndpointStatusSuspended,
+		EndpointStatusError,
+		EndpointStatusOff,
+		EndpointStatusEnteredInError,
+		EndpointStatusTest:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EndpointStatus value in definition order.
+func (EndpointStatus) firstCode() string {
+	return string(EndpointStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EndpointStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EndpointStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EpisodeOfCareStatus represents EpisodeOfCareStatus.
 type EpisodeOfCareStatus string
 
@@ -1262,6 +3292,36 @@ const (
 	EpisodeOfCareStatusEnteredInError EpisodeOfCareStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the EpisodeOfCareStatus values.
+func (c EpisodeOfCareStatus) known() bool {
+	switch c {
+	case EpisodeOfCareStatusPlanned,
+		EpisodeOfCareStatusWaitlist,
+		EpisodeOfCareStatusActive,
+		EpisodeOfCareStatusOnhold,
+		EpisodeOfCareStatusFinished,
+		EpisodeOfCareStatusCancelled,
+		EpisodeOfCareStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EpisodeOfCareStatus value in definition order.
+func (EpisodeOfCareStatus) firstCode() string {
+	return string(EpisodeOfCareStatusPlanned)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EpisodeOfCareStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EpisodeOfCareStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EventCapabilityMode represents EventCapabilityMode.
 type EventCapabilityMode string
 
@@ -1273,6 +3333,31 @@ const (
 	EventCapabilityModeReceiver EventCapabilityMode = "receiver"
 )
 
+// known reports whether c is one of the EventCapabilityMode values.
+func (c EventCapabilityMode) known() bool {
+	switch c {
+	case EventCapabilityModeSender,
+		EventCapabilityModeReceiver:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EventCapabilityMode value in definition order.
+func (EventCapabilityMode) firstCode() string {
+	return string(EventCapabilityModeSender)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EventCapabilityMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EventCapabilityMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EventStatus represents EventStatus.
 type EventStatus string
 
@@ -1296,6 +3381,37 @@ const (
 	EventStatusUnknown EventStatus = "unknown"
 )
 
+// known reports whether c is one of the EventStatus values.
+func (c EventStatus) known() bool {
+	switch c {
+	case EventStatusPreparation,
+		EventStatusInProgress,
+		EventStatusNotDone,
+		EventStatusOnHold,
+		EventStatusStopped,
+		EventStatusCompleted,
+		EventStatusEnteredInError,
+		EventStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EventStatus value in definition order.
+func (EventStatus) firstCode() string {
+	return string(EventStatusPreparation)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EventStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EventStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EventTiming represents EventTiming.
 type EventTiming string
 
@@ -1341,6 +3457,55 @@ const (
 	EventTimingPcv  EventTiming = "PCV"
 )
 
+// known reports whether c is one of the EventTiming values.
+func (c EventTiming) known() bool {
+	switch c {
+	case EventTimingMorn,
+		EventTimingMornEarly,
+		EventTimingMornLate,
+		EventTimingNoon,
+		EventTimingAft,
+		EventTimingAftEarly,
+		EventTimingAftLate,
+		EventTimingEve,
+		EventTimingEveEarly,
+		EventTimingEveLate,
+		EventTimingNight,
+		EventTimingPhs,
+		EventTimingHs,
+		EventTimingWake,
+		EventTimingC,
+		EventTimingCm,
+		EventTimingCd,
+		EventTimingCv,
+		EventTimingAc,
+		EventTimingAcm,
+		EventTimingAcd,
+		EventTimingAcv,
+		EventTimingPc,
+		EventTimingPcm,
+		EventTimingPcd,
+		EventTimingPcv:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EventTiming value in definition order.
+func (EventTiming) firstCode() string {
+	return string(EventTimingMorn)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EventTiming) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EventTiming) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ExampleScenarioActorType represents ExampleScenarioActorType.
 type ExampleScenarioActorType string
 
@@ -1352,6 +3517,31 @@ const (
 	ExampleScenarioActorTypeEntity ExampleScenarioActorType = "entity"
 )
 
+// known reports whether c is one of the ExampleScenarioActorType values.
+func (c ExampleScenarioActorType) known() bool {
+	switch c {
+	case ExampleScenarioActorTypePerson,
+		ExampleScenarioActorTypeEntity:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ExampleScenarioActorType value in definition order.
+func (ExampleScenarioActorType) firstCode() string {
+	return string(ExampleScenarioActorTypePerson)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ExampleScenarioActorType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ExampleScenarioActorType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ExplanationOfBenefitStatus represents ExplanationOfBenefitStatus.
 type ExplanationOfBenefitStatus string
 
@@ -1367,6 +3557,33 @@ const (
 	ExplanationOfBenefitStatusEnteredInError ExplanationOfBenefitStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the ExplanationOfBenefitStatus values.
+func (c ExplanationOfBenefitStatus) known() bool {
+	switch c {
+	case ExplanationOfBenefitStatusActive,
+		ExplanationOfBenefitStatusCancelled,
+		ExplanationOfBenefitStatusDraft,
+		ExplanationOfBenefitStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ExplanationOfBenefitStatus value in definition order.
+func (ExplanationOfBenefitStatus) firstCode() string {
+	return string(ExplanationOfBenefitStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ExplanationOfBenefitStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ExplanationOfBenefitStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ExtensionContextType represents ExtensionContextType.
 type ExtensionContextType string
 
@@ -1380,6 +3597,32 @@ const (
 	ExtensionContextTypeExtension ExtensionContextType = "extension"
 )
 
+// known reports whether c is one of the ExtensionContextType values.
+func (c ExtensionContextType) known() bool {
+	switch c {
+	case ExtensionContextTypeFhirpath,
+		ExtensionContextTypeElement,
+		ExtensionContextTypeExtension:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ExtensionContextType value in definition order.
+func (ExtensionContextType) firstCode() string {
+	return string(ExtensionContextTypeFhirpath)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ExtensionContextType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ExtensionContextType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // FilterOperator represents FilterOperator.
 type FilterOperator string
 
@@ -1405,6 +3648,38 @@ const (
 	FilterOperatorExists FilterOperator = "exists"
 )
 
+// known reports whether c is one of the FilterOperator values.
+func (c FilterOperator) known() bool {
+	switch c {
+	case FilterOperatorEqual,
+		FilterOperatorIsA,
+		FilterOperatorDescendentOf,
+		FilterOperatorIsNotA,
+		FilterOperatorRegex,
+		FilterOperatorIn,
+		FilterOperatorNotIn,
+		FilterOperatorGeneralizes,
+		FilterOperatorExists:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FilterOperator value in definition order.
+func (FilterOperator) firstCode() string {
+	return string(FilterOperatorEqual)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FilterOperator) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FilterOperator) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // FlagStatus represents FlagStatus.
 type FlagStatus string
 
@@ -1418,6 +3693,32 @@ const (
 	FlagStatusEnteredInError FlagStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the FlagStatus values.
+func (c FlagStatus) known() bool {
+	switch c {
+	case FlagStatusActive,
+		FlagStatusInactive,
+		FlagStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FlagStatus value in definition order.
+func (FlagStatus) firstCode() string {
+	return string(FlagStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FlagStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FlagStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // FinancialResourceStatusCodes represents Financial Resource Status Codes.
 type FinancialResourceStatusCodes string
 
@@ -1433,6 +3734,33 @@ const (
 	FinancialResourceStatusCodesEnteredInError FinancialResourceStatusCodes = "entered-in-error"
 )
 
+// known reports whether c is one of the FinancialResourceStatusCodes values.
+func (c FinancialResourceStatusCodes) known() bool {
+	switch c {
+	case FinancialResourceStatusCodesActive,
+		FinancialResourceStatusCodesCancelled,
+		FinancialResourceStatusCodesDraft,
+		FinancialResourceStatusCodesEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FinancialResourceStatusCodes value in definition order.
+func (FinancialResourceStatusCodes) firstCode() string {
+	return string(FinancialResourceStatusCodesActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FinancialResourceStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FinancialResourceStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GoalLifecycleStatus represents GoalLifecycleStatus.
 type GoalLifecycleStatus string
 
@@ -1458,6 +3786,38 @@ const (
 	GoalLifecycleStatusRejected GoalLifecycleStatus = "rejected"
 )
 
+// known reports whether c is one of the GoalLifecycleStatus values.
+func (c GoalLifecycleStatus) known() bool {
+	switch c {
+	case GoalLifecycleStatusProposed,
+		GoalLifecycleStatusPlanned,
+		GoalLifecycleStatusAccepted,
+		GoalLifecycleStatusActive,
+		GoalLifecycleStatusOnHold,
+		GoalLifecycleStatusCompleted,
+		GoalLifecycleStatusCancelled,
+		GoalLifecycleStatusEnteredInError,
+		GoalLifecycleStatusRejected:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GoalLifecycleStatus value in definition order.
+func (GoalLifecycleStatus) firstCode() string {
+	return string(GoalLifecycleStatusProposed)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GoalLifecycleStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GoalLifecycleStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GraphCompartmentRule represents GraphCompartmentRule.
 type GraphCompartmentRule string
 
@@ -1473,6 +3833,33 @@ const (
 	GraphCompartmentRuleCustom GraphCompartmentRule = "custom"
 )
 
+// known reports whether c is one of the GraphCompartmentRule values.
+func (c GraphCompartmentRule) known() bool {
+	switch c {
+	case GraphCompartmentRuleIdentical,
+		GraphCompartmentRuleMatching,
+		GraphCompartmentRuleDifferent,
+		GraphCompartmentRuleCustom:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GraphCompartmentRule value in definition order.
+func (GraphCompartmentRule) firstCode() string {
+	return string(GraphCompartmentRuleIdentical)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GraphCompartmentRule) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GraphCompartmentRule) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GraphCompartmentUse represents GraphCompartmentUse.
 type GraphCompartmentUse string
 
@@ -1484,6 +3871,31 @@ const (
 	GraphCompartmentUseRequirement GraphCompartmentUse = "requirement"
 )
 
+// known reports whether c is one of the GraphCompartmentUse values.
+func (c GraphCompartmentUse) known() bool {
+	switch c {
+	case GraphCompartmentUseCondition,
+		GraphCompartmentUseRequirement:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GraphCompartmentUse value in definition order.
+func (GraphCompartmentUse) firstCode() string {
+	return string(GraphCompartmentUseCondition)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GraphCompartmentUse) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GraphCompartmentUse) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GroupMeasure represents GroupMeasure.
 type GroupMeasure string
 
@@ -1503,6 +3915,35 @@ const (
 	GroupMeasureMedianOfMedian GroupMeasure = "median-of-median"
 )
 
+// known reports whether c is one of the GroupMeasure values.
+func (c GroupMeasure) known() bool {
+	switch c {
+	case GroupMeasureMean,
+		GroupMeasureMedian,
+		GroupMeasureMeanOfMean,
+		GroupMeasureMeanOfMedian,
+		GroupMeasureMedianOfMean,
+		GroupMeasureMedianOfMedian:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GroupMeasure value in definition order.
+func (GroupMeasure) firstCode() string {
+	return string(GroupMeasureMean)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GroupMeasure) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GroupMeasure) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GroupType represents GroupType.
 type GroupType string
 
@@ -1522,6 +3963,35 @@ const (
 	GroupTypeSubstance GroupType = "substance"
 )
 
+// known reports whether c is one of the GroupType values.
+func (c GroupType) known() bool {
+	switch c {
+	case GroupTypePerson,
+		GroupTypeAnimal,
+		GroupTypePractitioner,
+		GroupTypeDevice,
+		GroupTypeMedication,
+		GroupTypeSubstance:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GroupType value in definition order.
+func (GroupType) firstCode() string {
+	return string(GroupTypePerson)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GroupType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GroupType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GuidanceResponseStatus represents GuidanceResponseStatus.
 type GuidanceResponseStatus string
 
@@ -1541,6 +4011,35 @@ const (
 	GuidanceResponseStatusEnteredInError GuidanceResponseStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the GuidanceResponseStatus values.
+func (c GuidanceResponseStatus) known() bool {
+	switch c {
+	case GuidanceResponseStatusSuccess,
+		GuidanceResponseStatusDataRequested,
+		GuidanceResponseStatusDataRequired,
+		GuidanceResponseStatusInProgress,
+		GuidanceResponseStatusFailure,
+		GuidanceResponseStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GuidanceResponseStatus value in definition order.
+func (GuidanceResponseStatus) firstCode() string {
+	return string(GuidanceResponseStatusSuccess)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GuidanceResponseStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GuidanceResponseStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GuidePageGeneration represents GuidePageGeneration.
 type GuidePageGeneration string
 
@@ -1556,6 +4055,33 @@ const (
 	GuidePageGenerationGenerated GuidePageGeneration = "generated"
 )
 
+// known reports whether c is one of the GuidePageGeneration values.
+func (c GuidePageGeneration) known() bool {
+	switch c {
+	case GuidePageGenerationHtml,
+		GuidePageGenerationMarkdown,
+		GuidePageGenerationXml,
+		GuidePageGenerationGenerated:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GuidePageGeneration value in definition order.
+func (GuidePageGeneration) firstCode() string {
+	return string(GuidePageGenerationHtml)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GuidePageGeneration) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GuidePageGeneration) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GuideParameterCode represents GuideParameterCode.
 type GuideParameterCode string
 
@@ -1583,6 +4109,39 @@ const (
 	GuideParameterCodeHtmlTemplate GuideParameterCode = "html-template"
 )
 
+// known reports whether c is one of the GuideParameterCode values.
+func (c GuideParameterCode) known() bool {
+	switch c {
+	case GuideParameterCodeApply,
+		GuideParameterCodePathResource,
+		GuideParameterCodePathPages,
+		GuideParameterCodePathTxCache,
+		GuideParameterCodeExpansionParameter,
+		GuideParameterCodeRuleBrokenLinks,
+		GuideParameterCodeGenerateXml,
+		GuideParameterCodeGenerateJson,
+		GuideParameterCodeGenerateTurtle,
+		GuideParameterCodeHtmlTemplate:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GuideParameterCode value in definition order.
+func (GuideParameterCode) firstCode() string {
+	return string(GuideParameterCodeApply)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GuideParameterCode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GuideParameterCode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // FamilyHistoryStatus represents FamilyHistoryStatus.
 type FamilyHistoryStatus string
 
@@ -1598,6 +4157,33 @@ const (
 	FamilyHistoryStatusHealthUnknown FamilyHistoryStatus = "health-unknown"
 )
 
+// known reports whether c is one of the FamilyHistoryStatus values.
+func (c FamilyHistoryStatus) known() bool {
+	switch c {
+	case FamilyHistoryStatusPartial,
+		FamilyHistoryStatusCompleted,
+		FamilyHistoryStatusEnteredInError,
+		FamilyHistoryStatusHealthUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FamilyHistoryStatus value in definition order.
+func (FamilyHistoryStatus) firstCode() string {
+	return string(FamilyHistoryStatusPartial)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FamilyHistoryStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FamilyHistoryStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TestScriptRequestMethodCode represents TestScriptRequestMethodCode.
 type TestScriptRequestMethodCode string
 
@@ -1619,6 +4205,36 @@ const (
 	TestScriptRequestMethodCodeHead TestScriptRequestMethodCode = "head"
 )
 
+// known reports whether c is one of the TestScriptRequestMethodCode values.
+func (c TestScriptRequestMethodCode) known() bool {
+	switch c {
+	case TestScriptRequestMethodCodeDelete,
+		TestScriptRequestMethodCodeGet,
+		TestScriptRequestMethodCodeOptions,
+		TestScriptRequestMethodCodePatch,
+		TestScriptRequestMethodCodePost,
+		TestScriptRequestMethodCodePut,
+		TestScriptRequestMethodCodeHead:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TestScriptRequestMethodCode value in definition order.
+func (TestScriptRequestMethodCode) firstCode() string {
+	return string(TestScriptRequestMethodCodeDelete)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TestScriptRequestMethodCode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TestScriptRequestMethodCode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // HTTPVerb represents HTTPVerb.
 type HTTPVerb string
 
@@ -1638,6 +4254,35 @@ const (
 	HTTPVerbPatch HTTPVerb = "PATCH"
 )
 
+// known reports whether c is one of the HTTPVerb values.
+func (c HTTPVerb) known() bool {
+	switch c {
+	case HTTPVerbGet,
+		HTTPVerbHead,
+		HTTPVerbPost,
+		HTTPVerbPut,
+		HTTPVerbDelete,
+		HTTPVerbPatch:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first HTTPVerb value in definition order.
+func (HTTPVerb) firstCode() string {
+	return string(HTTPVerbGet)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c HTTPVerb) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *HTTPVerb) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // IdentifierUse represents IdentifierUse.
 type IdentifierUse string
 
@@ -1655,6 +4300,34 @@ const (
 	IdentifierUseOld IdentifierUse = "old"
 )
 
+// known reports whether c is one of the IdentifierUse values.
+func (c IdentifierUse) known() bool {
+	switch c {
+	case IdentifierUseUsual,
+		IdentifierUseOfficial,
+		IdentifierUseTemp,
+		IdentifierUseSecondary,
+		IdentifierUseOld:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first IdentifierUse value in definition order.
+func (IdentifierUse) firstCode() string {
+	return string(IdentifierUseUsual)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c IdentifierUse) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *IdentifierUse) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // IdentityAssuranceLevel represents IdentityAssuranceLevel.
 type IdentityAssuranceLevel string
 
@@ -1670,6 +4343,33 @@ const (
 	IdentityAssuranceLevelLevel4 IdentityAssuranceLevel = "level4"
 )
 
+// known reports whether c is one of the IdentityAssuranceLevel values.
+func (c IdentityAssuranceLevel) known() bool {
+	switch c {
+	case IdentityAssuranceLevelLevel1,
+		IdentityAssuranceLevelLevel2,
+		IdentityAssuranceLevelLevel3,
+		IdentityAssuranceLevelLevel4:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first IdentityAssuranceLevel value in definition order.
+func (IdentityAssuranceLevel) firstCode() string {
+	return string(IdentityAssuranceLevelLevel1)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c IdentityAssuranceLevel) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *IdentityAssuranceLevel) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ImagingStudyStatus represents ImagingStudyStatus.
 type ImagingStudyStatus string
 
@@ -1687,6 +4387,34 @@ const (
 	ImagingStudyStatusUnknown ImagingStudyStatus = "unknown"
 )
 
+// known reports whether c is one of the ImagingStudyStatus values.
+func (c ImagingStudyStatus) known() bool {
+	switch c {
+	case ImagingStudyStatusRegistered,
+		ImagingStudyStatusAvailable,
+		ImagingStudyStatusCancelled,
+		ImagingStudyStatusEnteredInError,
+		ImagingStudyStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ImagingStudyStatus value in definition order.
+func (ImagingStudyStatus) firstCode() string {
+	return string(ImagingStudyStatusRegistered)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ImagingStudyStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ImagingStudyStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ImmunizationEvaluationStatusCodes represents Immunization Evaluation Status Codes.
 type ImmunizationEvaluationStatusCodes string
 
@@ -1696,6 +4424,31 @@ const (
 	ImmunizationEvaluationStatusCodesEnteredInError ImmunizationEvaluationStatusCodes = "entered-in-error"
 )
 
+// known reports whether c is one of the ImmunizationEvaluationStatusCodes values.
+func (c ImmunizationEvaluationStatusCodes) known() bool {
+	switch c {
+	case ImmunizationEvaluationStatusCodesCompleted,
+		ImmunizationEvaluationStatusCodesEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ImmunizationEvaluationStatusCodes value in definition order.
+func (ImmunizationEvaluationStatusCodes) firstCode() string {
+	return string(ImmunizationEvaluationStatusCodesCompleted)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ImmunizationEvaluationStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ImmunizationEvaluationStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ImmunizationStatusCodes represents Immunization Status Codes.
 type ImmunizationStatusCodes string
 
@@ -1706,6 +4459,32 @@ const (
 	ImmunizationStatusCodesNotDone        ImmunizationStatusCodes = "not-done"
 )
 
+// known reports whether c is one of the ImmunizationStatusCodes values.
+func (c ImmunizationStatusCodes) known() bool {
+	switch c {
+	case ImmunizationStatusCodesCompleted,
+		ImmunizationStatusCodesEnteredInError,
+		ImmunizationStatusCodesNotDone:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ImmunizationStatusCodes value in definition order.
+func (ImmunizationStatusCodes) firstCode() string {
+	return string(ImmunizationStatusCodesCompleted)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ImmunizationStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ImmunizationStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // IngredientManufacturerRole represents IngredientManufacturerRole.
 type IngredientManufacturerRole string
 
@@ -1719,6 +4498,32 @@ const (
 	IngredientManufacturerRoleActual IngredientManufacturerRole = "actual"
 )
 
+// known reports whether c is one of the IngredientManufacturerRole values.
+func (c IngredientManufacturerRole) known() bool {
+	switch c {
+	case IngredientManufacturerRoleAllowed,
+		IngredientManufacturerRolePossible,
+		IngredientManufacturerRoleActual:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first IngredientManufacturerRole value in definition order.
+func (IngredientManufacturerRole) firstCode() string {
+	return string(IngredientManufacturerRoleAllowed)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c IngredientManufacturerRole) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *IngredientManufacturerRole) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // InteractionTrigger represents Interaction Trigger.
 type InteractionTrigger string
 
@@ -1729,6 +4534,32 @@ const (
 	InteractionTriggerDelete InteractionTrigger = "delete"
 )
 
+// known reports whether c is one of the InteractionTrigger values.
+func (c InteractionTrigger) known() bool {
+	switch c {
+	case InteractionTriggerCreate,
+		InteractionTriggerUpdate,
+		InteractionTriggerDelete:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first InteractionTrigger value in definition order.
+func (InteractionTrigger) firstCode() string {
+	return string(InteractionTriggerCreate)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c InteractionTrigger) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *InteractionTrigger) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // InvoicePriceComponentType represents InvoicePriceComponentType.
 type InvoicePriceComponentType string
 
@@ -1748,6 +4579,35 @@ const (
 	InvoicePriceComponentTypeInformational InvoicePriceComponentType = "informational"
 )
 
+// known reports whether c is one of the InvoicePriceComponentType values.
+func (c InvoicePriceComponentType) known() bool {
+	switch c {
+	case InvoicePriceComponentTypeBase,
+		InvoicePriceComponentTypeSurcharge,
+		InvoicePriceComponentTypeDeduction,
+		InvoicePriceComponentTypeDiscount,
+		InvoicePriceComponentTypeTax,
+		InvoicePriceComponentTypeInformational:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first InvoicePriceComponentType value in definition order.
+func (InvoicePriceComponentType) firstCode() string {
+	return string(InvoicePriceComponentTypeBase)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c InvoicePriceComponentType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *InvoicePriceComponentType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // InvoiceStatus represents InvoiceStatus.
 type InvoiceStatus string
 
@@ -1765,6 +4625,34 @@ const (
 	InvoiceStatusEnteredInError InvoiceStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the InvoiceStatus values.
+func (c InvoiceStatus) known() bool {
+	switch c {
+	case InvoiceStatusDraft,
+		InvoiceStatusIssued,
+		InvoiceStatusBalanced,
+		InvoiceStatusCancelled,
+		InvoiceStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first InvoiceStatus value in definition order.
+func (InvoiceStatus) firstCode() string {
+	return string(InvoiceStatusDraft)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c InvoiceStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *InvoiceStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // IssueSeverity represents IssueSeverity.
 type IssueSeverity string
 
@@ -1780,6 +4668,33 @@ const (
 	IssueSeverityInformation IssueSeverity = "information"
 )
 
+// known reports whether c is one of the IssueSeverity values.
+func (c IssueSeverity) known() bool {
+	switch c {
+	case IssueSeverityFatal,
+		IssueSeverityError,
+		IssueSeverityWarning,
+		IssueSeverityInformation:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first IssueSeverity value in definition order.
+func (IssueSeverity) firstCode() string {
+	return string(IssueSeverityFatal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c IssueSeverity) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *IssueSeverity) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // IssueType represents IssueType.
 type IssueType string
 
@@ -1849,6 +4764,60 @@ const (
 	IssueTypeInformational IssueType = "informational"
 )
 
+// known reports whether c is one of the IssueType values.
+func (c IssueType) known() bool {
+	switch c {
+	case IssueTypeInvalid,
+		IssueTypeStructure,
+		IssueTypeRequired,
+		IssueTypeValue,
+		IssueTypeInvariant,
+		IssueTypeSecurity,
+		IssueTypeLogin,
+		IssueTypeUnknown,
+		IssueTypeExpired,
+		IssueTypeForbidden,
+		IssueTypeSuppressed,
+		IssueTypeProcessing,
+		IssueTypeNotSupported,
+		IssueTypeDuplicate,
+		IssueTypeMultipleMatches,
+		IssueTypeNotFound,
+		IssueTypeDeleted,
+		IssueTypeTooLong,
+		IssueTypeCodeInvalid,
+		IssueTypeExtension,
+		IssueTypeTooCostly,
+		IssueTypeBusinessRule,
+		IssueTypeConflict,
+		IssueTypeTransient,
+		IssueTypeLockError,
+		IssueTypeNoStore,
+		IssueTypeException,
+		IssueTypeTimeout,
+		IssueTypeIncomplete,
+		IssueTypeThrottled,
+		IssueTypeInformational:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first IssueType value in definition order.
+func (IssueType) firstCode() string {
+	return string(IssueTypeInvalid)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c IssueType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *IssueType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // QuestionnaireItemType represents QuestionnaireItemType.
 type QuestionnaireItemType string
 
@@ -1890,6 +4859,46 @@ const (
 	QuestionnaireItemTypeQuantity QuestionnaireItemType = "quantity"
 )
 
+// known reports whether c is one of the QuestionnaireItemType values.
+func (c QuestionnaireItemType) known() bool {
+	switch c {
+	case QuestionnaireItemTypeGroup,
+		QuestionnaireItemTypeDisplay,
+		QuestionnaireItemTypeQuestion,
+		QuestionnaireItemTypeBoolean,
+		QuestionnaireItemTypeDecimal,
+		QuestionnaireItemTypeInteger,
+		QuestionnaireItemTypeDate,
+		QuestionnaireItemTypeDatetime,
+		QuestionnaireItemTypeTime,
+		QuestionnaireItemTypeString,
+		QuestionnaireItemTypeText,
+		QuestionnaireItemTypeUrl,
+		QuestionnaireItemTypeChoice,
+		QuestionnaireItemTypeOpenChoice,
+		QuestionnaireItemTypeAttachment,
+		QuestionnaireItemTypeReference,
+		QuestionnaireItemTypeQuantity:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first QuestionnaireItemType value in definition order.
+func (QuestionnaireItemType) firstCode() string {
+	return string(QuestionnaireItemTypeGroup)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c QuestionnaireItemType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *QuestionnaireItemType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // LinkType represents LinkType.
 type LinkType string
 
@@ -1905,6 +4914,33 @@ const (
 	LinkTypeSeealso LinkType = "seealso"
 )
 
+// known reports whether c is one of the LinkType values.
+func (c LinkType) known() bool {
+	switch c {
+	case LinkTypeReplacedBy,
+		LinkTypeReplaces,
+		LinkTypeRefer,
+		LinkTypeSeealso:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first LinkType value in definition order.
+func (LinkType) firstCode() string {
+	return string(LinkTypeReplacedBy)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c LinkType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *LinkType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // LinkageType represents LinkageType.
 type LinkageType string
 
@@ -1918,6 +4954,32 @@ const (
 	LinkageTypeHistorical LinkageType = "historical"
 )
 
+// known reports whether c is one of the LinkageType values.
+func (c LinkageType) known() bool {
+	switch c {
+	case LinkageTypeSource,
+		LinkageTypeAlternate,
+		LinkageTypeHistorical:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first LinkageType value in definition order.
+func (LinkageType) firstCode() string {
+	return string(LinkageTypeSource)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c LinkageType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *LinkageType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ListMode represents ListMode.
 type ListMode string
 
@@ -1931,6 +4993,32 @@ const (
 	ListModeChanges ListMode = "changes"
 )
 
+// known reports whether c is one of the ListMode values.
+func (c ListMode) known() bool {
+	switch c {
+	case ListModeWorking,
+		ListModeSnapshot,
+		ListModeChanges:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ListMode value in definition order.
+func (ListMode) firstCode() string {
+	return string(ListModeWorking)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ListMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ListMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ListStatus represents ListStatus.
 type ListStatus string
 
@@ -1944,6 +5032,32 @@ const (
 	ListStatusEnteredInError ListStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the ListStatus values.
+func (c ListStatus) known() bool {
+	switch c {
+	case ListStatusCurrent,
+		ListStatusRetired,
+		ListStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ListStatus value in definition order.
+func (ListStatus) firstCode() string {
+	return string(ListStatusCurrent)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ListStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ListStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // LocationMode represents LocationMode.
 type LocationMode string
 
@@ -1955,6 +5069,31 @@ const (
 	LocationModeKind LocationMode = "kind"
 )
 
+// known reports whether c is one of the LocationMode values.
+func (c LocationMode) known() bool {
+	switch c {
+	case LocationModeInstance,
+		LocationModeKind:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first LocationMode value in definition order.
+func (LocationMode) firstCode() string {
+	return string(LocationModeInstance)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c LocationMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *LocationMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // LocationStatus represents LocationStatus.
 type LocationStatus string
 
@@ -1968,6 +5107,32 @@ const (
 	LocationStatusInactive LocationStatus = "inactive"
 )
 
+// known reports whether c is one of the LocationStatus values.
+func (c LocationStatus) known() bool {
+	switch c {
+	case LocationStatusActive,
+		LocationStatusSuspended,
+		LocationStatusInactive:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first LocationStatus value in definition order.
+func (LocationStatus) firstCode() string {
+	return string(LocationStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c LocationStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *LocationStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapContextType represents StructureMapContextType.
 type StructureMapContextType string
 
@@ -1979,6 +5144,31 @@ const (
 	StructureMapContextTypeVariable StructureMapContextType = "variable"
 )
 
+// known reports whether c is one of the StructureMapContextType values.
+func (c StructureMapContextType) known() bool {
+	switch c {
+	case StructureMapContextTypeType,
+		StructureMapContextTypeVariable:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapContextType value in definition order.
+func (StructureMapContextType) firstCode() string {
+	return string(StructureMapContextTypeType)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapContextType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapContextType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapGroupTypeMode represents StructureMapGroupTypeMode.
 type StructureMapGroupTypeMode string
 
@@ -1992,6 +5182,32 @@ const (
 	StructureMapGroupTypeModeTypeAndTypes StructureMapGroupTypeMode = "type-and-types"
 )
 
+// known reports whether c is one of the StructureMapGroupTypeMode values.
+func (c StructureMapGroupTypeMode) known() bool {
+	switch c {
+	case StructureMapGroupTypeModeNone,
+		StructureMapGroupTypeModeTypes,
+		StructureMapGroupTypeModeTypeAndTypes:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapGroupTypeMode value in definition order.
+func (StructureMapGroupTypeMode) firstCode() string {
+	return string(StructureMapGroupTypeModeNone)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapGroupTypeMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapGroupTypeMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapInputMode represents StructureMapInputMode.
 type StructureMapInputMode string
 
@@ -2003,6 +5219,31 @@ const (
 	StructureMapInputModeTarget StructureMapInputMode = "target"
 )
 
+// known reports whether c is one of the StructureMapInputMode values.
+func (c StructureMapInputMode) known() bool {
+	switch c {
+	case StructureMapInputModeSource,
+		StructureMapInputModeTarget:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapInputMode value in definition order.
+func (StructureMapInputMode) firstCode() string {
+	return string(StructureMapInputModeSource)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapInputMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapInputMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapModelMode represents StructureMapModelMode.
 type StructureMapModelMode string
 
@@ -2018,6 +5259,33 @@ const (
 	StructureMapModelModeProduced StructureMapModelMode = "produced"
 )
 
+// known reports whether c is one of the StructureMapModelMode values.
+func (c StructureMapModelMode) known() bool {
+	switch c {
+	case StructureMapModelModeSource,
+		StructureMapModelModeQueried,
+		StructureMapModelModeTarget,
+		StructureMapModelModeProduced:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapModelMode value in definition order.
+func (StructureMapModelMode) firstCode() string {
+	return string(StructureMapModelModeSource)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapModelMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapModelMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapSourceListMode represents StructureMapSourceListMode.
 type StructureMapSourceListMode string
 
@@ -2035,6 +5303,34 @@ const (
 	StructureMapSourceListModeOnlyOne StructureMapSourceListMode = "only_one"
 )
 
+// known reports whether c is one of the StructureMapSourceListMode values.
+func (c StructureMapSourceListMode) known() bool {
+	switch c {
+	case StructureMapSourceListModeFirst,
+		StructureMapSourceListModeNotFirst,
+		StructureMapSourceListModeLast,
+		StructureMapSourceListModeNotLast,
+		StructureMapSourceListModeOnlyOne:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapSourceListMode value in definition order.
+func (StructureMapSourceListMode) firstCode() string {
+	return string(StructureMapSourceListModeFirst)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapSourceListMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapSourceListMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapTargetListMode represents StructureMapTargetListMode.
 type StructureMapTargetListMode string
 
@@ -2050,6 +5346,33 @@ const (
 	StructureMapTargetListModeCollate StructureMapTargetListMode = "collate"
 )
 
+// known reports whether c is one of the StructureMapTargetListMode values.
+func (c StructureMapTargetListMode) known() bool {
+	switch c {
+	case StructureMapTargetListModeFirst,
+		StructureMapTargetListModeShare,
+		StructureMapTargetListModeLast,
+		StructureMapTargetListModeCollate:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapTargetListMode value in definition order.
+func (StructureMapTargetListMode) firstCode() string {
+	return string(StructureMapTargetListModeFirst)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapTargetListMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapTargetListMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapTransform represents StructureMapTransform.
 type StructureMapTransform string
 
@@ -2091,6 +5414,46 @@ const (
 	StructureMapTransformCp StructureMapTransform = "cp"
 )
 
+// known reports whether c is one of the StructureMapTransform values.
+func (c StructureMapTransform) known() bool {
+	switch c {
+	case StructureMapTransformCreate,
+		StructureMapTransformCopy,
+		StructureMapTransformTruncate,
+		StructureMapTransformEscape,
+		StructureMapTransformCast,
+		StructureMapTransformAppend,
+		StructureMapTransformTranslate,
+		StructureMapTransformReference,
+		StructureMapTransformDateop,
+		StructureMapTransformUuid,
+		StructureMapTransformPointer,
+		StructureMapTransformEvaluate,
+		StructureMapTransformCc,
+		StructureMapTransformC,
+		StructureMapTransformQty,
+		StructureMapTransformId,
+		StructureMapTransformCp:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapTransform value in definition order.
+func (StructureMapTransform) firstCode() string {
+	return string(StructureMapTransformCreate)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapTransform) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapTransform) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MeasureReportStatus represents MeasureReportStatus.
 type MeasureReportStatus string
 
@@ -2104,6 +5467,32 @@ const (
 	MeasureReportStatusError MeasureReportStatus = "error"
 )
 
+// known reports whether c is one of the MeasureReportStatus values.
+func (c MeasureReportStatus) known() bool {
+	switch c {
+	case MeasureReportStatusComplete,
+		MeasureReportStatusPending,
+		MeasureReportStatusError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MeasureReportStatus value in definition order.
+func (MeasureReportStatus) firstCode() string {
+	return string(MeasureReportStatusComplete)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MeasureReportStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MeasureReportStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MeasureReportType represents MeasureReportType.
 type MeasureReportType string
 
@@ -2119,6 +5508,33 @@ const (
 	MeasureReportTypeDataCollection MeasureReportType = "data-collection"
 )
 
+// known reports whether c is one of the MeasureReportType values.
+func (c MeasureReportType) known() bool {
+	switch c {
+	case MeasureReportTypeIndividual,
+		MeasureReportTypeSubjectList,
+		MeasureReportTypeSummary,
+		MeasureReportTypeDataCollection:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MeasureReportType value in definition order.
+func (MeasureReportType) firstCode() string {
+	return string(MeasureReportTypeIndividual)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MeasureReportType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MeasureReportType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationAdministrationStatusCodes represents allowed values for the MedicationAdministration Status Codes code system.
 type MedicationAdministrationStatusCodes string
 
@@ -2140,6 +5556,36 @@ const (
 	MedicationAdministrationStatusCodesUnknown MedicationAdministrationStatusCodes = "unknown"
 )
 
+// known reports whether c is one of the MedicationAdministrationStatusCodes values.
+func (c MedicationAdministrationStatusCodes) known() bool {
+	switch c {
+	case MedicationAdministrationStatusCodesInProgress,
+		MedicationAdministrationStatusCodesNotDone,
+		MedicationAdministrationStatusCodesOnHold,
+		MedicationAdministrationStatusCodesCompleted,
+		MedicationAdministrationStatusCodesEnteredInError,
+		MedicationAdministrationStatusCodesStopped,
+		MedicationAdministrationStatusCodesUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationAdministrationStatusCodes value in definition order.
+func (MedicationAdministrationStatusCodes) firstCode() string {
+	return string(MedicationAdministrationStatusCodesInProgress)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationAdministrationStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationAdministrationStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationStatementStatusCodes represents allowed values for the MedicationStatement Status Codes code system.
 type MedicationStatementStatusCodes string
 
@@ -2163,6 +5609,37 @@ const (
 	MedicationStatementStatusCodesNotTaken MedicationStatementStatusCodes = "not-taken"
 )
 
+// known reports whether c is one of the MedicationStatementStatusCodes values.
+func (c MedicationStatementStatusCodes) known() bool {
+	switch c {
+	case MedicationStatementStatusCodesActive,
+		MedicationStatementStatusCodesCompleted,
+		MedicationStatementStatusCodesEnteredInError,
+		MedicationStatementStatusCodesIntended,
+		MedicationStatementStatusCodesStopped,
+		MedicationStatementStatusCodesOnHold,
+		MedicationStatementStatusCodesUnknown,
+		MedicationStatementStatusCodesNotTaken:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationStatementStatusCodes value in definition order.
+func (MedicationStatementStatusCodes) firstCode() string {
+	return string(MedicationStatementStatusCodesActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationStatementStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationStatementStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationStatusCodes represents allowed values for the Medication Status Codes code system.
 type MedicationStatusCodes string
 
@@ -2176,6 +5653,32 @@ const (
 	MedicationStatusCodesEnteredInError MedicationStatusCodes = "entered-in-error"
 )
 
+// known reports whether c is one of the MedicationStatusCodes values.
+func (c MedicationStatusCodes) known() bool {
+	switch c {
+	case MedicationStatusCodesActive,
+		MedicationStatusCodesInactive,
+		MedicationStatusCodesEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationStatusCodes value in definition order.
+func (MedicationStatusCodes) firstCode() string {
+	return string(MedicationStatusCodesActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationDispenseStatusCodes represents allowed values for the MedicationDispense Status Codes code system.
 type MedicationDispenseStatusCodes string
 
@@ -2201,6 +5704,38 @@ const (
 	MedicationDispenseStatusCodesUnknown MedicationDispenseStatusCodes = "unknown"
 )
 
+// known reports whether c is one of the MedicationDispenseStatusCodes values.
+func (c MedicationDispenseStatusCodes) known() bool {
+	switch c {
+	case MedicationDispenseStatusCodesPreparation,
+		MedicationDispenseStatusCodesInProgress,
+		MedicationDispenseStatusCodesCancelled,
+		MedicationDispenseStatusCodesOnHold,
+		MedicationDispenseStatusCodesCompleted,
+		MedicationDispenseStatusCodesEnteredInError,
+		MedicationDispenseStatusCodesStopped,
+		MedicationDispenseStatusCodesDeclined,
+		MedicationDispenseStatusCodesUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationDispenseStatusCodes value in definition order.
+func (MedicationDispenseStatusCodes) firstCode() string {
+	return string(MedicationDispenseStatusCodesPreparation)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationDispenseStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationDispenseStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationKnowledgeStatusCodes represents allowed values for the MedicationKnowledge Status Codes code system.
 type MedicationKnowledgeStatusCodes string
 
@@ -2214,6 +5749,32 @@ const (
 	MedicationKnowledgeStatusCodesEnteredInError MedicationKnowledgeStatusCodes = "entered-in-error"
 )
 
+// known reports whether c is one of the MedicationKnowledgeStatusCodes values.
+func (c MedicationKnowledgeStatusCodes) known() bool {
+	switch c {
+	case MedicationKnowledgeStatusCodesActive,
+		MedicationKnowledgeStatusCodesInactive,
+		MedicationKnowledgeStatusCodesEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationKnowledgeStatusCodes value in definition order.
+func (MedicationKnowledgeStatusCodes) firstCode() string {
+	return string(MedicationKnowledgeStatusCodesActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationKnowledgeStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationKnowledgeStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationRequestIntent represents allowed values for the medicationRequest Intent code system.
 type MedicationRequestIntent string
 
@@ -2237,6 +5798,37 @@ const (
 	MedicationRequestIntentOption MedicationRequestIntent = "option"
 )
 
+// known reports whether c is one of the MedicationRequestIntent values.
+func (c MedicationRequestIntent) known() bool {
+	switch c {
+	case MedicationRequestIntentProposal,
+		MedicationRequestIntentPlan,
+		MedicationRequestIntentOrder,
+		MedicationRequestIntentOriginalOrder,
+		MedicationRequestIntentReflexOrder,
+		MedicationRequestIntentFillerOrder,
+		MedicationRequestIntentInstanceOrder,
+		MedicationRequestIntentOption:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationRequestIntent value in definition order.
+func (MedicationRequestIntent) firstCode() string {
+	return string(MedicationRequestIntentProposal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationRequestIntent) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationRequestIntent) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationrequestStatus represents allowed values for the medicationrequest Status code system.
 type MedicationrequestStatus string
 
@@ -2260,6 +5852,37 @@ const (
 	MedicationrequestStatusUnknown MedicationrequestStatus = "unknown"
 )
 
+// known reports whether c is one of the MedicationrequestStatus values.
+func (c MedicationrequestStatus) known() bool {
+	switch c {
+	case MedicationrequestStatusActive,
+		MedicationrequestStatusOnHold,
+		MedicationrequestStatusCancelled,
+		MedicationrequestStatusCompleted,
+		MedicationrequestStatusEnteredInError,
+		MedicationrequestStatusStopped,
+		MedicationrequestStatusDraft,
+		MedicationrequestStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationrequestStatus value in definition order.
+func (MedicationrequestStatus) firstCode() string {
+	return string(MedicationrequestStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationrequestStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationrequestStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MessageSignificanceCategory represents MessageSignificanceCategory.
 type MessageSignificanceCategory string
 
@@ -2273,6 +5896,32 @@ const (
 	MessageSignificanceCategoryNotification MessageSignificanceCategory = "notification"
 )
 
+// known reports whether c is one of the MessageSignificanceCategory values.
+func (c MessageSignificanceCategory) known() bool {
+	switch c {
+	case MessageSignificanceCategoryConsequence,
+		MessageSignificanceCategoryCurrency,
+		MessageSignificanceCategoryNotification:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MessageSignificanceCategory value in definition order.
+func (MessageSignificanceCategory) firstCode() string {
+	return string(MessageSignificanceCategoryConsequence)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MessageSignificanceCategory) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MessageSignificanceCategory) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // Messageheaderresponserequest represents messageheader-response-request.
 type Messageheaderresponserequest string
 
@@ -2288,6 +5937,33 @@ const (
 	MessageheaderresponserequestOnSuccess Messageheaderresponserequest = "on-success"
 )
 
+// known reports whether c is one of the Messageheaderresponserequest values.
+func (c Messageheaderresponserequest) known() bool {
+	switch c {
+	case MessageheaderresponserequestAlways,
+		MessageheaderresponserequestOnError,
+		MessageheaderresponserequestNever,
+		MessageheaderresponserequestOnSuccess:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first Messageheaderresponserequest value in definition order.
+func (Messageheaderresponserequest) firstCode() string {
+	return string(MessageheaderresponserequestAlways)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c Messageheaderresponserequest) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *Messageheaderresponserequest) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceMetricCalibrationState represents DeviceMetricCalibrationState.
 type DeviceMetricCalibrationState string
 
@@ -2303,6 +5979,33 @@ const (
 	DeviceMetricCalibrationStateUnspecified DeviceMetricCalibrationState = "unspecified"
 )
 
+// known reports whether c is one of the DeviceMetricCalibrationState values.
+func (c DeviceMetricCalibrationState) known() bool {
+	switch c {
+	case DeviceMetricCalibrationStateNotCalibrated,
+		DeviceMetricCalibrationStateCalibrationRequired,
+		DeviceMetricCalibrationStateCalibrated,
+		DeviceMetricCalibrationStateUnspecified:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceMetricCalibrationState value in definition order.
+func (DeviceMetricCalibrationState) firstCode() string {
+	return string(DeviceMetricCalibrationStateNotCalibrated)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceMetricCalibrationState) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceMetricCalibrationState) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceMetricCalibrationType represents DeviceMetricCalibrationType.
 type DeviceMetricCalibrationType string
 
@@ -2318,6 +6021,33 @@ const (
 	DeviceMetricCalibrationTypeTwoPoint DeviceMetricCalibrationType = "two-point"
 )
 
+// known reports whether c is one of the DeviceMetricCalibrationType values.
+func (c DeviceMetricCalibrationType) known() bool {
+	switch c {
+	case DeviceMetricCalibrationTypeUnspecified,
+		DeviceMetricCalibrationTypeOffset,
+		DeviceMetricCalibrationTypeGain,
+		DeviceMetricCalibrationTypeTwoPoint:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceMetricCalibrationType value in definition order.
+func (DeviceMetricCalibrationType) firstCode() string {
+	return string(DeviceMetricCalibrationTypeUnspecified)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceMetricCalibrationType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceMetricCalibrationType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceMetricCategory represents DeviceMetricCategory.
 type DeviceMetricCategory string
 
@@ -2333,6 +6063,33 @@ const (
 	DeviceMetricCategoryUnspecified DeviceMetricCategory = "unspecified"
 )
 
+// known reports whether c is one of the DeviceMetricCategory values.
+func (c DeviceMetricCategory) known() bool {
+	switch c {
+	case DeviceMetricCategoryMeasurement,
+		DeviceMetricCategorySetting,
+		DeviceMetricCategoryCalculation,
+		DeviceMetricCategoryUnspecified:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceMetricCategory value in definition order.
+func (DeviceMetricCategory) firstCode() string {
+	return string(DeviceMetricCategoryMeasurement)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceMetricCategory) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceMetricCategory) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceMetricColor represents DeviceMetricColor.
 type DeviceMetricColor string
 
@@ -2356,6 +6113,37 @@ const (
 	DeviceMetricColorWhite DeviceMetricColor = "white"
 )
 
+// known reports whether c is one of the DeviceMetricColor values.
+func (c DeviceMetricColor) known() bool {
+	switch c {
+	case DeviceMetricColorBlack,
+		DeviceMetricColorRed,
+		DeviceMetricColorGreen,
+		DeviceMetricColorYellow,
+		DeviceMetricColorBlue,
+		DeviceMetricColorMagenta,
+		DeviceMetricColorCyan,
+		DeviceMetricColorWhite:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceMetricColor value in definition order.
+func (DeviceMetricColor) firstCode() string {
+	return string(DeviceMetricColorBlack)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceMetricColor) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceMetricColor) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceMetricOperationalStatus represents DeviceMetricOperationalStatus.
 type DeviceMetricOperationalStatus string
 
@@ -2371,6 +6159,33 @@ const (
 	DeviceMetricOperationalStatusEnteredInError DeviceMetricOperationalStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the DeviceMetricOperationalStatus values.
+func (c DeviceMetricOperationalStatus) known() bool {
+	switch c {
+	case DeviceMetricOperationalStatusOn,
+		DeviceMetricOperationalStatusOff,
+		DeviceMetricOperationalStatusStandby,
+		DeviceMetricOperationalStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceMetricOperationalStatus value in definition order.
+func (DeviceMetricOperationalStatus) firstCode() string {
+	return string(DeviceMetricOperationalStatusOn)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceMetricOperationalStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceMetricOperationalStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // NameUse represents NameUse.
 type NameUse string
 
@@ -2392,6 +6207,36 @@ const (
 	NameUseMaiden NameUse = "maiden"
 )
 
+// known reports whether c is one of the NameUse values.
+func (c NameUse) known() bool {
+	switch c {
+	case NameUseUsual,
+		NameUseOfficial,
+		NameUseTemp,
+		NameUseNickname,
+		NameUseAnonymous,
+		NameUseOld,
+		NameUseMaiden:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first NameUse value in definition order.
+func (NameUse) firstCode() string {
+	return string(NameUseUsual)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c NameUse) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *NameUse) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // NamingSystemIdentifierType represents NamingSystemIdentifierType.
 type NamingSystemIdentifierType string
 
@@ -2407,6 +6252,33 @@ const (
 	NamingSystemIdentifierTypeOther NamingSystemIdentifierType = "other"
 )
 
+// known reports whether c is one of the NamingSystemIdentifierType values.
+func (c NamingSystemIdentifierType) known() bool {
+	switch c {
+	case NamingSystemIdentifierTypeOid,
+		NamingSystemIdentifierTypeUuid,
+		NamingSystemIdentifierTypeUri,
+		NamingSystemIdentifierTypeOther:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first NamingSystemIdentifierType value in definition order.
+func (NamingSystemIdentifierType) firstCode() string {
+	return string(NamingSystemIdentifierTypeOid)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c NamingSystemIdentifierType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *NamingSystemIdentifierType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // NamingSystemType represents NamingSystemType.
 type NamingSystemType string
 
@@ -2420,6 +6292,32 @@ const (
 	NamingSystemTypeRoot NamingSystemType = "root"
 )
 
+// known reports whether c is one of the NamingSystemType values.
+func (c NamingSystemType) known() bool {
+	switch c {
+	case NamingSystemTypeCodesystem,
+		NamingSystemTypeIdentifier,
+		NamingSystemTypeRoot:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first NamingSystemType value in definition order.
+func (NamingSystemType) firstCode() string {
+	return string(NamingSystemTypeCodesystem)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c NamingSystemType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *NamingSystemType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // NarrativeStatus represents NarrativeStatus.
 type NarrativeStatus string
 
@@ -2435,6 +6333,33 @@ const (
 	NarrativeStatusEmpty NarrativeStatus = "empty"
 )
 
+// known reports whether c is one of the NarrativeStatus values.
+func (c NarrativeStatus) known() bool {
+	switch c {
+	case NarrativeStatusGenerated,
+		NarrativeStatusExtensions,
+		NarrativeStatusAdditional,
+		NarrativeStatusEmpty:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first NarrativeStatus value in definition order.
+func (NarrativeStatus) firstCode() string {
+	return string(NarrativeStatusGenerated)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c NarrativeStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *NarrativeStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AuditEventAgentNetworkType represents AuditEventAgentNetworkType.
 type AuditEventAgentNetworkType string
 
@@ -2452,6 +6377,34 @@ const (
 	AuditEventAgentNetworkType5 AuditEventAgentNetworkType = "5"
 )
 
+// known reports whether c is one of the AuditEventAgentNetworkType values.
+func (c AuditEventAgentNetworkType) known() bool {
+	switch c {
+	case AuditEventAgentNetworkType1,
+		AuditEventAgentNetworkType2,
+		AuditEventAgentNetworkType3,
+		AuditEventAgentNetworkType4,
+		AuditEventAgentNetworkType5:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AuditEventAgentNetworkType value in definition order.
+func (AuditEventAgentNetworkType) firstCode() string {
+	return string(AuditEventAgentNetworkType1)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AuditEventAgentNetworkType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AuditEventAgentNetworkType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // NoteType represents NoteType.
 type NoteType string
 
@@ -2465,6 +6418,32 @@ const (
 	NoteTypePrintoper NoteType = "printoper"
 )
 
+// known reports whether c is one of the NoteType values.
+func (c NoteType) known() bool {
+	switch c {
+	case NoteTypeDisplay,
+		NoteTypePrint,
+		NoteTypePrintoper:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first NoteType value in definition order.
+func (NoteType) firstCode() string {
+	return string(NoteTypeDisplay)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c NoteType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *NoteType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // NutritionProductStatus represents NutritionProductStatus.
 type NutritionProductStatus string
 
@@ -2478,6 +6457,32 @@ const (
 	NutritionProductStatusEnteredInError NutritionProductStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the NutritionProductStatus values.
+func (c NutritionProductStatus) known() bool {
+	switch c {
+	case NutritionProductStatusActive,
+		NutritionProductStatusInactive,
+		NutritionProductStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first NutritionProductStatus value in definition order.
+func (NutritionProductStatus) firstCode() string {
+	return string(NutritionProductStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c NutritionProductStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *NutritionProductStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ObservationRangeCategory represents ObservationRangeCategory.
 type ObservationRangeCategory string
 
@@ -2491,6 +6496,32 @@ const (
 	ObservationRangeCategoryAbsolute ObservationRangeCategory = "absolute"
 )
 
+// known reports whether c is one of the ObservationRangeCategory values.
+func (c ObservationRangeCategory) known() bool {
+	switch c {
+	case ObservationRangeCategoryReference,
+		ObservationRangeCategoryCritical,
+		ObservationRangeCategoryAbsolute:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ObservationRangeCategory value in definition order.
+func (ObservationRangeCategory) firstCode() string {
+	return string(ObservationRangeCategoryReference)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ObservationRangeCategory) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ObservationRangeCategory) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ObservationStatus represents ObservationStatus.
 type ObservationStatus string
 
@@ -2514,6 +6545,37 @@ const (
 	ObservationStatusUnknown ObservationStatus = "unknown"
 )
 
+// known reports whether c is one of the ObservationStatus values.
+func (c ObservationStatus) known() bool {
+	switch c {
+	case ObservationStatusRegistered,
+		ObservationStatusPreliminary,
+		ObservationStatusFinal,
+		ObservationStatusAmended,
+		ObservationStatusCorrected,
+		ObservationStatusCancelled,
+		ObservationStatusEnteredInError,
+		ObservationStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ObservationStatus value in definition order.
+func (ObservationStatus) firstCode() string {
+	return string(ObservationStatusRegistered)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ObservationStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ObservationStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // OperationKind represents OperationKind.
 type OperationKind string
 
@@ -2525,6 +6587,31 @@ const (
 	OperationKindQuery OperationKind = "query"
 )
 
+// known reports whether c is one of the OperationKind values.
+func (c OperationKind) known() bool {
+	switch c {
+	case OperationKindOperation,
+		OperationKindQuery:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first OperationKind value in definition order.
+func (OperationKind) firstCode() string {
+	return string(OperationKindOperation)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c OperationKind) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *OperationKind) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // OperationParameterUse represents OperationParameterUse.
 type OperationParameterUse string
 
@@ -2536,6 +6623,31 @@ const (
 	OperationParameterUseOut OperationParameterUse = "out"
 )
 
+// known reports whether c is one of the OperationParameterUse values.
+func (c OperationParameterUse) known() bool {
+	switch c {
+	case OperationParameterUseIn,
+		OperationParameterUseOut:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first OperationParameterUse value in definition order.
+func (OperationParameterUse) firstCode() string {
+	return string(OperationParameterUseIn)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c OperationParameterUse) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *OperationParameterUse) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // OrientationType represents orientationType.
 type OrientationType string
 
@@ -2547,6 +6659,31 @@ const (
 	OrientationTypeAntisense OrientationType = "antisense"
 )
 
+// known reports whether c is one of the OrientationType values.
+func (c OrientationType) known() bool {
+	switch c {
+	case OrientationTypeSense,
+		OrientationTypeAntisense:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first OrientationType value in definition order.
+func (OrientationType) firstCode() string {
+	return string(OrientationTypeSense)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c OrientationType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *OrientationType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ParticipantRequired represents ParticipantRequired.
 type ParticipantRequired string
 
@@ -2560,6 +6697,32 @@ const (
 	ParticipantRequiredInformationOnly ParticipantRequired = "information-only"
 )
 
+// known reports whether c is one of the ParticipantRequired values.
+func (c ParticipantRequired) known() bool {
+	switch c {
+	case ParticipantRequiredRequired,
+		ParticipantRequiredOptional,
+		ParticipantRequiredInformationOnly:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ParticipantRequired value in definition order.
+func (ParticipantRequired) firstCode() string {
+	return string(ParticipantRequiredRequired)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ParticipantRequired) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ParticipantRequired) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ParticipationStatus represents ParticipationStatus.
 type ParticipationStatus string
 
@@ -2575,6 +6738,33 @@ const (
 	ParticipationStatusNeedsAction ParticipationStatus = "needs-action"
 )
 
+// known reports whether c is one of the ParticipationStatus values.
+func (c ParticipationStatus) known() bool {
+	switch c {
+	case ParticipationStatusAccepted,
+		ParticipationStatusDeclined,
+		ParticipationStatusTentative,
+		ParticipationStatusNeedsAction:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ParticipationStatus value in definition order.
+func (ParticipationStatus) firstCode() string {
+	return string(ParticipationStatusAccepted)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ParticipationStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ParticipationStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ObservationDataType represents ObservationDataType.
 type ObservationDataType string
 
@@ -2604,6 +6794,40 @@ const (
 	ObservationDataTypePeriod ObservationDataType = "Period"
 )
 
+// known reports whether c is one of the ObservationDataType values.
+func (c ObservationDataType) known() bool {
+	switch c {
+	case ObservationDataTypeQuantity,
+		ObservationDataTypeCodeableconcept,
+		ObservationDataTypeString,
+		ObservationDataTypeBoolean,
+		ObservationDataTypeInteger,
+		ObservationDataTypeRange,
+		ObservationDataTypeRatio,
+		ObservationDataTypeSampleddata,
+		ObservationDataTypeTime,
+		ObservationDataTypeDatetime,
+		ObservationDataTypePeriod:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ObservationDataType value in definition order.
+func (ObservationDataType) firstCode() string {
+	return string(ObservationDataTypeQuantity)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ObservationDataType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ObservationDataType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // BiologicallyDerivedProductCategory represents BiologicallyDerivedProductCategory.
 type BiologicallyDerivedProductCategory string
 
@@ -2621,6 +6845,34 @@ const (
 	BiologicallyDerivedProductCategoryBiologicalagent BiologicallyDerivedProductCategory = "biologicalAgent"
 )
 
+// known reports whether c is one of the BiologicallyDerivedProductCategory values.
+func (c BiologicallyDerivedProductCategory) known() bool {
+	switch c {
+	case BiologicallyDerivedProductCategoryOrgan,
+		BiologicallyDerivedProductCategoryTissue,
+		BiologicallyDerivedProductCategoryFluid,
+		BiologicallyDerivedProductCategoryCells,
+		BiologicallyDerivedProductCategoryBiologicalagent:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first BiologicallyDerivedProductCategory value in definition order.
+func (BiologicallyDerivedProductCategory) firstCode() string {
+	return string(BiologicallyDerivedProductCategoryOrgan)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c BiologicallyDerivedProductCategory) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *BiologicallyDerivedProductCategory) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // BiologicallyDerivedProductStatus represents BiologicallyDerivedProductStatus.
 type BiologicallyDerivedProductStatus string
 
@@ -2632,6 +6884,31 @@ const (
 	BiologicallyDerivedProductStatusUnavailable BiologicallyDerivedProductStatus = "unavailable"
 )
 
+// known reports whether c is one of the BiologicallyDerivedProductStatus values.
+func (c BiologicallyDerivedProductStatus) known() bool {
+	switch c {
+	case BiologicallyDerivedProductStatusAvailable,
+		BiologicallyDerivedProductStatusUnavailable:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first BiologicallyDerivedProductStatus value in definition order.
+func (BiologicallyDerivedProductStatus) firstCode() string {
+	return string(BiologicallyDerivedProductStatusAvailable)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c BiologicallyDerivedProductStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *BiologicallyDerivedProductStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // BiologicallyDerivedProductStorageScale represents BiologicallyDerivedProductStorageScale.
 type BiologicallyDerivedProductStorageScale string
 
@@ -2645,6 +6922,32 @@ const (
 	BiologicallyDerivedProductStorageScaleKelvin BiologicallyDerivedProductStorageScale = "kelvin"
 )
 
+// known reports whether c is one of the BiologicallyDerivedProductStorageScale values.
+func (c BiologicallyDerivedProductStorageScale) known() bool {
+	switch c {
+	case BiologicallyDerivedProductStorageScaleFarenheit,
+		BiologicallyDerivedProductStorageScaleCelsius,
+		BiologicallyDerivedProductStorageScaleKelvin:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first BiologicallyDerivedProductStorageScale value in definition order.
+func (BiologicallyDerivedProductStorageScale) firstCode() string {
+	return string(BiologicallyDerivedProductStorageScaleFarenheit)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c BiologicallyDerivedProductStorageScale) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *BiologicallyDerivedProductStorageScale) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // PropertyRepresentation represents PropertyRepresentation.
 type PropertyRepresentation string
 
@@ -2662,6 +6965,34 @@ const (
 	PropertyRepresentationXhtml PropertyRepresentation = "xhtml"
 )
 
+// known reports whether c is one of the PropertyRepresentation values.
+func (c PropertyRepresentation) known() bool {
+	switch c {
+	case PropertyRepresentationXmlattr,
+		PropertyRepresentationXmltext,
+		PropertyRepresentationTypeattr,
+		PropertyRepresentationCdatext,
+		PropertyRepresentationXhtml:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first PropertyRepresentation value in definition order.
+func (PropertyRepresentation) firstCode() string {
+	return string(PropertyRepresentationXmlattr)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c PropertyRepresentation) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *PropertyRepresentation) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ProvenanceEntityRole represents ProvenanceEntityRole.
 type ProvenanceEntityRole string
 
@@ -2679,6 +7010,34 @@ const (
 	ProvenanceEntityRoleRemoval ProvenanceEntityRole = "removal"
 )
 
+// known reports whether c is one of the ProvenanceEntityRole values.
+func (c ProvenanceEntityRole) known() bool {
+	switch c {
+	case ProvenanceEntityRoleDerivation,
+		ProvenanceEntityRoleRevision,
+		ProvenanceEntityRoleQuotation,
+		ProvenanceEntityRoleSource,
+		ProvenanceEntityRoleRemoval:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ProvenanceEntityRole value in definition order.
+func (ProvenanceEntityRole) firstCode() string {
+	return string(ProvenanceEntityRoleDerivation)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ProvenanceEntityRole) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ProvenanceEntityRole) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // PublicationStatus represents PublicationStatus.
 type PublicationStatus string
 
@@ -2694,6 +7053,33 @@ const (
 	PublicationStatusUnknown PublicationStatus = "unknown"
 )
 
+// known reports whether c is one of the PublicationStatus values.
+func (c PublicationStatus) known() bool {
+	switch c {
+	case PublicationStatusDraft,
+		PublicationStatusActive,
+		PublicationStatusRetired,
+		PublicationStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first PublicationStatus value in definition order.
+func (PublicationStatus) firstCode() string {
+	return string(PublicationStatusDraft)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c PublicationStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *PublicationStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // QualityType represents qualityType.
 type QualityType string
 
@@ -2707,6 +7093,32 @@ const (
 	QualityTypeUnknown QualityType = "unknown"
 )
 
+// known reports whether c is one of the QualityType values.
+func (c QualityType) known() bool {
+	switch c {
+	case QualityTypeIndel,
+		QualityTypeSnp,
+		QualityTypeUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first QualityType value in definition order.
+func (QualityType) firstCode() string {
+	return string(QualityTypeIndel)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c QualityType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *QualityType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // QuantityComparator represents QuantityComparator.
 type QuantityComparator string
 
@@ -2722,6 +7134,33 @@ const (
 	QuantityComparatorGreaterThan QuantityComparator = ">"
 )
 
+// known reports whether c is one of the QuantityComparator values.
+func (c QuantityComparator) known() bool {
+	switch c {
+	case QuantityComparatorLessThan,
+		QuantityComparatorLessOrEqual,
+		QuantityComparatorGreaterOrEqual,
+		QuantityComparatorGreaterThan:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first QuantityComparator value in definition order.
+func (QuantityComparator) firstCode() string {
+	return string(QuantityComparatorLessThan)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c QuantityComparator) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *QuantityComparator) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // QuestionnaireResponseStatus represents QuestionnaireResponseStatus.
 type QuestionnaireResponseStatus string
 
@@ -2739,6 +7178,34 @@ const (
 	QuestionnaireResponseStatusStopped QuestionnaireResponseStatus = "stopped"
 )
 
+// known reports whether c is one of the QuestionnaireResponseStatus values.
+func (c QuestionnaireResponseStatus) known() bool {
+	switch c {
+	case QuestionnaireResponseStatusInProgress,
+		QuestionnaireResponseStatusCompleted,
+		QuestionnaireResponseStatusAmended,
+		QuestionnaireResponseStatusEnteredInError,
+		QuestionnaireResponseStatusStopped:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first QuestionnaireResponseStatus value in definition order.
+func (QuestionnaireResponseStatus) firstCode() string {
+	return string(QuestionnaireResponseStatusInProgress)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c QuestionnaireResponseStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *QuestionnaireResponseStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EnableWhenBehavior represents EnableWhenBehavior.
 type EnableWhenBehavior string
 
@@ -2750,6 +7217,31 @@ const (
 	EnableWhenBehaviorAny EnableWhenBehavior = "any"
 )
 
+// known reports whether c is one of the EnableWhenBehavior values.
+func (c EnableWhenBehavior) known() bool {
+	switch c {
+	case EnableWhenBehaviorAll,
+		EnableWhenBehaviorAny:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EnableWhenBehavior value in definition order.
+func (EnableWhenBehavior) firstCode() string {
+	return string(EnableWhenBehaviorAll)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EnableWhenBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EnableWhenBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // QuestionnaireItemOperator represents QuestionnaireItemOperator.
 type QuestionnaireItemOperator string
 
@@ -2771,6 +7263,36 @@ const (
 	QuestionnaireItemOperatorLessOrEqual QuestionnaireItemOperator = "<="
 )
 
+// known reports whether c is one of the QuestionnaireItemOperator values.
+func (c QuestionnaireItemOperator) known() bool {
+	switch c {
+	case QuestionnaireItemOperatorExists,
+		QuestionnaireItemOperatorEqual,
+		QuestionnaireItemOperatorNotEqual,
+		QuestionnaireItemOperatorGreaterThan,
+		QuestionnaireItemOperatorLessThan,
+		QuestionnaireItemOperatorGreaterOrEqual,
+		QuestionnaireItemOperatorLessOrEqual:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first QuestionnaireItemOperator value in definition order.
+func (QuestionnaireItemOperator) firstCode() string {
+	return string(QuestionnaireItemOperatorExists)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c QuestionnaireItemOperator) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *QuestionnaireItemOperator) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AllergyIntoleranceSeverity represents AllergyIntoleranceSeverity.
 type AllergyIntoleranceSeverity string
 
@@ -2784,6 +7306,32 @@ const (
 	AllergyIntoleranceSeveritySevere AllergyIntoleranceSeverity = "severe"
 )
 
+// known reports whether c is one of the AllergyIntoleranceSeverity values.
+func (c AllergyIntoleranceSeverity) known() bool {
+	switch c {
+	case AllergyIntoleranceSeverityMild,
+		AllergyIntoleranceSeverityModerate,
+		AllergyIntoleranceSeveritySevere:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AllergyIntoleranceSeverity value in definition order.
+func (AllergyIntoleranceSeverity) firstCode() string {
+	return string(AllergyIntoleranceSeverityMild)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AllergyIntoleranceSeverity) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AllergyIntoleranceSeverity) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ReferenceHandlingPolicy represents ReferenceHandlingPolicy.
 type ReferenceHandlingPolicy string
 
@@ -2801,6 +7349,34 @@ const (
 	ReferenceHandlingPolicyLocal ReferenceHandlingPolicy = "local"
 )
 
+// known reports whether c is one of the ReferenceHandlingPolicy values.
+func (c ReferenceHandlingPolicy) known() bool {
+	switch c {
+	case ReferenceHandlingPolicyLiteral,
+		ReferenceHandlingPolicyLogical,
+		ReferenceHandlingPolicyResolves,
+		ReferenceHandlingPolicyEnforced,
+		ReferenceHandlingPolicyLocal:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ReferenceHandlingPolicy value in definition order.
+func (ReferenceHandlingPolicy) firstCode() string {
+	return string(ReferenceHandlingPolicyLiteral)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ReferenceHandlingPolicy) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ReferenceHandlingPolicy) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ReferenceVersionRules represents ReferenceVersionRules.
 type ReferenceVersionRules string
 
@@ -2814,6 +7390,32 @@ const (
 	ReferenceVersionRulesSpecific ReferenceVersionRules = "specific"
 )
 
+// known reports whether c is one of the ReferenceVersionRules values.
+func (c ReferenceVersionRules) known() bool {
+	switch c {
+	case ReferenceVersionRulesEither,
+		ReferenceVersionRulesIndependent,
+		ReferenceVersionRulesSpecific:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ReferenceVersionRules value in definition order.
+func (ReferenceVersionRules) firstCode() string {
+	return string(ReferenceVersionRulesEither)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ReferenceVersionRules) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ReferenceVersionRules) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RelatedArtifactType represents RelatedArtifactType.
 type RelatedArtifactType string
 
@@ -2837,6 +7439,37 @@ const (
 	RelatedArtifactTypeComposedOf RelatedArtifactType = "composed-of"
 )
 
+// known reports whether c is one of the RelatedArtifactType values.
+func (c RelatedArtifactType) known() bool {
+	switch c {
+	case RelatedArtifactTypeDocumentation,
+		RelatedArtifactTypeJustification,
+		RelatedArtifactTypeCitation,
+		RelatedArtifactTypePredecessor,
+		RelatedArtifactTypeSuccessor,
+		RelatedArtifactTypeDerivedFrom,
+		RelatedArtifactTypeDependsOn,
+		RelatedArtifactTypeComposedOf:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RelatedArtifactType value in definition order.
+func (RelatedArtifactType) firstCode() string {
+	return string(RelatedArtifactTypeDocumentation)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RelatedArtifactType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RelatedArtifactType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CatalogEntryRelationType represents CatalogEntryRelationType.
 type CatalogEntryRelationType string
 
@@ -2848,6 +7481,31 @@ const (
 	CatalogEntryRelationTypeIsReplacedBy CatalogEntryRelationType = "is-replaced-by"
 )
 
+// known reports whether c is one of the CatalogEntryRelationType values.
+func (c CatalogEntryRelationType) known() bool {
+	switch c {
+	case CatalogEntryRelationTypeTriggers,
+		CatalogEntryRelationTypeIsReplacedBy:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CatalogEntryRelationType value in definition order.
+func (CatalogEntryRelationType) firstCode() string {
+	return string(CatalogEntryRelationTypeTriggers)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CatalogEntryRelationType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CatalogEntryRelationType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RemittanceOutcome represents RemittanceOutcome.
 type RemittanceOutcome string
 
@@ -2863,6 +7521,33 @@ const (
 	RemittanceOutcomePartial RemittanceOutcome = "partial"
 )
 
+// known reports whether c is one of the RemittanceOutcome values.
+func (c RemittanceOutcome) known() bool {
+	switch c {
+	case RemittanceOutcomeQueued,
+		RemittanceOutcomeComplete,
+		RemittanceOutcomeError,
+		RemittanceOutcomePartial:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RemittanceOutcome value in definition order.
+func (RemittanceOutcome) firstCode() string {
+	return string(RemittanceOutcomeQueued)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RemittanceOutcome) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RemittanceOutcome) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TestReportActionResult represents TestReportActionResult.
 type TestReportActionResult string
 
@@ -2880,6 +7565,34 @@ const (
 	TestReportActionResultError TestReportActionResult = "error"
 )
 
+// known reports whether c is one of the TestReportActionResult values.
+func (c TestReportActionResult) known() bool {
+	switch c {
+	case TestReportActionResultPass,
+		TestReportActionResultSkip,
+		TestReportActionResultFail,
+		TestReportActionResultWarning,
+		TestReportActionResultError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TestReportActionResult value in definition order.
+func (TestReportActionResult) firstCode() string {
+	return string(TestReportActionResultPass)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TestReportActionResult) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TestReportActionResult) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TestReportParticipantType represents TestReportParticipantType.
 type TestReportParticipantType string
 
@@ -2893,6 +7606,32 @@ const (
 	TestReportParticipantTypeServer TestReportParticipantType = "server"
 )
 
+// known reports whether c is one of the TestReportParticipantType values.
+func (c TestReportParticipantType) known() bool {
+	switch c {
+	case TestReportParticipantTypeTestEngine,
+		TestReportParticipantTypeClient,
+		TestReportParticipantTypeServer:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TestReportParticipantType value in definition order.
+func (TestReportParticipantType) firstCode() string {
+	return string(TestReportParticipantTypeTestEngine)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TestReportParticipantType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TestReportParticipantType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ReportRelationshipType represents ReportRelationshipType.
 type ReportRelationshipType string
 
@@ -2916,6 +7655,37 @@ const (
 	ReportRelationshipTypeTransformedwith ReportRelationshipType = "transformedWith"
 )
 
+// known reports whether c is one of the ReportRelationshipType values.
+func (c ReportRelationshipType) known() bool {
+	switch c {
+	case ReportRelationshipTypeReplaces,
+		ReportRelationshipTypeAmends,
+		ReportRelationshipTypeAppends,
+		ReportRelationshipTypeTransforms,
+		ReportRelationshipTypeReplacedwith,
+		ReportRelationshipTypeAmendedwith,
+		ReportRelationshipTypeAppendedwith,
+		ReportRelationshipTypeTransformedwith:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ReportRelationshipType value in definition order.
+func (ReportRelationshipType) firstCode() string {
+	return string(ReportRelationshipTypeReplaces)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ReportRelationshipType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ReportRelationshipType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TestReportResult represents TestReportResult.
 type TestReportResult string
 
@@ -2929,6 +7699,32 @@ const (
 	TestReportResultPending TestReportResult = "pending"
 )
 
+// known reports whether c is one of the TestReportResult values.
+func (c TestReportResult) known() bool {
+	switch c {
+	case TestReportResultPass,
+		TestReportResultFail,
+		TestReportResultPending:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TestReportResult value in definition order.
+func (TestReportResult) firstCode() string {
+	return string(TestReportResultPass)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TestReportResult) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TestReportResult) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TestReportStatus represents TestReportStatus.
 type TestReportStatus string
 
@@ -2946,6 +7742,34 @@ const (
 	TestReportStatusEnteredInError TestReportStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the TestReportStatus values.
+func (c TestReportStatus) known() bool {
+	switch c {
+	case TestReportStatusCompleted,
+		TestReportStatusInProgress,
+		TestReportStatusWaiting,
+		TestReportStatusStopped,
+		TestReportStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TestReportStatus value in definition order.
+func (TestReportStatus) firstCode() string {
+	return string(TestReportStatusCompleted)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TestReportStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TestReportStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RepositoryType represents repositoryType.
 type RepositoryType string
 
@@ -2963,6 +7787,34 @@ const (
 	RepositoryTypeOther RepositoryType = "other"
 )
 
+// known reports whether c is one of the RepositoryType values.
+func (c RepositoryType) known() bool {
+	switch c {
+	case RepositoryTypeDirectlink,
+		RepositoryTypeOpenapi,
+		RepositoryTypeLogin,
+		RepositoryTypeOauth,
+		RepositoryTypeOther:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RepositoryType value in definition order.
+func (RepositoryType) firstCode() string {
+	return string(RepositoryTypeDirectlink)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RepositoryType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RepositoryType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RequestIntent represents RequestIntent.
 type RequestIntent string
 
@@ -2988,6 +7840,38 @@ const (
 	RequestIntentOption RequestIntent = "option"
 )
 
+// known reports whether c is one of the RequestIntent values.
+func (c RequestIntent) known() bool {
+	switch c {
+	case RequestIntentProposal,
+		RequestIntentPlan,
+		RequestIntentDirective,
+		RequestIntentOrder,
+		RequestIntentOriginalOrder,
+		RequestIntentReflexOrder,
+		RequestIntentFillerOrder,
+		RequestIntentInstanceOrder,
+		RequestIntentOption:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RequestIntent value in definition order.
+func (RequestIntent) firstCode() string {
+	return string(RequestIntentProposal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RequestIntent) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RequestIntent) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RequestPriority represents RequestPriority.
 type RequestPriority string
 
@@ -3003,6 +7887,33 @@ const (
 	RequestPriorityStat RequestPriority = "stat"
 )
 
+// known reports whether c is one of the RequestPriority values.
+func (c RequestPriority) known() bool {
+	switch c {
+	case RequestPriorityRoutine,
+		RequestPriorityUrgent,
+		RequestPriorityAsap,
+		RequestPriorityStat:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RequestPriority value in definition order.
+func (RequestPriority) firstCode() string {
+	return string(RequestPriorityRoutine)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RequestPriority) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RequestPriority) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RequestResourceType represents RequestResourceType.
 type RequestResourceType string
 
@@ -3040,6 +7951,44 @@ const (
 	RequestResourceTypeVisionprescription RequestResourceType = "VisionPrescription"
 )
 
+// known reports whether c is one of the RequestResourceType values.
+func (c RequestResourceType) known() bool {
+	switch c {
+	case RequestResourceTypeAppointment,
+		RequestResourceTypeAppointmentresponse,
+		RequestResourceTypeCareplan,
+		RequestResourceTypeClaim,
+		RequestResourceTypeCommunicationrequest,
+		RequestResourceTypeContract,
+		RequestResourceTypeDevicerequest,
+		RequestResourceTypeEnrollmentrequest,
+		RequestResourceTypeImmunizationrecommendation,
+		RequestResourceTypeMedicationrequest,
+		RequestResourceTypeNutritionorder,
+		RequestResourceTypeServicerequest,
+		RequestResourceTypeSupplyrequest,
+		RequestResourceTypeTask,
+		RequestResourceTypeVisionprescription:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RequestResourceType value in definition order.
+func (RequestResourceType) firstCode() string {
+	return string(RequestResourceTypeAppointment)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RequestResourceType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RequestResourceType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RequestStatus represents RequestStatus.
 type RequestStatus string
 
@@ -3061,6 +8010,36 @@ const (
 	RequestStatusUnknown RequestStatus = "unknown"
 )
 
+// known reports whether c is one of the RequestStatus values.
+func (c RequestStatus) known() bool {
+	switch c {
+	case RequestStatusDraft,
+		RequestStatusActive,
+		RequestStatusOnHold,
+		RequestStatusRevoked,
+		RequestStatusCompleted,
+		RequestStatusEnteredInError,
+		RequestStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RequestStatus value in definition order.
+func (RequestStatus) firstCode() string {
+	return string(RequestStatusDraft)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RequestStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RequestStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ResearchElementType represents ResearchElementType.
 type ResearchElementType string
 
@@ -3074,6 +8053,32 @@ const (
 	ResearchElementTypeOutcome ResearchElementType = "outcome"
 )
 
+// known reports whether c is one of the ResearchElementType values.
+func (c ResearchElementType) known() bool {
+	switch c {
+	case ResearchElementTypePopulation,
+		ResearchElementTypeExposure,
+		ResearchElementTypeOutcome:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ResearchElementType value in definition order.
+func (ResearchElementType) firstCode() string {
+	return string(ResearchElementTypePopulation)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ResearchElementType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ResearchElementType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ResearchStudyStatus represents ResearchStudyStatus.
 type ResearchStudyStatus string
 
@@ -3103,6 +8108,40 @@ const (
 	ResearchStudyStatusWithdrawn ResearchStudyStatus = "withdrawn"
 )
 
+// known reports whether c is one of the ResearchStudyStatus values.
+func (c ResearchStudyStatus) known() bool {
+	switch c {
+	case ResearchStudyStatusActive,
+		ResearchStudyStatusAdministrativelyCompleted,
+		ResearchStudyStatusApproved,
+		ResearchStudyStatusClosedToAccrual,
+		ResearchStudyStatusClosedToAccrualAndIntervention,
+		ResearchStudyStatusCompleted,
+		ResearchStudyStatusDisapproved,
+		ResearchStudyStatusInReview,
+		ResearchStudyStatusTemporarilyClosedToAccrual,
+		ResearchStudyStatusTemporarilyClosedToAccrualAndIntervention,
+		ResearchStudyStatusWithdrawn:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ResearchStudyStatus value in definition order.
+func (ResearchStudyStatus) firstCode() string {
+	return string(ResearchStudyStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ResearchStudyStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ResearchStudyStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ResearchSubjectStatus represents ResearchSubjectStatus.
 type ResearchSubjectStatus string
 
@@ -3136,6 +8175,42 @@ const (
 	ResearchSubjectStatusWithdrawn ResearchSubjectStatus = "withdrawn"
 )
 
+// known reports whether c is one of the ResearchSubjectStatus values.
+func (c ResearchSubjectStatus) known() bool {
+	switch c {
+	case ResearchSubjectStatusCandidate,
+		ResearchSubjectStatusEligible,
+		ResearchSubjectStatusFollowUp,
+		ResearchSubjectStatusIneligible,
+		ResearchSubjectStatusNotRegistered,
+		ResearchSubjectStatusOffStudy,
+		ResearchSubjectStatusOnStudy,
+		ResearchSubjectStatusOnStudyIntervention,
+		ResearchSubjectStatusOnStudyObservation,
+		ResearchSubjectStatusPendingOnStudy,
+		ResearchSubjectStatusPotentialCandidate,
+		ResearchSubjectStatusScreening,
+		ResearchSubjectStatusWithdrawn:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ResearchSubjectStatus value in definition order.
+func (ResearchSubjectStatus) firstCode() string {
+	return string(ResearchSubjectStatusCandidate)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ResearchSubjectStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ResearchSubjectStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AggregationMode represents AggregationMode.
 type AggregationMode string
 
@@ -3149,6 +8224,32 @@ const (
 	AggregationModeBundled AggregationMode = "bundled"
 )
 
+// known reports whether c is one of the AggregationMode values.
+func (c AggregationMode) known() bool {
+	switch c {
+	case AggregationModeContained,
+		AggregationModeReferenced,
+		AggregationModeBundled:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AggregationMode value in definition order.
+func (AggregationMode) firstCode() string {
+	return string(AggregationModeContained)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AggregationMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AggregationMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SlicingRules represents SlicingRules.
 type SlicingRules string
 
@@ -3162,6 +8263,32 @@ const (
 	SlicingRulesOpenatend SlicingRules = "openAtEnd"
 )
 
+// known reports whether c is one of the SlicingRules values.
+func (c SlicingRules) known() bool {
+	switch c {
+	case SlicingRulesClosed,
+		SlicingRulesOpen,
+		SlicingRulesOpenatend:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SlicingRules value in definition order.
+func (SlicingRules) firstCode() string {
+	return string(SlicingRulesClosed)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SlicingRules) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SlicingRules) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ResponseType represents ResponseType.
 type ResponseType string
 
@@ -3175,6 +8302,32 @@ const (
 	ResponseTypeFatalError ResponseType = "fatal-error"
 )
 
+// known reports whether c is one of the ResponseType values.
+func (c ResponseType) known() bool {
+	switch c {
+	case ResponseTypeOk,
+		ResponseTypeTransientError,
+		ResponseTypeFatalError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ResponseType value in definition order.
+func (ResponseType) firstCode() string {
+	return string(ResponseTypeOk)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ResponseType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ResponseType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RestfulCapabilityMode represents RestfulCapabilityMode.
 type RestfulCapabilityMode string
 
@@ -3186,6 +8339,31 @@ const (
 	RestfulCapabilityModeServer RestfulCapabilityMode = "server"
 )
 
+// known reports whether c is one of the RestfulCapabilityMode values.
+func (c RestfulCapabilityMode) known() bool {
+	switch c {
+	case RestfulCapabilityModeClient,
+		RestfulCapabilityModeServer:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RestfulCapabilityMode value in definition order.
+func (RestfulCapabilityMode) firstCode() string {
+	return string(RestfulCapabilityModeClient)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RestfulCapabilityMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RestfulCapabilityMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SearchComparator represents SearchComparator.
 type SearchComparator string
 
@@ -3211,6 +8389,38 @@ const (
 	SearchComparatorAp SearchComparator = "ap"
 )
 
+// known reports whether c is one of the SearchComparator values.
+func (c SearchComparator) known() bool {
+	switch c {
+	case SearchComparatorEq,
+		SearchComparatorNe,
+		SearchComparatorGt,
+		SearchComparatorLt,
+		SearchComparatorGe,
+		SearchComparatorLe,
+		SearchComparatorSa,
+		SearchComparatorEb,
+		SearchComparatorAp:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SearchComparator value in definition order.
+func (SearchComparator) firstCode() string {
+	return string(SearchComparatorEq)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SearchComparator) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SearchComparator) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SearchEntryMode represents SearchEntryMode.
 type SearchEntryMode string
 
@@ -3224,6 +8434,32 @@ const (
 	SearchEntryModeOutcome SearchEntryMode = "outcome"
 )
 
+// known reports whether c is one of the SearchEntryMode values.
+func (c SearchEntryMode) known() bool {
+	switch c {
+	case SearchEntryModeMatch,
+		SearchEntryModeInclude,
+		SearchEntryModeOutcome:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SearchEntryMode value in definition order.
+func (SearchEntryMode) firstCode() string {
+	return string(SearchEntryModeMatch)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SearchEntryMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SearchEntryMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SearchModifierCode represents SearchModifierCode.
 type SearchModifierCode string
 
@@ -3255,6 +8491,41 @@ const (
 	SearchModifierCodeOftype SearchModifierCode = "ofType"
 )
 
+// known reports whether c is one of the SearchModifierCode values.
+func (c SearchModifierCode) known() bool {
+	switch c {
+	case SearchModifierCodeMissing,
+		SearchModifierCodeExact,
+		SearchModifierCodeContains,
+		SearchModifierCodeNot,
+		SearchModifierCodeText,
+		SearchModifierCodeIn,
+		SearchModifierCodeNotIn,
+		SearchModifierCodeBelow,
+		SearchModifierCodeAbove,
+		SearchModifierCodeType,
+		SearchModifierCodeIdentifier,
+		SearchModifierCodeOftype:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SearchModifierCode value in definition order.
+func (SearchModifierCode) firstCode() string {
+	return string(SearchModifierCodeMissing)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SearchModifierCode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SearchModifierCode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SearchParamType represents SearchParamType.
 type SearchParamType string
 
@@ -3280,6 +8551,38 @@ const (
 	SearchParamTypeSpecial SearchParamType = "special"
 )
 
+// known reports whether c is one of the SearchParamType values.
+func (c SearchParamType) known() bool {
+	switch c {
+	case SearchParamTypeNumber,
+		SearchParamTypeDate,
+		SearchParamTypeString,
+		SearchParamTypeToken,
+		SearchParamTypeReference,
+		SearchParamTypeComposite,
+		SearchParamTypeQuantity,
+		SearchParamTypeUri,
+		SearchParamTypeSpecial:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SearchParamType value in definition order.
+func (SearchParamType) firstCode() string {
+	return string(SearchParamTypeNumber)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SearchParamType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SearchParamType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // XPathUsageType represents XPathUsageType.
 type XPathUsageType string
 
@@ -3297,6 +8600,34 @@ const (
 	XPathUsageTypeOther XPathUsageType = "other"
 )
 
+// known reports whether c is one of the XPathUsageType values.
+func (c XPathUsageType) known() bool {
+	switch c {
+	case XPathUsageTypeNormal,
+		XPathUsageTypePhonetic,
+		XPathUsageTypeNearby,
+		XPathUsageTypeDistance,
+		XPathUsageTypeOther:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first XPathUsageType value in definition order.
+func (XPathUsageType) firstCode() string {
+	return string(XPathUsageTypeNormal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c XPathUsageType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *XPathUsageType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SequenceType represents sequenceType.
 type SequenceType string
 
@@ -3310,6 +8641,32 @@ const (
 	SequenceTypeRna SequenceType = "rna"
 )
 
+// known reports whether c is one of the SequenceType values.
+func (c SequenceType) known() bool {
+	switch c {
+	case SequenceTypeAa,
+		SequenceTypeDna,
+		SequenceTypeRna:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SequenceType value in definition order.
+func (SequenceType) firstCode() string {
+	return string(SequenceTypeAa)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SequenceType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SequenceType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SlotStatus represents SlotStatus.
 type SlotStatus string
 
@@ -3327,6 +8684,34 @@ const (
 	SlotStatusEnteredInError SlotStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the SlotStatus values.
+func (c SlotStatus) known() bool {
+	switch c {
+	case SlotStatusBusy,
+		SlotStatusFree,
+		SlotStatusBusyUnavailable,
+		SlotStatusBusyTentative,
+		SlotStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SlotStatus value in definition order.
+func (SlotStatus) firstCode() string {
+	return string(SlotStatusBusy)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SlotStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SlotStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SortDirection represents SortDirection.
 type SortDirection string
 
@@ -3338,6 +8723,31 @@ const (
 	SortDirectionDescending SortDirection = "descending"
 )
 
+// known reports whether c is one of the SortDirection values.
+func (c SortDirection) known() bool {
+	switch c {
+	case SortDirectionAscending,
+		SortDirectionDescending:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SortDirection value in definition order.
+func (SortDirection) firstCode() string {
+	return string(SortDirectionAscending)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SortDirection) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SortDirection) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SpecimenContainedPreference represents SpecimenContainedPreference.
 type SpecimenContainedPreference string
 
@@ -3349,6 +8759,31 @@ const (
 	SpecimenContainedPreferenceAlternate SpecimenContainedPreference = "alternate"
 )
 
+// known reports whether c is one of the SpecimenContainedPreference values.
+func (c SpecimenContainedPreference) known() bool {
+	switch c {
+	case SpecimenContainedPreferencePreferred,
+		SpecimenContainedPreferenceAlternate:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SpecimenContainedPreference value in definition order.
+func (SpecimenContainedPreference) firstCode() string {
+	return string(SpecimenContainedPreferencePreferred)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SpecimenContainedPreference) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SpecimenContainedPreference) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SpecimenStatus represents SpecimenStatus.
 type SpecimenStatus string
 
@@ -3364,6 +8799,33 @@ const (
 	SpecimenStatusEnteredInError SpecimenStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the SpecimenStatus values.
+func (c SpecimenStatus) known() bool {
+	switch c {
+	case SpecimenStatusAvailable,
+		SpecimenStatusUnavailable,
+		SpecimenStatusUnsatisfactory,
+		SpecimenStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SpecimenStatus value in definition order.
+func (SpecimenStatus) firstCode() string {
+	return string(SpecimenStatusAvailable)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SpecimenStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SpecimenStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StrandType represents strandType.
 type StrandType string
 
@@ -3375,6 +8837,31 @@ const (
 	StrandTypeCrick StrandType = "crick"
 )
 
+// known reports whether c is one of the StrandType values.
+func (c StrandType) known() bool {
+	switch c {
+	case StrandTypeWatson,
+		StrandTypeCrick:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StrandType value in definition order.
+func (StrandType) firstCode() string {
+	return string(StrandTypeWatson)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StrandType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StrandType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureDefinitionKind represents StructureDefinitionKind.
 type StructureDefinitionKind string
 
@@ -3390,6 +8877,33 @@ const (
 	StructureDefinitionKindLogical StructureDefinitionKind = "logical"
 )
 
+// known reports whether c is one of the StructureDefinitionKind values.
+func (c StructureDefinitionKind) known() bool {
+	switch c {
+	case StructureDefinitionKindPrimitiveType,
+		StructureDefinitionKindComplexType,
+		StructureDefinitionKindResource,
+		StructureDefinitionKindLogical:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureDefinitionKind value in definition order.
+func (StructureDefinitionKind) firstCode() string {
+	return string(StructureDefinitionKindPrimitiveType)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureDefinitionKind) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureDefinitionKind) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SubscriptionChannelType represents SubscriptionChannelType.
 type SubscriptionChannelType string
 
@@ -3407,6 +8921,34 @@ const (
 	SubscriptionChannelTypeMessage SubscriptionChannelType = "message"
 )
 
+// known reports whether c is one of the SubscriptionChannelType values.
+func (c SubscriptionChannelType) known() bool {
+	switch c {
+	case SubscriptionChannelTypeRestHook,
+		SubscriptionChannelTypeWebsocket,
+		SubscriptionChannelTypeEmail,
+		SubscriptionChannelTypeSms,
+		SubscriptionChannelTypeMessage:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SubscriptionChannelType value in definition order.
+func (SubscriptionChannelType) firstCode() string {
+	return string(SubscriptionChannelTypeRestHook)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SubscriptionChannelType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SubscriptionChannelType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SubscriptionNotificationType represents SubscriptionNotificationType.
 type SubscriptionNotificationType string
 
@@ -3424,6 +8966,34 @@ const (
 	SubscriptionNotificationTypeQueryEvent SubscriptionNotificationType = "query-event"
 )
 
+// known reports whether c is one of the SubscriptionNotificationType values.
+func (c SubscriptionNotificationType) known() bool {
+	switch c {
+	case SubscriptionNotificationTypeHandshake,
+		SubscriptionNotificationTypeHeartbeat,
+		SubscriptionNotificationTypeEventNotification,
+		SubscriptionNotificationTypeQueryStatus,
+		SubscriptionNotificationTypeQueryEvent:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SubscriptionNotificationType value in definition order.
+func (SubscriptionNotificationType) firstCode() string {
+	return string(SubscriptionNotificationTypeHandshake)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SubscriptionNotificationType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SubscriptionNotificationType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SubscriptionSearchModifier represents Subscription Search Modifier.
 type SubscriptionSearchModifier string
 
@@ -3461,6 +9031,44 @@ const (
 	SubscriptionSearchModifierOfType SubscriptionSearchModifier = "of-type"
 )
 
+// known reports whether c is one of the SubscriptionSearchModifier values.
+func (c SubscriptionSearchModifier) known() bool {
+	switch c {
+	case SubscriptionSearchModifierEqual,
+		SubscriptionSearchModifierEq,
+		SubscriptionSearchModifierNe,
+		SubscriptionSearchModifierGt,
+		SubscriptionSearchModifierLt,
+		SubscriptionSearchModifierGe,
+		SubscriptionSearchModifierLe,
+		SubscriptionSearchModifierSa,
+		SubscriptionSearchModifierEb,
+		SubscriptionSearchModifierAp,
+		SubscriptionSearchModifierAbove,
+		SubscriptionSearchModifierBelow,
+		SubscriptionSearchModifierIn,
+		SubscriptionSearchModifierNotIn,
+		SubscriptionSearchModifierOfType:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SubscriptionSearchModifier value in definition order.
+func (SubscriptionSearchModifier) firstCode() string {
+	return string(SubscriptionSearchModifierEqual)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SubscriptionSearchModifier) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SubscriptionSearchModifier) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SubscriptionStatusCodes represents SubscriptionStatusCodes.
 type SubscriptionStatusCodes string
 
@@ -3476,6 +9084,33 @@ const (
 	SubscriptionStatusCodesOff SubscriptionStatusCodes = "off"
 )
 
+// known reports whether c is one of the SubscriptionStatusCodes values.
+func (c SubscriptionStatusCodes) known() bool {
+	switch c {
+	case SubscriptionStatusCodesRequested,
+		SubscriptionStatusCodesActive,
+		SubscriptionStatusCodesError,
+		SubscriptionStatusCodesOff:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SubscriptionStatusCodes value in definition order.
+func (SubscriptionStatusCodes) firstCode() string {
+	return string(SubscriptionStatusCodesRequested)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SubscriptionStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SubscriptionStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CriteriaNotExistsBehavior represents CriteriaNotExistsBehavior.
 type CriteriaNotExistsBehavior string
 
@@ -3487,6 +9122,31 @@ const (
 	CriteriaNotExistsBehaviorTestFails CriteriaNotExistsBehavior = "test-fails"
 )
 
+// known reports whether c is one of the CriteriaNotExistsBehavior values.
+func (c CriteriaNotExistsBehavior) known() bool {
+	switch c {
+	case CriteriaNotExistsBehaviorTestPasses,
+		CriteriaNotExistsBehaviorTestFails:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CriteriaNotExistsBehavior value in definition order.
+func (CriteriaNotExistsBehavior) firstCode() string {
+	return string(CriteriaNotExistsBehaviorTestPasses)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CriteriaNotExistsBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CriteriaNotExistsBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // FHIRSubstanceStatus represents FHIRSubstanceStatus.
 type FHIRSubstanceStatus string
 
@@ -3500,6 +9160,32 @@ const (
 	FHIRSubstanceStatusEnteredInError FHIRSubstanceStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the FHIRSubstanceStatus values.
+func (c FHIRSubstanceStatus) known() bool {
+	switch c {
+	case FHIRSubstanceStatusActive,
+		FHIRSubstanceStatusInactive,
+		FHIRSubstanceStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FHIRSubstanceStatus value in definition order.
+func (FHIRSubstanceStatus) firstCode() string {
+	return string(FHIRSubstanceStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FHIRSubstanceStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FHIRSubstanceStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SupplyDeliveryStatus represents SupplyDeliveryStatus.
 type SupplyDeliveryStatus string
 
@@ -3515,6 +9201,33 @@ const (
 	SupplyDeliveryStatusEnteredInError SupplyDeliveryStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the SupplyDeliveryStatus values.
+func (c SupplyDeliveryStatus) known() bool {
+	switch c {
+	case SupplyDeliveryStatusInProgress,
+		SupplyDeliveryStatusCompleted,
+		SupplyDeliveryStatusAbandoned,
+		SupplyDeliveryStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SupplyDeliveryStatus value in definition order.
+func (SupplyDeliveryStatus) firstCode() string {
+	return string(SupplyDeliveryStatusInProgress)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SupplyDeliveryStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SupplyDeliveryStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SupplyRequestStatus represents SupplyRequestStatus.
 type SupplyRequestStatus string
 
@@ -3536,6 +9249,36 @@ const (
 	SupplyRequestStatusUnknown SupplyRequestStatus = "unknown"
 )
 
+// known reports whether c is one of the SupplyRequestStatus values.
+func (c SupplyRequestStatus) known() bool {
+	switch c {
+	case SupplyRequestStatusDraft,
+		SupplyRequestStatusActive,
+		SupplyRequestStatusSuspended,
+		SupplyRequestStatusCancelled,
+		SupplyRequestStatusCompleted,
+		SupplyRequestStatusEnteredInError,
+		SupplyRequestStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SupplyRequestStatus value in definition order.
+func (SupplyRequestStatus) firstCode() string {
+	return string(SupplyRequestStatusDraft)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SupplyRequestStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SupplyRequestStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SystemRestfulInteraction represents SystemRestfulInteraction.
 type SystemRestfulInteraction string
 
@@ -3547,6 +9290,33 @@ const (
 	SystemRestfulInteractionHistorySystem SystemRestfulInteraction = "history-system"
 )
 
+// known reports whether c is one of the SystemRestfulInteraction values.
+func (c SystemRestfulInteraction) known() bool {
+	switch c {
+	case SystemRestfulInteractionTransaction,
+		SystemRestfulInteractionBatch,
+		SystemRestfulInteractionSearchSystem,
+		SystemRestfulInteractionHistorySystem:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SystemRestfulInteraction value in definition order.
+func (SystemRestfulInteraction) firstCode() string {
+	return string(SystemRestfulInteractionTransaction)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SystemRestfulInteraction) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SystemRestfulInteraction) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TaskIntent represents TaskIntent.
 type TaskIntent string
 
@@ -3564,6 +9334,38 @@ const (
 	TaskIntentOption        TaskIntent = "option"
 )
 
+// known reports whether c is one of the TaskIntent values.
+func (c TaskIntent) known() bool {
+	switch c {
+	case TaskIntentUnknown,
+		TaskIntentProposal,
+		TaskIntentPlan,
+		TaskIntentOrder,
+		TaskIntentOriginalOrder,
+		TaskIntentReflexOrder,
+		TaskIntentFillerOrder,
+		TaskIntentInstanceOrder,
+		TaskIntentOption:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TaskIntent value in definition order.
+func (TaskIntent) firstCode() string {
+	return string(TaskIntentUnknown)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TaskIntent) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TaskIntent) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TaskStatus represents TaskStatus.
 type TaskStatus string
 
@@ -3595,6 +9397,41 @@ const (
 	TaskStatusEnteredInError TaskStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the TaskStatus values.
+func (c TaskStatus) known() bool {
+	switch c {
+	case TaskStatusDraft,
+		TaskStatusRequested,
+		TaskStatusReceived,
+		TaskStatusAccepted,
+		TaskStatusRejected,
+		TaskStatusReady,
+		TaskStatusCancelled,
+		TaskStatusInProgress,
+		TaskStatusOnHold,
+		TaskStatusFailed,
+		TaskStatusCompleted,
+		TaskStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TaskStatus value in definition order.
+func (TaskStatus) firstCode() string {
+	return string(TaskStatusDraft)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TaskStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TaskStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TriggerType represents TriggerType.
 type TriggerType string
 
@@ -3618,6 +9455,37 @@ const (
 	TriggerTypeDataAccessEnded TriggerType = "data-access-ended"
 )
 
+// known reports whether c is one of the TriggerType values.
+func (c TriggerType) known() bool {
+	switch c {
+	case TriggerTypeNamedEvent,
+		TriggerTypePeriodic,
+		TriggerTypeDataChanged,
+		TriggerTypeDataAdded,
+		TriggerTypeDataModified,
+		TriggerTypeDataRemoved,
+		TriggerTypeDataAccessed,
+		TriggerTypeDataAccessEnded:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TriggerType value in definition order.
+func (TriggerType) firstCode() string {
+	return string(TriggerTypeNamedEvent)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TriggerType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TriggerType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TypeDerivationRule represents TypeDerivationRule.
 type TypeDerivationRule string
 
@@ -3629,6 +9497,31 @@ const (
 	TypeDerivationRuleConstraint TypeDerivationRule = "constraint"
 )
 
+// known reports whether c is one of the TypeDerivationRule values.
+func (c TypeDerivationRule) known() bool {
+	switch c {
+	case TypeDerivationRuleSpecialization,
+		TypeDerivationRuleConstraint:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TypeDerivationRule value in definition order.
+func (TypeDerivationRule) firstCode() string {
+	return string(TypeDerivationRuleSpecialization)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TypeDerivationRule) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TypeDerivationRule) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TypeRestfulInteraction represents TypeRestfulInteraction.
 type TypeRestfulInteraction string
 
@@ -3645,6 +9538,38 @@ const (
 	TypeRestfulInteractionSearchType      TypeRestfulInteraction = "search-type"
 )
 
+// known reports whether c is one of the TypeRestfulInteraction values.
+func (c TypeRestfulInteraction) known() bool {
+	switch c {
+	case TypeRestfulInteractionRead,
+		TypeRestfulInteractionVread,
+		TypeRestfulInteractionUpdate,
+		TypeRestfulInteractionPatch,
+		TypeRestfulInteractionDelete,
+		TypeRestfulInteractionHistoryInstance,
+		TypeRestfulInteractionHistoryType,
+		TypeRestfulInteractionCreate,
+		TypeRestfulInteractionSearchType:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TypeRestfulInteraction value in definition order.
+func (TypeRestfulInteraction) firstCode() string {
+	return string(TypeRestfulInteractionRead)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TypeRestfulInteraction) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TypeRestfulInteraction) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // UDIEntryType represents UDIEntryType.
 type UDIEntryType string
 
@@ -3664,6 +9589,35 @@ const (
 	UDIEntryTypeUnknown UDIEntryType = "unknown"
 )
 
+// known reports whether c is one of the UDIEntryType values.
+func (c UDIEntryType) known() bool {
+	switch c {
+	case UDIEntryTypeBarcode,
+		UDIEntryTypeRfid,
+		UDIEntryTypeManual,
+		UDIEntryTypeCard,
+		UDIEntryTypeSelfReported,
+		UDIEntryTypeUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first UDIEntryType value in definition order.
+func (UDIEntryType) firstCode() string {
+	return string(UDIEntryTypeBarcode)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c UDIEntryType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *UDIEntryType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // UnitsOfTime represents UnitsOfTime.
 type UnitsOfTime string
 
@@ -3685,6 +9639,36 @@ const (
 	UnitsOfTimeA UnitsOfTime = "a"
 )
 
+// known reports whether c is one of the UnitsOfTime values.
+func (c UnitsOfTime) known() bool {
+	switch c {
+	case UnitsOfTimeS,
+		UnitsOfTimeMin,
+		UnitsOfTimeH,
+		UnitsOfTimeD,
+		UnitsOfTimeWk,
+		UnitsOfTimeMo,
+		UnitsOfTimeA:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first UnitsOfTime value in definition order.
+func (UnitsOfTime) firstCode() string {
+	return string(UnitsOfTimeS)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c UnitsOfTime) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *UnitsOfTime) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EvidenceVariableHandling represents EvidenceVariableHandling.
 type EvidenceVariableHandling string
 
@@ -3700,6 +9684,33 @@ const (
 	EvidenceVariableHandlingPolychotomous EvidenceVariableHandling = "polychotomous"
 )
 
+// known reports whether c is one of the EvidenceVariableHandling values.
+func (c EvidenceVariableHandling) known() bool {
+	switch c {
+	case EvidenceVariableHandlingContinuous,
+		EvidenceVariableHandlingDichotomous,
+		EvidenceVariableHandlingOrdinal,
+		EvidenceVariableHandlingPolychotomous:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EvidenceVariableHandling value in definition order.
+func (EvidenceVariableHandling) firstCode() string {
+	return string(EvidenceVariableHandlingContinuous)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EvidenceVariableHandling) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EvidenceVariableHandling) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // VariableType represents VariableType.
 type VariableType string
 
@@ -3713,6 +9724,32 @@ const (
 	VariableTypeDescriptive VariableType = "descriptive"
 )
 
+// known reports whether c is one of the VariableType values.
+func (c VariableType) known() bool {
+	switch c {
+	case VariableTypeDichotomous,
+		VariableTypeContinuous,
+		VariableTypeDescriptive:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first VariableType value in definition order.
+func (VariableType) firstCode() string {
+	return string(VariableTypeDichotomous)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c VariableType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *VariableType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // Status represents allowed values for the status code system.
 type Status string
 
@@ -3732,6 +9769,35 @@ const (
 	StatusRevalFail Status = "reval-fail"
 )
 
+// known reports whether c is one of the Status values.
+func (c Status) known() bool {
+	switch c {
+	case StatusAttested,
+		StatusValidated,
+		StatusInProcess,
+		StatusReqRevalid,
+		StatusValFail,
+		StatusRevalFail:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first Status value in definition order.
+func (Status) firstCode() string {
+	return string(StatusAttested)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c Status) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *Status) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ResourceVersionPolicy represents ResourceVersionPolicy.
 type ResourceVersionPolicy string
 
@@ -3745,6 +9811,32 @@ const (
 	ResourceVersionPolicyVersionedUpdate ResourceVersionPolicy = "versioned-update"
 )
 
+// known reports whether c is one of the ResourceVersionPolicy values.
+func (c ResourceVersionPolicy) known() bool {
+	switch c {
+	case ResourceVersionPolicyNoVersion,
+		ResourceVersionPolicyVersioned,
+		ResourceVersionPolicyVersionedUpdate:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ResourceVersionPolicy value in definition order.
+func (ResourceVersionPolicy) firstCode() string {
+	return string(ResourceVersionPolicyNoVersion)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ResourceVersionPolicy) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ResourceVersionPolicy) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // VisionBase represents VisionBase.
 type VisionBase string
 
@@ -3760,6 +9852,33 @@ const (
 	VisionBaseOut VisionBase = "out"
 )
 
+// known reports whether c is one of the VisionBase values.
+func (c VisionBase) known() bool {
+	switch c {
+	case VisionBaseUp,
+		VisionBaseDown,
+		VisionBaseIn,
+		VisionBaseOut:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first VisionBase value in definition order.
+func (VisionBase) firstCode() string {
+	return string(VisionBaseUp)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c VisionBase) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *VisionBase) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // VisionEyes represents VisionEyes.
 type VisionEyes string
 
@@ -3770,3 +9889,28 @@ const (
 	// VisionEyesLeft - Left Eye
 	VisionEyesLeft VisionEyes = "left"
 )
+
+// known reports whether c is one of the VisionEyes values.
+func (c VisionEyes) known() bool {
+	switch c {
+	case VisionEyesRight,
+		VisionEyesLeft:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first VisionEyes value in definition order.
+func (VisionEyes) firstCode() string {
+	return string(VisionEyesRight)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c VisionEyes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *VisionEyes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
@@ -1,9 +1,12 @@
 package r4b
 
 import (
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCodeSystemTypes(t *testing.T) {
@@ -92,3 +95,52 @@ func TestQuantityComparator(t *testing.T) {
 		assert.Equal(t, QuantityComparator(">"), QuantityComparatorGreaterThan)
 	})
 }
+
+func TestStrictCodes(t *testing.T) {
+	jsonData := []byte(`{"resourceType":"Observation","status":"bogus","code":{"text":"hr"}}`)
+	xmlData := []byte(`<Observation xmlns="http://hl7.org/fhir"><status value="bogus"/><code><text value="hr"/></code></Observation>`)
+
+	t.Run("lenient by default", func(t *testing.T) {
+		require.False(t, StrictCodes)
+
+		var obs Observation
+		require.NoError(t, json.Unmarshal(jsonData, &obs))
+		assert.Equal(t, ObservationStatus("bogus"), *obs.Status)
+
+		r, err := UnmarshalResourceXML(xmlData)
+		require.NoError(t, err)
+		assert.Equal(t, ObservationStatus("bogus"), *r.(*Observation).Status)
+	})
+
+	t.Run("strict rejects unknown codes", func(t *testing.T) {
+		StrictCodes = true
+		t.Cleanup(func() { StrictCodes = false })
+
+		var obs Observation
+		err := json.Unmarshal(jsonData, &obs)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown r4b.ObservationStatus code "bogus"`)
+
+		_, err = UnmarshalResourceXML(xmlData)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"bogus"`)
+	})
+
+	t.Run("strict accepts known codes", func(t *testing.T) {
+		StrictCodes = true
+		t.Cleanup(func() { StrictCodes = false })
+
+		var obs Observation
+		require.NoError(t, json.Unmarshal([]byte(`{"resourceType":"Observation","status":"final","code":{}}`), &obs))
+		assert.Equal(t, ObservationStatusFinal, *obs.Status)
+	})
+}
+
+func TestCodeSystemTypeString(t *testing.T) {
+	assert.Equal(t, "final", fmt.Sprintf("%s", ObservationStatusFinal))
+	assert.Equal(t, "final", fmt.Sprintf("%v", ObservationStatusFinal))
+	assert.Equal(t, "female", AdministrativeGenderFemale.String())
+
+	var s fmt.Stringer = HTTPVerbPost
+	assert.Equal(t, "POST", s.String())
+}
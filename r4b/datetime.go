@@ -0,0 +1,30 @@
+package r4b
+
+import "time"
+
+// Layouts of the strings produced by the Format functions.
+const (
+	dateTimeLayout = time.RFC3339
+	instantLayout  = time.RFC3339Nano
+	dateLayout     = "2006-01-02"
+)
+
+// FormatDateTime formats t as a FHIR dateTime to the second, with the UTC
+// offset of t's location, e.g. "2024-03-05T14:30:00+01:00". Fractional
+// seconds are dropped; use FormatInstant to keep them.
+func FormatDateTime(t time.Time) string {
+	return t.Format(dateTimeLayout)
+}
+
+// FormatInstant formats t as a FHIR instant with the UTC offset of t's
+// location and as many fractional second digits as needed to represent t
+// exactly, e.g. "2024-03-05T14:30:00.123+01:00".
+func FormatInstant(t time.Time) string {
+	return t.Format(instantLayout)
+}
+
+// FormatDate formats t as a FHIR date, e.g. "2024-03-05". The day is taken
+// in t's location.
+func FormatDate(t time.Time) string {
+	return t.Format(dateLayout)
+}
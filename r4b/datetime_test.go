@@ -0,0 +1,49 @@
+package r4b
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatDateTime(t *testing.T) {
+	loc := time.FixedZone("", 90*60)
+	tm := time.Date(2024, 3, 5, 14, 30, 15, 123456789, loc)
+
+	s := FormatDateTime(tm)
+	assert.Equal(t, "2024-03-05T14:30:15+01:30", s)
+	parsed, ok := parseDateTime(&s)
+	require.True(t, ok)
+	assert.True(t, tm.Truncate(time.Second).Equal(parsed))
+
+	assert.Equal(t, "2024-03-05T13:00:15Z", FormatDateTime(tm.UTC().Truncate(time.Second)))
+}
+
+func TestFormatInstant(t *testing.T) {
+	loc := time.FixedZone("", -5*60*60)
+	for _, tm := range []time.Time{
+		time.Date(2024, 3, 5, 14, 30, 15, 123000000, loc),
+		time.Date(2024, 3, 5, 14, 30, 15, 123456789, time.UTC),
+		time.Date(2024, 3, 5, 14, 30, 15, 0, loc),
+	} {
+		s := FormatInstant(tm)
+		parsed, err := time.Parse(time.RFC3339, s)
+		require.NoError(t, err, s)
+		assert.True(t, tm.Equal(parsed), s)
+	}
+	assert.Equal(t, "2024-03-05T14:30:15.123-05:00", FormatInstant(time.Date(2024, 3, 5, 14, 30, 15, 123000000, loc)))
+}
+
+func TestFormatDate(t *testing.T) {
+	loc := time.FixedZone("", 10*60*60)
+	tm := time.Date(2024, 3, 5, 2, 0, 0, 0, loc)
+
+	s := FormatDate(tm)
+	assert.Equal(t, "2024-03-05", s)
+	assert.Equal(t, "2024-03-04", FormatDate(tm.UTC()))
+	parsed, ok := parseDateTime(&s)
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), parsed)
+}
@@ -91,10 +91,32 @@ func (d Decimal) Equal(other Decimal) bool {
 	return d.Float64() == other.Float64()
 }
 
+// DecimalFormat, if non-nil, formats every Decimal marshaled to JSON in
+// place of its exact textual representation, for consumers that cannot
+// handle precision-preserving decimals. It must return a valid JSON number.
+// See FixedDecimalFormat. XML output is not affected.
+//
+// Modify it only during initialization.
+var DecimalFormat func(Decimal) string
+
+// FixedDecimalFormat returns a DecimalFormat that writes decimals as plain
+// numbers with exactly places digits after the decimal point, rounding
+// through float64 (e.g., with places 2, 1.5 marshals as 1.50 and 0.125 as
+// 0.12).
+func FixedDecimalFormat(places int) func(Decimal) string {
+	return func(d Decimal) string {
+		return strconv.FormatFloat(d.Float64(), 'f', places, 64)
+	}
+}
+
 // MarshalJSON implements json.Marshaler.
 // Emits the decimal as a bare JSON number, preserving the original precision
-// (e.g., Decimal("1.50") marshals as 1.50, not "1.50" or 1.5).
+// (e.g., Decimal("1.50") marshals as 1.50, not "1.50" or 1.5), unless
+// DecimalFormat is set.
 func (d Decimal) MarshalJSON() ([]byte, error) {
+	if DecimalFormat != nil {
+		return []byte(DecimalFormat(d)), nil
+	}
 	if d.value == "" {
 		return []byte("0"), nil
 	}
@@ -0,0 +1,49 @@
+package r4b_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4b"
+)
+
+func TestDiffReport(t *testing.T) {
+	t.Run("changed primitives", func(t *testing.T) {
+		a := &r4b.Patient{
+			Id:     ptrString("p1"),
+			Active: ptrBool(true),
+			Name:   []r4b.HumanName{{Family: ptrString("Doe"), Given: []string{"John"}}},
+		}
+		b := &r4b.Patient{
+			Id:     ptrString("p1"),
+			Active: ptrBool(false),
+			Name:   []r4b.HumanName{{Family: ptrString("Smith"), Given: []string{"John"}}},
+		}
+
+		changes := r4b.DiffReport(a, b)
+		require.Len(t, changes, 2)
+		assert.Equal(t, r4b.Change{Path: "Patient.active", Old: true, New: false}, changes[0])
+		assert.Equal(t, r4b.Change{Path: "Patient.name[0].family", Old: "Doe", New: "Smith"}, changes[1])
+		assert.Equal(t, `Patient.name[0].family: "Doe" -> "Smith"`, changes[1].String())
+	})
+
+	t.Run("added and removed elements", func(t *testing.T) {
+		gender := r4b.AdministrativeGenderMale
+		a := &r4b.Patient{Gender: &gender}
+		b := &r4b.Patient{BirthDate: ptrString("1970-01-01")}
+
+		changes := r4b.DiffReport(a, b)
+		require.Len(t, changes, 2)
+		assert.Equal(t, r4b.Change{Path: "Patient.gender", Old: r4b.AdministrativeGenderMale}, changes[0])
+		assert.Equal(t, r4b.Change{Path: "Patient.birthDate", New: "1970-01-01"}, changes[1])
+		assert.Equal(t, `Patient.gender: "male" -> (absent)`, changes[0].String())
+	})
+
+	t.Run("identical resources", func(t *testing.T) {
+		a := &r4b.Patient{Active: ptrBool(true), Name: []r4b.HumanName{{Family: ptrString("Doe")}}}
+		b := &r4b.Patient{Active: ptrBool(true), Name: []r4b.HumanName{{Family: ptrString("Doe")}}}
+		assert.Empty(t, r4b.DiffReport(a, b))
+	})
+}
@@ -0,0 +1,59 @@
+package r4b_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4b"
+)
+
+func TestEffectiveTime(t *testing.T) {
+	t.Run("observation effectiveDateTime", func(t *testing.T) {
+		obs := &r4b.Observation{EffectiveDateTime: ptrString("2024-03-05T10:30:00+02:00")}
+
+		got, ok := r4b.EffectiveTime(obs)
+		require.True(t, ok)
+		assert.True(t, got.Equal(time.Date(2024, 3, 5, 8, 30, 0, 0, time.UTC)))
+	})
+
+	t.Run("period start", func(t *testing.T) {
+		report := &r4b.DiagnosticReport{EffectivePeriod: &r4b.Period{
+			Start: ptrString("2024-03-05"),
+			End:   ptrString("2024-03-07"),
+		}}
+
+		got, ok := r4b.EffectiveTime(report)
+		require.True(t, ok)
+		assert.Equal(t, time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), got)
+	})
+
+	t.Run("procedure period", func(t *testing.T) {
+		proc := &r4b.Procedure{PerformedPeriod: &r4b.Period{Start: ptrString("2024-03-05T10:30:00Z")}}
+
+		got, ok := r4b.EffectiveTime(proc)
+		require.True(t, ok)
+		assert.Equal(t, time.Date(2024, 3, 5, 10, 30, 0, 0, time.UTC), got)
+	})
+
+	t.Run("partial date", func(t *testing.T) {
+		obs := &r4b.Observation{EffectiveDateTime: ptrString("2024-03")}
+
+		got, ok := r4b.EffectiveTime(obs)
+		require.True(t, ok)
+		assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), got)
+	})
+
+	t.Run("absent or unsupported", func(t *testing.T) {
+		_, ok := r4b.EffectiveTime(&r4b.Observation{})
+		assert.False(t, ok)
+
+		_, ok = r4b.EffectiveTime(&r4b.Observation{EffectiveDateTime: ptrString("not a date")})
+		assert.False(t, ok)
+
+		_, ok = r4b.EffectiveTime(&r4b.Patient{})
+		assert.False(t, ok)
+	})
+}
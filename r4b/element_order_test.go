@@ -0,0 +1,89 @@
+package r4b
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestElementOrder_Patient(t *testing.T) {
+	order := ElementOrder("Patient")
+	require.GreaterOrEqual(t, len(order), 8)
+	assert.Equal(t, []string{
+		"id", "meta", "implicitRules", "language", "text",
+		"contained", "extension", "modifierExtension",
+	}, order[:8])
+	assert.NotContains(t, order, "_birthDate")
+	assert.Less(t, indexOf(order, "gender"), indexOf(order, "birthDate"))
+}
+
+func TestElementOrder_ChoiceAndDatatypes(t *testing.T) {
+	order := ElementOrder("Patient")
+	assert.Equal(t, indexOf(order, "deceasedBoolean")+1, indexOf(order, "deceasedDateTime"))
+
+	assert.Equal(t, []string{"id", "extension", "use", "text", "family", "given", "prefix", "suffix", "period"},
+		ElementOrder("HumanName"))
+	assert.NotEmpty(t, ElementOrder("PatientContact"))
+}
+
+func TestElementOrder_Unknown(t *testing.T) {
+	assert.Nil(t, ElementOrder("NotAType"))
+}
+
+func TestElementOrder_ReturnsCopy(t *testing.T) {
+	order := ElementOrder("HumanName")
+	order[0] = "mutated"
+	_ = append(order[:1], "appended")
+
+	assert.Equal(t, "id", ElementOrder("HumanName")[0])
+	assert.Equal(t, "extension", ElementOrder("HumanName")[1])
+}
+
+func indexOf(list []string, s string) int {
+	for i, v := range list {
+		if v == s {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestGoFieldName(t *testing.T) {
+	name, ok := GoFieldName("Patient", "birthDate")
+	assert.True(t, ok)
+	assert.Equal(t, "BirthDate", name)
+
+	name, ok = GoFieldName("Patient", "deceasedDateTime")
+	assert.True(t, ok)
+	assert.Equal(t, "DeceasedDateTime", name)
+
+	name, ok = GoFieldName("HumanName", "family")
+	assert.True(t, ok)
+	assert.Equal(t, "Family", name)
+
+	_, ok = GoFieldName("Patient", "_birthDate")
+	assert.False(t, ok)
+	_, ok = GoFieldName("Patient", "unknown")
+	assert.False(t, ok)
+	_, ok = GoFieldName("Unknown", "id")
+	assert.False(t, ok)
+}
+
+func TestGoFieldName_MatchesJSONTags(t *testing.T) {
+	for _, rt := range AllResourceTypes() {
+		r, err := NewResource(rt)
+		require.NoError(t, err)
+		typ := reflect.TypeOf(r).Elem()
+		for _, el := range ElementOrder(rt) {
+			name, ok := GoFieldName(rt, el)
+			require.True(t, ok, "%s.%s", rt, el)
+			f, ok := typ.FieldByName(name)
+			require.True(t, ok, "%s.%s", rt, name)
+			tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			assert.Equal(t, el, tag, "%s.%s", rt, name)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package r4b
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// Encoder writes FHIR resources as JSON to an output stream, reusing an
+// internal buffer across calls to amortize allocations in batch exports.
+//
+// Like Marshal, it does not HTML-escape narrative content. Each resource is
+// followed by a newline, so a sequence of Encode calls produces NDJSON.
+//
+// An Encoder is not safe for concurrent use.
+type Encoder struct {
+	w   io.Writer
+	buf bytes.Buffer
+	enc *json.Encoder
+}
+
+// NewEncoder returns an Encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	e := &Encoder{w: w}
+	e.enc = json.NewEncoder(&e.buf)
+	e.enc.SetEscapeHTML(false)
+	return e
+}
+
+// Encode writes the JSON encoding of r, followed by a newline, to the stream.
+func (e *Encoder) Encode(r Resource) error {
+	if r == nil {
+		return errors.New("cannot encode nil resource")
+	}
+	e.buf.Reset()
+	if err := e.enc.Encode(r); err != nil {
+		return err
+	}
+	_, err := e.w.Write(e.buf.Bytes())
+	return err
+}
@@ -0,0 +1,154 @@
+package r4b_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4b"
+)
+
+func TestRemoveExtensions(t *testing.T) {
+	const vendor = "http://vendor.example.org/fhir/"
+
+	newPatient := func() *r4b.Patient {
+		return &r4b.Patient{
+			Extension: []r4b.Extension{
+				{Url: vendor + "internal-id", ValueString: ptrString("123")},
+				{Url: "http://hl7.org/fhir/StructureDefinition/patient-birthPlace", ValueString: ptrString("Paris")},
+			},
+			BirthDate: ptrString("1970-01-01"),
+			BirthDateExt: &r4b.Element{Extension: []r4b.Extension{
+				{Url: vendor + "birth-date-source", ValueString: ptrString("registry")},
+			}},
+			Name: []r4b.HumanName{{
+				Family: ptrString("Doe"),
+				FamilyExt: &r4b.Element{Extension: []r4b.Extension{
+					{Url: vendor + "family-source", ValueString: ptrString("import")},
+					{Url: "http://hl7.org/fhir/StructureDefinition/humanname-own-prefix", ValueString: ptrString("van")},
+				}},
+			}},
+		}
+	}
+
+	t.Run("removes matching extensions", func(t *testing.T) {
+		patient := newPatient()
+
+		got, ok := r4b.RemoveExtensions(patient, vendor).(*r4b.Patient)
+		require.True(t, ok)
+		require.Len(t, got.Extension, 1)
+		assert.Equal(t, "http://hl7.org/fhir/StructureDefinition/patient-birthPlace", got.Extension[0].Url)
+		assert.Nil(t, got.BirthDateExt, "emptied companion is removed")
+		assert.Equal(t, "1970-01-01", *got.BirthDate)
+		require.NotNil(t, got.Name[0].FamilyExt)
+		require.Len(t, got.Name[0].FamilyExt.Extension, 1)
+		assert.Equal(t, "http://hl7.org/fhir/StructureDefinition/humanname-own-prefix", got.Name[0].FamilyExt.Extension[0].Url)
+
+		data, err := r4b.Marshal(got)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), vendor)
+		assert.NotContains(t, string(data), "_birthDate")
+	})
+
+	t.Run("original is unchanged", func(t *testing.T) {
+		patient := newPatient()
+
+		r4b.RemoveExtensions(patient, vendor)
+		assert.Len(t, patient.Extension, 2)
+		require.NotNil(t, patient.BirthDateExt)
+		assert.Len(t, patient.BirthDateExt.Extension, 1)
+	})
+
+	t.Run("nested extensions", func(t *testing.T) {
+		patient := &r4b.Patient{Extension: []r4b.Extension{{
+			Url: "http://example.org/complex",
+			Extension: []r4b.Extension{
+				{Url: vendor + "part", ValueString: ptrString("x")},
+				{Url: "part", ValueString: ptrString("y")},
+			},
+		}}}
+
+		got := r4b.RemoveExtensions(patient, vendor).(*r4b.Patient)
+		require.Len(t, got.Extension, 1)
+		require.Len(t, got.Extension[0].Extension, 1)
+		assert.Equal(t, "part", got.Extension[0].Extension[0].Url)
+	})
+
+	t.Run("empty prefix removes all extensions", func(t *testing.T) {
+		patient := newPatient()
+		patient.ModifierExtension = []r4b.Extension{{Url: "http://example.org/modifier", ValueBoolean: ptrBool(true)}}
+
+		got := r4b.RemoveExtensions(patient, "").(*r4b.Patient)
+		assert.Empty(t, got.Extension)
+		assert.Empty(t, got.ModifierExtension)
+		assert.Nil(t, got.BirthDateExt)
+		assert.Nil(t, got.Name[0].FamilyExt)
+		assert.Equal(t, "Doe", *got.Name[0].Family)
+	})
+}
+
+func TestCheckModifierExtensions(t *testing.T) {
+	const (
+		knownURL   = "http://example.org/fhir/StructureDefinition/known-modifier"
+		unknownURL = "http://example.org/fhir/StructureDefinition/unknown-modifier"
+	)
+	known := map[string]bool{knownURL: true}
+
+	t.Run("known", func(t *testing.T) {
+		patient := &r4b.Patient{
+			ModifierExtension: []r4b.Extension{{Url: knownURL, ValueBoolean: ptrBool(true)}},
+			Extension:         []r4b.Extension{{Url: unknownURL, ValueBoolean: ptrBool(true)}},
+		}
+		assert.NoError(t, r4b.CheckModifierExtensions(patient, known))
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		patient := &r4b.Patient{
+			ModifierExtension: []r4b.Extension{{Url: knownURL, ValueBoolean: ptrBool(true)}},
+			Contact: []r4b.PatientContact{{
+				ModifierExtension: []r4b.Extension{{Url: unknownURL, ValueBoolean: ptrBool(true)}},
+			}},
+		}
+		err := r4b.CheckModifierExtensions(patient, known)
+		assert.Equal(t, []string{"Patient.contact[0].modifierExtension[0]"}, validationPaths(t, err))
+		assert.ErrorContains(t, err, unknownURL)
+	})
+}
+
+func TestExtensionURLs(t *testing.T) {
+	patient := &r4b.Patient{
+		Id: ptrString("p1"),
+		Extension: []r4b.Extension{
+			{Url: "http://example.org/race", Extension: []r4b.Extension{
+				{Url: "ombCategory"},
+				{Url: "text", ValueString: ptrString("x")},
+			}},
+		},
+		ModifierExtension: []r4b.Extension{{Url: "http://example.org/modifier"}},
+		BirthDate:         ptrString("1970-01-01"),
+		BirthDateExt: &r4b.Element{Extension: []r4b.Extension{
+			{Url: "http://example.org/birthTime"},
+		}},
+		Name: []r4b.HumanName{{
+			Family:    ptrString("Doe"),
+			Extension: []r4b.Extension{{Url: "http://example.org/race"}},
+		}},
+		Contained: []r4b.Resource{&r4b.Organization{
+			Id:        ptrString("o1"),
+			Extension: []r4b.Extension{{Url: "http://example.org/contained"}},
+		}},
+	}
+
+	assert.ElementsMatch(t, []string{
+		"http://example.org/race",
+		"ombCategory",
+		"text",
+		"http://example.org/modifier",
+		"http://example.org/birthTime",
+		"http://example.org/contained",
+	}, r4b.ExtensionURLs(patient))
+
+	assert.Empty(t, r4b.ExtensionURLs(&r4b.Patient{Id: ptrString("p2")}))
+	assert.Empty(t, r4b.ExtensionURLs(nil))
+}
@@ -23,3 +23,28 @@ type DomainResource interface {
 	GetExtension() []Extension
 	GetModifierExtension() []Extension
 }
+
+// StatusHolder is implemented by resources with a top-level status code
+// (e.g. Observation, MedicationRequest), exposing it as a plain string
+// regardless of the field's generated enum type.
+type StatusHolder interface {
+	Resource
+	GetStatus() string
+}
+
+// PerformerHolder is implemented by resources with performer-like reference
+// elements (e.g. Observation.performer, DiagnosticReport.performer and
+// resultsInterpreter), returning them as a single list. Backbone performers
+// such as Procedure.performer contribute their actor.
+type PerformerHolder interface {
+	Resource
+	Performers() []Reference
+}
+
+// CodeHolder is implemented by resources whose top-level code element is a
+// single CodeableConcept saying what the resource is about (e.g.
+// Observation.code, Condition.code, Procedure.code).
+type CodeHolder interface {
+	Resource
+	PrimaryCode() *CodeableConcept
+}
@@ -0,0 +1,57 @@
+package r4b_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4b"
+)
+
+func TestUnmarshalResourceJSONLenient(t *testing.T) {
+	t.Run("trailing commas", func(t *testing.T) {
+		data := []byte(`{
+			"resourceType": "Patient",
+			"id": "p1",
+			"name": [{"family": "Doe", "given": ["John",],},],
+		}`)
+
+		_, err := r4b.UnmarshalResource(data)
+		assert.Error(t, err, "strict mode rejects trailing commas")
+
+		r, err := r4b.UnmarshalResourceJSONLenient(data)
+		require.NoError(t, err)
+		patient, ok := r.(*r4b.Patient)
+		require.True(t, ok)
+		assert.Equal(t, "p1", *patient.Id)
+		assert.Equal(t, []string{"John"}, patient.Name[0].Given)
+	})
+
+	t.Run("comments", func(t *testing.T) {
+		data := []byte(`{
+			// fixture for the demographics tests
+			"resourceType": "Patient",
+			"id": "p1", /* keep in sync with p1.xml */
+			"active": true, // trailing comment hides nothing
+		}`)
+
+		r, err := r4b.UnmarshalResourceJSONLenient(data)
+		require.NoError(t, err)
+		assert.True(t, *r.(*r4b.Patient).Active)
+	})
+
+	t.Run("string contents are kept", func(t *testing.T) {
+		data := []byte(`{"resourceType": "Patient", "id": "p1",
+			"name": [{"text": "a, } // b /* c */ \", ]"}]}`)
+
+		r, err := r4b.UnmarshalResourceJSONLenient(data)
+		require.NoError(t, err)
+		assert.Equal(t, `a, } // b /* c */ ", ]`, *r.(*r4b.Patient).Name[0].Text)
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		_, err := r4b.UnmarshalResourceJSONLenient([]byte(`{"resourceType": "Patient", "id": }`))
+		assert.Error(t, err)
+	})
+}
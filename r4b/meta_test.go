@@ -0,0 +1,60 @@
+package r4b_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gofhir/models/r4b"
+)
+
+func TestMetaVersion(t *testing.T) {
+	t.Run("unset", func(t *testing.T) {
+		m := &r4b.Meta{}
+		_, ok := m.Version()
+		assert.False(t, ok)
+
+		m.IncrementVersion()
+		assert.Equal(t, "1", *m.VersionId)
+		v, ok := m.Version()
+		assert.True(t, ok)
+		assert.Equal(t, 1, v)
+	})
+
+	t.Run("numeric", func(t *testing.T) {
+		m := &r4b.Meta{VersionId: ptrString("41")}
+		v, ok := m.Version()
+		assert.True(t, ok)
+		assert.Equal(t, 41, v)
+
+		m.IncrementVersion()
+		assert.Equal(t, "42", *m.VersionId)
+	})
+
+	t.Run("non-numeric", func(t *testing.T) {
+		m := &r4b.Meta{VersionId: ptrString("W/\"abc\"")}
+		_, ok := m.Version()
+		assert.False(t, ok)
+
+		m.IncrementVersion()
+		assert.Equal(t, "W/\"abc\"", *m.VersionId)
+	})
+
+	t.Run("signed", func(t *testing.T) {
+		for _, s := range []string{"+5", "-5", "-0"} {
+			m := &r4b.Meta{VersionId: ptrString(s)}
+			_, ok := m.Version()
+			assert.False(t, ok, s)
+
+			m.IncrementVersion()
+			assert.Equal(t, s, *m.VersionId)
+		}
+	})
+
+	t.Run("nil meta", func(t *testing.T) {
+		var m *r4b.Meta
+		_, ok := m.Version()
+		assert.False(t, ok)
+		assert.NotPanics(t, m.IncrementVersion)
+	})
+}
@@ -0,0 +1,59 @@
+package r4b
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"strconv"
+)
+
+// LineError is a problem found on one line of an NDJSON stream by
+// ValidateNDJSON.
+type LineError struct {
+	Line int // 1-based line number
+	Err  error
+}
+
+// Error implements the error interface.
+func (e LineError) Error() string {
+	return "line " + strconv.Itoa(e.Line) + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying decode or validation error.
+func (e LineError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateNDJSON decodes each line of the NDJSON stream r, as produced by
+// bulk data exports, and passes the resource to validate, which defaults to
+// Validate when nil. It returns one LineError per line that fails to decode
+// or validate, in line order; valid and blank lines produce no entry, so a
+// clean stream yields nil.
+//
+// A read error from r ends the scan and is reported on the line being read.
+func ValidateNDJSON(r io.Reader, validate func(Resource) error) []LineError {
+	if validate == nil {
+		validate = Validate
+	}
+	var errs []LineError
+	br := bufio.NewReader(r)
+	for line := 1; ; line++ {
+		data, readErr := br.ReadBytes('\n')
+		if readErr != nil && !errors.Is(readErr, io.EOF) {
+			return append(errs, LineError{Line: line, Err: readErr})
+		}
+		if data = bytes.TrimSpace(data); len(data) > 0 {
+			res, err := UnmarshalResource(data)
+			if err == nil {
+				err = validate(res)
+			}
+			if err != nil {
+				errs = append(errs, LineError{Line: line, Err: err})
+			}
+		}
+		if readErr != nil {
+			return errs
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package r4b_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4b"
+)
+
+func TestParametersBuilderHelpers(t *testing.T) {
+	patient := &r4b.Patient{Id: ptrString("p1")}
+	params := r4b.NewParametersBuilder().
+		AddString("_type", "Observation").
+		AddReference("subject", r4b.Reference{Reference: ptrString("Patient/p1")}).
+		AddResource("patient", patient).
+		AddPart("window", func(b *r4b.ParametersBuilder) {
+			b.AddString("start", "2024-01-01").
+				AddPart("bounds", func(b *r4b.ParametersBuilder) {
+					b.AddString("inclusive", "true")
+				})
+		}).
+		Build()
+
+	require.Len(t, params.Parameter, 4)
+	assert.Equal(t, "_type", *params.Parameter[0].Name)
+	assert.Equal(t, "Observation", *params.Parameter[0].ValueString)
+	assert.Equal(t, "Patient/p1", *params.Parameter[1].ValueReference.Reference)
+	assert.Same(t, patient, params.Parameter[2].Resource)
+
+	window := params.Parameter[3]
+	assert.Equal(t, "window", *window.Name)
+	require.Len(t, window.Part, 2)
+	assert.Equal(t, "2024-01-01", *window.Part[0].ValueString)
+	require.Len(t, window.Part[1].Part, 1)
+	assert.Equal(t, "inclusive", *window.Part[1].Part[0].Name)
+
+	data, err := json.Marshal(params)
+	require.NoError(t, err)
+	decoded, err := r4b.UnmarshalResource(data)
+	require.NoError(t, err)
+	assert.Equal(t, "p1", *decoded.(*r4b.Parameters).Parameter[2].Resource.GetId())
+	assert.Equal(t, "true", *decoded.(*r4b.Parameters).Parameter[3].Part[1].Part[0].ValueString)
+}
+
+func TestParametersGet(t *testing.T) {
+	params := r4b.NewParametersBuilder().
+		AddString("mode", "full").
+		AddResource("patient", &r4b.Patient{Id: ptrString("p1")}).
+		AddString("_type", "Observation").
+		AddString("_type", "Condition").
+		Build()
+
+	mode, ok := params.GetString("mode")
+	assert.True(t, ok)
+	assert.Equal(t, "full", mode)
+
+	_, ok = params.GetString("patient")
+	assert.False(t, ok, "parameter has no valueString")
+	_, ok = params.GetString("missing")
+	assert.False(t, ok)
+
+	patient, ok := params.GetResource("patient").(*r4b.Patient)
+	require.True(t, ok)
+	assert.Equal(t, "p1", *patient.Id)
+	assert.Nil(t, params.GetResource("mode"))
+	assert.Nil(t, params.GetResource("missing"))
+
+	assert.Equal(t, "Observation", *params.Get("_type").ValueString)
+	all := params.GetAll("_type")
+	require.Len(t, all, 2)
+	assert.Equal(t, "Condition", *all[1].ValueString)
+	assert.Nil(t, params.Get("missing"))
+	assert.Empty(t, params.GetAll("missing"))
+
+	params.Get("mode").ValueString = ptrString("summary")
+	mode, _ = params.GetString("mode")
+	assert.Equal(t, "summary", mode)
+
+	var nilParams *r4b.Parameters
+	assert.Nil(t, nilParams.Get("mode"))
+	assert.Nil(t, nilParams.GetResource("mode"))
+}
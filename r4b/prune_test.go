@@ -0,0 +1,85 @@
+package r4b_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4b"
+)
+
+func TestPrune(t *testing.T) {
+	t.Run("removes empty contact entry", func(t *testing.T) {
+		patient := &r4b.Patient{
+			Id: ptrString("p1"),
+			Contact: []r4b.PatientContact{
+				{},
+				{Name: &r4b.HumanName{Family: ptrString("Doe")}},
+			},
+		}
+
+		pruned := r4b.Prune(patient).(*r4b.Patient)
+
+		require.Len(t, pruned.Contact, 1)
+		assert.Equal(t, "Doe", *pruned.Contact[0].Name.Family)
+		assert.Len(t, patient.Contact, 2, "original must be left untouched")
+	})
+
+	t.Run("removes all-empty slice", func(t *testing.T) {
+		patient := &r4b.Patient{
+			Contact: []r4b.PatientContact{{}, {Telecom: []r4b.ContactPoint{{}}}},
+		}
+
+		pruned := r4b.Prune(patient).(*r4b.Patient)
+
+		assert.Nil(t, pruned.Contact)
+	})
+
+	t.Run("nils out empty pointer datatypes", func(t *testing.T) {
+		patient := &r4b.Patient{
+			Meta:          &r4b.Meta{},
+			MaritalStatus: &r4b.CodeableConcept{Coding: []r4b.Coding{{}}},
+			BirthDateExt:  &r4b.Element{},
+		}
+
+		pruned := r4b.Prune(patient).(*r4b.Patient)
+
+		assert.Nil(t, pruned.Meta)
+		assert.Nil(t, pruned.MaritalStatus)
+		assert.Nil(t, pruned.BirthDateExt)
+		assert.NotNil(t, patient.Meta)
+	})
+
+	t.Run("keeps primitive values", func(t *testing.T) {
+		patient := &r4b.Patient{
+			Active: ptrBool(false),
+			Name:   []r4b.HumanName{{Given: []string{""}}},
+		}
+
+		pruned := r4b.Prune(patient).(*r4b.Patient)
+
+		require.NotNil(t, pruned.Active)
+		assert.False(t, *pruned.Active)
+		require.Len(t, pruned.Name, 1)
+	})
+
+	t.Run("prunes contained resources without removing them", func(t *testing.T) {
+		patient := &r4b.Patient{
+			Contained: []r4b.Resource{
+				&r4b.Organization{Id: ptrString("org1"), Identifier: []r4b.Identifier{{}}},
+			},
+		}
+
+		pruned := r4b.Prune(patient).(*r4b.Patient)
+
+		require.Len(t, pruned.Contained, 1)
+		org := pruned.Contained[0].(*r4b.Organization)
+		assert.Nil(t, org.Identifier)
+		assert.Len(t, patient.Contained[0].(*r4b.Organization).Identifier, 1)
+	})
+
+	t.Run("nil resource", func(t *testing.T) {
+		assert.Nil(t, r4b.Prune(nil))
+	})
+}
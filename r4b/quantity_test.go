@@ -0,0 +1,89 @@
+package r4b_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4b"
+)
+
+func TestQuantityCanonical(t *testing.T) {
+	t.Run("trailing zeros and system case", func(t *testing.T) {
+		a := r4b.Quantity{
+			Value:  r4b.MustDecimal("1.50"),
+			Unit:   ptrString("mmHg"),
+			System: ptrString("http://UNITSOFMEASURE.org"),
+			Code:   ptrString("mm[Hg]"),
+		}
+		b := r4b.Quantity{
+			Value:  r4b.MustDecimal("1.5"),
+			Unit:   ptrString("mmHg"),
+			System: ptrString("http://unitsofmeasure.org"),
+			Code:   ptrString("mm[Hg]"),
+		}
+
+		assert.Equal(t, a.Canonical(), b.Canonical())
+		assert.Equal(t, "1.5", a.Canonical().Value.String())
+		assert.Equal(t, "http://unitsofmeasure.org", *a.Canonical().System)
+	})
+
+	t.Run("value forms", func(t *testing.T) {
+		tests := map[string]string{
+			"100":    "100",
+			"100.00": "100",
+			"0.000":  "0",
+			"-0.0":   "0",
+			"-2.50":  "-2.5",
+			"1.5e2":  "150",
+		}
+		for in, want := range tests {
+			q := r4b.Quantity{Value: r4b.MustDecimal(in)}
+			assert.Equal(t, want, q.Canonical().Value.String(), in)
+		}
+	})
+
+	t.Run("original intact", func(t *testing.T) {
+		q := r4b.Quantity{Value: r4b.MustDecimal("1.50"), System: ptrString("HTTP://X"), Unit: ptrString("mmHg")}
+
+		c := q.Canonical()
+		*c.Unit = "changed"
+
+		assert.Equal(t, "1.50", q.Value.String())
+		assert.Equal(t, "HTTP://X", *q.System)
+		assert.Equal(t, "mmHg", *q.Unit)
+	})
+
+	t.Run("empty quantity", func(t *testing.T) {
+		assert.Equal(t, r4b.Quantity{}, r4b.Quantity{}.Canonical())
+	})
+}
+
+func TestQuantitySimpleQuantity(t *testing.T) {
+	t.Run("without comparator", func(t *testing.T) {
+		q := r4b.Quantity{
+			Value:  r4b.MustDecimal("5.0"),
+			Unit:   ptrString("mg"),
+			System: ptrString("http://unitsofmeasure.org"),
+			Code:   ptrString("mg"),
+		}
+
+		sq, err := q.ToSimpleQuantity()
+		require.NoError(t, err)
+		assert.Equal(t, "5.0", sq.Value.String())
+		assert.Equal(t, "mg", *sq.Code)
+		assert.Equal(t, q, sq.ToQuantity())
+
+		*sq.Unit = "milligram"
+		assert.Equal(t, "mg", *q.Unit, "conversion copies")
+	})
+
+	t.Run("with comparator", func(t *testing.T) {
+		comparator := r4b.QuantityComparatorLessThan
+		q := r4b.Quantity{Value: r4b.MustDecimal("5"), Comparator: &comparator}
+
+		_, err := q.ToSimpleQuantity()
+		assert.ErrorContains(t, err, "comparator")
+	})
+}
@@ -5,7 +5,9 @@
 package r4b
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 )
 
@@ -164,24 +166,107 @@ func NewResource(resourceType string) (Resource, error) {
 	return factory(), nil
 }
 
+// OnDecode, if non-nil, is called after every polymorphic resource decode
+// with the resource type and the resulting error (nil on success).
+// It fires for UnmarshalResource and UnmarshalResourceXML, including the
+// contained and Bundle entry resources they dispatch. resourceType is empty
+// when the type could not be determined.
+//
+// OnDecode is intended for metrics. It is read without synchronization,
+// so set it once during initialization before decoding begins.
+var OnDecode func(resourceType string, err error)
+
 // UnmarshalResource deserializes JSON to the correct resource type.
 // It first peeks at the resourceType field to determine the type,
 // then unmarshals the full JSON into the appropriate struct.
 func UnmarshalResource(data []byte) (Resource, error) {
+	resource, resourceType, err := unmarshalResource(data)
+	if OnDecode != nil {
+		OnDecode(resourceType, err)
+	}
+	return resource, err
+}
+
+// DecodeResourceWithRaw is like UnmarshalResource but also returns a copy of
+// data, so callers such as proxies can inspect the decoded resource and still
+// forward the original payload byte for byte.
+func DecodeResourceWithRaw(data []byte) (Resource, []byte, error) {
+	resource, err := UnmarshalResource(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resource, bytes.Clone(data), nil
+}
+
+// UnmarshalResourceArrayJSON deserializes a top-level JSON array of resources
+// (as found in some non-standard exports), dispatching each element on its
+// resourceType. An empty array yields an empty slice.
+func UnmarshalResourceArrayJSON(data []byte) ([]Resource, error) {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return nil, fmt.Errorf("failed to parse resource array: %w", err)
+	}
+	resources := make([]Resource, 0, len(raws))
+	for i, raw := range raws {
+		resource, err := UnmarshalResource(raw)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+// UnmarshalEntryJSON deserializes a single resource wrapped in a Bundle
+// entry-style envelope, {"resource": {...}}, as sent by some legacy systems.
+// It returns an error if the resource key is absent or null.
+func UnmarshalEntryJSON(data []byte) (Resource, error) {
+	var entry struct {
+		Resource json.RawMessage `json:"resource"`
+	}
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("failed to parse entry: %w", err)
+	}
+	if len(entry.Resource) == 0 || string(entry.Resource) == "null" {
+		return nil, errors.New("entry has no resource")
+	}
+	return UnmarshalResource(entry.Resource)
+}
+
+// unmarshalResource implements UnmarshalResource, also returning the
+// resource type it dispatched on (empty if it could not be determined).
+func unmarshalResource(data []byte) (Resource, string, error) {
 	resourceType, err := GetResourceType(data)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get resource type: %w", err)
+		return nil, "", fmt.Errorf("failed to get resource type: %w", err)
 	}
 
 	resource, err := NewResource(resourceType)
 	if err != nil {
-		return nil, err
+		return nil, resourceType, err
 	}
 
 	if err := json.Unmarshal(data, resource); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal %s: %w", resourceType, err)
+		return nil, resourceType, fmt.Errorf("failed to unmarshal %s: %w", resourceType, err)
 	}
 
+	return resource, resourceType, nil
+}
+
+// errMissingResourceType is returned by GetResourceType when the JSON object
+// has no resourceType.
+var errMissingResourceType = errors.New("resourceType field is missing or empty")
+
+// unmarshalContained decodes the contained resource at index i of its
+// container, naming the index in any error.
+func unmarshalContained(i int, raw json.RawMessage) (Resource, error) {
+	resource, err := UnmarshalResource(raw)
+	if errors.Is(err, errMissingResourceType) {
+		return nil, fmt.Errorf("contained[%d]: missing resourceType", i)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+	}
 	return resource, nil
 }
 
@@ -195,7 +280,7 @@ func GetResourceType(data []byte) (string, error) {
 		return "", fmt.Errorf("failed to parse JSON: %w", err)
 	}
 	if peek.ResourceType == "" {
-		return "", fmt.Errorf("resourceType field is missing or empty")
+		return "", errMissingResourceType
 	}
 	return peek.ResourceType, nil
 }
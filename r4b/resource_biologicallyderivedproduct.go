@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -119,6 +118,84 @@ func (r *BiologicallyDerivedProduct) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *BiologicallyDerivedProduct) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *BiologicallyDerivedProduct) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *BiologicallyDerivedProduct) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddProductCategoryExtension appends ext to the extensions of the productCategory
+// primitive (JSON "_productCategory"), allocating ProductCategoryExt if needed.
+func (r *BiologicallyDerivedProduct) AddProductCategoryExtension(ext Extension) {
+	if r.ProductCategoryExt == nil {
+		r.ProductCategoryExt = &Element{}
+	}
+	r.ProductCategoryExt.Extension = append(r.ProductCategoryExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *BiologicallyDerivedProduct) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddQuantityExtension appends ext to the extensions of the quantity
+// primitive (JSON "_quantity"), allocating QuantityExt if needed.
+func (r *BiologicallyDerivedProduct) AddQuantityExtension(ext Extension) {
+	if r.QuantityExt == nil {
+		r.QuantityExt = &Element{}
+	}
+	r.QuantityExt.Extension = append(r.QuantityExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *BiologicallyDerivedProduct) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the BiologicallyDerivedProduct, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *BiologicallyDerivedProduct) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the BiologicallyDerivedProduct,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *BiologicallyDerivedProduct) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -159,9 +236,9 @@ func (r *BiologicallyDerivedProduct) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -432,6 +509,12 @@ type BiologicallyDerivedProductCollection struct {
 	CollectedPeriod *Period `json:"collectedPeriod,omitempty"`
 }
 
+// HasCollected reports whether any collected[x] variant of the
+// BiologicallyDerivedProductCollection is set.
+func (b *BiologicallyDerivedProductCollection) HasCollected() bool {
+	return b.CollectedDateTime != nil || b.CollectedPeriod != nil
+}
+
 // MarshalXML serializes BiologicallyDerivedProductCollection to FHIR-conformant XML.
 func (b BiologicallyDerivedProductCollection) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -559,6 +642,12 @@ type BiologicallyDerivedProductManipulation struct {
 	TimePeriod *Period `json:"timePeriod,omitempty"`
 }
 
+// HasTime reports whether any time[x] variant of the
+// BiologicallyDerivedProductManipulation is set.
+func (b *BiologicallyDerivedProductManipulation) HasTime() bool {
+	return b.TimeDateTime != nil || b.TimePeriod != nil
+}
+
 // MarshalXML serializes BiologicallyDerivedProductManipulation to FHIR-conformant XML.
 func (b BiologicallyDerivedProductManipulation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -677,6 +766,12 @@ type BiologicallyDerivedProductProcessing struct {
 	TimePeriod *Period `json:"timePeriod,omitempty"`
 }
 
+// HasTime reports whether any time[x] variant of the
+// BiologicallyDerivedProductProcessing is set.
+func (b *BiologicallyDerivedProductProcessing) HasTime() bool {
+	return b.TimeDateTime != nil || b.TimePeriod != nil
+}
+
 // MarshalXML serializes BiologicallyDerivedProductProcessing to FHIR-conformant XML.
 func (b BiologicallyDerivedProductProcessing) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
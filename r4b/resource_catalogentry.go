@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -125,6 +124,93 @@ func (r *CatalogEntry) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *CatalogEntry) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *CatalogEntry) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *CatalogEntry) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddOrderableExtension appends ext to the extensions of the orderable
+// primitive (JSON "_orderable"), allocating OrderableExt if needed.
+func (r *CatalogEntry) AddOrderableExtension(ext Extension) {
+	if r.OrderableExt == nil {
+		r.OrderableExt = &Element{}
+	}
+	r.OrderableExt.Extension = append(r.OrderableExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *CatalogEntry) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddValidToExtension appends ext to the extensions of the validTo
+// primitive (JSON "_validTo"), allocating ValidToExt if needed.
+func (r *CatalogEntry) AddValidToExtension(ext Extension) {
+	if r.ValidToExt == nil {
+		r.ValidToExt = &Element{}
+	}
+	r.ValidToExt.Extension = append(r.ValidToExt.Extension, ext)
+}
+
+// AddLastUpdatedExtension appends ext to the extensions of the lastUpdated
+// primitive (JSON "_lastUpdated"), allocating LastUpdatedExt if needed.
+func (r *CatalogEntry) AddLastUpdatedExtension(ext Extension) {
+	if r.LastUpdatedExt == nil {
+		r.LastUpdatedExt = &Element{}
+	}
+	r.LastUpdatedExt.Extension = append(r.LastUpdatedExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *CatalogEntry) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the CatalogEntry, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *CatalogEntry) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the CatalogEntry,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *CatalogEntry) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -165,9 +251,9 @@ func (r *CatalogEntry) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -163,6 +162,133 @@ func (r *ChargeItem) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *ChargeItem) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *ChargeItem) Performers() []Reference {
+	var refs []Reference
+	for _, p := range r.Performer {
+		refs = append(refs, p.Actor)
+	}
+	return refs
+}
+
+// PrimaryCode returns the resource's code element, or nil if it is unset,
+// implementing CodeHolder.
+func (r *ChargeItem) PrimaryCode() *CodeableConcept {
+	return codeOrNil(&r.Code)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *ChargeItem) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *ChargeItem) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *ChargeItem) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddFactorOverrideExtension appends ext to the extensions of the factorOverride
+// primitive (JSON "_factorOverride"), allocating FactorOverrideExt if needed.
+func (r *ChargeItem) AddFactorOverrideExtension(ext Extension) {
+	if r.FactorOverrideExt == nil {
+		r.FactorOverrideExt = &Element{}
+	}
+	r.FactorOverrideExt.Extension = append(r.FactorOverrideExt.Extension, ext)
+}
+
+// AddOverrideReasonExtension appends ext to the extensions of the overrideReason
+// primitive (JSON "_overrideReason"), allocating OverrideReasonExt if needed.
+func (r *ChargeItem) AddOverrideReasonExtension(ext Extension) {
+	if r.OverrideReasonExt == nil {
+		r.OverrideReasonExt = &Element{}
+	}
+	r.OverrideReasonExt.Extension = append(r.OverrideReasonExt.Extension, ext)
+}
+
+// AddEnteredDateExtension appends ext to the extensions of the enteredDate
+// primitive (JSON "_enteredDate"), allocating EnteredDateExt if needed.
+func (r *ChargeItem) AddEnteredDateExtension(ext Extension) {
+	if r.EnteredDateExt == nil {
+		r.EnteredDateExt = &Element{}
+	}
+	r.EnteredDateExt.Extension = append(r.EnteredDateExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *ChargeItem) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *ChargeItem) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the ChargeItem, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *ChargeItem) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"occurrence": {"OccurrenceDateTime", "OccurrencePeriod", "OccurrenceTiming"},
+		"product":    {"ProductReference", "ProductCodeableConcept"},
+	}
+}
+
+// HasOccurrence reports whether any occurrence[x] variant of the
+// ChargeItem is set.
+func (r *ChargeItem) HasOccurrence() bool {
+	return r.OccurrenceDateTime != nil || r.OccurrencePeriod != nil || r.OccurrenceTiming != nil
+}
+
+// HasProduct reports whether any product[x] variant of the
+// ChargeItem is set.
+func (r *ChargeItem) HasProduct() bool {
+	return r.ProductReference != nil || r.ProductCodeableConcept != nil
+}
+
+// ValidateReferences checks that every populated reference in the ChargeItem,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *ChargeItem) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -203,9 +329,9 @@ func (r *ChargeItem) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -151,6 +150,84 @@ func (r *Claim) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *Claim) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Claim) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Claim) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *Claim) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddUseExtension appends ext to the extensions of the use
+// primitive (JSON "_use"), allocating UseExt if needed.
+func (r *Claim) AddUseExtension(ext Extension) {
+	if r.UseExt == nil {
+		r.UseExt = &Element{}
+	}
+	r.UseExt.Extension = append(r.UseExt.Extension, ext)
+}
+
+// AddCreatedExtension appends ext to the extensions of the created
+// primitive (JSON "_created"), allocating CreatedExt if needed.
+func (r *Claim) AddCreatedExtension(ext Extension) {
+	if r.CreatedExt == nil {
+		r.CreatedExt = &Element{}
+	}
+	r.CreatedExt.Extension = append(r.CreatedExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *Claim) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the Claim, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Claim) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the Claim,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Claim) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -191,9 +268,9 @@ func (r *Claim) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -622,6 +699,12 @@ type ClaimAccident struct {
 	LocationReference *Reference `json:"locationReference,omitempty"`
 }
 
+// HasLocation reports whether any location[x] variant of the
+// ClaimAccident is set.
+func (b *ClaimAccident) HasLocation() bool {
+	return b.LocationAddress != nil || b.LocationReference != nil
+}
+
 // MarshalXML serializes ClaimAccident to FHIR-conformant XML.
 func (b ClaimAccident) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -887,6 +970,12 @@ type ClaimDiagnosis struct {
 	PackageCode *CodeableConcept `json:"packageCode,omitempty"`
 }
 
+// HasDiagnosis reports whether any diagnosis[x] variant of the
+// ClaimDiagnosis is set.
+func (b *ClaimDiagnosis) HasDiagnosis() bool {
+	return b.DiagnosisCodeableConcept != nil || b.DiagnosisReference != nil
+}
+
 // MarshalXML serializes ClaimDiagnosis to FHIR-conformant XML.
 func (b ClaimDiagnosis) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1236,6 +1325,18 @@ type ClaimItem struct {
 	Detail []ClaimItemDetail `json:"detail,omitempty"`
 }
 
+// HasServiced reports whether any serviced[x] variant of the
+// ClaimItem is set.
+func (b *ClaimItem) HasServiced() bool {
+	return b.ServicedDate != nil || b.ServicedPeriod != nil
+}
+
+// HasLocation reports whether any location[x] variant of the
+// ClaimItem is set.
+func (b *ClaimItem) HasLocation() bool {
+	return b.LocationCodeableConcept != nil || b.LocationAddress != nil || b.LocationReference != nil
+}
+
 // MarshalXML serializes ClaimItem to FHIR-conformant XML.
 func (b ClaimItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -2115,6 +2216,12 @@ type ClaimProcedure struct {
 	Udi []Reference `json:"udi,omitempty"`
 }
 
+// HasProcedure reports whether any procedure[x] variant of the
+// ClaimProcedure is set.
+func (b *ClaimProcedure) HasProcedure() bool {
+	return b.ProcedureCodeableConcept != nil || b.ProcedureReference != nil
+}
+
 // MarshalXML serializes ClaimProcedure to FHIR-conformant XML.
 func (b ClaimProcedure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -2398,6 +2505,18 @@ type ClaimSupportingInfo struct {
 	Reason *CodeableConcept `json:"reason,omitempty"`
 }
 
+// HasTiming reports whether any timing[x] variant of the
+// ClaimSupportingInfo is set.
+func (b *ClaimSupportingInfo) HasTiming() bool {
+	return b.TimingDate != nil || b.TimingPeriod != nil
+}
+
+// HasValue reports whether any value[x] variant of the
+// ClaimSupportingInfo is set.
+func (b *ClaimSupportingInfo) HasValue() bool {
+	return b.ValueBoolean != nil || b.ValueString != nil || b.ValueQuantity != nil || b.ValueAttachment != nil || b.ValueReference != nil
+}
+
 // MarshalXML serializes ClaimSupportingInfo to FHIR-conformant XML.
 func (b ClaimSupportingInfo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -181,6 +180,228 @@ func (r *CodeSystem) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *CodeSystem) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *CodeSystem) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *CodeSystem) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddUrlExtension appends ext to the extensions of the url
+// primitive (JSON "_url"), allocating UrlExt if needed.
+func (r *CodeSystem) AddUrlExtension(ext Extension) {
+	if r.UrlExt == nil {
+		r.UrlExt = &Element{}
+	}
+	r.UrlExt.Extension = append(r.UrlExt.Extension, ext)
+}
+
+// AddVersionExtension appends ext to the extensions of the version
+// primitive (JSON "_version"), allocating VersionExt if needed.
+func (r *CodeSystem) AddVersionExtension(ext Extension) {
+	if r.VersionExt == nil {
+		r.VersionExt = &Element{}
+	}
+	r.VersionExt.Extension = append(r.VersionExt.Extension, ext)
+}
+
+// AddNameExtension appends ext to the extensions of the name
+// primitive (JSON "_name"), allocating NameExt if needed.
+func (r *CodeSystem) AddNameExtension(ext Extension) {
+	if r.NameExt == nil {
+		r.NameExt = &Element{}
+	}
+	r.NameExt.Extension = append(r.NameExt.Extension, ext)
+}
+
+// AddTitleExtension appends ext to the extensions of the title
+// primitive (JSON "_title"), allocating TitleExt if needed.
+func (r *CodeSystem) AddTitleExtension(ext Extension) {
+	if r.TitleExt == nil {
+		r.TitleExt = &Element{}
+	}
+	r.TitleExt.Extension = append(r.TitleExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *CodeSystem) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddExperimentalExtension appends ext to the extensions of the experimental
+// primitive (JSON "_experimental"), allocating ExperimentalExt if needed.
+func (r *CodeSystem) AddExperimentalExtension(ext Extension) {
+	if r.ExperimentalExt == nil {
+		r.ExperimentalExt = &Element{}
+	}
+	r.ExperimentalExt.Extension = append(r.ExperimentalExt.Extension, ext)
+}
+
+// AddDateExtension appends ext to the extensions of the date
+// primitive (JSON "_date"), allocating DateExt if needed.
+func (r *CodeSystem) AddDateExtension(ext Extension) {
+	if r.DateExt == nil {
+		r.DateExt = &Element{}
+	}
+	r.DateExt.Extension = append(r.DateExt.Extension, ext)
+}
+
+// AddPublisherExtension appends ext to the extensions of the publisher
+// primitive (JSON "_publisher"), allocating PublisherExt if needed.
+func (r *CodeSystem) AddPublisherExtension(ext Extension) {
+	if r.PublisherExt == nil {
+		r.PublisherExt = &Element{}
+	}
+	r.PublisherExt.Extension = append(r.PublisherExt.Extension, ext)
+}
+
+// AddDescriptionExtension appends ext to the extensions of the description
+// primitive (JSON "_description"), allocating DescriptionExt if needed.
+func (r *CodeSystem) AddDescriptionExtension(ext Extension) {
+	if r.DescriptionExt == nil {
+		r.DescriptionExt = &Element{}
+	}
+	r.DescriptionExt.Extension = append(r.DescriptionExt.Extension, ext)
+}
+
+// AddPurposeExtension appends ext to the extensions of the purpose
+// primitive (JSON "_purpose"), allocating PurposeExt if needed.
+func (r *CodeSystem) AddPurposeExtension(ext Extension) {
+	if r.PurposeExt == nil {
+		r.PurposeExt = &Element{}
+	}
+	r.PurposeExt.Extension = append(r.PurposeExt.Extension, ext)
+}
+
+// AddCopyrightExtension appends ext to the extensions of the copyright
+// primitive (JSON "_copyright"), allocating CopyrightExt if needed.
+func (r *CodeSystem) AddCopyrightExtension(ext Extension) {
+	if r.CopyrightExt == nil {
+		r.CopyrightExt = &Element{}
+	}
+	r.CopyrightExt.Extension = append(r.CopyrightExt.Extension, ext)
+}
+
+// AddCaseSensitiveExtension appends ext to the extensions of the caseSensitive
+// primitive (JSON "_caseSensitive"), allocating CaseSensitiveExt if needed.
+func (r *CodeSystem) AddCaseSensitiveExtension(ext Extension) {
+	if r.CaseSensitiveExt == nil {
+		r.CaseSensitiveExt = &Element{}
+	}
+	r.CaseSensitiveExt.Extension = append(r.CaseSensitiveExt.Extension, ext)
+}
+
+// AddValueSetExtension appends ext to the extensions of the valueSet
+// primitive (JSON "_valueSet"), allocating ValueSetExt if needed.
+func (r *CodeSystem) AddValueSetExtension(ext Extension) {
+	if r.ValueSetExt == nil {
+		r.ValueSetExt = &Element{}
+	}
+	r.ValueSetExt.Extension = append(r.ValueSetExt.Extension, ext)
+}
+
+// AddHierarchyMeaningExtension appends ext to the extensions of the hierarchyMeaning
+// primitive (JSON "_hierarchyMeaning"), allocating HierarchyMeaningExt if needed.
+func (r *CodeSystem) AddHierarchyMeaningExtension(ext Extension) {
+	if r.HierarchyMeaningExt == nil {
+		r.HierarchyMeaningExt = &Element{}
+	}
+	r.HierarchyMeaningExt.Extension = append(r.HierarchyMeaningExt.Extension, ext)
+}
+
+// AddCompositionalExtension appends ext to the extensions of the compositional
+// primitive (JSON "_compositional"), allocating CompositionalExt if needed.
+func (r *CodeSystem) AddCompositionalExtension(ext Extension) {
+	if r.CompositionalExt == nil {
+		r.CompositionalExt = &Element{}
+	}
+	r.CompositionalExt.Extension = append(r.CompositionalExt.Extension, ext)
+}
+
+// AddVersionNeededExtension appends ext to the extensions of the versionNeeded
+// primitive (JSON "_versionNeeded"), allocating VersionNeededExt if needed.
+func (r *CodeSystem) AddVersionNeededExtension(ext Extension) {
+	if r.VersionNeededExt == nil {
+		r.VersionNeededExt = &Element{}
+	}
+	r.VersionNeededExt.Extension = append(r.VersionNeededExt.Extension, ext)
+}
+
+// AddContentExtension appends ext to the extensions of the content
+// primitive (JSON "_content"), allocating ContentExt if needed.
+func (r *CodeSystem) AddContentExtension(ext Extension) {
+	if r.ContentExt == nil {
+		r.ContentExt = &Element{}
+	}
+	r.ContentExt.Extension = append(r.ContentExt.Extension, ext)
+}
+
+// AddSupplementsExtension appends ext to the extensions of the supplements
+// primitive (JSON "_supplements"), allocating SupplementsExt if needed.
+func (r *CodeSystem) AddSupplementsExtension(ext Extension) {
+	if r.SupplementsExt == nil {
+		r.SupplementsExt = &Element{}
+	}
+	r.SupplementsExt.Extension = append(r.SupplementsExt.Extension, ext)
+}
+
+// AddCountExtension appends ext to the extensions of the count
+// primitive (JSON "_count"), allocating CountExt if needed.
+func (r *CodeSystem) AddCountExtension(ext Extension) {
+	if r.CountExt == nil {
+		r.CountExt = &Element{}
+	}
+	r.CountExt.Extension = append(r.CountExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *CodeSystem) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the CodeSystem, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *CodeSystem) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the CodeSystem,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *CodeSystem) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -221,9 +442,9 @@ func (r *CodeSystem) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -922,6 +1143,12 @@ type CodeSystemConceptProperty struct {
 	ValueDecimalExt *Element `json:"_valueDecimal,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// CodeSystemConceptProperty is set.
+func (b *CodeSystemConceptProperty) HasValue() bool {
+	return b.ValueCode != nil || b.ValueCoding != nil || b.ValueString != nil || b.ValueInteger != nil || b.ValueBoolean != nil || b.ValueDateTime != nil || b.ValueDecimal != nil
+}
+
 // MarshalXML serializes CodeSystemConceptProperty to FHIR-conformant XML.
 func (b CodeSystemConceptProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -147,6 +146,119 @@ func (r *CommunicationRequest) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *CommunicationRequest) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *CommunicationRequest) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *CommunicationRequest) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *CommunicationRequest) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddPriorityExtension appends ext to the extensions of the priority
+// primitive (JSON "_priority"), allocating PriorityExt if needed.
+func (r *CommunicationRequest) AddPriorityExtension(ext Extension) {
+	if r.PriorityExt == nil {
+		r.PriorityExt = &Element{}
+	}
+	r.PriorityExt.Extension = append(r.PriorityExt.Extension, ext)
+}
+
+// AddDoNotPerformExtension appends ext to the extensions of the doNotPerform
+// primitive (JSON "_doNotPerform"), allocating DoNotPerformExt if needed.
+func (r *CommunicationRequest) AddDoNotPerformExtension(ext Extension) {
+	if r.DoNotPerformExt == nil {
+		r.DoNotPerformExt = &Element{}
+	}
+	r.DoNotPerformExt.Extension = append(r.DoNotPerformExt.Extension, ext)
+}
+
+// AddAuthoredOnExtension appends ext to the extensions of the authoredOn
+// primitive (JSON "_authoredOn"), allocating AuthoredOnExt if needed.
+func (r *CommunicationRequest) AddAuthoredOnExtension(ext Extension) {
+	if r.AuthoredOnExt == nil {
+		r.AuthoredOnExt = &Element{}
+	}
+	r.AuthoredOnExt.Extension = append(r.AuthoredOnExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *CommunicationRequest) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstCategory returns a pointer to the first category entry, or nil
+// if there is none.
+func (r *CommunicationRequest) FirstCategory() *CodeableConcept {
+	if r == nil || len(r.Category) == 0 {
+		return nil
+	}
+	return &r.Category[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *CommunicationRequest) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the CommunicationRequest, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *CommunicationRequest) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"occurrence": {"OccurrenceDateTime", "OccurrencePeriod"},
+	}
+}
+
+// HasOccurrence reports whether any occurrence[x] variant of the
+// CommunicationRequest is set.
+func (r *CommunicationRequest) HasOccurrence() bool {
+	return r.OccurrenceDateTime != nil || r.OccurrencePeriod != nil
+}
+
+// ValidateReferences checks that every populated reference in the CommunicationRequest,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *CommunicationRequest) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -187,9 +299,9 @@ func (r *CommunicationRequest) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -588,6 +700,12 @@ type CommunicationRequestPayload struct {
 	ContentReference *Reference `json:"contentReference,omitempty"`
 }
 
+// HasContent reports whether any content[x] variant of the
+// CommunicationRequestPayload is set.
+func (b *CommunicationRequestPayload) HasContent() bool {
+	return b.ContentString != nil || b.ContentAttachment != nil || b.ContentReference != nil
+}
+
 // MarshalXML serializes CommunicationRequestPayload to FHIR-conformant XML.
 func (b CommunicationRequestPayload) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -137,6 +136,111 @@ func (r *Coverage) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *Coverage) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Coverage) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Coverage) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *Coverage) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddSubscriberIdExtension appends ext to the extensions of the subscriberId
+// primitive (JSON "_subscriberId"), allocating SubscriberIdExt if needed.
+func (r *Coverage) AddSubscriberIdExtension(ext Extension) {
+	if r.SubscriberIdExt == nil {
+		r.SubscriberIdExt = &Element{}
+	}
+	r.SubscriberIdExt.Extension = append(r.SubscriberIdExt.Extension, ext)
+}
+
+// AddDependentExtension appends ext to the extensions of the dependent
+// primitive (JSON "_dependent"), allocating DependentExt if needed.
+func (r *Coverage) AddDependentExtension(ext Extension) {
+	if r.DependentExt == nil {
+		r.DependentExt = &Element{}
+	}
+	r.DependentExt.Extension = append(r.DependentExt.Extension, ext)
+}
+
+// AddOrderExtension appends ext to the extensions of the order
+// primitive (JSON "_order"), allocating OrderExt if needed.
+func (r *Coverage) AddOrderExtension(ext Extension) {
+	if r.OrderExt == nil {
+		r.OrderExt = &Element{}
+	}
+	r.OrderExt.Extension = append(r.OrderExt.Extension, ext)
+}
+
+// AddNetworkExtension appends ext to the extensions of the network
+// primitive (JSON "_network"), allocating NetworkExt if needed.
+func (r *Coverage) AddNetworkExtension(ext Extension) {
+	if r.NetworkExt == nil {
+		r.NetworkExt = &Element{}
+	}
+	r.NetworkExt.Extension = append(r.NetworkExt.Extension, ext)
+}
+
+// AddSubrogationExtension appends ext to the extensions of the subrogation
+// primitive (JSON "_subrogation"), allocating SubrogationExt if needed.
+func (r *Coverage) AddSubrogationExtension(ext Extension) {
+	if r.SubrogationExt == nil {
+		r.SubrogationExt = &Element{}
+	}
+	r.SubrogationExt.Extension = append(r.SubrogationExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *Coverage) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the Coverage, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Coverage) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the Coverage,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Coverage) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -177,9 +281,9 @@ func (r *Coverage) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -615,6 +719,12 @@ type CoverageCostToBeneficiary struct {
 	Exception []CoverageCostToBeneficiaryException `json:"exception,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// CoverageCostToBeneficiary is set.
+func (b *CoverageCostToBeneficiary) HasValue() bool {
+	return b.ValueQuantity != nil || b.ValueMoney != nil
+}
+
 // MarshalXML serializes CoverageCostToBeneficiary to FHIR-conformant XML.
 func (b CoverageCostToBeneficiary) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -127,6 +126,117 @@ func (r *DetectedIssue) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *DetectedIssue) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *DetectedIssue) Performers() []Reference {
+	var refs []Reference
+	if r.Author != nil {
+		refs = append(refs, *r.Author)
+	}
+	return refs
+}
+
+// PrimaryCode returns the resource's code element, or nil if it is unset,
+// implementing CodeHolder.
+func (r *DetectedIssue) PrimaryCode() *CodeableConcept {
+	return r.Code
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *DetectedIssue) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *DetectedIssue) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *DetectedIssue) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddSeverityExtension appends ext to the extensions of the severity
+// primitive (JSON "_severity"), allocating SeverityExt if needed.
+func (r *DetectedIssue) AddSeverityExtension(ext Extension) {
+	if r.SeverityExt == nil {
+		r.SeverityExt = &Element{}
+	}
+	r.SeverityExt.Extension = append(r.SeverityExt.Extension, ext)
+}
+
+// AddDetailExtension appends ext to the extensions of the detail
+// primitive (JSON "_detail"), allocating DetailExt if needed.
+func (r *DetectedIssue) AddDetailExtension(ext Extension) {
+	if r.DetailExt == nil {
+		r.DetailExt = &Element{}
+	}
+	r.DetailExt.Extension = append(r.DetailExt.Extension, ext)
+}
+
+// AddReferenceExtension appends ext to the extensions of the reference
+// primitive (JSON "_reference"), allocating ReferenceExt if needed.
+func (r *DetectedIssue) AddReferenceExtension(ext Extension) {
+	if r.ReferenceExt == nil {
+		r.ReferenceExt = &Element{}
+	}
+	r.ReferenceExt.Extension = append(r.ReferenceExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *DetectedIssue) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the DetectedIssue, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *DetectedIssue) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"identified": {"IdentifiedDateTime", "IdentifiedPeriod"},
+	}
+}
+
+// HasIdentified reports whether any identified[x] variant of the
+// DetectedIssue is set.
+func (r *DetectedIssue) HasIdentified() bool {
+	return r.IdentifiedDateTime != nil || r.IdentifiedPeriod != nil
+}
+
+// ValidateReferences checks that every populated reference in the DetectedIssue,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *DetectedIssue) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -167,9 +277,9 @@ func (r *DetectedIssue) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -163,6 +162,156 @@ func (r *Device) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *Device) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Device) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Device) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *Device) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddDistinctIdentifierExtension appends ext to the extensions of the distinctIdentifier
+// primitive (JSON "_distinctIdentifier"), allocating DistinctIdentifierExt if needed.
+func (r *Device) AddDistinctIdentifierExtension(ext Extension) {
+	if r.DistinctIdentifierExt == nil {
+		r.DistinctIdentifierExt = &Element{}
+	}
+	r.DistinctIdentifierExt.Extension = append(r.DistinctIdentifierExt.Extension, ext)
+}
+
+// AddManufacturerExtension appends ext to the extensions of the manufacturer
+// primitive (JSON "_manufacturer"), allocating ManufacturerExt if needed.
+func (r *Device) AddManufacturerExtension(ext Extension) {
+	if r.ManufacturerExt == nil {
+		r.ManufacturerExt = &Element{}
+	}
+	r.ManufacturerExt.Extension = append(r.ManufacturerExt.Extension, ext)
+}
+
+// AddManufactureDateExtension appends ext to the extensions of the manufactureDate
+// primitive (JSON "_manufactureDate"), allocating ManufactureDateExt if needed.
+func (r *Device) AddManufactureDateExtension(ext Extension) {
+	if r.ManufactureDateExt == nil {
+		r.ManufactureDateExt = &Element{}
+	}
+	r.ManufactureDateExt.Extension = append(r.ManufactureDateExt.Extension, ext)
+}
+
+// AddExpirationDateExtension appends ext to the extensions of the expirationDate
+// primitive (JSON "_expirationDate"), allocating ExpirationDateExt if needed.
+func (r *Device) AddExpirationDateExtension(ext Extension) {
+	if r.ExpirationDateExt == nil {
+		r.ExpirationDateExt = &Element{}
+	}
+	r.ExpirationDateExt.Extension = append(r.ExpirationDateExt.Extension, ext)
+}
+
+// AddLotNumberExtension appends ext to the extensions of the lotNumber
+// primitive (JSON "_lotNumber"), allocating LotNumberExt if needed.
+func (r *Device) AddLotNumberExtension(ext Extension) {
+	if r.LotNumberExt == nil {
+		r.LotNumberExt = &Element{}
+	}
+	r.LotNumberExt.Extension = append(r.LotNumberExt.Extension, ext)
+}
+
+// AddSerialNumberExtension appends ext to the extensions of the serialNumber
+// primitive (JSON "_serialNumber"), allocating SerialNumberExt if needed.
+func (r *Device) AddSerialNumberExtension(ext Extension) {
+	if r.SerialNumberExt == nil {
+		r.SerialNumberExt = &Element{}
+	}
+	r.SerialNumberExt.Extension = append(r.SerialNumberExt.Extension, ext)
+}
+
+// AddModelNumberExtension appends ext to the extensions of the modelNumber
+// primitive (JSON "_modelNumber"), allocating ModelNumberExt if needed.
+func (r *Device) AddModelNumberExtension(ext Extension) {
+	if r.ModelNumberExt == nil {
+		r.ModelNumberExt = &Element{}
+	}
+	r.ModelNumberExt.Extension = append(r.ModelNumberExt.Extension, ext)
+}
+
+// AddPartNumberExtension appends ext to the extensions of the partNumber
+// primitive (JSON "_partNumber"), allocating PartNumberExt if needed.
+func (r *Device) AddPartNumberExtension(ext Extension) {
+	if r.PartNumberExt == nil {
+		r.PartNumberExt = &Element{}
+	}
+	r.PartNumberExt.Extension = append(r.PartNumberExt.Extension, ext)
+}
+
+// AddUrlExtension appends ext to the extensions of the url
+// primitive (JSON "_url"), allocating UrlExt if needed.
+func (r *Device) AddUrlExtension(ext Extension) {
+	if r.UrlExt == nil {
+		r.UrlExt = &Element{}
+	}
+	r.UrlExt.Extension = append(r.UrlExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *Device) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *Device) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the Device, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Device) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the Device,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Device) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -203,9 +352,9 @@ func (r *Device) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
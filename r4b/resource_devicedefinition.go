@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -147,6 +146,93 @@ func (r *DeviceDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *DeviceDefinition) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *DeviceDefinition) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddModelNumberExtension appends ext to the extensions of the modelNumber
+// primitive (JSON "_modelNumber"), allocating ModelNumberExt if needed.
+func (r *DeviceDefinition) AddModelNumberExtension(ext Extension) {
+	if r.ModelNumberExt == nil {
+		r.ModelNumberExt = &Element{}
+	}
+	r.ModelNumberExt.Extension = append(r.ModelNumberExt.Extension, ext)
+}
+
+// AddUrlExtension appends ext to the extensions of the url
+// primitive (JSON "_url"), allocating UrlExt if needed.
+func (r *DeviceDefinition) AddUrlExtension(ext Extension) {
+	if r.UrlExt == nil {
+		r.UrlExt = &Element{}
+	}
+	r.UrlExt.Extension = append(r.UrlExt.Extension, ext)
+}
+
+// AddOnlineInformationExtension appends ext to the extensions of the onlineInformation
+// primitive (JSON "_onlineInformation"), allocating OnlineInformationExt if needed.
+func (r *DeviceDefinition) AddOnlineInformationExtension(ext Extension) {
+	if r.OnlineInformationExt == nil {
+		r.OnlineInformationExt = &Element{}
+	}
+	r.OnlineInformationExt.Extension = append(r.OnlineInformationExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *DeviceDefinition) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *DeviceDefinition) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the DeviceDefinition, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *DeviceDefinition) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"manufacturer": {"ManufacturerString", "ManufacturerReference"},
+	}
+}
+
+// HasManufacturer reports whether any manufacturer[x] variant of the
+// DeviceDefinition is set.
+func (r *DeviceDefinition) HasManufacturer() bool {
+	return r.ManufacturerString != nil || r.ManufacturerReference != nil
+}
+
+// ValidateReferences checks that every populated reference in the DeviceDefinition,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *DeviceDefinition) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -187,9 +273,9 @@ func (r *DeviceDefinition) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -137,6 +136,101 @@ func (r *EvidenceReport) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *EvidenceReport) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *EvidenceReport) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *EvidenceReport) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddUrlExtension appends ext to the extensions of the url
+// primitive (JSON "_url"), allocating UrlExt if needed.
+func (r *EvidenceReport) AddUrlExtension(ext Extension) {
+	if r.UrlExt == nil {
+		r.UrlExt = &Element{}
+	}
+	r.UrlExt.Extension = append(r.UrlExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *EvidenceReport) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddPublisherExtension appends ext to the extensions of the publisher
+// primitive (JSON "_publisher"), allocating PublisherExt if needed.
+func (r *EvidenceReport) AddPublisherExtension(ext Extension) {
+	if r.PublisherExt == nil {
+		r.PublisherExt = &Element{}
+	}
+	r.PublisherExt.Extension = append(r.PublisherExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *EvidenceReport) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *EvidenceReport) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the EvidenceReport, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *EvidenceReport) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"citeAs": {"CiteAsReference", "CiteAsMarkdown"},
+	}
+}
+
+// HasCiteAs reports whether any citeAs[x] variant of the
+// EvidenceReport is set.
+func (r *EvidenceReport) HasCiteAs() bool {
+	return r.CiteAsReference != nil || r.CiteAsMarkdown != nil
+}
+
+// ValidateReferences checks that every populated reference in the EvidenceReport,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *EvidenceReport) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -177,9 +271,9 @@ func (r *EvidenceReport) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -533,6 +627,12 @@ type EvidenceReportRelatesTo struct {
 	TargetReference *Reference `json:"targetReference,omitempty"`
 }
 
+// HasTarget reports whether any target[x] variant of the
+// EvidenceReportRelatesTo is set.
+func (b *EvidenceReportRelatesTo) HasTarget() bool {
+	return b.TargetIdentifier != nil || b.TargetReference != nil
+}
+
 // MarshalXML serializes EvidenceReportRelatesTo to FHIR-conformant XML.
 func (b EvidenceReportRelatesTo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -991,6 +1091,12 @@ type EvidenceReportSubjectCharacteristic struct {
 	Period *Period `json:"period,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// EvidenceReportSubjectCharacteristic is set.
+func (b *EvidenceReportSubjectCharacteristic) HasValue() bool {
+	return b.ValueReference != nil || b.ValueCodeableConcept != nil || b.ValueBoolean != nil || b.ValueQuantity != nil || b.ValueRange != nil
+}
+
 // MarshalXML serializes EvidenceReportSubjectCharacteristic to FHIR-conformant XML.
 func (b EvidenceReportSubjectCharacteristic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -165,6 +164,192 @@ func (r *EvidenceVariable) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *EvidenceVariable) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *EvidenceVariable) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *EvidenceVariable) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddUrlExtension appends ext to the extensions of the url
+// primitive (JSON "_url"), allocating UrlExt if needed.
+func (r *EvidenceVariable) AddUrlExtension(ext Extension) {
+	if r.UrlExt == nil {
+		r.UrlExt = &Element{}
+	}
+	r.UrlExt.Extension = append(r.UrlExt.Extension, ext)
+}
+
+// AddVersionExtension appends ext to the extensions of the version
+// primitive (JSON "_version"), allocating VersionExt if needed.
+func (r *EvidenceVariable) AddVersionExtension(ext Extension) {
+	if r.VersionExt == nil {
+		r.VersionExt = &Element{}
+	}
+	r.VersionExt.Extension = append(r.VersionExt.Extension, ext)
+}
+
+// AddNameExtension appends ext to the extensions of the name
+// primitive (JSON "_name"), allocating NameExt if needed.
+func (r *EvidenceVariable) AddNameExtension(ext Extension) {
+	if r.NameExt == nil {
+		r.NameExt = &Element{}
+	}
+	r.NameExt.Extension = append(r.NameExt.Extension, ext)
+}
+
+// AddTitleExtension appends ext to the extensions of the title
+// primitive (JSON "_title"), allocating TitleExt if needed.
+func (r *EvidenceVariable) AddTitleExtension(ext Extension) {
+	if r.TitleExt == nil {
+		r.TitleExt = &Element{}
+	}
+	r.TitleExt.Extension = append(r.TitleExt.Extension, ext)
+}
+
+// AddShortTitleExtension appends ext to the extensions of the shortTitle
+// primitive (JSON "_shortTitle"), allocating ShortTitleExt if needed.
+func (r *EvidenceVariable) AddShortTitleExtension(ext Extension) {
+	if r.ShortTitleExt == nil {
+		r.ShortTitleExt = &Element{}
+	}
+	r.ShortTitleExt.Extension = append(r.ShortTitleExt.Extension, ext)
+}
+
+// AddSubtitleExtension appends ext to the extensions of the subtitle
+// primitive (JSON "_subtitle"), allocating SubtitleExt if needed.
+func (r *EvidenceVariable) AddSubtitleExtension(ext Extension) {
+	if r.SubtitleExt == nil {
+		r.SubtitleExt = &Element{}
+	}
+	r.SubtitleExt.Extension = append(r.SubtitleExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *EvidenceVariable) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddDateExtension appends ext to the extensions of the date
+// primitive (JSON "_date"), allocating DateExt if needed.
+func (r *EvidenceVariable) AddDateExtension(ext Extension) {
+	if r.DateExt == nil {
+		r.DateExt = &Element{}
+	}
+	r.DateExt.Extension = append(r.DateExt.Extension, ext)
+}
+
+// AddDescriptionExtension appends ext to the extensions of the description
+// primitive (JSON "_description"), allocating DescriptionExt if needed.
+func (r *EvidenceVariable) AddDescriptionExtension(ext Extension) {
+	if r.DescriptionExt == nil {
+		r.DescriptionExt = &Element{}
+	}
+	r.DescriptionExt.Extension = append(r.DescriptionExt.Extension, ext)
+}
+
+// AddPublisherExtension appends ext to the extensions of the publisher
+// primitive (JSON "_publisher"), allocating PublisherExt if needed.
+func (r *EvidenceVariable) AddPublisherExtension(ext Extension) {
+	if r.PublisherExt == nil {
+		r.PublisherExt = &Element{}
+	}
+	r.PublisherExt.Extension = append(r.PublisherExt.Extension, ext)
+}
+
+// AddActualExtension appends ext to the extensions of the actual
+// primitive (JSON "_actual"), allocating ActualExt if needed.
+func (r *EvidenceVariable) AddActualExtension(ext Extension) {
+	if r.ActualExt == nil {
+		r.ActualExt = &Element{}
+	}
+	r.ActualExt.Extension = append(r.ActualExt.Extension, ext)
+}
+
+// AddCharacteristicCombinationExtension appends ext to the extensions of the characteristicCombination
+// primitive (JSON "_characteristicCombination"), allocating CharacteristicCombinationExt if needed.
+func (r *EvidenceVariable) AddCharacteristicCombinationExtension(ext Extension) {
+	if r.CharacteristicCombinationExt == nil {
+		r.CharacteristicCombinationExt = &Element{}
+	}
+	r.CharacteristicCombinationExt.Extension = append(r.CharacteristicCombinationExt.Extension, ext)
+}
+
+// AddHandlingExtension appends ext to the extensions of the handling
+// primitive (JSON "_handling"), allocating HandlingExt if needed.
+func (r *EvidenceVariable) AddHandlingExtension(ext Extension) {
+	if r.HandlingExt == nil {
+		r.HandlingExt = &Element{}
+	}
+	r.HandlingExt.Extension = append(r.HandlingExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *EvidenceVariable) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *EvidenceVariable) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// FirstCategory returns a pointer to the first category entry, or nil
+// if there is none.
+func (r *EvidenceVariable) FirstCategory() *EvidenceVariableCategory {
+	if r == nil || len(r.Category) == 0 {
+		return nil
+	}
+	return &r.Category[0]
+}
+
+// ChoiceGroups returns the choice elements of the EvidenceVariable, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *EvidenceVariable) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the EvidenceVariable,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *EvidenceVariable) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -205,9 +390,9 @@ func (r *EvidenceVariable) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -609,6 +794,12 @@ type EvidenceVariableCategory struct {
 	ValueRange *Range `json:"valueRange,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// EvidenceVariableCategory is set.
+func (b *EvidenceVariableCategory) HasValue() bool {
+	return b.ValueCodeableConcept != nil || b.ValueQuantity != nil || b.ValueRange != nil
+}
+
 // MarshalXML serializes EvidenceVariableCategory to FHIR-conformant XML.
 func (b EvidenceVariableCategory) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -750,6 +941,12 @@ type EvidenceVariableCharacteristic struct {
 	GroupMeasure *GroupMeasure `json:"groupMeasure,omitempty"`
 }
 
+// HasDefinition reports whether any definition[x] variant of the
+// EvidenceVariableCharacteristic is set.
+func (b *EvidenceVariableCharacteristic) HasDefinition() bool {
+	return b.DefinitionReference != nil || b.DefinitionCanonical != nil || b.DefinitionCodeableConcept != nil || b.DefinitionExpression != nil
+}
+
 // MarshalXML serializes EvidenceVariableCharacteristic to FHIR-conformant XML.
 func (b EvidenceVariableCharacteristic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
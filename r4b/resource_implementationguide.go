@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -157,6 +156,156 @@ func (r *ImplementationGuide) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *ImplementationGuide) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *ImplementationGuide) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *ImplementationGuide) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddUrlExtension appends ext to the extensions of the url
+// primitive (JSON "_url"), allocating UrlExt if needed.
+func (r *ImplementationGuide) AddUrlExtension(ext Extension) {
+	if r.UrlExt == nil {
+		r.UrlExt = &Element{}
+	}
+	r.UrlExt.Extension = append(r.UrlExt.Extension, ext)
+}
+
+// AddVersionExtension appends ext to the extensions of the version
+// primitive (JSON "_version"), allocating VersionExt if needed.
+func (r *ImplementationGuide) AddVersionExtension(ext Extension) {
+	if r.VersionExt == nil {
+		r.VersionExt = &Element{}
+	}
+	r.VersionExt.Extension = append(r.VersionExt.Extension, ext)
+}
+
+// AddNameExtension appends ext to the extensions of the name
+// primitive (JSON "_name"), allocating NameExt if needed.
+func (r *ImplementationGuide) AddNameExtension(ext Extension) {
+	if r.NameExt == nil {
+		r.NameExt = &Element{}
+	}
+	r.NameExt.Extension = append(r.NameExt.Extension, ext)
+}
+
+// AddTitleExtension appends ext to the extensions of the title
+// primitive (JSON "_title"), allocating TitleExt if needed.
+func (r *ImplementationGuide) AddTitleExtension(ext Extension) {
+	if r.TitleExt == nil {
+		r.TitleExt = &Element{}
+	}
+	r.TitleExt.Extension = append(r.TitleExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *ImplementationGuide) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddExperimentalExtension appends ext to the extensions of the experimental
+// primitive (JSON "_experimental"), allocating ExperimentalExt if needed.
+func (r *ImplementationGuide) AddExperimentalExtension(ext Extension) {
+	if r.ExperimentalExt == nil {
+		r.ExperimentalExt = &Element{}
+	}
+	r.ExperimentalExt.Extension = append(r.ExperimentalExt.Extension, ext)
+}
+
+// AddDateExtension appends ext to the extensions of the date
+// primitive (JSON "_date"), allocating DateExt if needed.
+func (r *ImplementationGuide) AddDateExtension(ext Extension) {
+	if r.DateExt == nil {
+		r.DateExt = &Element{}
+	}
+	r.DateExt.Extension = append(r.DateExt.Extension, ext)
+}
+
+// AddPublisherExtension appends ext to the extensions of the publisher
+// primitive (JSON "_publisher"), allocating PublisherExt if needed.
+func (r *ImplementationGuide) AddPublisherExtension(ext Extension) {
+	if r.PublisherExt == nil {
+		r.PublisherExt = &Element{}
+	}
+	r.PublisherExt.Extension = append(r.PublisherExt.Extension, ext)
+}
+
+// AddDescriptionExtension appends ext to the extensions of the description
+// primitive (JSON "_description"), allocating DescriptionExt if needed.
+func (r *ImplementationGuide) AddDescriptionExtension(ext Extension) {
+	if r.DescriptionExt == nil {
+		r.DescriptionExt = &Element{}
+	}
+	r.DescriptionExt.Extension = append(r.DescriptionExt.Extension, ext)
+}
+
+// AddCopyrightExtension appends ext to the extensions of the copyright
+// primitive (JSON "_copyright"), allocating CopyrightExt if needed.
+func (r *ImplementationGuide) AddCopyrightExtension(ext Extension) {
+	if r.CopyrightExt == nil {
+		r.CopyrightExt = &Element{}
+	}
+	r.CopyrightExt.Extension = append(r.CopyrightExt.Extension, ext)
+}
+
+// AddPackageIdExtension appends ext to the extensions of the packageId
+// primitive (JSON "_packageId"), allocating PackageIdExt if needed.
+func (r *ImplementationGuide) AddPackageIdExtension(ext Extension) {
+	if r.PackageIdExt == nil {
+		r.PackageIdExt = &Element{}
+	}
+	r.PackageIdExt.Extension = append(r.PackageIdExt.Extension, ext)
+}
+
+// AddLicenseExtension appends ext to the extensions of the license
+// primitive (JSON "_license"), allocating LicenseExt if needed.
+func (r *ImplementationGuide) AddLicenseExtension(ext Extension) {
+	if r.LicenseExt == nil {
+		r.LicenseExt = &Element{}
+	}
+	r.LicenseExt.Extension = append(r.LicenseExt.Extension, ext)
+}
+
+// ChoiceGroups returns the choice elements of the ImplementationGuide, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *ImplementationGuide) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the ImplementationGuide,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *ImplementationGuide) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -197,9 +346,9 @@ func (r *ImplementationGuide) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -803,6 +952,12 @@ type ImplementationGuideDefinitionPage struct {
 	Page []ImplementationGuideDefinitionPage `json:"page,omitempty"`
 }
 
+// HasName reports whether any name[x] variant of the
+// ImplementationGuideDefinitionPage is set.
+func (b *ImplementationGuideDefinitionPage) HasName() bool {
+	return b.NameUrl != nil || b.NameReference != nil
+}
+
 // MarshalXML serializes ImplementationGuideDefinitionPage to FHIR-conformant XML.
 func (b ImplementationGuideDefinitionPage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1046,6 +1201,12 @@ type ImplementationGuideDefinitionResource struct {
 	GroupingId *string `json:"groupingId,omitempty"`
 }
 
+// HasExample reports whether any example[x] variant of the
+// ImplementationGuideDefinitionResource is set.
+func (b *ImplementationGuideDefinitionResource) HasExample() bool {
+	return b.ExampleBoolean != nil || b.ExampleCanonical != nil
+}
+
 // MarshalXML serializes ImplementationGuideDefinitionResource to FHIR-conformant XML.
 func (b ImplementationGuideDefinitionResource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1769,6 +1930,12 @@ type ImplementationGuideManifestResource struct {
 	RelativePath *string `json:"relativePath,omitempty"`
 }
 
+// HasExample reports whether any example[x] variant of the
+// ImplementationGuideManifestResource is set.
+func (b *ImplementationGuideManifestResource) HasExample() bool {
+	return b.ExampleBoolean != nil || b.ExampleCanonical != nil
+}
+
 // MarshalXML serializes ImplementationGuideManifestResource to FHIR-conformant XML.
 func (b ImplementationGuideManifestResource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -111,6 +110,66 @@ func (r *Ingredient) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *Ingredient) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Ingredient) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Ingredient) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *Ingredient) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddAllergenicIndicatorExtension appends ext to the extensions of the allergenicIndicator
+// primitive (JSON "_allergenicIndicator"), allocating AllergenicIndicatorExt if needed.
+func (r *Ingredient) AddAllergenicIndicatorExtension(ext Extension) {
+	if r.AllergenicIndicatorExt == nil {
+		r.AllergenicIndicatorExt = &Element{}
+	}
+	r.AllergenicIndicatorExt.Extension = append(r.AllergenicIndicatorExt.Extension, ext)
+}
+
+// ChoiceGroups returns the choice elements of the Ingredient, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Ingredient) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the Ingredient,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Ingredient) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -151,9 +210,9 @@ func (r *Ingredient) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -592,6 +651,18 @@ type IngredientSubstanceStrength struct {
 	ReferenceStrength []IngredientSubstanceStrengthReferenceStrength `json:"referenceStrength,omitempty"`
 }
 
+// HasPresentation reports whether any presentation[x] variant of the
+// IngredientSubstanceStrength is set.
+func (b *IngredientSubstanceStrength) HasPresentation() bool {
+	return b.PresentationRatio != nil || b.PresentationRatioRange != nil
+}
+
+// HasConcentration reports whether any concentration[x] variant of the
+// IngredientSubstanceStrength is set.
+func (b *IngredientSubstanceStrength) HasConcentration() bool {
+	return b.ConcentrationRatio != nil || b.ConcentrationRatioRange != nil
+}
+
 // MarshalXML serializes IngredientSubstanceStrength to FHIR-conformant XML.
 func (b IngredientSubstanceStrength) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -772,6 +843,12 @@ type IngredientSubstanceStrengthReferenceStrength struct {
 	Country []CodeableConcept `json:"country,omitempty"`
 }
 
+// HasStrength reports whether any strength[x] variant of the
+// IngredientSubstanceStrengthReferenceStrength is set.
+func (b *IngredientSubstanceStrengthReferenceStrength) HasStrength() bool {
+	return b.StrengthRatio != nil || b.StrengthRatioRange != nil
+}
+
 // MarshalXML serializes IngredientSubstanceStrengthReferenceStrength to FHIR-conformant XML.
 func (b IngredientSubstanceStrengthReferenceStrength) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -153,6 +152,137 @@ func (r *Media) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *Media) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Media) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Media) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *Media) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddIssuedExtension appends ext to the extensions of the issued
+// primitive (JSON "_issued"), allocating IssuedExt if needed.
+func (r *Media) AddIssuedExtension(ext Extension) {
+	if r.IssuedExt == nil {
+		r.IssuedExt = &Element{}
+	}
+	r.IssuedExt.Extension = append(r.IssuedExt.Extension, ext)
+}
+
+// AddDeviceNameExtension appends ext to the extensions of the deviceName
+// primitive (JSON "_deviceName"), allocating DeviceNameExt if needed.
+func (r *Media) AddDeviceNameExtension(ext Extension) {
+	if r.DeviceNameExt == nil {
+		r.DeviceNameExt = &Element{}
+	}
+	r.DeviceNameExt.Extension = append(r.DeviceNameExt.Extension, ext)
+}
+
+// AddHeightExtension appends ext to the extensions of the height
+// primitive (JSON "_height"), allocating HeightExt if needed.
+func (r *Media) AddHeightExtension(ext Extension) {
+	if r.HeightExt == nil {
+		r.HeightExt = &Element{}
+	}
+	r.HeightExt.Extension = append(r.HeightExt.Extension, ext)
+}
+
+// AddWidthExtension appends ext to the extensions of the width
+// primitive (JSON "_width"), allocating WidthExt if needed.
+func (r *Media) AddWidthExtension(ext Extension) {
+	if r.WidthExt == nil {
+		r.WidthExt = &Element{}
+	}
+	r.WidthExt.Extension = append(r.WidthExt.Extension, ext)
+}
+
+// AddFramesExtension appends ext to the extensions of the frames
+// primitive (JSON "_frames"), allocating FramesExt if needed.
+func (r *Media) AddFramesExtension(ext Extension) {
+	if r.FramesExt == nil {
+		r.FramesExt = &Element{}
+	}
+	r.FramesExt.Extension = append(r.FramesExt.Extension, ext)
+}
+
+// AddDurationExtension appends ext to the extensions of the duration
+// primitive (JSON "_duration"), allocating DurationExt if needed.
+func (r *Media) AddDurationExtension(ext Extension) {
+	if r.DurationExt == nil {
+		r.DurationExt = &Element{}
+	}
+	r.DurationExt.Extension = append(r.DurationExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *Media) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *Media) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the Media, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Media) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"created": {"CreatedDateTime", "CreatedPeriod"},
+	}
+}
+
+// HasCreated reports whether any created[x] variant of the
+// Media is set.
+func (r *Media) HasCreated() bool {
+	return r.CreatedDateTime != nil || r.CreatedPeriod != nil
+}
+
+// ValidateReferences checks that every populated reference in the Media,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Media) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -193,9 +323,9 @@ func (r *Media) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -139,6 +138,100 @@ func (r *MedicationAdministration) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *MedicationAdministration) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *MedicationAdministration) Performers() []Reference {
+	var refs []Reference
+	for _, p := range r.Performer {
+		refs = append(refs, p.Actor)
+	}
+	return refs
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *MedicationAdministration) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *MedicationAdministration) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *MedicationAdministration) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *MedicationAdministration) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *MedicationAdministration) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the MedicationAdministration, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *MedicationAdministration) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"medication": {"MedicationCodeableConcept", "MedicationReference"},
+		"effective":  {"EffectiveDateTime", "EffectivePeriod"},
+	}
+}
+
+// HasMedication reports whether any medication[x] variant of the
+// MedicationAdministration is set.
+func (r *MedicationAdministration) HasMedication() bool {
+	return r.MedicationCodeableConcept != nil || r.MedicationReference != nil
+}
+
+// HasEffective reports whether any effective[x] variant of the
+// MedicationAdministration is set.
+func (r *MedicationAdministration) HasEffective() bool {
+	return r.EffectiveDateTime != nil || r.EffectivePeriod != nil
+}
+
+// ValidateReferences checks that every populated reference in the MedicationAdministration,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *MedicationAdministration) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -179,9 +272,9 @@ func (r *MedicationAdministration) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -563,6 +656,12 @@ type MedicationAdministrationDosage struct {
 	RateQuantity *Quantity `json:"rateQuantity,omitempty"`
 }
 
+// HasRate reports whether any rate[x] variant of the
+// MedicationAdministrationDosage is set.
+func (b *MedicationAdministrationDosage) HasRate() bool {
+	return b.RateRatio != nil || b.RateQuantity != nil
+}
+
 // MarshalXML serializes MedicationAdministrationDosage to FHIR-conformant XML.
 func (b MedicationAdministrationDosage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -149,6 +148,118 @@ func (r *MedicationDispense) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *MedicationDispense) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *MedicationDispense) Performers() []Reference {
+	var refs []Reference
+	for _, p := range r.Performer {
+		refs = append(refs, p.Actor)
+	}
+	return refs
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *MedicationDispense) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *MedicationDispense) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *MedicationDispense) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddWhenPreparedExtension appends ext to the extensions of the whenPrepared
+// primitive (JSON "_whenPrepared"), allocating WhenPreparedExt if needed.
+func (r *MedicationDispense) AddWhenPreparedExtension(ext Extension) {
+	if r.WhenPreparedExt == nil {
+		r.WhenPreparedExt = &Element{}
+	}
+	r.WhenPreparedExt.Extension = append(r.WhenPreparedExt.Extension, ext)
+}
+
+// AddWhenHandedOverExtension appends ext to the extensions of the whenHandedOver
+// primitive (JSON "_whenHandedOver"), allocating WhenHandedOverExt if needed.
+func (r *MedicationDispense) AddWhenHandedOverExtension(ext Extension) {
+	if r.WhenHandedOverExt == nil {
+		r.WhenHandedOverExt = &Element{}
+	}
+	r.WhenHandedOverExt.Extension = append(r.WhenHandedOverExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *MedicationDispense) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *MedicationDispense) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the MedicationDispense, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *MedicationDispense) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"statusReason": {"StatusReasonCodeableConcept", "StatusReasonReference"},
+		"medication":   {"MedicationCodeableConcept", "MedicationReference"},
+	}
+}
+
+// HasStatusReason reports whether any statusReason[x] variant of the
+// MedicationDispense is set.
+func (r *MedicationDispense) HasStatusReason() bool {
+	return r.StatusReasonCodeableConcept != nil || r.StatusReasonReference != nil
+}
+
+// HasMedication reports whether any medication[x] variant of the
+// MedicationDispense is set.
+func (r *MedicationDispense) HasMedication() bool {
+	return r.MedicationCodeableConcept != nil || r.MedicationReference != nil
+}
+
+// ValidateReferences checks that every populated reference in the MedicationDispense,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *MedicationDispense) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -189,9 +300,9 @@ func (r *MedicationDispense) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -175,6 +174,145 @@ func (r *MedicationRequest) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *MedicationRequest) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *MedicationRequest) Performers() []Reference {
+	var refs []Reference
+	if r.Performer != nil {
+		refs = append(refs, *r.Performer)
+	}
+	return refs
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *MedicationRequest) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *MedicationRequest) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *MedicationRequest) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddIntentExtension appends ext to the extensions of the intent
+// primitive (JSON "_intent"), allocating IntentExt if needed.
+func (r *MedicationRequest) AddIntentExtension(ext Extension) {
+	if r.IntentExt == nil {
+		r.IntentExt = &Element{}
+	}
+	r.IntentExt.Extension = append(r.IntentExt.Extension, ext)
+}
+
+// AddPriorityExtension appends ext to the extensions of the priority
+// primitive (JSON "_priority"), allocating PriorityExt if needed.
+func (r *MedicationRequest) AddPriorityExtension(ext Extension) {
+	if r.PriorityExt == nil {
+		r.PriorityExt = &Element{}
+	}
+	r.PriorityExt.Extension = append(r.PriorityExt.Extension, ext)
+}
+
+// AddDoNotPerformExtension appends ext to the extensions of the doNotPerform
+// primitive (JSON "_doNotPerform"), allocating DoNotPerformExt if needed.
+func (r *MedicationRequest) AddDoNotPerformExtension(ext Extension) {
+	if r.DoNotPerformExt == nil {
+		r.DoNotPerformExt = &Element{}
+	}
+	r.DoNotPerformExt.Extension = append(r.DoNotPerformExt.Extension, ext)
+}
+
+// AddAuthoredOnExtension appends ext to the extensions of the authoredOn
+// primitive (JSON "_authoredOn"), allocating AuthoredOnExt if needed.
+func (r *MedicationRequest) AddAuthoredOnExtension(ext Extension) {
+	if r.AuthoredOnExt == nil {
+		r.AuthoredOnExt = &Element{}
+	}
+	r.AuthoredOnExt.Extension = append(r.AuthoredOnExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *MedicationRequest) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstCategory returns a pointer to the first category entry, or nil
+// if there is none.
+func (r *MedicationRequest) FirstCategory() *CodeableConcept {
+	if r == nil || len(r.Category) == 0 {
+		return nil
+	}
+	return &r.Category[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *MedicationRequest) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the MedicationRequest, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *MedicationRequest) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"reported":   {"ReportedBoolean", "ReportedReference"},
+		"medication": {"MedicationCodeableConcept", "MedicationReference"},
+	}
+}
+
+// HasReported reports whether any reported[x] variant of the
+// MedicationRequest is set.
+func (r *MedicationRequest) HasReported() bool {
+	return r.ReportedBoolean != nil || r.ReportedReference != nil
+}
+
+// HasMedication reports whether any medication[x] variant of the
+// MedicationRequest is set.
+func (r *MedicationRequest) HasMedication() bool {
+	return r.MedicationCodeableConcept != nil || r.MedicationReference != nil
+}
+
+// ValidateReferences checks that every populated reference in the MedicationRequest,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *MedicationRequest) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -215,9 +353,9 @@ func (r *MedicationRequest) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -1001,6 +1139,12 @@ type MedicationRequestSubstitution struct {
 	Reason *CodeableConcept `json:"reason,omitempty"`
 }
 
+// HasAllowed reports whether any allowed[x] variant of the
+// MedicationRequestSubstitution is set.
+func (b *MedicationRequestSubstitution) HasAllowed() bool {
+	return b.AllowedBoolean != nil || b.AllowedCodeableConcept != nil
+}
+
 // MarshalXML serializes MedicationRequestSubstitution to FHIR-conformant XML.
 func (b MedicationRequestSubstitution) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -155,6 +154,94 @@ func (r *MedicinalProductDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *MedicinalProductDefinition) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *MedicinalProductDefinition) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddVersionExtension appends ext to the extensions of the version
+// primitive (JSON "_version"), allocating VersionExt if needed.
+func (r *MedicinalProductDefinition) AddVersionExtension(ext Extension) {
+	if r.VersionExt == nil {
+		r.VersionExt = &Element{}
+	}
+	r.VersionExt.Extension = append(r.VersionExt.Extension, ext)
+}
+
+// AddStatusDateExtension appends ext to the extensions of the statusDate
+// primitive (JSON "_statusDate"), allocating StatusDateExt if needed.
+func (r *MedicinalProductDefinition) AddStatusDateExtension(ext Extension) {
+	if r.StatusDateExt == nil {
+		r.StatusDateExt = &Element{}
+	}
+	r.StatusDateExt.Extension = append(r.StatusDateExt.Extension, ext)
+}
+
+// AddDescriptionExtension appends ext to the extensions of the description
+// primitive (JSON "_description"), allocating DescriptionExt if needed.
+func (r *MedicinalProductDefinition) AddDescriptionExtension(ext Extension) {
+	if r.DescriptionExt == nil {
+		r.DescriptionExt = &Element{}
+	}
+	r.DescriptionExt.Extension = append(r.DescriptionExt.Extension, ext)
+}
+
+// AddIndicationExtension appends ext to the extensions of the indication
+// primitive (JSON "_indication"), allocating IndicationExt if needed.
+func (r *MedicinalProductDefinition) AddIndicationExtension(ext Extension) {
+	if r.IndicationExt == nil {
+		r.IndicationExt = &Element{}
+	}
+	r.IndicationExt.Extension = append(r.IndicationExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *MedicinalProductDefinition) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstName returns a pointer to the first name entry, or nil
+// if there is none.
+func (r *MedicinalProductDefinition) FirstName() *MedicinalProductDefinitionName {
+	if r == nil || len(r.Name) == 0 {
+		return nil
+	}
+	return &r.Name[0]
+}
+
+// ChoiceGroups returns the choice elements of the MedicinalProductDefinition, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *MedicinalProductDefinition) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the MedicinalProductDefinition,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *MedicinalProductDefinition) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -195,9 +282,9 @@ func (r *MedicinalProductDefinition) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -660,6 +747,12 @@ type MedicinalProductDefinitionCharacteristic struct {
 	ValueAttachment *Attachment `json:"valueAttachment,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// MedicinalProductDefinitionCharacteristic is set.
+func (b *MedicinalProductDefinitionCharacteristic) HasValue() bool {
+	return b.ValueCodeableConcept != nil || b.ValueQuantity != nil || b.ValueDate != nil || b.ValueBoolean != nil || b.ValueAttachment != nil
+}
+
 // MarshalXML serializes MedicinalProductDefinitionCharacteristic to FHIR-conformant XML.
 func (b MedicinalProductDefinitionCharacteristic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
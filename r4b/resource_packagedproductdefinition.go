@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -127,6 +126,85 @@ func (r *PackagedProductDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *PackagedProductDefinition) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *PackagedProductDefinition) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddNameExtension appends ext to the extensions of the name
+// primitive (JSON "_name"), allocating NameExt if needed.
+func (r *PackagedProductDefinition) AddNameExtension(ext Extension) {
+	if r.NameExt == nil {
+		r.NameExt = &Element{}
+	}
+	r.NameExt.Extension = append(r.NameExt.Extension, ext)
+}
+
+// AddStatusDateExtension appends ext to the extensions of the statusDate
+// primitive (JSON "_statusDate"), allocating StatusDateExt if needed.
+func (r *PackagedProductDefinition) AddStatusDateExtension(ext Extension) {
+	if r.StatusDateExt == nil {
+		r.StatusDateExt = &Element{}
+	}
+	r.StatusDateExt.Extension = append(r.StatusDateExt.Extension, ext)
+}
+
+// AddDescriptionExtension appends ext to the extensions of the description
+// primitive (JSON "_description"), allocating DescriptionExt if needed.
+func (r *PackagedProductDefinition) AddDescriptionExtension(ext Extension) {
+	if r.DescriptionExt == nil {
+		r.DescriptionExt = &Element{}
+	}
+	r.DescriptionExt.Extension = append(r.DescriptionExt.Extension, ext)
+}
+
+// AddCopackagedIndicatorExtension appends ext to the extensions of the copackagedIndicator
+// primitive (JSON "_copackagedIndicator"), allocating CopackagedIndicatorExt if needed.
+func (r *PackagedProductDefinition) AddCopackagedIndicatorExtension(ext Extension) {
+	if r.CopackagedIndicatorExt == nil {
+		r.CopackagedIndicatorExt = &Element{}
+	}
+	r.CopackagedIndicatorExt.Extension = append(r.CopackagedIndicatorExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *PackagedProductDefinition) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the PackagedProductDefinition, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *PackagedProductDefinition) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the PackagedProductDefinition,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *PackagedProductDefinition) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -167,9 +245,9 @@ func (r *PackagedProductDefinition) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -885,6 +963,12 @@ type PackagedProductDefinitionPackageProperty struct {
 	ValueAttachment *Attachment `json:"valueAttachment,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// PackagedProductDefinitionPackageProperty is set.
+func (b *PackagedProductDefinitionPackageProperty) HasValue() bool {
+	return b.ValueCodeableConcept != nil || b.ValueQuantity != nil || b.ValueDate != nil || b.ValueBoolean != nil || b.ValueAttachment != nil
+}
+
 // MarshalXML serializes PackagedProductDefinitionPackageProperty to FHIR-conformant XML.
 func (b PackagedProductDefinitionPackageProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1030,6 +1114,12 @@ type PackagedProductDefinitionPackageShelfLifeStorage struct {
 	SpecialPrecautionsForStorage []CodeableConcept `json:"specialPrecautionsForStorage,omitempty"`
 }
 
+// HasPeriod reports whether any period[x] variant of the
+// PackagedProductDefinitionPackageShelfLifeStorage is set.
+func (b *PackagedProductDefinitionPackageShelfLifeStorage) HasPeriod() bool {
+	return b.PeriodDuration != nil || b.PeriodString != nil
+}
+
 // MarshalXML serializes PackagedProductDefinitionPackageShelfLifeStorage to FHIR-conformant XML.
 func (b PackagedProductDefinitionPackageShelfLifeStorage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -119,6 +118,85 @@ func (r *QuestionnaireResponse) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *QuestionnaireResponse) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *QuestionnaireResponse) Performers() []Reference {
+	var refs []Reference
+	if r.Author != nil {
+		refs = append(refs, *r.Author)
+	}
+	return refs
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *QuestionnaireResponse) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *QuestionnaireResponse) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddQuestionnaireExtension appends ext to the extensions of the questionnaire
+// primitive (JSON "_questionnaire"), allocating QuestionnaireExt if needed.
+func (r *QuestionnaireResponse) AddQuestionnaireExtension(ext Extension) {
+	if r.QuestionnaireExt == nil {
+		r.QuestionnaireExt = &Element{}
+	}
+	r.QuestionnaireExt.Extension = append(r.QuestionnaireExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *QuestionnaireResponse) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddAuthoredExtension appends ext to the extensions of the authored
+// primitive (JSON "_authored"), allocating AuthoredExt if needed.
+func (r *QuestionnaireResponse) AddAuthoredExtension(ext Extension) {
+	if r.AuthoredExt == nil {
+		r.AuthoredExt = &Element{}
+	}
+	r.AuthoredExt.Extension = append(r.AuthoredExt.Extension, ext)
+}
+
+// ChoiceGroups returns the choice elements of the QuestionnaireResponse, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *QuestionnaireResponse) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the QuestionnaireResponse,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *QuestionnaireResponse) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -159,9 +237,9 @@ func (r *QuestionnaireResponse) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -600,6 +678,12 @@ type QuestionnaireResponseItemAnswer struct {
 	Item []QuestionnaireResponseItem `json:"item,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// QuestionnaireResponseItemAnswer is set.
+func (b *QuestionnaireResponseItemAnswer) HasValue() bool {
+	return b.ValueBoolean != nil || b.ValueDecimal != nil || b.ValueInteger != nil || b.ValueDate != nil || b.ValueDateTime != nil || b.ValueTime != nil || b.ValueString != nil || b.ValueUri != nil || b.ValueAttachment != nil || b.ValueCoding != nil || b.ValueQuantity != nil || b.ValueReference != nil
+}
+
 // MarshalXML serializes QuestionnaireResponseItemAnswer to FHIR-conformant XML.
 func (b QuestionnaireResponseItemAnswer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
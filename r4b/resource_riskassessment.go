@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -133,6 +132,108 @@ func (r *RiskAssessment) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *RiskAssessment) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *RiskAssessment) Performers() []Reference {
+	var refs []Reference
+	if r.Performer != nil {
+		refs = append(refs, *r.Performer)
+	}
+	return refs
+}
+
+// PrimaryCode returns the resource's code element, or nil if it is unset,
+// implementing CodeHolder.
+func (r *RiskAssessment) PrimaryCode() *CodeableConcept {
+	return r.Code
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *RiskAssessment) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *RiskAssessment) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *RiskAssessment) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddMitigationExtension appends ext to the extensions of the mitigation
+// primitive (JSON "_mitigation"), allocating MitigationExt if needed.
+func (r *RiskAssessment) AddMitigationExtension(ext Extension) {
+	if r.MitigationExt == nil {
+		r.MitigationExt = &Element{}
+	}
+	r.MitigationExt.Extension = append(r.MitigationExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *RiskAssessment) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *RiskAssessment) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the RiskAssessment, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *RiskAssessment) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"occurrence": {"OccurrenceDateTime", "OccurrencePeriod"},
+	}
+}
+
+// HasOccurrence reports whether any occurrence[x] variant of the
+// RiskAssessment is set.
+func (r *RiskAssessment) HasOccurrence() bool {
+	return r.OccurrenceDateTime != nil || r.OccurrencePeriod != nil
+}
+
+// ValidateReferences checks that every populated reference in the RiskAssessment,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *RiskAssessment) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -173,9 +274,9 @@ func (r *RiskAssessment) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -527,6 +628,18 @@ type RiskAssessmentPrediction struct {
 	Rationale *string `json:"rationale,omitempty"`
 }
 
+// HasProbability reports whether any probability[x] variant of the
+// RiskAssessmentPrediction is set.
+func (b *RiskAssessmentPrediction) HasProbability() bool {
+	return b.ProbabilityDecimal != nil || b.ProbabilityRange != nil
+}
+
+// HasWhen reports whether any when[x] variant of the
+// RiskAssessmentPrediction is set.
+func (b *RiskAssessmentPrediction) HasWhen() bool {
+	return b.WhenPeriod != nil || b.WhenRange != nil
+}
+
 // MarshalXML serializes RiskAssessmentPrediction to FHIR-conformant XML.
 func (b RiskAssessmentPrediction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -187,6 +186,201 @@ func (r *SearchParameter) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *SearchParameter) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *SearchParameter) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *SearchParameter) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddUrlExtension appends ext to the extensions of the url
+// primitive (JSON "_url"), allocating UrlExt if needed.
+func (r *SearchParameter) AddUrlExtension(ext Extension) {
+	if r.UrlExt == nil {
+		r.UrlExt = &Element{}
+	}
+	r.UrlExt.Extension = append(r.UrlExt.Extension, ext)
+}
+
+// AddVersionExtension appends ext to the extensions of the version
+// primitive (JSON "_version"), allocating VersionExt if needed.
+func (r *SearchParameter) AddVersionExtension(ext Extension) {
+	if r.VersionExt == nil {
+		r.VersionExt = &Element{}
+	}
+	r.VersionExt.Extension = append(r.VersionExt.Extension, ext)
+}
+
+// AddNameExtension appends ext to the extensions of the name
+// primitive (JSON "_name"), allocating NameExt if needed.
+func (r *SearchParameter) AddNameExtension(ext Extension) {
+	if r.NameExt == nil {
+		r.NameExt = &Element{}
+	}
+	r.NameExt.Extension = append(r.NameExt.Extension, ext)
+}
+
+// AddDerivedFromExtension appends ext to the extensions of the derivedFrom
+// primitive (JSON "_derivedFrom"), allocating DerivedFromExt if needed.
+func (r *SearchParameter) AddDerivedFromExtension(ext Extension) {
+	if r.DerivedFromExt == nil {
+		r.DerivedFromExt = &Element{}
+	}
+	r.DerivedFromExt.Extension = append(r.DerivedFromExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *SearchParameter) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddExperimentalExtension appends ext to the extensions of the experimental
+// primitive (JSON "_experimental"), allocating ExperimentalExt if needed.
+func (r *SearchParameter) AddExperimentalExtension(ext Extension) {
+	if r.ExperimentalExt == nil {
+		r.ExperimentalExt = &Element{}
+	}
+	r.ExperimentalExt.Extension = append(r.ExperimentalExt.Extension, ext)
+}
+
+// AddDateExtension appends ext to the extensions of the date
+// primitive (JSON "_date"), allocating DateExt if needed.
+func (r *SearchParameter) AddDateExtension(ext Extension) {
+	if r.DateExt == nil {
+		r.DateExt = &Element{}
+	}
+	r.DateExt.Extension = append(r.DateExt.Extension, ext)
+}
+
+// AddPublisherExtension appends ext to the extensions of the publisher
+// primitive (JSON "_publisher"), allocating PublisherExt if needed.
+func (r *SearchParameter) AddPublisherExtension(ext Extension) {
+	if r.PublisherExt == nil {
+		r.PublisherExt = &Element{}
+	}
+	r.PublisherExt.Extension = append(r.PublisherExt.Extension, ext)
+}
+
+// AddDescriptionExtension appends ext to the extensions of the description
+// primitive (JSON "_description"), allocating DescriptionExt if needed.
+func (r *SearchParameter) AddDescriptionExtension(ext Extension) {
+	if r.DescriptionExt == nil {
+		r.DescriptionExt = &Element{}
+	}
+	r.DescriptionExt.Extension = append(r.DescriptionExt.Extension, ext)
+}
+
+// AddPurposeExtension appends ext to the extensions of the purpose
+// primitive (JSON "_purpose"), allocating PurposeExt if needed.
+func (r *SearchParameter) AddPurposeExtension(ext Extension) {
+	if r.PurposeExt == nil {
+		r.PurposeExt = &Element{}
+	}
+	r.PurposeExt.Extension = append(r.PurposeExt.Extension, ext)
+}
+
+// AddCodeExtension appends ext to the extensions of the code
+// primitive (JSON "_code"), allocating CodeExt if needed.
+func (r *SearchParameter) AddCodeExtension(ext Extension) {
+	if r.CodeExt == nil {
+		r.CodeExt = &Element{}
+	}
+	r.CodeExt.Extension = append(r.CodeExt.Extension, ext)
+}
+
+// AddTypeExtension appends ext to the extensions of the type
+// primitive (JSON "_type"), allocating TypeExt if needed.
+func (r *SearchParameter) AddTypeExtension(ext Extension) {
+	if r.TypeExt == nil {
+		r.TypeExt = &Element{}
+	}
+	r.TypeExt.Extension = append(r.TypeExt.Extension, ext)
+}
+
+// AddExpressionExtension appends ext to the extensions of the expression
+// primitive (JSON "_expression"), allocating ExpressionExt if needed.
+func (r *SearchParameter) AddExpressionExtension(ext Extension) {
+	if r.ExpressionExt == nil {
+		r.ExpressionExt = &Element{}
+	}
+	r.ExpressionExt.Extension = append(r.ExpressionExt.Extension, ext)
+}
+
+// AddXpathExtension appends ext to the extensions of the xpath
+// primitive (JSON "_xpath"), allocating XpathExt if needed.
+func (r *SearchParameter) AddXpathExtension(ext Extension) {
+	if r.XpathExt == nil {
+		r.XpathExt = &Element{}
+	}
+	r.XpathExt.Extension = append(r.XpathExt.Extension, ext)
+}
+
+// AddXpathUsageExtension appends ext to the extensions of the xpathUsage
+// primitive (JSON "_xpathUsage"), allocating XpathUsageExt if needed.
+func (r *SearchParameter) AddXpathUsageExtension(ext Extension) {
+	if r.XpathUsageExt == nil {
+		r.XpathUsageExt = &Element{}
+	}
+	r.XpathUsageExt.Extension = append(r.XpathUsageExt.Extension, ext)
+}
+
+// AddMultipleOrExtension appends ext to the extensions of the multipleOr
+// primitive (JSON "_multipleOr"), allocating MultipleOrExt if needed.
+func (r *SearchParameter) AddMultipleOrExtension(ext Extension) {
+	if r.MultipleOrExt == nil {
+		r.MultipleOrExt = &Element{}
+	}
+	r.MultipleOrExt.Extension = append(r.MultipleOrExt.Extension, ext)
+}
+
+// AddMultipleAndExtension appends ext to the extensions of the multipleAnd
+// primitive (JSON "_multipleAnd"), allocating MultipleAndExt if needed.
+func (r *SearchParameter) AddMultipleAndExtension(ext Extension) {
+	if r.MultipleAndExt == nil {
+		r.MultipleAndExt = &Element{}
+	}
+	r.MultipleAndExt.Extension = append(r.MultipleAndExt.Extension, ext)
+}
+
+// ChoiceGroups returns the choice elements of the SearchParameter, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *SearchParameter) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the SearchParameter,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *SearchParameter) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -227,9 +421,9 @@ func (r *SearchParameter) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -115,6 +114,93 @@ func (r *Subscription) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *Subscription) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Subscription) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Subscription) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *Subscription) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddEndExtension appends ext to the extensions of the end
+// primitive (JSON "_end"), allocating EndExt if needed.
+func (r *Subscription) AddEndExtension(ext Extension) {
+	if r.EndExt == nil {
+		r.EndExt = &Element{}
+	}
+	r.EndExt.Extension = append(r.EndExt.Extension, ext)
+}
+
+// AddReasonExtension appends ext to the extensions of the reason
+// primitive (JSON "_reason"), allocating ReasonExt if needed.
+func (r *Subscription) AddReasonExtension(ext Extension) {
+	if r.ReasonExt == nil {
+		r.ReasonExt = &Element{}
+	}
+	r.ReasonExt.Extension = append(r.ReasonExt.Extension, ext)
+}
+
+// AddCriteriaExtension appends ext to the extensions of the criteria
+// primitive (JSON "_criteria"), allocating CriteriaExt if needed.
+func (r *Subscription) AddCriteriaExtension(ext Extension) {
+	if r.CriteriaExt == nil {
+		r.CriteriaExt = &Element{}
+	}
+	r.CriteriaExt.Extension = append(r.CriteriaExt.Extension, ext)
+}
+
+// AddErrorExtension appends ext to the extensions of the error
+// primitive (JSON "_error"), allocating ErrorExt if needed.
+func (r *Subscription) AddErrorExtension(ext Extension) {
+	if r.ErrorExt == nil {
+		r.ErrorExt = &Element{}
+	}
+	r.ErrorExt.Extension = append(r.ErrorExt.Extension, ext)
+}
+
+// ChoiceGroups returns the choice elements of the Subscription, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Subscription) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the Subscription,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Subscription) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -155,9 +241,9 @@ func (r *Subscription) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -133,6 +132,85 @@ func (r *SubstanceDefinition) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *SubstanceDefinition) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *SubstanceDefinition) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddVersionExtension appends ext to the extensions of the version
+// primitive (JSON "_version"), allocating VersionExt if needed.
+func (r *SubstanceDefinition) AddVersionExtension(ext Extension) {
+	if r.VersionExt == nil {
+		r.VersionExt = &Element{}
+	}
+	r.VersionExt.Extension = append(r.VersionExt.Extension, ext)
+}
+
+// AddDescriptionExtension appends ext to the extensions of the description
+// primitive (JSON "_description"), allocating DescriptionExt if needed.
+func (r *SubstanceDefinition) AddDescriptionExtension(ext Extension) {
+	if r.DescriptionExt == nil {
+		r.DescriptionExt = &Element{}
+	}
+	r.DescriptionExt.Extension = append(r.DescriptionExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *SubstanceDefinition) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *SubstanceDefinition) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// FirstName returns a pointer to the first name entry, or nil
+// if there is none.
+func (r *SubstanceDefinition) FirstName() *SubstanceDefinitionName {
+	if r == nil || len(r.Name) == 0 {
+		return nil
+	}
+	return &r.Name[0]
+}
+
+// ChoiceGroups returns the choice elements of the SubstanceDefinition, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *SubstanceDefinition) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the SubstanceDefinition,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *SubstanceDefinition) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -173,9 +251,9 @@ func (r *SubstanceDefinition) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -685,6 +763,12 @@ type SubstanceDefinitionMoiety struct {
 	MeasurementType *CodeableConcept `json:"measurementType,omitempty"`
 }
 
+// HasAmount reports whether any amount[x] variant of the
+// SubstanceDefinitionMoiety is set.
+func (b *SubstanceDefinitionMoiety) HasAmount() bool {
+	return b.AmountQuantity != nil || b.AmountString != nil
+}
+
 // MarshalXML serializes SubstanceDefinitionMoiety to FHIR-conformant XML.
 func (b SubstanceDefinitionMoiety) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1313,6 +1397,12 @@ type SubstanceDefinitionProperty struct {
 	ValueAttachment *Attachment `json:"valueAttachment,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// SubstanceDefinitionProperty is set.
+func (b *SubstanceDefinitionProperty) HasValue() bool {
+	return b.ValueCodeableConcept != nil || b.ValueQuantity != nil || b.ValueDate != nil || b.ValueBoolean != nil || b.ValueAttachment != nil
+}
+
 // MarshalXML serializes SubstanceDefinitionProperty to FHIR-conformant XML.
 func (b SubstanceDefinitionProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1470,6 +1560,18 @@ type SubstanceDefinitionRelationship struct {
 	Source []Reference `json:"source,omitempty"`
 }
 
+// HasSubstanceDefinition reports whether any substanceDefinition[x] variant of the
+// SubstanceDefinitionRelationship is set.
+func (b *SubstanceDefinitionRelationship) HasSubstanceDefinition() bool {
+	return b.SubstanceDefinitionReference != nil || b.SubstanceDefinitionCodeableConcept != nil
+}
+
+// HasAmount reports whether any amount[x] variant of the
+// SubstanceDefinitionRelationship is set.
+func (b *SubstanceDefinitionRelationship) HasAmount() bool {
+	return b.AmountQuantity != nil || b.AmountRatio != nil || b.AmountString != nil
+}
+
 // MarshalXML serializes SubstanceDefinitionRelationship to FHIR-conformant XML.
 func (b SubstanceDefinitionRelationship) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
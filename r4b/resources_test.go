@@ -2,6 +2,8 @@ package r4b
 
 import (
 	"encoding/json"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -167,3 +169,262 @@ func TestPatient_MarshalJSON_NoHTMLEscape(t *testing.T) {
 		assert.Equal(t, originalDiv, *decoded.Text.Div)
 	})
 }
+
+func TestStatusHolder(t *testing.T) {
+	t.Run("observation status", func(t *testing.T) {
+		var r Resource = &Observation{Status: ptr(ObservationStatusFinal)}
+
+		holder, ok := r.(StatusHolder)
+		require.True(t, ok)
+		assert.Equal(t, "final", holder.GetStatus())
+	})
+
+	t.Run("unset status", func(t *testing.T) {
+		var r Resource = &MedicationRequest{}
+
+		holder, ok := r.(StatusHolder)
+		require.True(t, ok)
+		assert.Equal(t, "", holder.GetStatus())
+	})
+
+	t.Run("resource without status", func(t *testing.T) {
+		var r Resource = &Patient{}
+
+		_, ok := r.(StatusHolder)
+		assert.False(t, ok)
+	})
+}
+
+func TestPerformerHolder(t *testing.T) {
+	t.Run("observation performers", func(t *testing.T) {
+		var r Resource = &Observation{
+			Performer: []Reference{{Reference: ptr("Practitioner/1")}, {Reference: ptr("Organization/2")}},
+		}
+
+		holder, ok := r.(PerformerHolder)
+		require.True(t, ok)
+		refs := holder.Performers()
+		require.Len(t, refs, 2)
+		assert.Equal(t, "Practitioner/1", *refs[0].Reference)
+		assert.Equal(t, "Organization/2", *refs[1].Reference)
+	})
+
+	t.Run("diagnostic report performers and interpreters", func(t *testing.T) {
+		var r Resource = &DiagnosticReport{
+			Performer:          []Reference{{Reference: ptr("Organization/lab")}},
+			ResultsInterpreter: []Reference{{Reference: ptr("Practitioner/1")}},
+		}
+
+		holder, ok := r.(PerformerHolder)
+		require.True(t, ok)
+		refs := holder.Performers()
+		require.Len(t, refs, 2)
+		assert.Equal(t, "Organization/lab", *refs[0].Reference)
+		assert.Equal(t, "Practitioner/1", *refs[1].Reference)
+	})
+
+	t.Run("no performers set", func(t *testing.T) {
+		holder, ok := Resource(&Observation{}).(PerformerHolder)
+		require.True(t, ok)
+		assert.Empty(t, holder.Performers())
+	})
+
+	t.Run("resource without performers", func(t *testing.T) {
+		_, ok := Resource(&Patient{}).(PerformerHolder)
+		assert.False(t, ok)
+	})
+}
+
+func TestAsDomainResource(t *testing.T) {
+	patient := &Patient{Id: ptr("p1")}
+	dr, ok := AsDomainResource(patient)
+	require.True(t, ok)
+	dr.SetText(&Narrative{Status: ptr(NarrativeStatusGenerated)})
+	assert.Equal(t, NarrativeStatusGenerated, *patient.Text.Status)
+
+	_, ok = AsDomainResource(&Bundle{})
+	assert.False(t, ok)
+	_, ok = AsDomainResource(nil)
+	assert.False(t, ok)
+
+	for _, rt := range AllResourceTypes() {
+		r, err := NewResource(rt)
+		require.NoError(t, err)
+		_, ok := AsDomainResource(r)
+		assert.Equal(t, rt != "Bundle" && rt != "Binary" && rt != "Parameters", ok, rt)
+	}
+}
+
+func TestPrimaryCode(t *testing.T) {
+	t.Run("observation", func(t *testing.T) {
+		obs := &Observation{Code: CodeableConcept{Text: ptr("Heart rate")}}
+		code := PrimaryCode(obs)
+		require.NotNil(t, code)
+		assert.Equal(t, "Heart rate", *code.Text)
+		assert.Same(t, &obs.Code, code)
+
+		// Code is required, so it is not a pointer; unset still means nil.
+		assert.Nil(t, PrimaryCode(&Observation{}))
+		assert.Nil(t, (&Observation{}).PrimaryCode())
+	})
+
+	t.Run("condition", func(t *testing.T) {
+		cond := &Condition{Code: &CodeableConcept{Text: ptr("Asthma")}}
+		assert.Same(t, cond.Code, PrimaryCode(cond))
+		assert.Nil(t, PrimaryCode(&Condition{}))
+	})
+
+	t.Run("resource without code", func(t *testing.T) {
+		assert.Nil(t, PrimaryCode(&Patient{}))
+		assert.Nil(t, PrimaryCode(nil))
+	})
+
+	// Every resource with a single CodeableConcept code implements CodeHolder.
+	concept := reflect.TypeOf(CodeableConcept{})
+	for _, rt := range AllResourceTypes() {
+		r, err := NewResource(rt)
+		require.NoError(t, err)
+		f, ok := reflect.TypeOf(r).Elem().FieldByName("Code")
+		want := ok && (f.Type == concept || f.Type == reflect.PointerTo(concept))
+		_, got := r.(CodeHolder)
+		assert.Equal(t, want, got, rt)
+	}
+}
+
+func TestAddPrimitiveExtension(t *testing.T) {
+	patient := &Patient{BirthDate: ptr("1970-01-01")}
+	patient.AddBirthDateExtension(Extension{
+		Url:           "http://hl7.org/fhir/StructureDefinition/patient-birthTime",
+		ValueDateTime: ptr("1970-01-01T08:30:00Z"),
+	})
+	patient.AddBirthDateExtension(Extension{Url: "http://example.org/second", ValueBoolean: ptr(true)})
+
+	require.NotNil(t, patient.BirthDateExt)
+	require.Len(t, patient.BirthDateExt.Extension, 2)
+	assert.Equal(t, "http://example.org/second", patient.BirthDateExt.Extension[1].Url)
+
+	data, err := Marshal(patient)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"birthDate":"1970-01-01"`)
+	assert.Contains(t, string(data), `"_birthDate":{"extension":[{"url":"http://hl7.org/fhir/StructureDefinition/patient-birthTime","valueDateTime":"1970-01-01T08:30:00Z"}`)
+}
+
+func TestFirstElementAccessors(t *testing.T) {
+	t.Run("empty name list", func(t *testing.T) {
+		patient := &Patient{}
+		assert.Nil(t, patient.FirstName())
+		assert.Nil(t, patient.FirstIdentifier())
+
+		var nilPatient *Patient
+		assert.Nil(t, nilPatient.FirstName())
+	})
+
+	t.Run("populated name list", func(t *testing.T) {
+		patient := &Patient{Name: []HumanName{{Family: ptr("Doe")}, {Family: ptr("Roe")}}}
+
+		name := patient.FirstName()
+		require.NotNil(t, name)
+		assert.Equal(t, "Doe", *name.Family)
+
+		name.Family = ptr("Smith")
+		assert.Equal(t, "Smith", *patient.Name[0].Family, "pointer into the list")
+	})
+}
+
+func TestXHTMLFields(t *testing.T) {
+	t.Run("narrative div is the only xhtml element", func(t *testing.T) {
+		// Any new xhtml element gets the same raw handling as Narrative.div;
+		// this test flags it so it can be covered explicitly.
+		var paths []string
+		for path, typ := range fhirpathModel.path2Type {
+			if typ == "xhtml" {
+				paths = append(paths, path)
+			}
+		}
+		assert.Equal(t, []string{"Narrative.div"}, paths)
+	})
+
+	t.Run("nested narrative div is raw in JSON and XML", func(t *testing.T) {
+		div := `<div xmlns="http://www.w3.org/1999/xhtml"><p>A &amp; <b>B</b></p></div>`
+		patient := &Patient{
+			Id: ptr("p1"),
+			Contained: []Resource{
+				&Organization{Id: ptr("o1"), Text: &Narrative{Status: ptr(NarrativeStatusGenerated), Div: ptr(div)}},
+			},
+		}
+
+		data, err := Marshal(patient)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `<p>A &amp; <b>B</b></p>`)
+
+		xmlData, err := MarshalResourceXML(patient)
+		require.NoError(t, err)
+		assert.Contains(t, string(xmlData), div)
+
+		decoded, err := UnmarshalResourceXML(xmlData)
+		require.NoError(t, err)
+		org := decoded.(*Patient).Contained[0].(*Organization)
+		assert.Equal(t, div, *org.Text.Div)
+	})
+}
+
+func TestChoiceGroups(t *testing.T) {
+	obs := &Observation{}
+	groups := obs.ChoiceGroups()
+
+	var want []string
+	for _, typ := range FHIRPathModel().ChoiceTypes("Observation.value") {
+		want = append(want, "Value"+strings.ToUpper(typ[:1])+typ[1:])
+	}
+	assert.Equal(t, want, groups["value"])
+	assert.Contains(t, groups["value"], "ValueQuantity")
+	assert.Contains(t, groups["value"], "ValueString")
+	assert.Contains(t, groups, "effective")
+
+	assert.Nil(t, (&Bundle{}).ChoiceGroups())
+
+	// Every variant names a field of the resource.
+	for _, rt := range AllResourceTypes() {
+		r, err := NewResource(rt)
+		require.NoError(t, err)
+		groups, ok := r.(interface{ ChoiceGroups() map[string][]string })
+		require.True(t, ok, rt)
+		typ := reflect.TypeOf(r).Elem()
+		for name, fields := range groups.ChoiceGroups() {
+			assert.NotEmpty(t, fields, "%s.%s", rt, name)
+			for _, f := range fields {
+				_, ok := typ.FieldByName(f)
+				assert.True(t, ok, "%s.%s", rt, f)
+			}
+		}
+	}
+}
+
+func TestHasChoice(t *testing.T) {
+	p := &Patient{}
+	assert.False(t, p.HasDeceased())
+	p.DeceasedBoolean = ptr(false)
+	assert.True(t, p.HasDeceased())
+	p.DeceasedBoolean = nil
+	p.DeceasedDateTime = ptr("2020-01-01")
+	assert.True(t, p.HasDeceased())
+
+	c := &ObservationComponent{}
+	assert.False(t, c.HasValue())
+	c.ValueString = ptr("n/a")
+	assert.True(t, c.HasValue())
+
+	// Every choice group has a predicate.
+	for _, rt := range AllResourceTypes() {
+		r, err := NewResource(rt)
+		require.NoError(t, err)
+		groups := r.(interface{ ChoiceGroups() map[string][]string }).ChoiceGroups()
+		v := reflect.ValueOf(r)
+		for name := range groups {
+			m := v.MethodByName("Has" + strings.ToUpper(name[:1]) + name[1:])
+			if assert.True(t, m.IsValid(), "%s.%s", rt, name) {
+				assert.False(t, m.Call(nil)[0].Bool(), "%s.%s", rt, name)
+			}
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package r4b
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Values parses the space-delimited data of s into decimals, preserving the
+// precision of each value as written. The special tokens "E" (error), "U"
+// (above detection limit) and "L" (below detection limit) yield nil entries.
+// It returns nil if s has no data.
+func (s SampledData) Values() ([]*Decimal, error) {
+	if s.Data == nil {
+		return nil, nil
+	}
+	fields := strings.Fields(*s.Data)
+	values := make([]*Decimal, len(fields))
+	for i, f := range fields {
+		switch f {
+		case "E", "U", "L":
+			continue
+		}
+		d, err := NewDecimalFromString(f)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SampledData value %q at position %d: %w", f, i, err)
+		}
+		values[i] = d
+	}
+	return values, nil
+}
+
+// SetValues sets the data of s to values, space-delimited, writing each
+// decimal with its original precision and nil entries as "E". Since nil does
+// not say which special token was meant, set Data directly to write "U" or
+// "L". An empty values clears the data.
+func (s *SampledData) SetValues(values []*Decimal) {
+	if len(values) == 0 {
+		s.Data = nil
+		return
+	}
+	fields := make([]string, len(values))
+	for i, v := range values {
+		if v == nil {
+			fields[i] = "E"
+			continue
+		}
+		fields[i] = v.String()
+	}
+	data := strings.Join(fields, " ")
+	s.Data = &data
+}
@@ -0,0 +1,38 @@
+package r4b_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4b"
+)
+
+func TestStorageKey(t *testing.T) {
+	t.Run("with id", func(t *testing.T) {
+		key, err := r4b.StorageKey(&r4b.Patient{Id: ptrString("123")})
+		require.NoError(t, err)
+		assert.Equal(t, "Patient/123", key)
+
+		resourceType, id, err := r4b.ParseStorageKey(key)
+		require.NoError(t, err)
+		assert.Equal(t, "Patient", resourceType)
+		assert.Equal(t, "123", id)
+	})
+
+	t.Run("without id", func(t *testing.T) {
+		_, err := r4b.StorageKey(&r4b.Patient{})
+		assert.EqualError(t, err, "Patient has no id")
+
+		_, err = r4b.StorageKey(nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid keys", func(t *testing.T) {
+		for _, key := range []string{"", "Patient", "Patient/", "/123", "Patient/123/_history/1", "Unknown/123"} {
+			_, _, err := r4b.ParseStorageKey(key)
+			assert.Error(t, err, key)
+		}
+	})
+}
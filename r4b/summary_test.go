@@ -0,0 +1,16 @@
+package r4b_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gofhir/models/r4b"
+)
+
+func TestIsSummaryField(t *testing.T) {
+	assert.True(t, r4b.IsSummaryField("Patient", "name"))
+	assert.True(t, r4b.IsSummaryField("Observation", "status"))
+	assert.False(t, r4b.IsSummaryField("Patient", "contact"))
+	assert.False(t, r4b.IsSummaryField("Unknown", "id"))
+}
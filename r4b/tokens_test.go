@@ -0,0 +1,60 @@
+package r4b_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gofhir/models/r4b"
+)
+
+func TestExtractTokens(t *testing.T) {
+	t.Run("observation codes and categories", func(t *testing.T) {
+		status := r4b.ObservationStatusFinal
+		obs := &r4b.Observation{
+			Identifier: []r4b.Identifier{
+				{System: ptrString("urn:ids"), Value: ptrString("obs-1")},
+			},
+			Status: &status,
+			Category: []r4b.CodeableConcept{{
+				Coding: []r4b.Coding{{
+					System: ptrString("http://terminology.hl7.org/CodeSystem/observation-category"),
+					Code:   ptrString("vital-signs"),
+				}},
+			}},
+			Code: r4b.CodeableConcept{
+				Coding: []r4b.Coding{
+					{System: ptrString("http://loinc.org"), Code: ptrString("8867-4")},
+					{System: ptrString("http://loinc.org"), Code: ptrString("8867-4")},
+					{Code: ptrString("hr")},
+					{System: ptrString("http://loinc.org"), Display: ptrString("no code")},
+				},
+				Text: ptrString("Heart rate"),
+			},
+		}
+
+		assert.Equal(t, []string{
+			"urn:ids|obs-1",
+			"|final",
+			"http://terminology.hl7.org/CodeSystem/observation-category|vital-signs",
+			"http://loinc.org|8867-4",
+			"|hr",
+		}, r4b.ExtractTokens(obs))
+	})
+
+	t.Run("includes contained resources", func(t *testing.T) {
+		patient := &r4b.Patient{
+			Contained: []r4b.Resource{
+				&r4b.Organization{Identifier: []r4b.Identifier{
+					{System: ptrString("urn:org"), Value: ptrString("acme")},
+				}},
+			},
+		}
+
+		assert.Equal(t, []string{"urn:org|acme"}, r4b.ExtractTokens(patient))
+	})
+
+	t.Run("nil resource", func(t *testing.T) {
+		assert.Empty(t, r4b.ExtractTokens(nil))
+	})
+}
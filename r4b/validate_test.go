@@ -0,0 +1,118 @@
+package r4b_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4b"
+)
+
+func validationPaths(t *testing.T, err error) []string {
+	t.Helper()
+	require.Error(t, err)
+	var paths []string
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var ve *r4b.ValidationError
+		require.True(t, errors.As(e, &ve), "unexpected error %v", e)
+		paths = append(paths, ve.Path)
+	}
+	return paths
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		status := r4b.ObservationStatusFinal
+		obs := &r4b.Observation{
+			Status: &status,
+			Code:   r4b.CodeableConcept{Text: ptrString("weight")},
+		}
+		assert.NoError(t, r4b.Validate(obs))
+	})
+
+	t.Run("missing required elements", func(t *testing.T) {
+		obs := &r4b.Observation{
+			Component: []r4b.ObservationComponent{{ValueString: ptrString("n/a")}},
+		}
+		assert.Equal(t, []string{
+			"Observation.status",
+			"Observation.code",
+			"Observation.component[0].code",
+		}, validationPaths(t, r4b.Validate(obs)))
+	})
+
+	t.Run("contained resources", func(t *testing.T) {
+		status := r4b.ObservationStatusFinal
+		obs := &r4b.Observation{
+			Status:    &status,
+			Code:      r4b.CodeableConcept{Text: ptrString("weight")},
+			Contained: []r4b.Resource{&r4b.Bundle{Id: ptrString("b1")}},
+		}
+		assert.Equal(t, []string{"Observation.contained[#b1].type"}, validationPaths(t, r4b.Validate(obs)))
+	})
+
+	t.Run("nested contained resources", func(t *testing.T) {
+		nested := func(depth int) *r4b.Basic {
+			root := &r4b.Basic{Code: r4b.CodeableConcept{Text: ptrString("root")}}
+			parent := root
+			for i := 1; i <= depth; i++ {
+				child := &r4b.Basic{
+					Id:   ptrString("c" + strconv.Itoa(i)),
+					Code: r4b.CodeableConcept{Text: ptrString("child")},
+				}
+				parent.Contained = []r4b.Resource{child}
+				parent = child
+			}
+			return root
+		}
+
+		assert.NoError(t, r4b.Validate(nested(r4b.MaxContainedDepth)))
+
+		err := r4b.Validate(nested(r4b.MaxContainedDepth + 2))
+		assert.Equal(t, []string{"Basic.contained[#c1].contained[#c2].contained[#c3].contained[#c4]"}, validationPaths(t, err))
+		assert.ErrorContains(t, err, "nesting depth 4 exceeds the maximum of 3")
+
+		defer func(limit int) { r4b.MaxContainedDepth = limit }(r4b.MaxContainedDepth)
+		r4b.MaxContainedDepth = 1
+		assert.Equal(t, []string{"Basic.contained[#c1].contained[#c2]"}, validationPaths(t, r4b.Validate(nested(2))))
+		r4b.MaxContainedDepth = 0
+		assert.NoError(t, r4b.Validate(nested(5)))
+	})
+
+	t.Run("choice element", func(t *testing.T) {
+		status := r4b.MedicationrequestStatusActive
+		intent := r4b.MedicationRequestIntentOrder
+		req := &r4b.MedicationRequest{
+			Status:  &status,
+			Intent:  &intent,
+			Subject: r4b.Reference{Reference: ptrString("Patient/1")},
+		}
+		assert.Equal(t, []string{"MedicationRequest.medication"}, validationPaths(t, r4b.Validate(req)))
+
+		req.MedicationReference = &r4b.Reference{Reference: ptrString("Medication/1")}
+		assert.NoError(t, r4b.Validate(req))
+	})
+
+	t.Run("empty primitive companion", func(t *testing.T) {
+		patient := &r4b.Patient{BirthDateExt: &r4b.Element{Id: ptrString("bd")}}
+		assert.Equal(t, []string{"Patient.birthDate"}, validationPaths(t, r4b.Validate(patient)))
+
+		patient.BirthDate = ptrString("1970-01-01")
+		assert.NoError(t, r4b.Validate(patient))
+	})
+
+	t.Run("primitive with only extensions", func(t *testing.T) {
+		patient := &r4b.Patient{BirthDateExt: &r4b.Element{Extension: []r4b.Extension{{
+			Url:       "http://hl7.org/fhir/StructureDefinition/data-absent-reason",
+			ValueCode: ptrString("unknown"),
+		}}}}
+		assert.NoError(t, r4b.Validate(patient))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Error(t, r4b.Validate(nil))
+	})
+}
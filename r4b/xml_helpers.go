@@ -368,14 +368,49 @@ func xmlEncodeInlineResource(e *xml.Encoder, resource Resource) error {
 
 // xmlEncodeRawXHTML injects raw XHTML content verbatim into the XML output.
 // The rawXHTML string should contain the full <div xmlns="...">...</div> element.
+// The outer element is re-emitted with its attributes as written, and its
+// content is injected unescaped.
 func xmlEncodeRawXHTML(e *xml.Encoder, rawXHTML *string) error {
 	if rawXHTML == nil || *rawXHTML == "" {
 		return nil
 	}
-	type rawInner struct {
-		Content string `xml:",innerxml"`
+	raw := *rawXHTML
+	d := xml.NewDecoder(strings.NewReader(raw))
+	var start xml.StartElement
+	for {
+		tok, err := d.RawToken()
+		if err != nil {
+			return fmt.Errorf("invalid xhtml: %w", err)
+		}
+		if t, ok := tok.(xml.StartElement); ok {
+			start = t
+			break
+		}
+	}
+	// Keep prefixed attributes (xmlns:xlink, xml:lang) as written rather
+	// than letting the encoder invent namespace prefixes for them.
+	attrs := make([]xml.Attr, len(start.Attr))
+	for i, a := range start.Attr {
+		name := a.Name.Local
+		if a.Name.Space != "" {
+			name = a.Name.Space + ":" + name
+		}
+		attrs[i] = xml.Attr{Name: xml.Name{Local: name}, Value: a.Value}
 	}
-	return e.Encode(rawInner{Content: *rawXHTML})
+	var content string
+	if end := strings.LastIndex(raw, "</"); end > int(d.InputOffset()) {
+		content = raw[d.InputOffset():end]
+	}
+	type rawElement struct {
+		XMLName xml.Name
+		Attrs   []xml.Attr `xml:",any,attr"`
+		Content string     `xml:",innerxml"`
+	}
+	return e.Encode(rawElement{
+		XMLName: xml.Name{Local: start.Name.Local},
+		Attrs:   attrs,
+		Content: content,
+	})
 }
 
 // ============================================================================
@@ -390,24 +425,14 @@ func UnmarshalResourceXML(data []byte) (Resource, error) {
 	for {
 		tok, err := d.Token()
 		if err != nil {
-			return nil, fmt.Errorf("failed to find root element: %w", err)
+			err = fmt.Errorf("failed to find root element: %w", err)
+			if OnDecode != nil {
+				OnDecode("", err)
+			}
+			return nil, err
 		}
 		if start, ok := tok.(xml.StartElement); ok {
-			resource, err := NewResource(start.Name.Local)
-			if err != nil {
-				return nil, fmt.Errorf("unknown resource type %q: %w", start.Name.Local, err)
-			}
-			type xmlUnmarshaler interface {
-				UnmarshalXML(*xml.Decoder, xml.StartElement) error
-			}
-			u, ok := resource.(xmlUnmarshaler)
-			if !ok {
-				return nil, fmt.Errorf("resource %s does not implement UnmarshalXML", start.Name.Local)
-			}
-			if err := u.UnmarshalXML(d, start); err != nil {
-				return nil, err
-			}
-			return resource, nil
+			return xmlDecodeInlineResource(d, start)
 		}
 	}
 }
@@ -541,7 +566,7 @@ func xmlDecodePrimitiveDecimal(d *xml.Decoder, start xml.StartElement) (*Decimal
 }
 
 // xmlDecodePrimitiveCode decodes a FHIR code primitive with a custom string-based type.
-func xmlDecodePrimitiveCode[T ~string](d *xml.Decoder, start xml.StartElement) (*T, *Element, error) {
+func xmlDecodePrimitiveCode[T knownCode](d *xml.Decoder, start xml.StartElement) (*T, *Element, error) {
 	s, elem, err := xmlDecodePrimitiveString(d, start)
 	if err != nil {
 		return nil, nil, err
@@ -550,6 +575,9 @@ func xmlDecodePrimitiveCode[T ~string](d *xml.Decoder, start xml.StartElement) (
 		return nil, elem, nil
 	}
 	v := T(*s)
+	if err := checkCode(v); err != nil {
+		return nil, nil, err
+	}
 	return &v, elem, nil
 }
 
@@ -584,7 +612,17 @@ func xmlDecodeContainedResource(d *xml.Decoder, start xml.StartElement) (Resourc
 }
 
 // xmlDecodeInlineResource decodes a resource element where the element name IS the resource type.
+// The outcome is reported to OnDecode when set.
 func xmlDecodeInlineResource(d *xml.Decoder, start xml.StartElement) (Resource, error) {
+	resource, err := xmlDecodeResource(d, start)
+	if OnDecode != nil {
+		OnDecode(start.Name.Local, err)
+	}
+	return resource, err
+}
+
+// xmlDecodeResource implements xmlDecodeInlineResource without the OnDecode report.
+func xmlDecodeResource(d *xml.Decoder, start xml.StartElement) (Resource, error) {
 	resource, err := NewResource(start.Name.Local)
 	if err != nil {
 		return nil, fmt.Errorf("unknown resource type %q: %w", start.Name.Local, err)
@@ -0,0 +1,113 @@
+package r4b
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+)
+
+// xmlPrefixRe matches the namespace prefixes accepted by
+// MarshalResourceXMLWithPrefix.
+var xmlPrefixRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9._-]*$`)
+
+// MarshalResourceXMLWithPrefix writes r to w as XML like MarshalResourceXML,
+// but binds the FHIR namespace to prefix on the root element
+// (<f:Patient xmlns:f="http://hl7.org/fhir">) and qualifies every FHIR
+// element with it. Narrative XHTML keeps its own default namespace and is
+// written unprefixed. An empty prefix gives the MarshalResourceXML output.
+//
+// UnmarshalResourceXML and the Decoder resolve namespaces, so they read the
+// output back.
+func MarshalResourceXMLWithPrefix(w io.Writer, r Resource, prefix string) error {
+	if prefix != "" && !xmlPrefixRe.MatchString(prefix) {
+		return fmt.Errorf("invalid XML namespace prefix %q", prefix)
+	}
+	data, err := MarshalResourceXML(r)
+	if err != nil {
+		return err
+	}
+	if prefix != "" {
+		if data, err = prefixFHIRElements(data, prefix); err != nil {
+			return err
+		}
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// prefixFHIRElements rewrites the unprefixed FHIR XML in data so that FHIR
+// elements carry prefix. Elements that declare another default namespace,
+// and their descendants, are copied unchanged. Empty elements are written
+// self-closing.
+func prefixFHIRElements(data []byte, prefix string) ([]byte, error) {
+	var buf bytes.Buffer
+	d := xml.NewDecoder(bytes.NewReader(data))
+	var foreign []bool // per open element: outside the FHIR namespace
+	open := false      // a start tag is waiting for ">" or "/>"
+	for {
+		tok, err := d.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := tok.(xml.EndElement); ok && open {
+			buf.WriteString("/>")
+			open = false
+			foreign = foreign[:len(foreign)-1]
+			continue
+		}
+		if open {
+			buf.WriteByte('>')
+			open = false
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			inForeign := len(foreign) > 0 && foreign[len(foreign)-1]
+			for _, a := range t.Attr {
+				if a.Name.Space == "" && a.Name.Local == "xmlns" && a.Value != fhirNamespace {
+					inForeign = true
+				}
+			}
+			buf.WriteByte('<')
+			if !inForeign {
+				buf.WriteString(prefix + ":")
+			}
+			buf.WriteString(t.Name.Local)
+			for _, a := range t.Attr {
+				name := a.Name.Local
+				if a.Name.Space != "" {
+					name = a.Name.Space + ":" + name
+				} else if name == "xmlns" && a.Value == fhirNamespace {
+					name = "xmlns:" + prefix
+				}
+				buf.WriteString(" " + name + `="` + xmlEscapeAttr(a.Value) + `"`)
+			}
+			foreign = append(foreign, inForeign)
+			open = true
+		case xml.EndElement:
+			buf.WriteString("</")
+			if !foreign[len(foreign)-1] {
+				buf.WriteString(prefix + ":")
+			}
+			buf.WriteString(t.Name.Local + ">")
+			foreign = foreign[:len(foreign)-1]
+		case xml.CharData:
+			if err := xml.EscapeText(&buf, t); err != nil {
+				return nil, err
+			}
+		case xml.Comment:
+			buf.WriteString("<!--")
+			buf.Write(t)
+			buf.WriteString("-->")
+		case xml.ProcInst:
+			buf.WriteString("<?" + t.Target + " ")
+			buf.Write(t.Inst)
+			buf.WriteString("?>")
+		}
+	}
+	return buf.Bytes(), nil
+}
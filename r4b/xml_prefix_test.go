@@ -0,0 +1,66 @@
+package r4b_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r4b"
+)
+
+func TestMarshalResourceXMLWithPrefix(t *testing.T) {
+	status := r4b.NarrativeStatusGenerated
+	patient := &r4b.Patient{
+		Id: ptrString("p1"),
+		Text: &r4b.Narrative{
+			Status: &status,
+			Div:    ptrString(`<div xmlns="http://www.w3.org/1999/xhtml"><p>Jane</p></div>`),
+		},
+		Active: ptrBool(true),
+		Name:   []r4b.HumanName{{Family: ptrString("Doe")}},
+		Contained: []r4b.Resource{
+			&r4b.Organization{Id: ptrString("org1"), Name: ptrString("Acme")},
+		},
+	}
+
+	t.Run("prefixed output", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, r4b.MarshalResourceXMLWithPrefix(&buf, patient, "f"))
+		out := buf.String()
+
+		assert.Contains(t, out, `<f:Patient xmlns:f="http://hl7.org/fhir">`)
+		assert.Contains(t, out, `<f:id value="p1"/>`)
+		assert.Contains(t, out, `<f:contained><f:Organization><f:id value="org1"/>`)
+		assert.Contains(t, out, `<f:name><f:family value="Doe"/></f:name>`)
+		assert.Contains(t, out, `<div xmlns="http://www.w3.org/1999/xhtml"><p>Jane</p></div>`)
+		assert.Contains(t, out, `</f:Patient>`)
+		assert.NotContains(t, out, `<Patient`)
+
+		decoded, err := r4b.UnmarshalResourceXML(buf.Bytes())
+		require.NoError(t, err)
+		got, ok := decoded.(*r4b.Patient)
+		require.True(t, ok)
+		assert.Equal(t, "p1", *got.Id)
+		assert.True(t, *got.Active)
+		assert.Equal(t, "Doe", *got.Name[0].Family)
+		assert.Equal(t, *patient.Text.Div, *got.Text.Div)
+		require.Len(t, got.Contained, 1)
+		assert.Equal(t, "Acme", *got.Contained[0].(*r4b.Organization).Name)
+	})
+
+	t.Run("empty prefix", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, r4b.MarshalResourceXMLWithPrefix(&buf, patient, ""))
+		want, err := r4b.MarshalResourceXML(patient)
+		require.NoError(t, err)
+		assert.Equal(t, string(want), buf.String())
+	})
+
+	t.Run("invalid prefix", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.Error(t, r4b.MarshalResourceXMLWithPrefix(&buf, patient, "a:b"))
+		assert.Zero(t, buf.Len())
+	})
+}
@@ -0,0 +1,123 @@
+package r4b
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// UnmarshalResourceXMLStrict is like UnmarshalResourceXML but also rejects
+// documents whose elements do not appear in StructureDefinition order, at
+// every nesting level including contained and Bundle entry resources.
+//
+// Elements the decoder does not know are ignored by the order check, as they
+// are by UnmarshalResourceXML.
+func UnmarshalResourceXMLStrict(data []byte) (Resource, error) {
+	resource, err := UnmarshalResourceXML(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := xmlCheckDocumentOrder(data); err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
+
+// xmlCheckDocumentOrder validates element order for the resource at the root of data.
+func xmlCheckDocumentOrder(data []byte) error {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return fmt.Errorf("failed to find root element: %w", err)
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			return xmlCheckResourceOrder(d, start, start.Name.Local)
+		}
+	}
+}
+
+// xmlCheckResourceOrder validates a resource element whose name is the resource type.
+func xmlCheckResourceOrder(d *xml.Decoder, start xml.StartElement, path string) error {
+	resource, err := NewResource(start.Name.Local)
+	if err != nil {
+		return fmt.Errorf("unknown resource type %q: %w", start.Name.Local, err)
+	}
+	return xmlCheckElementOrder(d, reflect.TypeOf(resource).Elem(), path)
+}
+
+// xmlCheckElementOrder consumes the children of an element of struct type t,
+// up to and including its end tag, failing on the first child that appears
+// before one of its predecessors in elementOrders.
+func xmlCheckElementOrder(d *xml.Decoder, t reflect.Type, path string) error {
+	order := elementOrders[t.Name()]
+	last, lastName := -1, ""
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch tok := tok.(type) {
+		case xml.EndElement:
+			return nil
+		case xml.StartElement:
+			name := tok.Name.Local
+			idx := slices.Index(order, name)
+			if idx < 0 {
+				if err := d.Skip(); err != nil {
+					return err
+				}
+				continue
+			}
+			if idx < last {
+				return fmt.Errorf("%s: element <%s> out of order, must precede <%s>", path, name, lastName)
+			}
+			last, lastName = idx, name
+			if err := xmlCheckChildOrder(d, xmlFieldType(t, name), path+"."+name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// xmlCheckChildOrder validates the element just opened, whose Go field type is ft.
+func xmlCheckChildOrder(d *xml.Decoder, ft reflect.Type, path string) error {
+	for ft.Kind() == reflect.Ptr || ft.Kind() == reflect.Slice {
+		ft = ft.Elem()
+	}
+	switch {
+	case ft.Kind() == reflect.Interface:
+		// Wrapped resource: <contained><Patient>...</Patient></contained>
+		for {
+			tok, err := d.Token()
+			if err != nil {
+				return err
+			}
+			switch tok := tok.(type) {
+			case xml.StartElement:
+				if err := xmlCheckResourceOrder(d, tok, path); err != nil {
+					return err
+				}
+			case xml.EndElement:
+				return nil
+			}
+		}
+	case ft.Kind() == reflect.Struct && elementOrders[ft.Name()] != nil:
+		return xmlCheckElementOrder(d, ft, path)
+	default:
+		// Primitives and raw XHTML
+		return d.Skip()
+	}
+}
+
+// xmlFieldType returns the type of the field of struct type t whose JSON name is name.
+func xmlFieldType(t reflect.Type, name string) reflect.Type {
+	for i := 0; i < t.NumField(); i++ {
+		if jsonFieldName(t.Field(i)) == name {
+			return t.Field(i).Type
+		}
+	}
+	return reflect.TypeOf("")
+}
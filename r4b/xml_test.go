@@ -1,6 +1,8 @@
 package r4b
 
 import (
+	"bytes"
+	"encoding/xml"
 	"strings"
 	"testing"
 
@@ -172,6 +174,68 @@ func TestPatient_MarshalXML_ContainedResource(t *testing.T) {
 	assert.Contains(t, xml, `</contained>`)
 }
 
+// assertNoResourceIdAttr fails if any resource element in data carries an
+// id attribute; resources encode their id as an <id value="..."/> child.
+func assertNoResourceIdAttr(t *testing.T, data []byte) {
+	t.Helper()
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || !IsKnownResourceType(start.Name.Local) {
+			continue
+		}
+		for _, attr := range start.Attr {
+			assert.NotEqual(t, "id", attr.Name.Local, "<%s> has an id attribute", start.Name.Local)
+		}
+	}
+}
+
+func TestMarshalXML_NoResourceIdAttribute(t *testing.T) {
+	t.Run("root Patient", func(t *testing.T) {
+		data, err := MarshalResourceXML(&Patient{Id: ptr("p1")})
+		require.NoError(t, err)
+
+		assert.Contains(t, string(data), `<Patient xmlns="http://hl7.org/fhir"><id value="p1"/>`)
+		assertNoResourceIdAttr(t, data)
+	})
+
+	t.Run("contained and entry resources", func(t *testing.T) {
+		bundle := &Bundle{
+			Id: ptr("b1"),
+			Entry: []BundleEntry{{
+				Resource: &Patient{
+					Id:        ptr("p1"),
+					Contained: []Resource{&Organization{Id: ptr("o1")}},
+				},
+			}},
+		}
+
+		data, err := MarshalResourceXML(bundle)
+		require.NoError(t, err)
+
+		assert.Contains(t, string(data), `<Patient><id value="p1"/>`)
+		assert.Contains(t, string(data), `<Organization><id value="o1"/>`)
+		assertNoResourceIdAttr(t, data)
+	})
+
+	t.Run("every resource type", func(t *testing.T) {
+		for _, resourceType := range AllResourceTypes() {
+			r, err := NewResource(resourceType)
+			require.NoError(t, err)
+			r.SetId("x1")
+
+			data, err := MarshalResourceXML(r)
+			require.NoError(t, err, resourceType)
+			assert.Contains(t, string(data), `<id value="x1"/>`, resourceType)
+			assertNoResourceIdAttr(t, data)
+		}
+	})
+}
+
 func TestExtension_MarshalXML_UrlAttribute(t *testing.T) {
 	ext := Extension{
 		Url:         "http://example.org/my-ext",
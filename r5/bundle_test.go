@@ -0,0 +1,379 @@
+package r5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r5"
+)
+
+func TestMergeBundles(t *testing.T) {
+	searchset := r5.BundleTypeSearchset
+	entry := func(fullURL, id string) r5.BundleEntry {
+		return r5.BundleEntry{
+			FullUrl:  ptrString(fullURL),
+			Resource: &r5.Patient{Id: ptrString(id)},
+		}
+	}
+	total := func(n uint32) *uint32 { return &n }
+
+	t.Run("searchset pages with overlapping entry", func(t *testing.T) {
+		page1 := &r5.Bundle{
+			Type:  &searchset,
+			Total: total(2),
+			Link:  []r5.BundleLink{{Relation: ptrString("next"), Url: ptrString("http://x/page2")}},
+			Entry: []r5.BundleEntry{entry("http://x/Patient/1", "1"), entry("http://x/Patient/2", "2")},
+		}
+		page2 := &r5.Bundle{
+			Type:  &searchset,
+			Total: total(2),
+			Entry: []r5.BundleEntry{entry("http://x/Patient/2", "2-dup"), entry("http://x/Patient/3", "3")},
+		}
+
+		merged := r5.MergeBundles(page1, page2)
+		require.NotNil(t, merged)
+		assert.Equal(t, r5.BundleTypeSearchset, *merged.Type)
+		require.NotNil(t, merged.Total)
+		assert.Equal(t, uint32(4), *merged.Total)
+		assert.Empty(t, merged.Link)
+
+		var ids []string
+		for _, e := range merged.Entry {
+			ids = append(ids, *e.Resource.GetId())
+		}
+		assert.Equal(t, []string{"1", "2", "3"}, ids)
+
+		// inputs are untouched
+		assert.Len(t, page1.Entry, 2)
+		assert.Len(t, page2.Entry, 2)
+	})
+
+	t.Run("entries without fullUrl are kept", func(t *testing.T) {
+		collection := r5.BundleTypeCollection
+		a := &r5.Bundle{Type: &collection, Total: total(1), Entry: []r5.BundleEntry{{Resource: &r5.Patient{}}}}
+		b := &r5.Bundle{Entry: []r5.BundleEntry{{Resource: &r5.Patient{}}}}
+
+		merged := r5.MergeBundles(a, b)
+		assert.Equal(t, r5.BundleTypeCollection, *merged.Type)
+		assert.Nil(t, merged.Total)
+		assert.Len(t, merged.Entry, 2)
+	})
+
+	t.Run("nil bundles", func(t *testing.T) {
+		assert.Nil(t, r5.MergeBundles())
+		assert.Nil(t, r5.MergeBundles(nil, nil))
+
+		merged := r5.MergeBundles(nil, &r5.Bundle{Type: &searchset})
+		require.NotNil(t, merged)
+		assert.Equal(t, r5.BundleTypeSearchset, *merged.Type)
+	})
+}
+
+func TestBundleSearchEntries(t *testing.T) {
+	searchset := r5.BundleTypeSearchset
+	severity := r5.IssueSeverityWarning
+	code := r5.IssueTypeNotSupported
+	oo := &r5.OperationOutcome{Issue: []r5.OperationOutcomeIssue{{
+		Severity:    &severity,
+		Code:        &code,
+		Diagnostics: ptrString("unknown search parameter: foo"),
+	}}}
+
+	b := &r5.Bundle{Type: &searchset}
+	b.AddMatch(&r5.Patient{Id: ptrString("p1")})
+	b.AddInclude(&r5.Organization{Id: ptrString("o1")})
+	b.AddOutcome(oo)
+
+	require.Len(t, b.Entry, 3)
+	var modes []r5.SearchEntryMode
+	for _, e := range b.Entry {
+		require.NotNil(t, e.Search)
+		modes = append(modes, *e.Search.Mode)
+	}
+	assert.Equal(t, []r5.SearchEntryMode{
+		r5.SearchEntryModeMatch, r5.SearchEntryModeInclude, r5.SearchEntryModeOutcome,
+	}, modes)
+	assert.Same(t, oo, b.Entry[2].Resource)
+
+	data, err := r5.Marshal(b)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"search":{"mode":"outcome"}`)
+}
+
+func TestFlattenBundle(t *testing.T) {
+	newBundle := func() *r5.Bundle {
+		return &r5.Bundle{Entry: []r5.BundleEntry{
+			{Resource: &r5.Patient{
+				Id:                   ptrString("p1"),
+				ManagingOrganization: &r5.Reference{Reference: ptrString("#org1")},
+				Contained: []r5.Resource{&r5.Organization{
+					Id:   ptrString("org1"),
+					Name: ptrString("Acme Clinic"),
+				}},
+			}},
+			{Request: &r5.BundleEntryRequest{Url: ptrString("Patient/p1")}},
+			{Resource: &r5.Practitioner{
+				Id: ptrString("pr1"),
+				Contained: []r5.Resource{
+					&r5.Organization{Id: ptrString("org2"), PartOf: &r5.Reference{Reference: ptrString("#org3")}},
+					&r5.Organization{Id: ptrString("org3")},
+				},
+			}},
+		}}
+	}
+
+	t.Run("contained organizations", func(t *testing.T) {
+		b := newBundle()
+
+		resources, err := r5.FlattenBundle(b)
+		require.NoError(t, err)
+
+		var keys []string
+		for _, r := range resources {
+			key, err := r5.StorageKey(r)
+			require.NoError(t, err)
+			keys = append(keys, key)
+		}
+		assert.Equal(t, []string{
+			"Patient/p1", "Organization/p1-org1",
+			"Practitioner/pr1", "Organization/pr1-org2", "Organization/pr1-org3",
+		}, keys)
+
+		patient := resources[0].(*r5.Patient)
+		assert.Equal(t, "Organization/p1-org1", *patient.ManagingOrganization.Reference)
+		assert.Empty(t, patient.Contained)
+		assert.Equal(t, "Acme Clinic", *resources[1].(*r5.Organization).Name)
+		assert.Equal(t, "Organization/pr1-org3", *resources[3].(*r5.Organization).PartOf.Reference)
+
+		original := b.Entry[0].Resource.(*r5.Patient)
+		assert.Equal(t, "#org1", *original.ManagingOrganization.Reference, "bundle is not modified")
+		assert.Len(t, original.Contained, 1)
+	})
+
+	t.Run("contained ids shared across entries", func(t *testing.T) {
+		b := newBundle()
+		practitioner := b.Entry[2].Resource.(*r5.Practitioner)
+		practitioner.Contained[0].SetId("org1")
+		practitioner.Qualification = []r5.PractitionerQualification{{Issuer: &r5.Reference{Reference: ptrString("#org1")}}}
+
+		resources, err := r5.FlattenBundle(b)
+		require.NoError(t, err)
+
+		assert.Equal(t, "p1-org1", *resources[1].GetId())
+		assert.Equal(t, "pr1-org1", *resources[3].GetId())
+		assert.Equal(t, "Organization/p1-org1", *resources[0].(*r5.Patient).ManagingOrganization.Reference)
+		assert.Equal(t, "Organization/pr1-org1", *resources[2].(*r5.Practitioner).Qualification[0].Issuer.Reference)
+	})
+
+	t.Run("container without id", func(t *testing.T) {
+		b := newBundle()
+		b.Entry[0].Resource.(*r5.Patient).Id = nil
+		b.Entry[0].FullUrl = ptrString("urn:uuid:0c3a6a8e-5f4b-4c1e-9d2a-7b8e1f2a3c4d")
+
+		resources, err := r5.FlattenBundle(b)
+		require.NoError(t, err)
+
+		id := *resources[1].GetId()
+		assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-5[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, id)
+		assert.Equal(t, "Organization/"+id, *resources[0].(*r5.Patient).ManagingOrganization.Reference)
+
+		again, err := r5.FlattenBundle(b)
+		require.NoError(t, err)
+		assert.Equal(t, id, *again[1].GetId(), "ids are deterministic")
+
+		b.Entry[0].FullUrl = ptrString("urn:uuid:9e1d2c3b-4a5f-4e6d-8c7b-6a5f4e3d2c1b")
+		other, err := r5.FlattenBundle(b)
+		require.NoError(t, err)
+		assert.NotEqual(t, id, *other[1].GetId(), "ids depend on the fullUrl")
+
+		b.Entry[0].FullUrl = nil
+		byIndex, err := r5.FlattenBundle(b)
+		require.NoError(t, err)
+		again, err = r5.FlattenBundle(b)
+		require.NoError(t, err)
+		assert.Equal(t, *byIndex[1].GetId(), *again[1].GetId())
+	})
+
+	t.Run("nested contained resources", func(t *testing.T) {
+		b := newBundle()
+		org2 := b.Entry[2].Resource.(*r5.Practitioner).Contained[0].(*r5.Organization)
+		org2.Contained = []r5.Resource{&r5.Endpoint{Id: ptrString("ep1")}}
+		org2.Endpoint = []r5.Reference{{Reference: ptrString("#ep1")}}
+
+		resources, err := r5.FlattenBundle(b)
+		require.NoError(t, err)
+
+		require.Len(t, resources, 6)
+		key, err := r5.StorageKey(resources[5])
+		require.NoError(t, err)
+		assert.Equal(t, "Endpoint/pr1-org2-ep1", key)
+		flat := resources[3].(*r5.Organization)
+		assert.Empty(t, flat.Contained)
+		assert.Equal(t, "Endpoint/pr1-org2-ep1", *flat.Endpoint[0].Reference)
+		assert.Equal(t, "Organization/pr1-org3", *flat.PartOf.Reference)
+	})
+
+	t.Run("duplicate resource", func(t *testing.T) {
+		b := newBundle()
+		b.Entry = append(b.Entry, r5.BundleEntry{Resource: &r5.Organization{Id: ptrString("p1-org1")}})
+
+		_, err := r5.FlattenBundle(b)
+		assert.EqualError(t, err, "entry 3: duplicate resource Organization/p1-org1")
+	})
+
+	t.Run("contained without id", func(t *testing.T) {
+		b := newBundle()
+		b.Entry[0].Resource.(*r5.Patient).Contained[0].(*r5.Organization).Id = nil
+
+		_, err := r5.FlattenBundle(b)
+		assert.ErrorContains(t, err, "has no id")
+	})
+}
+
+func TestReferenceGraph(t *testing.T) {
+	ref := func(s string) r5.Reference { return r5.Reference{Reference: ptrString(s)} }
+	subject := ref("Patient/p1")
+	bundle := &r5.Bundle{
+		Entry: []r5.BundleEntry{
+			{
+				FullUrl:  ptrString("http://example.org/fhir/Patient/p1"),
+				Resource: &r5.Patient{Id: ptrString("p1")},
+			},
+			{
+				FullUrl: ptrString("http://example.org/fhir/Observation/o1"),
+				Resource: &r5.Observation{
+					Id:      ptrString("o1"),
+					Subject: &subject,
+					Performer: []r5.Reference{
+						ref("http://example.org/fhir/Patient/p1/_history/2"),
+						ref("Practitioner/elsewhere"),
+						ref("#local"),
+					},
+				},
+			},
+			{
+				FullUrl: ptrString("urn:uuid:2b1e"),
+				Resource: &r5.Observation{
+					Subject:   &subject,
+					Performer: []r5.Reference{ref("Observation/o1")},
+				},
+			},
+			{FullUrl: ptrString("urn:uuid:empty")},
+		},
+	}
+
+	assert.Equal(t, map[string][]string{
+		"Patient/p1":     nil,
+		"Observation/o1": {"Patient/p1", "Practitioner/elsewhere"},
+		"urn:uuid:2b1e":  {"Patient/p1", "Observation/o1"},
+	}, r5.ReferenceGraph(bundle))
+
+	assert.Nil(t, r5.ReferenceGraph(nil))
+}
+
+func TestCorrelateTransaction(t *testing.T) {
+	post := r5.HTTPVerbPost
+	put := r5.HTTPVerbPut
+	transaction := r5.BundleTypeTransaction
+	transactionResponse := r5.BundleTypeTransactionResponse
+	request := &r5.Bundle{
+		Type: &transaction,
+		Entry: []r5.BundleEntry{
+			{
+				FullUrl:  ptrString("urn:uuid:1"),
+				Resource: &r5.Patient{Active: ptrBool(true)},
+				Request:  &r5.BundleEntryRequest{Method: &post, Url: ptrString("Patient")},
+			},
+			{
+				Resource: &r5.Patient{Id: ptrString("p2")},
+				Request:  &r5.BundleEntryRequest{Method: &put, Url: ptrString("Patient/p2")},
+			},
+		},
+	}
+	outcome := &r5.OperationOutcome{}
+	response := &r5.Bundle{
+		Type: &transactionResponse,
+		Entry: []r5.BundleEntry{
+			{Response: &r5.BundleEntryResponse{
+				Status:   ptrString("201 Created"),
+				Location: ptrString("Patient/p1/_history/1"),
+			}},
+			{
+				Resource: &r5.Patient{Id: ptrString("p2")},
+				Response: &r5.BundleEntryResponse{Status: ptrString("200 OK"), Outcome: outcome},
+			},
+		},
+	}
+
+	results, err := r5.CorrelateTransaction(request, response)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	assert.Same(t, request.Entry[0].Resource, results[0].Request.Resource)
+	assert.Equal(t, "201 Created", results[0].Status)
+	assert.Equal(t, "Patient/p1/_history/1", results[0].Location)
+	assert.Nil(t, results[0].Resource)
+
+	assert.Equal(t, "Patient/p2", *results[1].Request.Request.Url)
+	assert.Equal(t, "200 OK", results[1].Status)
+	assert.Empty(t, results[1].Location)
+	assert.Same(t, response.Entry[1].Resource, results[1].Resource)
+	assert.Same(t, outcome, results[1].Outcome)
+
+	_, err = r5.CorrelateTransaction(request, &r5.Bundle{Entry: response.Entry[:1]})
+	assert.EqualError(t, err, "request has 2 entries but response has 1")
+	_, err = r5.CorrelateTransaction(request, nil)
+	assert.Error(t, err)
+}
+
+func TestValidateBundle(t *testing.T) {
+	final := r5.ObservationStatusFinal
+	observation := func(subject string) *r5.Observation {
+		return &r5.Observation{
+			Status:  &final,
+			Code:    r5.CodeableConcept{Text: ptrString("Heart rate")},
+			Subject: &r5.Reference{Reference: ptrString(subject)},
+		}
+	}
+	bundle := &r5.Bundle{
+		Entry: []r5.BundleEntry{
+			{FullUrl: ptrString("urn:uuid:61ebe359-bfdc-4613-8bf2-c5e300945f0a"), Resource: &r5.Patient{
+				ManagingOrganization: &r5.Reference{Reference: ptrString("#org1")},
+				Contained:            []r5.Resource{&r5.Organization{Id: ptrString("org1")}},
+			}},
+			{FullUrl: ptrString("urn:uuid:1"), Resource: observation("urn:uuid:61ebe359-bfdc-4613-8bf2-c5e300945f0a")},
+			{FullUrl: ptrString("urn:uuid:2"), Resource: observation("Patient/on-server")},
+		},
+	}
+	assert.Nil(t, r5.ValidateBundle(bundle))
+
+	bundle.Entry = append(bundle.Entry, r5.BundleEntry{
+		FullUrl:  ptrString("urn:uuid:3"),
+		Resource: observation("urn:uuid:dangling"),
+	}, r5.BundleEntry{
+		FullUrl:  ptrString("urn:uuid:4"),
+		Resource: observation("#org1"),
+	})
+	bundle.Entry[2].Resource.(*r5.Observation).Status = nil
+
+	oo := r5.ValidateBundle(bundle)
+	require.NotNil(t, oo)
+	require.Len(t, oo.Issue, 3)
+
+	assert.Equal(t, r5.IssueTypeInvalid, *oo.Issue[0].Code)
+	assert.Equal(t, []string{"Bundle.entry[2].resource.status"}, oo.Issue[0].Expression)
+
+	assert.Equal(t, r5.IssueSeverityError, *oo.Issue[1].Severity)
+	assert.Equal(t, r5.IssueTypeNotFound, *oo.Issue[1].Code)
+	assert.Equal(t, []string{"Bundle.entry[3].resource.subject"}, oo.Issue[1].Expression)
+	assert.Contains(t, *oo.Issue[1].Diagnostics, "urn:uuid:dangling")
+
+	// Contained resources are local to their entry.
+	assert.Equal(t, r5.IssueTypeNotFound, *oo.Issue[2].Code)
+	assert.Equal(t, []string{"Bundle.entry[4].resource.subject"}, oo.Issue[2].Expression)
+	assert.Equal(t, "reference #org1 does not resolve to a contained resource", *oo.Issue[2].Diagnostics)
+
+	assert.Nil(t, r5.ValidateBundle(nil))
+}
@@ -0,0 +1,102 @@
+package r5
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// CanonicalUnorderedElements lists the repeating elements whose order is not
+// significant, which MarshalCanonical and MarshalCanonicalXML sort into a
+// deterministic order. An entry is either an element name, matching that
+// element on any type (e.g. "identifier"), or a "Type.element" pair naming a
+// single type (e.g. "Meta.tag"). Elements whose order carries meaning, such
+// as HumanName.given or Bundle.entry, must not be listed.
+//
+// Modify it only during initialization.
+var CanonicalUnorderedElements = map[string]bool{
+	"identifier":    true,
+	"telecom":       true,
+	"Meta.profile":  true,
+	"Meta.security": true,
+	"Meta.tag":      true,
+}
+
+// MarshalCanonical serializes a FHIR resource to a canonical JSON form
+// suitable for signing, hashing and byte-wise comparison.
+//
+// Elements appear in StructureDefinition order with no insignificant
+// whitespace. Extensions at every level are sorted by url, keeping the
+// relative order of extensions sharing a url, and the repeating elements
+// listed in CanonicalUnorderedElements are sorted by their serialized
+// content. All other lists keep their order. r is not modified.
+func MarshalCanonical(r Resource) ([]byte, error) {
+	return Marshal(canonicalize(r))
+}
+
+// canonicalize returns a copy of r with extensions and order-insensitive
+// repeating elements sorted.
+func canonicalize(r Resource) Resource {
+	c := cloneResource(r)
+	var nodes []reflect.Value
+	_ = Walk(c, func(_ string, node any) error {
+		nodes = append(nodes, reflect.ValueOf(node))
+		return nil
+	})
+	// Visit children before their parents so sort keys see normalized content.
+	for i := len(nodes) - 1; i >= 0; i-- {
+		sortExtensions(nodes[i])
+		sortUnordered(nodes[i])
+	}
+	return c
+}
+
+// sortExtensions stably sorts the []Extension fields of the struct pointed to by v by url.
+func sortExtensions(v reflect.Value) {
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return
+	}
+	s := v.Elem()
+	for i := 0; i < s.NumField(); i++ {
+		if exts, ok := s.Field(i).Interface().([]Extension); ok {
+			sort.SliceStable(exts, func(a, b int) bool {
+				return exts[a].Url < exts[b].Url
+			})
+		}
+	}
+}
+
+// sortUnordered stably sorts the slice fields of the struct pointed to by v
+// that are listed in CanonicalUnorderedElements by their JSON encoding.
+func sortUnordered(v reflect.Value) {
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return
+	}
+	s := v.Elem()
+	t := s.Type()
+	for i := 0; i < s.NumField(); i++ {
+		f := s.Field(i)
+		if f.Kind() != reflect.Slice || f.Len() < 2 {
+			continue
+		}
+		name := jsonFieldName(t.Field(i))
+		if !CanonicalUnorderedElements[name] && !CanonicalUnorderedElements[t.Name()+"."+name] {
+			continue
+		}
+		keys := make([]string, f.Len())
+		order := make([]int, f.Len())
+		for j := range keys {
+			b, _ := json.Marshal(f.Index(j).Interface())
+			keys[j] = string(b)
+			order[j] = j
+		}
+		sort.SliceStable(order, func(a, b int) bool {
+			return keys[order[a]] < keys[order[b]]
+		})
+		sorted := reflect.MakeSlice(f.Type(), f.Len(), f.Len())
+		for j, k := range order {
+			sorted.Index(j).Set(f.Index(k))
+		}
+		f.Set(sorted)
+	}
+}
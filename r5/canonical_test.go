@@ -0,0 +1,67 @@
+package r5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r5"
+)
+
+func TestMarshalCanonical(t *testing.T) {
+	mrn := r5.Identifier{System: ptrString("urn:mrn"), Value: ptrString("123")}
+	ssn := r5.Identifier{System: ptrString("urn:ssn"), Value: ptrString("999")}
+	name := r5.HumanName{Family: ptrString("Doe"), Given: []string{"Mary", "Anne"}}
+
+	t.Run("normalizes identifier order", func(t *testing.T) {
+		a, err := r5.MarshalCanonical(&r5.Patient{Identifier: []r5.Identifier{mrn, ssn}})
+		require.NoError(t, err)
+		b, err := r5.MarshalCanonical(&r5.Patient{Identifier: []r5.Identifier{ssn, mrn}})
+		require.NoError(t, err)
+		assert.Equal(t, string(a), string(b))
+		assert.Equal(t, `{"resourceType":"Patient","identifier":[`+
+			`{"system":"urn:mrn","value":"123"},{"system":"urn:ssn","value":"999"}]}`, string(a))
+
+		x, err := r5.MarshalCanonicalXML(&r5.Patient{Identifier: []r5.Identifier{ssn, mrn}})
+		require.NoError(t, err)
+		y, err := r5.MarshalCanonicalXML(&r5.Patient{Identifier: []r5.Identifier{mrn, ssn}})
+		require.NoError(t, err)
+		assert.Equal(t, string(x), string(y))
+	})
+
+	t.Run("keeps given name order", func(t *testing.T) {
+		data, err := r5.MarshalCanonical(&r5.Patient{Name: []r5.HumanName{name}})
+		require.NoError(t, err)
+		assert.Equal(t, `{"resourceType":"Patient","name":[{"family":"Doe","given":["Mary","Anne"]}]}`, string(data))
+
+		reversed := r5.HumanName{Family: ptrString("Doe"), Given: []string{"Anne", "Mary"}}
+		other, err := r5.MarshalCanonical(&r5.Patient{Name: []r5.HumanName{reversed}})
+		require.NoError(t, err)
+		assert.NotEqual(t, string(data), string(other))
+	})
+
+	t.Run("sorts nested unordered elements", func(t *testing.T) {
+		a := &r5.Patient{Contact: []r5.PatientContact{{Telecom: []r5.ContactPoint{
+			{Value: ptrString("555-2")}, {Value: ptrString("555-1")},
+		}}}}
+		b := &r5.Patient{Contact: []r5.PatientContact{{Telecom: []r5.ContactPoint{
+			{Value: ptrString("555-1")}, {Value: ptrString("555-2")},
+		}}}}
+		x, err := r5.MarshalCanonical(a)
+		require.NoError(t, err)
+		y, err := r5.MarshalCanonical(b)
+		require.NoError(t, err)
+		assert.Equal(t, string(x), string(y))
+		assert.Equal(t, "555-2", *a.Contact[0].Telecom[0].Value, "input must not be modified")
+	})
+
+	t.Run("configurable", func(t *testing.T) {
+		r5.CanonicalUnorderedElements["HumanName.given"] = true
+		defer delete(r5.CanonicalUnorderedElements, "HumanName.given")
+
+		data, err := r5.MarshalCanonical(&r5.Patient{Name: []r5.HumanName{name}})
+		require.NoError(t, err)
+		assert.Equal(t, `{"resourceType":"Patient","name":[{"family":"Doe","given":["Anne","Mary"]}]}`, string(data))
+	})
+}
@@ -4,6 +4,45 @@
 
 package r5
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// StrictCodes controls how code values are decoded into the generated code
+// types below, from both JSON and XML. When false (the default), unknown
+// codes pass through as the raw string for forward compatibility; when true,
+// they are rejected with an error. Set it once during initialization.
+var StrictCodes bool
+
+// knownCode is implemented by the generated code types.
+type knownCode interface {
+	~string
+	known() bool
+}
+
+// checkCode returns an error for an unknown code when StrictCodes is set.
+func checkCode[T knownCode](c T) error {
+	if StrictCodes && !c.known() {
+		return fmt.Errorf("unknown %T code %q", c, string(c))
+	}
+	return nil
+}
+
+// unmarshalCode decodes a JSON string into a generated code type.
+func unmarshalCode[T knownCode](data []byte, c *T) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v := T(s)
+	if err := checkCode(v); err != nil {
+		return err
+	}
+	*c = v
+	return nil
+}
+
 // FHIRVersion represents FHIRVersion.
 type FHIRVersion string
 
@@ -125,6 +164,86 @@ const (
 	FHIRVersion500DraftFinal FHIRVersion = "5.0.0-draft-final"
 )
 
+// known reports whether c is one of the FHIRVersion values.
+func (c FHIRVersion) known() bool {
+	switch c {
+	case FHIRVersion001,
+		FHIRVersion005,
+		FHIRVersion006,
+		FHIRVersion011,
+		FHIRVersion00,
+		FHIRVersion0080,
+		FHIRVersion0081,
+		FHIRVersion0082,
+		FHIRVersion04,
+		FHIRVersion040,
+		FHIRVersion05,
+		FHIRVersion050,
+		FHIRVersion10,
+		FHIRVersion100,
+		FHIRVersion101,
+		FHIRVersion102,
+		FHIRVersion11,
+		FHIRVersion110,
+		FHIRVersion14,
+		FHIRVersion140,
+		FHIRVersion16,
+		FHIRVersion160,
+		FHIRVersion18,
+		FHIRVersion180,
+		FHIRVersion30,
+		FHIRVersion300,
+		FHIRVersion301,
+		FHIRVersion302,
+		FHIRVersion33,
+		FHIRVersion330,
+		FHIRVersion35,
+		FHIRVersion350,
+		FHIRVersion40,
+		FHIRVersion400,
+		FHIRVersion401,
+		FHIRVersion41,
+		FHIRVersion410,
+		FHIRVersion42,
+		FHIRVersion420,
+		FHIRVersion43,
+		FHIRVersion430,
+		FHIRVersion430Cibuild,
+		FHIRVersion430Snapshot1,
+		FHIRVersion44,
+		FHIRVersion440,
+		FHIRVersion45,
+		FHIRVersion450,
+		FHIRVersion46,
+		FHIRVersion460,
+		FHIRVersion50,
+		FHIRVersion500,
+		FHIRVersion500Cibuild,
+		FHIRVersion500Snapshot1,
+		FHIRVersion500Snapshot2,
+		FHIRVersion500Ballot,
+		FHIRVersion500Snapshot3,
+		FHIRVersion500DraftFinal:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FHIRVersion value in definition order.
+func (FHIRVersion) firstCode() string {
+	return string(FHIRVersion001)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FHIRVersion) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FHIRVersion) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AccountStatus represents Account Status.
 type AccountStatus string
 
@@ -142,6 +261,34 @@ const (
 	AccountStatusUnknown AccountStatus = "unknown"
 )
 
+// known reports whether c is one of the AccountStatus values.
+func (c AccountStatus) known() bool {
+	switch c {
+	case AccountStatusActive,
+		AccountStatusInactive,
+		AccountStatusEnteredInError,
+		AccountStatusOnHold,
+		AccountStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AccountStatus value in definition order.
+func (AccountStatus) firstCode() string {
+	return string(AccountStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AccountStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AccountStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionCardinalityBehavior represents Action Cardinality Behavior.
 type ActionCardinalityBehavior string
 
@@ -153,6 +300,31 @@ const (
 	ActionCardinalityBehaviorMultiple ActionCardinalityBehavior = "multiple"
 )
 
+// known reports whether c is one of the ActionCardinalityBehavior values.
+func (c ActionCardinalityBehavior) known() bool {
+	switch c {
+	case ActionCardinalityBehaviorSingle,
+		ActionCardinalityBehaviorMultiple:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionCardinalityBehavior value in definition order.
+func (ActionCardinalityBehavior) firstCode() string {
+	return string(ActionCardinalityBehaviorSingle)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionCardinalityBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionCardinalityBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionConditionKind represents Action Condition Kind.
 type ActionConditionKind string
 
@@ -166,6 +338,32 @@ const (
 	ActionConditionKindStop ActionConditionKind = "stop"
 )
 
+// known reports whether c is one of the ActionConditionKind values.
+func (c ActionConditionKind) known() bool {
+	switch c {
+	case ActionConditionKindApplicability,
+		ActionConditionKindStart,
+		ActionConditionKindStop:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionConditionKind value in definition order.
+func (ActionConditionKind) firstCode() string {
+	return string(ActionConditionKindApplicability)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionConditionKind) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionConditionKind) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionGroupingBehavior represents Action Grouping Behavior.
 type ActionGroupingBehavior string
 
@@ -179,6 +377,32 @@ const (
 	ActionGroupingBehaviorSentenceGroup ActionGroupingBehavior = "sentence-group"
 )
 
+// known reports whether c is one of the ActionGroupingBehavior values.
+func (c ActionGroupingBehavior) known() bool {
+	switch c {
+	case ActionGroupingBehaviorVisualGroup,
+		ActionGroupingBehaviorLogicalGroup,
+		ActionGroupingBehaviorSentenceGroup:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionGroupingBehavior value in definition order.
+func (ActionGroupingBehavior) firstCode() string {
+	return string(ActionGroupingBehaviorVisualGroup)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionGroupingBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionGroupingBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionParticipantType represents Action Participant Type.
 type ActionParticipantType string
 
@@ -206,6 +430,39 @@ const (
 	ActionParticipantTypeRelatedperson ActionParticipantType = "relatedperson"
 )
 
+// known reports whether c is one of the ActionParticipantType values.
+func (c ActionParticipantType) known() bool {
+	switch c {
+	case ActionParticipantTypeCareteam,
+		ActionParticipantTypeDevice,
+		ActionParticipantTypeGroup,
+		ActionParticipantTypeHealthcareservice,
+		ActionParticipantTypeLocation,
+		ActionParticipantTypeOrganization,
+		ActionParticipantTypePatient,
+		ActionParticipantTypePractitioner,
+		ActionParticipantTypePractitionerrole,
+		ActionParticipantTypeRelatedperson:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionParticipantType value in definition order.
+func (ActionParticipantType) firstCode() string {
+	return string(ActionParticipantTypeCareteam)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionParticipantType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionParticipantType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionPrecheckBehavior represents Action Precheck Behavior.
 type ActionPrecheckBehavior string
 
@@ -217,6 +474,31 @@ const (
 	ActionPrecheckBehaviorNo ActionPrecheckBehavior = "no"
 )
 
+// known reports whether c is one of the ActionPrecheckBehavior values.
+func (c ActionPrecheckBehavior) known() bool {
+	switch c {
+	case ActionPrecheckBehaviorYes,
+		ActionPrecheckBehaviorNo:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionPrecheckBehavior value in definition order.
+func (ActionPrecheckBehavior) firstCode() string {
+	return string(ActionPrecheckBehaviorYes)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionPrecheckBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionPrecheckBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionRelationshipType represents Action Relationship Type.
 type ActionRelationshipType string
 
@@ -242,6 +524,38 @@ const (
 	ActionRelationshipTypeAfterEnd ActionRelationshipType = "after-end"
 )
 
+// known reports whether c is one of the ActionRelationshipType values.
+func (c ActionRelationshipType) known() bool {
+	switch c {
+	case ActionRelationshipTypeBefore,
+		ActionRelationshipTypeBeforeStart,
+		ActionRelationshipTypeBeforeEnd,
+		ActionRelationshipTypeConcurrent,
+		ActionRelationshipTypeConcurrentWithStart,
+		ActionRelationshipTypeConcurrentWithEnd,
+		ActionRelationshipTypeAfter,
+		ActionRelationshipTypeAfterStart,
+		ActionRelationshipTypeAfterEnd:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionRelationshipType value in definition order.
+func (ActionRelationshipType) firstCode() string {
+	return string(ActionRelationshipTypeBefore)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionRelationshipType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionRelationshipType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionRequiredBehavior represents Action Required Behavior.
 type ActionRequiredBehavior string
 
@@ -255,6 +569,32 @@ const (
 	ActionRequiredBehaviorMustUnlessDocumented ActionRequiredBehavior = "must-unless-documented"
 )
 
+// known reports whether c is one of the ActionRequiredBehavior values.
+func (c ActionRequiredBehavior) known() bool {
+	switch c {
+	case ActionRequiredBehaviorMust,
+		ActionRequiredBehaviorCould,
+		ActionRequiredBehaviorMustUnlessDocumented:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionRequiredBehavior value in definition order.
+func (ActionRequiredBehavior) firstCode() string {
+	return string(ActionRequiredBehaviorMust)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionRequiredBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionRequiredBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ActionSelectionBehavior represents Action Selection Behavior.
 type ActionSelectionBehavior string
 
@@ -274,6 +614,35 @@ const (
 	ActionSelectionBehaviorOneOrMore ActionSelectionBehavior = "one-or-more"
 )
 
+// known reports whether c is one of the ActionSelectionBehavior values.
+func (c ActionSelectionBehavior) known() bool {
+	switch c {
+	case ActionSelectionBehaviorAny,
+		ActionSelectionBehaviorAll,
+		ActionSelectionBehaviorAllOrNone,
+		ActionSelectionBehaviorExactlyOne,
+		ActionSelectionBehaviorAtMostOne,
+		ActionSelectionBehaviorOneOrMore:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ActionSelectionBehavior value in definition order.
+func (ActionSelectionBehavior) firstCode() string {
+	return string(ActionSelectionBehaviorAny)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ActionSelectionBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ActionSelectionBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AdditionalBindingPurposeVS represents Additional Binding Purpose ValueSet.
 type AdditionalBindingPurposeVS string
 
@@ -301,6 +670,39 @@ const (
 	AdditionalBindingPurposeVSComponent AdditionalBindingPurposeVS = "component"
 )
 
+// known reports whether c is one of the AdditionalBindingPurposeVS values.
+func (c AdditionalBindingPurposeVS) known() bool {
+	switch c {
+	case AdditionalBindingPurposeVSMaximum,
+		AdditionalBindingPurposeVSMinimum,
+		AdditionalBindingPurposeVSRequired,
+		AdditionalBindingPurposeVSExtensible,
+		AdditionalBindingPurposeVSCandidate,
+		AdditionalBindingPurposeVSCurrent,
+		AdditionalBindingPurposeVSPreferred,
+		AdditionalBindingPurposeVSUi,
+		AdditionalBindingPurposeVSStarter,
+		AdditionalBindingPurposeVSComponent:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AdditionalBindingPurposeVS value in definition order.
+func (AdditionalBindingPurposeVS) firstCode() string {
+	return string(AdditionalBindingPurposeVSMaximum)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AdditionalBindingPurposeVS) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AdditionalBindingPurposeVS) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AddressType represents AddressType.
 type AddressType string
 
@@ -314,6 +716,32 @@ const (
 	AddressTypeBoth AddressType = "both"
 )
 
+// known reports whether c is one of the AddressType values.
+func (c AddressType) known() bool {
+	switch c {
+	case AddressTypePostal,
+		AddressTypePhysical,
+		AddressTypeBoth:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AddressType value in definition order.
+func (AddressType) firstCode() string {
+	return string(AddressTypePostal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AddressType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AddressType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AddressUse represents AddressUse.
 type AddressUse string
 
@@ -331,6 +759,34 @@ const (
 	AddressUseBilling AddressUse = "billing"
 )
 
+// known reports whether c is one of the AddressUse values.
+func (c AddressUse) known() bool {
+	switch c {
+	case AddressUseHome,
+		AddressUseWork,
+		AddressUseTemp,
+		AddressUseOld,
+		AddressUseBilling:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AddressUse value in definition order.
+func (AddressUse) firstCode() string {
+	return string(AddressUseHome)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AddressUse) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AddressUse) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AdministrativeGender represents AdministrativeGender.
 type AdministrativeGender string
 
@@ -346,6 +802,33 @@ const (
 	AdministrativeGenderUnknown AdministrativeGender = "unknown"
 )
 
+// known reports whether c is one of the AdministrativeGender values.
+func (c AdministrativeGender) known() bool {
+	switch c {
+	case AdministrativeGenderMale,
+		AdministrativeGenderFemale,
+		AdministrativeGenderOther,
+		AdministrativeGenderUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AdministrativeGender value in definition order.
+func (AdministrativeGender) firstCode() string {
+	return string(AdministrativeGenderMale)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AdministrativeGender) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AdministrativeGender) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AdverseEventActuality represents Adverse Event Actuality.
 type AdverseEventActuality string
 
@@ -357,6 +840,31 @@ const (
 	AdverseEventActualityPotential AdverseEventActuality = "potential"
 )
 
+// known reports whether c is one of the AdverseEventActuality values.
+func (c AdverseEventActuality) known() bool {
+	switch c {
+	case AdverseEventActualityActual,
+		AdverseEventActualityPotential:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AdverseEventActuality value in definition order.
+func (AdverseEventActuality) firstCode() string {
+	return string(AdverseEventActualityActual)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AdverseEventActuality) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AdverseEventActuality) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AdverseEventStatus represents Adverse Event Status.
 type AdverseEventStatus string
 
@@ -368,6 +876,33 @@ const (
 	AdverseEventStatusUnknown        AdverseEventStatus = "unknown"
 )
 
+// known reports whether c is one of the AdverseEventStatus values.
+func (c AdverseEventStatus) known() bool {
+	switch c {
+	case AdverseEventStatusInProgress,
+		AdverseEventStatusCompleted,
+		AdverseEventStatusEnteredInError,
+		AdverseEventStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AdverseEventStatus value in definition order.
+func (AdverseEventStatus) firstCode() string {
+	return string(AdverseEventStatusInProgress)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AdverseEventStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AdverseEventStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AllergyIntoleranceCategory represents Allergy Intolerance Category.
 type AllergyIntoleranceCategory string
 
@@ -383,6 +918,33 @@ const (
 	AllergyIntoleranceCategoryBiologic AllergyIntoleranceCategory = "biologic"
 )
 
+// known reports whether c is one of the AllergyIntoleranceCategory values.
+func (c AllergyIntoleranceCategory) known() bool {
+	switch c {
+	case AllergyIntoleranceCategoryFood,
+		AllergyIntoleranceCategoryMedication,
+		AllergyIntoleranceCategoryEnvironment,
+		AllergyIntoleranceCategoryBiologic:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AllergyIntoleranceCategory value in definition order.
+func (AllergyIntoleranceCategory) firstCode() string {
+	return string(AllergyIntoleranceCategoryFood)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AllergyIntoleranceCategory) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AllergyIntoleranceCategory) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AllergyIntoleranceCriticality represents Allergy Intolerance Criticality.
 type AllergyIntoleranceCriticality string
 
@@ -396,6 +958,32 @@ const (
 	AllergyIntoleranceCriticalityUnableToAssess AllergyIntoleranceCriticality = "unable-to-assess"
 )
 
+// known reports whether c is one of the AllergyIntoleranceCriticality values.
+func (c AllergyIntoleranceCriticality) known() bool {
+	switch c {
+	case AllergyIntoleranceCriticalityLow,
+		AllergyIntoleranceCriticalityHigh,
+		AllergyIntoleranceCriticalityUnableToAssess:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AllergyIntoleranceCriticality value in definition order.
+func (AllergyIntoleranceCriticality) firstCode() string {
+	return string(AllergyIntoleranceCriticalityLow)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AllergyIntoleranceCriticality) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AllergyIntoleranceCriticality) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AppointmentResponseStatus represents Appointment Response Status.
 type AppointmentResponseStatus string
 
@@ -413,6 +1001,34 @@ const (
 	AppointmentResponseStatusEnteredInError AppointmentResponseStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the AppointmentResponseStatus values.
+func (c AppointmentResponseStatus) known() bool {
+	switch c {
+	case AppointmentResponseStatusAccepted,
+		AppointmentResponseStatusDeclined,
+		AppointmentResponseStatusTentative,
+		AppointmentResponseStatusNeedsAction,
+		AppointmentResponseStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AppointmentResponseStatus value in definition order.
+func (AppointmentResponseStatus) firstCode() string {
+	return string(AppointmentResponseStatusAccepted)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AppointmentResponseStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AppointmentResponseStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AppointmentStatus represents Appointment Status.
 type AppointmentStatus string
 
@@ -440,6 +1056,39 @@ const (
 	AppointmentStatusWaitlist AppointmentStatus = "waitlist"
 )
 
+// known reports whether c is one of the AppointmentStatus values.
+func (c AppointmentStatus) known() bool {
+	switch c {
+	case AppointmentStatusProposed,
+		AppointmentStatusPending,
+		AppointmentStatusBooked,
+		AppointmentStatusArrived,
+		AppointmentStatusFulfilled,
+		AppointmentStatusCancelled,
+		AppointmentStatusNoshow,
+		AppointmentStatusEnteredInError,
+		AppointmentStatusCheckedIn,
+		AppointmentStatusWaitlist:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AppointmentStatus value in definition order.
+func (AppointmentStatus) firstCode() string {
+	return string(AppointmentStatusProposed)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AppointmentStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AppointmentStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ArtifactAssessmentDisposition represents Artifact Assessment Disposition.
 type ArtifactAssessmentDisposition string
 
@@ -457,6 +1106,34 @@ const (
 	ArtifactAssessmentDispositionNotPersuasiveWithModification ArtifactAssessmentDisposition = "not-persuasive-with-modification"
 )
 
+// known reports whether c is one of the ArtifactAssessmentDisposition values.
+func (c ArtifactAssessmentDisposition) known() bool {
+	switch c {
+	case ArtifactAssessmentDispositionUnresolved,
+		ArtifactAssessmentDispositionNotPersuasive,
+		ArtifactAssessmentDispositionPersuasive,
+		ArtifactAssessmentDispositionPersuasiveWithModification,
+		ArtifactAssessmentDispositionNotPersuasiveWithModification:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ArtifactAssessmentDisposition value in definition order.
+func (ArtifactAssessmentDisposition) firstCode() string {
+	return string(ArtifactAssessmentDispositionUnresolved)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ArtifactAssessmentDisposition) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ArtifactAssessmentDisposition) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ArtifactAssessmentInformationType represents Artifact Assessment Information Type.
 type ArtifactAssessmentInformationType string
 
@@ -476,6 +1153,35 @@ const (
 	ArtifactAssessmentInformationTypeChangeRequest ArtifactAssessmentInformationType = "change-request"
 )
 
+// known reports whether c is one of the ArtifactAssessmentInformationType values.
+func (c ArtifactAssessmentInformationType) known() bool {
+	switch c {
+	case ArtifactAssessmentInformationTypeComment,
+		ArtifactAssessmentInformationTypeClassifier,
+		ArtifactAssessmentInformationTypeRating,
+		ArtifactAssessmentInformationTypeContainer,
+		ArtifactAssessmentInformationTypeResponse,
+		ArtifactAssessmentInformationTypeChangeRequest:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ArtifactAssessmentInformationType value in definition order.
+func (ArtifactAssessmentInformationType) firstCode() string {
+	return string(ArtifactAssessmentInformationTypeComment)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ArtifactAssessmentInformationType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ArtifactAssessmentInformationType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ArtifactAssessmentWorkflowStatus represents Artifact Assessment Workflow Status.
 type ArtifactAssessmentWorkflowStatus string
 
@@ -503,6 +1209,39 @@ const (
 	ArtifactAssessmentWorkflowStatusEnteredInError ArtifactAssessmentWorkflowStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the ArtifactAssessmentWorkflowStatus values.
+func (c ArtifactAssessmentWorkflowStatus) known() bool {
+	switch c {
+	case ArtifactAssessmentWorkflowStatusSubmitted,
+		ArtifactAssessmentWorkflowStatusTriaged,
+		ArtifactAssessmentWorkflowStatusWaitingForInput,
+		ArtifactAssessmentWorkflowStatusResolvedNoChange,
+		ArtifactAssessmentWorkflowStatusResolvedChangeRequired,
+		ArtifactAssessmentWorkflowStatusDeferred,
+		ArtifactAssessmentWorkflowStatusDuplicate,
+		ArtifactAssessmentWorkflowStatusApplied,
+		ArtifactAssessmentWorkflowStatusPublished,
+		ArtifactAssessmentWorkflowStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ArtifactAssessmentWorkflowStatus value in definition order.
+func (ArtifactAssessmentWorkflowStatus) firstCode() string {
+	return string(ArtifactAssessmentWorkflowStatusSubmitted)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ArtifactAssessmentWorkflowStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ArtifactAssessmentWorkflowStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AssertionDirectionType represents Assertion Direction Type.
 type AssertionDirectionType string
 
@@ -514,6 +1253,31 @@ const (
 	AssertionDirectionTypeRequest AssertionDirectionType = "request"
 )
 
+// known reports whether c is one of the AssertionDirectionType values.
+func (c AssertionDirectionType) known() bool {
+	switch c {
+	case AssertionDirectionTypeResponse,
+		AssertionDirectionTypeRequest:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AssertionDirectionType value in definition order.
+func (AssertionDirectionType) firstCode() string {
+	return string(AssertionDirectionTypeResponse)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AssertionDirectionType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AssertionDirectionType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AssertionManualCompletionType represents Assertion Manual Completion Type.
 type AssertionManualCompletionType string
 
@@ -529,6 +1293,33 @@ const (
 	AssertionManualCompletionTypeStop AssertionManualCompletionType = "stop"
 )
 
+// known reports whether c is one of the AssertionManualCompletionType values.
+func (c AssertionManualCompletionType) known() bool {
+	switch c {
+	case AssertionManualCompletionTypeFail,
+		AssertionManualCompletionTypePass,
+		AssertionManualCompletionTypeSkip,
+		AssertionManualCompletionTypeStop:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AssertionManualCompletionType value in definition order.
+func (AssertionManualCompletionType) firstCode() string {
+	return string(AssertionManualCompletionTypeFail)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AssertionManualCompletionType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AssertionManualCompletionType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AssertionOperatorType represents Assertion Operator Type.
 type AssertionOperatorType string
 
@@ -560,6 +1351,41 @@ const (
 	AssertionOperatorTypeManualeval AssertionOperatorType = "manualEval"
 )
 
+// known reports whether c is one of the AssertionOperatorType values.
+func (c AssertionOperatorType) known() bool {
+	switch c {
+	case AssertionOperatorTypeEquals,
+		AssertionOperatorTypeNotequals,
+		AssertionOperatorTypeIn,
+		AssertionOperatorTypeNotin,
+		AssertionOperatorTypeGreaterthan,
+		AssertionOperatorTypeLessthan,
+		AssertionOperatorTypeEmpty,
+		AssertionOperatorTypeNotempty,
+		AssertionOperatorTypeContains,
+		AssertionOperatorTypeNotcontains,
+		AssertionOperatorTypeEval,
+		AssertionOperatorTypeManualeval:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AssertionOperatorType value in definition order.
+func (AssertionOperatorType) firstCode() string {
+	return string(AssertionOperatorTypeEquals)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AssertionOperatorType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AssertionOperatorType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AssertionResponseTypes represents Assertion Response Types.
 type AssertionResponseTypes string
 
@@ -655,6 +1481,73 @@ const (
 	AssertionResponseTypesHttpversionnotsupported AssertionResponseTypes = "httpVersionNotSupported"
 )
 
+// known reports whether c is one of the AssertionResponseTypes values.
+func (c AssertionResponseTypes) known() bool {
+	switch c {
+	case AssertionResponseTypesContinue,
+		AssertionResponseTypesSwitchingprotocols,
+		AssertionResponseTypesOkay,
+		AssertionResponseTypesCreated,
+		AssertionResponseTypesAccepted,
+		AssertionResponseTypesNonauthoritativeinformation,
+		AssertionResponseTypesNocontent,
+		AssertionResponseTypesResetcontent,
+		AssertionResponseTypesPartialcontent,
+		AssertionResponseTypesMultiplechoices,
+		AssertionResponseTypesMovedpermanently,
+		AssertionResponseTypesFound,
+		AssertionResponseTypesSeeother,
+		AssertionResponseTypesNotmodified,
+		AssertionResponseTypesUseproxy,
+		AssertionResponseTypesTemporaryredirect,
+		AssertionResponseTypesPermanentredirect,
+		AssertionResponseTypesBadrequest,
+		AssertionResponseTypesUnauthorized,
+		AssertionResponseTypesPaymentrequired,
+		AssertionResponseTypesForbidden,
+		AssertionResponseTypesNotfound,
+		AssertionResponseTypesMethodnotallowed,
+		AssertionResponseTypesNotacceptable,
+		AssertionResponseTypesProxyauthenticationrequired,
+		AssertionResponseTypesRequesttimeout,
+		AssertionResponseTypesConflict,
+		AssertionResponseTypesGone,
+		AssertionResponseTypesLengthrequired,
+		AssertionResponseTypesPreconditionfailed,
+		AssertionResponseTypesContenttoolarge,
+		AssertionResponseTypesUritoolong,
+		AssertionResponseTypesUnsupportedmediatype,
+		AssertionResponseTypesRangenotsatisfiable,
+		AssertionResponseTypesExpectationfailed,
+		AssertionResponseTypesMisdirectedrequest,
+		AssertionResponseTypesUnprocessablecontent,
+		AssertionResponseTypesUpgraderequired,
+		AssertionResponseTypesInternalservererror,
+		AssertionResponseTypesNotimplemented,
+		AssertionResponseTypesBadgateway,
+		AssertionResponseTypesServiceunavailable,
+		AssertionResponseTypesGatewaytimeout,
+		AssertionResponseTypesHttpversionnotsupported:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AssertionResponseTypes value in definition order.
+func (AssertionResponseTypes) firstCode() string {
+	return string(AssertionResponseTypesContinue)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AssertionResponseTypes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AssertionResponseTypes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AuditEventAction represents Audit Event Action.
 type AuditEventAction string
 
@@ -672,6 +1565,34 @@ const (
 	AuditEventActionE AuditEventAction = "E"
 )
 
+// known reports whether c is one of the AuditEventAction values.
+func (c AuditEventAction) known() bool {
+	switch c {
+	case AuditEventActionC,
+		AuditEventActionR,
+		AuditEventActionU,
+		AuditEventActionD,
+		AuditEventActionE:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AuditEventAction value in definition order.
+func (AuditEventAction) firstCode() string {
+	return string(AuditEventActionC)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AuditEventAction) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AuditEventAction) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AuditEventSeverity represents Audit Event Severity.
 type AuditEventSeverity string
 
@@ -695,6 +1616,37 @@ const (
 	AuditEventSeverityDebug AuditEventSeverity = "debug"
 )
 
+// known reports whether c is one of the AuditEventSeverity values.
+func (c AuditEventSeverity) known() bool {
+	switch c {
+	case AuditEventSeverityEmergency,
+		AuditEventSeverityAlert,
+		AuditEventSeverityCritical,
+		AuditEventSeverityError,
+		AuditEventSeverityWarning,
+		AuditEventSeverityNotice,
+		AuditEventSeverityInformational,
+		AuditEventSeverityDebug:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AuditEventSeverity value in definition order.
+func (AuditEventSeverity) firstCode() string {
+	return string(AuditEventSeverityEmergency)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AuditEventSeverity) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AuditEventSeverity) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // BindingStrength represents BindingStrength.
 type BindingStrength string
 
@@ -710,6 +1662,33 @@ const (
 	BindingStrengthExample BindingStrength = "example"
 )
 
+// known reports whether c is one of the BindingStrength values.
+func (c BindingStrength) known() bool {
+	switch c {
+	case BindingStrengthRequired,
+		BindingStrengthExtensible,
+		BindingStrengthPreferred,
+		BindingStrengthExample:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first BindingStrength value in definition order.
+func (BindingStrength) firstCode() string {
+	return string(BindingStrengthRequired)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c BindingStrength) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *BindingStrength) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // BiologicallyDerivedProductDispenseCodes represents BiologicallyDerivedProductDispense Status Codes.
 type BiologicallyDerivedProductDispenseCodes string
 
@@ -733,6 +1712,37 @@ const (
 	BiologicallyDerivedProductDispenseCodesUnknown BiologicallyDerivedProductDispenseCodes = "unknown"
 )
 
+// known reports whether c is one of the BiologicallyDerivedProductDispenseCodes values.
+func (c BiologicallyDerivedProductDispenseCodes) known() bool {
+	switch c {
+	case BiologicallyDerivedProductDispenseCodesPreparation,
+		BiologicallyDerivedProductDispenseCodesInProgress,
+		BiologicallyDerivedProductDispenseCodesAllocated,
+		BiologicallyDerivedProductDispenseCodesIssued,
+		BiologicallyDerivedProductDispenseCodesUnfulfilled,
+		BiologicallyDerivedProductDispenseCodesReturned,
+		BiologicallyDerivedProductDispenseCodesEnteredInError,
+		BiologicallyDerivedProductDispenseCodesUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first BiologicallyDerivedProductDispenseCodes value in definition order.
+func (BiologicallyDerivedProductDispenseCodes) firstCode() string {
+	return string(BiologicallyDerivedProductDispenseCodesPreparation)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c BiologicallyDerivedProductDispenseCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *BiologicallyDerivedProductDispenseCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // BundleType represents Bundle Type.
 type BundleType string
 
@@ -760,6 +1770,39 @@ const (
 	BundleTypeSubscriptionNotification BundleType = "subscription-notification"
 )
 
+// known reports whether c is one of the BundleType values.
+func (c BundleType) known() bool {
+	switch c {
+	case BundleTypeDocument,
+		BundleTypeMessage,
+		BundleTypeTransaction,
+		BundleTypeTransactionResponse,
+		BundleTypeBatch,
+		BundleTypeBatchResponse,
+		BundleTypeHistory,
+		BundleTypeSearchset,
+		BundleTypeCollection,
+		BundleTypeSubscriptionNotification:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first BundleType value in definition order.
+func (BundleType) firstCode() string {
+	return string(BundleTypeDocument)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c BundleType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *BundleType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CapabilityStatementKind represents Capability Statement Kind.
 type CapabilityStatementKind string
 
@@ -773,6 +1816,32 @@ const (
 	CapabilityStatementKindRequirements CapabilityStatementKind = "requirements"
 )
 
+// known reports whether c is one of the CapabilityStatementKind values.
+func (c CapabilityStatementKind) known() bool {
+	switch c {
+	case CapabilityStatementKindInstance,
+		CapabilityStatementKindCapability,
+		CapabilityStatementKindRequirements:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CapabilityStatementKind value in definition order.
+func (CapabilityStatementKind) firstCode() string {
+	return string(CapabilityStatementKindInstance)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CapabilityStatementKind) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CapabilityStatementKind) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CarePlanIntent represents Care Plan Intent.
 type CarePlanIntent string
 
@@ -785,6 +1854,34 @@ const (
 	CarePlanIntentDirective CarePlanIntent = "directive"
 )
 
+// known reports whether c is one of the CarePlanIntent values.
+func (c CarePlanIntent) known() bool {
+	switch c {
+	case CarePlanIntentProposal,
+		CarePlanIntentPlan,
+		CarePlanIntentOrder,
+		CarePlanIntentOption,
+		CarePlanIntentDirective:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CarePlanIntent value in definition order.
+func (CarePlanIntent) firstCode() string {
+	return string(CarePlanIntentProposal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CarePlanIntent) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CarePlanIntent) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CareTeamStatus represents Care Team Status.
 type CareTeamStatus string
 
@@ -802,6 +1899,34 @@ const (
 	CareTeamStatusEnteredInError CareTeamStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the CareTeamStatus values.
+func (c CareTeamStatus) known() bool {
+	switch c {
+	case CareTeamStatusProposed,
+		CareTeamStatusActive,
+		CareTeamStatusSuspended,
+		CareTeamStatusInactive,
+		CareTeamStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CareTeamStatus value in definition order.
+func (CareTeamStatus) firstCode() string {
+	return string(CareTeamStatusProposed)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CareTeamStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CareTeamStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CharacteristicCombination represents Characteristic Combination.
 type CharacteristicCombination string
 
@@ -823,6 +1948,36 @@ const (
 	CharacteristicCombinationDataset CharacteristicCombination = "dataset"
 )
 
+// known reports whether c is one of the CharacteristicCombination values.
+func (c CharacteristicCombination) known() bool {
+	switch c {
+	case CharacteristicCombinationAllOf,
+		CharacteristicCombinationAnyOf,
+		CharacteristicCombinationAtLeast,
+		CharacteristicCombinationAtMost,
+		CharacteristicCombinationStatistical,
+		CharacteristicCombinationNetEffect,
+		CharacteristicCombinationDataset:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CharacteristicCombination value in definition order.
+func (CharacteristicCombination) firstCode() string {
+	return string(CharacteristicCombinationAllOf)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CharacteristicCombination) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CharacteristicCombination) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ChargeItemStatus represents Charge Item Status.
 type ChargeItemStatus string
 
@@ -844,6 +1999,36 @@ const (
 	ChargeItemStatusUnknown ChargeItemStatus = "unknown"
 )
 
+// known reports whether c is one of the ChargeItemStatus values.
+func (c ChargeItemStatus) known() bool {
+	switch c {
+	case ChargeItemStatusPlanned,
+		ChargeItemStatusBillable,
+		ChargeItemStatusNotBillable,
+		ChargeItemStatusAborted,
+		ChargeItemStatusBilled,
+		ChargeItemStatusEnteredInError,
+		ChargeItemStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ChargeItemStatus value in definition order.
+func (ChargeItemStatus) firstCode() string {
+	return string(ChargeItemStatusPlanned)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ChargeItemStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ChargeItemStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ClaimProcessingCodes represents Claim Processing Codes.
 type ClaimProcessingCodes string
 
@@ -859,6 +2044,33 @@ const (
 	ClaimProcessingCodesPartial ClaimProcessingCodes = "partial"
 )
 
+// known reports whether c is one of the ClaimProcessingCodes values.
+func (c ClaimProcessingCodes) known() bool {
+	switch c {
+	case ClaimProcessingCodesQueued,
+		ClaimProcessingCodesComplete,
+		ClaimProcessingCodesError,
+		ClaimProcessingCodesPartial:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ClaimProcessingCodes value in definition order.
+func (ClaimProcessingCodes) firstCode() string {
+	return string(ClaimProcessingCodesQueued)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ClaimProcessingCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ClaimProcessingCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // Use represents Use.
 type Use string
 
@@ -872,6 +2084,32 @@ const (
 	UsePredetermination Use = "predetermination"
 )
 
+// known reports whether c is one of the Use values.
+func (c Use) known() bool {
+	switch c {
+	case UseClaim,
+		UsePreauthorization,
+		UsePredetermination:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first Use value in definition order.
+func (Use) firstCode() string {
+	return string(UseClaim)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c Use) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *Use) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ClinicalUseDefinitionType represents Clinical Use Definition Type.
 type ClinicalUseDefinitionType string
 
@@ -889,6 +2127,34 @@ const (
 	ClinicalUseDefinitionTypeWarning ClinicalUseDefinitionType = "warning"
 )
 
+// known reports whether c is one of the ClinicalUseDefinitionType values.
+func (c ClinicalUseDefinitionType) known() bool {
+	switch c {
+	case ClinicalUseDefinitionTypeIndication,
+		ClinicalUseDefinitionTypeContraindication,
+		ClinicalUseDefinitionTypeInteraction,
+		ClinicalUseDefinitionTypeUndesirableEffect,
+		ClinicalUseDefinitionTypeWarning:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ClinicalUseDefinitionType value in definition order.
+func (ClinicalUseDefinitionType) firstCode() string {
+	return string(ClinicalUseDefinitionTypeIndication)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ClinicalUseDefinitionType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ClinicalUseDefinitionType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CodeSearchSupport represents Code Search Support.
 type CodeSearchSupport string
 
@@ -902,6 +2168,32 @@ const (
 	CodeSearchSupportInComposeOrExpansion CodeSearchSupport = "in-compose-or-expansion"
 )
 
+// known reports whether c is one of the CodeSearchSupport values.
+func (c CodeSearchSupport) known() bool {
+	switch c {
+	case CodeSearchSupportInCompose,
+		CodeSearchSupportInExpansion,
+		CodeSearchSupportInComposeOrExpansion:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CodeSearchSupport value in definition order.
+func (CodeSearchSupport) firstCode() string {
+	return string(CodeSearchSupportInCompose)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CodeSearchSupport) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CodeSearchSupport) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CodeSystemContentMode represents Code System Content Mode.
 type CodeSystemContentMode string
 
@@ -919,6 +2211,34 @@ const (
 	CodeSystemContentModeSupplement CodeSystemContentMode = "supplement"
 )
 
+// known reports whether c is one of the CodeSystemContentMode values.
+func (c CodeSystemContentMode) known() bool {
+	switch c {
+	case CodeSystemContentModeNotPresent,
+		CodeSystemContentModeExample,
+		CodeSystemContentModeFragment,
+		CodeSystemContentModeComplete,
+		CodeSystemContentModeSupplement:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CodeSystemContentMode value in definition order.
+func (CodeSystemContentMode) firstCode() string {
+	return string(CodeSystemContentModeNotPresent)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CodeSystemContentMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CodeSystemContentMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CodeSystemHierarchyMeaning represents Code System Hierarchy Meaning.
 type CodeSystemHierarchyMeaning string
 
@@ -934,6 +2254,33 @@ const (
 	CodeSystemHierarchyMeaningClassifiedWith CodeSystemHierarchyMeaning = "classified-with"
 )
 
+// known reports whether c is one of the CodeSystemHierarchyMeaning values.
+func (c CodeSystemHierarchyMeaning) known() bool {
+	switch c {
+	case CodeSystemHierarchyMeaningGroupedBy,
+		CodeSystemHierarchyMeaningIsA,
+		CodeSystemHierarchyMeaningPartOf,
+		CodeSystemHierarchyMeaningClassifiedWith:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CodeSystemHierarchyMeaning value in definition order.
+func (CodeSystemHierarchyMeaning) firstCode() string {
+	return string(CodeSystemHierarchyMeaningGroupedBy)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CodeSystemHierarchyMeaning) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CodeSystemHierarchyMeaning) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CompartmentType represents Compartment Type.
 type CompartmentType string
 
@@ -953,6 +2300,35 @@ const (
 	CompartmentTypeEpisodeofcare CompartmentType = "EpisodeOfCare"
 )
 
+// known reports whether c is one of the CompartmentType values.
+func (c CompartmentType) known() bool {
+	switch c {
+	case CompartmentTypePatient,
+		CompartmentTypeEncounter,
+		CompartmentTypeRelatedperson,
+		CompartmentTypePractitioner,
+		CompartmentTypeDevice,
+		CompartmentTypeEpisodeofcare:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CompartmentType value in definition order.
+func (CompartmentType) firstCode() string {
+	return string(CompartmentTypePatient)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CompartmentType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CompartmentType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CompositionStatus represents Composition Status.
 type CompositionStatus string
 
@@ -982,6 +2358,40 @@ const (
 	CompositionStatusUnknown CompositionStatus = "unknown"
 )
 
+// known reports whether c is one of the CompositionStatus values.
+func (c CompositionStatus) known() bool {
+	switch c {
+	case CompositionStatusRegistered,
+		CompositionStatusPartial,
+		CompositionStatusPreliminary,
+		CompositionStatusFinal,
+		CompositionStatusAmended,
+		CompositionStatusCorrected,
+		CompositionStatusAppended,
+		CompositionStatusCancelled,
+		CompositionStatusEnteredInError,
+		CompositionStatusDeprecated,
+		CompositionStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CompositionStatus value in definition order.
+func (CompositionStatus) firstCode() string {
+	return string(CompositionStatusRegistered)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CompositionStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CompositionStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConceptMapRelationship represents ConceptMapRelationship.
 type ConceptMapRelationship string
 
@@ -999,6 +2409,34 @@ const (
 	ConceptMapRelationshipNotRelatedTo ConceptMapRelationship = "not-related-to"
 )
 
+// known reports whether c is one of the ConceptMapRelationship values.
+func (c ConceptMapRelationship) known() bool {
+	switch c {
+	case ConceptMapRelationshipRelatedTo,
+		ConceptMapRelationshipEquivalent,
+		ConceptMapRelationshipSourceIsNarrowerThanTarget,
+		ConceptMapRelationshipSourceIsBroaderThanTarget,
+		ConceptMapRelationshipNotRelatedTo:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConceptMapRelationship value in definition order.
+func (ConceptMapRelationship) firstCode() string {
+	return string(ConceptMapRelationshipRelatedTo)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConceptMapRelationship) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConceptMapRelationship) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // PropertyType represents Property Type.
 type PropertyType string
 
@@ -1020,6 +2458,36 @@ const (
 	PropertyTypeDecimal PropertyType = "decimal"
 )
 
+// known reports whether c is one of the PropertyType values.
+func (c PropertyType) known() bool {
+	switch c {
+	case PropertyTypeCode,
+		PropertyTypeCoding,
+		PropertyTypeString,
+		PropertyTypeInteger,
+		PropertyTypeBoolean,
+		PropertyTypeDatetime,
+		PropertyTypeDecimal:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first PropertyType value in definition order.
+func (PropertyType) firstCode() string {
+	return string(PropertyTypeCode)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c PropertyType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *PropertyType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConceptMapAttributeType represents ConceptMap Attribute Type.
 type ConceptMapAttributeType string
 
@@ -1037,6 +2505,34 @@ const (
 	ConceptMapAttributeTypeQuantity ConceptMapAttributeType = "Quantity"
 )
 
+// known reports whether c is one of the ConceptMapAttributeType values.
+func (c ConceptMapAttributeType) known() bool {
+	switch c {
+	case ConceptMapAttributeTypeCode,
+		ConceptMapAttributeTypeCoding,
+		ConceptMapAttributeTypeString,
+		ConceptMapAttributeTypeBoolean,
+		ConceptMapAttributeTypeQuantity:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConceptMapAttributeType value in definition order.
+func (ConceptMapAttributeType) firstCode() string {
+	return string(ConceptMapAttributeTypeCode)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConceptMapAttributeType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConceptMapAttributeType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConceptMapPropertyType represents ConceptMap Property Type.
 type ConceptMapPropertyType string
 
@@ -1058,6 +2554,36 @@ const (
 	ConceptMapPropertyTypeCode ConceptMapPropertyType = "code"
 )
 
+// known reports whether c is one of the ConceptMapPropertyType values.
+func (c ConceptMapPropertyType) known() bool {
+	switch c {
+	case ConceptMapPropertyTypeCoding,
+		ConceptMapPropertyTypeString,
+		ConceptMapPropertyTypeInteger,
+		ConceptMapPropertyTypeBoolean,
+		ConceptMapPropertyTypeDatetime,
+		ConceptMapPropertyTypeDecimal,
+		ConceptMapPropertyTypeCode:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConceptMapPropertyType value in definition order.
+func (ConceptMapPropertyType) firstCode() string {
+	return string(ConceptMapPropertyTypeCoding)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConceptMapPropertyType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConceptMapPropertyType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConceptMapGroupUnmappedMode represents Concept Map Group Unmapped Mode.
 type ConceptMapGroupUnmappedMode string
 
@@ -1071,6 +2597,32 @@ const (
 	ConceptMapGroupUnmappedModeOtherMap ConceptMapGroupUnmappedMode = "other-map"
 )
 
+// known reports whether c is one of the ConceptMapGroupUnmappedMode values.
+func (c ConceptMapGroupUnmappedMode) known() bool {
+	switch c {
+	case ConceptMapGroupUnmappedModeUseSourceCode,
+		ConceptMapGroupUnmappedModeFixed,
+		ConceptMapGroupUnmappedModeOtherMap:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConceptMapGroupUnmappedMode value in definition order.
+func (ConceptMapGroupUnmappedMode) firstCode() string {
+	return string(ConceptMapGroupUnmappedModeUseSourceCode)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConceptMapGroupUnmappedMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConceptMapGroupUnmappedMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConditionPreconditionType represents Condition Precondition Type.
 type ConditionPreconditionType string
 
@@ -1082,6 +2634,31 @@ const (
 	ConditionPreconditionTypeSpecific ConditionPreconditionType = "specific"
 )
 
+// known reports whether c is one of the ConditionPreconditionType values.
+func (c ConditionPreconditionType) known() bool {
+	switch c {
+	case ConditionPreconditionTypeSensitive,
+		ConditionPreconditionTypeSpecific:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConditionPreconditionType value in definition order.
+func (ConditionPreconditionType) firstCode() string {
+	return string(ConditionPreconditionTypeSensitive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConditionPreconditionType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConditionPreconditionType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConditionQuestionnairePurpose represents Condition Questionnaire Purpose.
 type ConditionQuestionnairePurpose string
 
@@ -1095,6 +2672,32 @@ const (
 	ConditionQuestionnairePurposeOutcome ConditionQuestionnairePurpose = "outcome"
 )
 
+// known reports whether c is one of the ConditionQuestionnairePurpose values.
+func (c ConditionQuestionnairePurpose) known() bool {
+	switch c {
+	case ConditionQuestionnairePurposePreadmit,
+		ConditionQuestionnairePurposeDiffDiagnosis,
+		ConditionQuestionnairePurposeOutcome:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConditionQuestionnairePurpose value in definition order.
+func (ConditionQuestionnairePurpose) firstCode() string {
+	return string(ConditionQuestionnairePurposePreadmit)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConditionQuestionnairePurpose) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConditionQuestionnairePurpose) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConditionalDeleteStatus represents Conditional Delete Status.
 type ConditionalDeleteStatus string
 
@@ -1108,6 +2711,32 @@ const (
 	ConditionalDeleteStatusMultiple ConditionalDeleteStatus = "multiple"
 )
 
+// known reports whether c is one of the ConditionalDeleteStatus values.
+func (c ConditionalDeleteStatus) known() bool {
+	switch c {
+	case ConditionalDeleteStatusNotSupported,
+		ConditionalDeleteStatusSingle,
+		ConditionalDeleteStatusMultiple:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConditionalDeleteStatus value in definition order.
+func (ConditionalDeleteStatus) firstCode() string {
+	return string(ConditionalDeleteStatusNotSupported)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConditionalDeleteStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConditionalDeleteStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConditionalReadStatus represents Conditional Read Status.
 type ConditionalReadStatus string
 
@@ -1123,6 +2752,33 @@ const (
 	ConditionalReadStatusFullSupport ConditionalReadStatus = "full-support"
 )
 
+// known reports whether c is one of the ConditionalReadStatus values.
+func (c ConditionalReadStatus) known() bool {
+	switch c {
+	case ConditionalReadStatusNotSupported,
+		ConditionalReadStatusModifiedSince,
+		ConditionalReadStatusNotMatch,
+		ConditionalReadStatusFullSupport:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConditionalReadStatus value in definition order.
+func (ConditionalReadStatus) firstCode() string {
+	return string(ConditionalReadStatusNotSupported)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConditionalReadStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConditionalReadStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConformanceExpectation represents Conformance Expectation.
 type ConformanceExpectation string
 
@@ -1138,6 +2794,33 @@ const (
 	ConformanceExpectationShouldNot ConformanceExpectation = "SHOULD-NOT"
 )
 
+// known reports whether c is one of the ConformanceExpectation values.
+func (c ConformanceExpectation) known() bool {
+	switch c {
+	case ConformanceExpectationShall,
+		ConformanceExpectationShould,
+		ConformanceExpectationMay,
+		ConformanceExpectationShouldNot:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConformanceExpectation value in definition order.
+func (ConformanceExpectation) firstCode() string {
+	return string(ConformanceExpectationShall)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConformanceExpectation) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConformanceExpectation) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConsentDataMeaning represents Consent Data Meaning.
 type ConsentDataMeaning string
 
@@ -1153,6 +2836,33 @@ const (
 	ConsentDataMeaningAuthoredby ConsentDataMeaning = "authoredby"
 )
 
+// known reports whether c is one of the ConsentDataMeaning values.
+func (c ConsentDataMeaning) known() bool {
+	switch c {
+	case ConsentDataMeaningInstance,
+		ConsentDataMeaningRelated,
+		ConsentDataMeaningDependents,
+		ConsentDataMeaningAuthoredby:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConsentDataMeaning value in definition order.
+func (ConsentDataMeaning) firstCode() string {
+	return string(ConsentDataMeaningInstance)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConsentDataMeaning) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConsentDataMeaning) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConsentProvisionType represents Consent Provision Type.
 type ConsentProvisionType string
 
@@ -1164,6 +2874,31 @@ const (
 	ConsentProvisionTypePermit ConsentProvisionType = "permit"
 )
 
+// known reports whether c is one of the ConsentProvisionType values.
+func (c ConsentProvisionType) known() bool {
+	switch c {
+	case ConsentProvisionTypeDeny,
+		ConsentProvisionTypePermit:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConsentProvisionType value in definition order.
+func (ConsentProvisionType) firstCode() string {
+	return string(ConsentProvisionTypeDeny)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConsentProvisionType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConsentProvisionType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConsentState represents Consent State.
 type ConsentState string
 
@@ -1183,6 +2918,35 @@ const (
 	ConsentStateUnknown ConsentState = "unknown"
 )
 
+// known reports whether c is one of the ConsentState values.
+func (c ConsentState) known() bool {
+	switch c {
+	case ConsentStateDraft,
+		ConsentStateActive,
+		ConsentStateInactive,
+		ConsentStateNotDone,
+		ConsentStateEnteredInError,
+		ConsentStateUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConsentState value in definition order.
+func (ConsentState) firstCode() string {
+	return string(ConsentStateDraft)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConsentState) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConsentState) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ConstraintSeverity represents ConstraintSeverity.
 type ConstraintSeverity string
 
@@ -1194,6 +2958,31 @@ const (
 	ConstraintSeverityWarning ConstraintSeverity = "warning"
 )
 
+// known reports whether c is one of the ConstraintSeverity values.
+func (c ConstraintSeverity) known() bool {
+	switch c {
+	case ConstraintSeverityError,
+		ConstraintSeverityWarning:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ConstraintSeverity value in definition order.
+func (ConstraintSeverity) firstCode() string {
+	return string(ConstraintSeverityError)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ConstraintSeverity) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ConstraintSeverity) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ContactPointSystem represents ContactPointSystem.
 type ContactPointSystem string
 
@@ -1215,6 +3004,36 @@ const (
 	ContactPointSystemOther ContactPointSystem = "other"
 )
 
+// known reports whether c is one of the ContactPointSystem values.
+func (c ContactPointSystem) known() bool {
+	switch c {
+	case ContactPointSystemPhone,
+		ContactPointSystemFax,
+		ContactPointSystemEmail,
+		ContactPointSystemPager,
+		ContactPointSystemUrl,
+		ContactPointSystemSms,
+		ContactPointSystemOther:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ContactPointSystem value in definition order.
+func (ContactPointSystem) firstCode() string {
+	return string(ContactPointSystemPhone)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ContactPointSystem) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ContactPointSystem) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ContactPointUse represents ContactPointUse.
 type ContactPointUse string
 
@@ -1232,6 +3051,34 @@ const (
 	ContactPointUseMobile ContactPointUse = "mobile"
 )
 
+// known reports whether c is one of the ContactPointUse values.
+func (c ContactPointUse) known() bool {
+	switch c {
+	case ContactPointUseHome,
+		ContactPointUseWork,
+		ContactPointUseTemp,
+		ContactPointUseOld,
+		ContactPointUseMobile:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ContactPointUse value in definition order.
+func (ContactPointUse) firstCode() string {
+	return string(ContactPointUseHome)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ContactPointUse) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ContactPointUse) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ContractResourcePublicationStatusCodes represents Contract Resource Publication Status codes.
 type ContractResourcePublicationStatusCodes string
 
@@ -1269,6 +3116,44 @@ const (
 	ContractResourcePublicationStatusCodesTerminated ContractResourcePublicationStatusCodes = "terminated"
 )
 
+// known reports whether c is one of the ContractResourcePublicationStatusCodes values.
+func (c ContractResourcePublicationStatusCodes) known() bool {
+	switch c {
+	case ContractResourcePublicationStatusCodesAmended,
+		ContractResourcePublicationStatusCodesAppended,
+		ContractResourcePublicationStatusCodesCancelled,
+		ContractResourcePublicationStatusCodesDisputed,
+		ContractResourcePublicationStatusCodesEnteredInError,
+		ContractResourcePublicationStatusCodesExecutable,
+		ContractResourcePublicationStatusCodesExecuted,
+		ContractResourcePublicationStatusCodesNegotiable,
+		ContractResourcePublicationStatusCodesOffered,
+		ContractResourcePublicationStatusCodesPolicy,
+		ContractResourcePublicationStatusCodesRejected,
+		ContractResourcePublicationStatusCodesRenewed,
+		ContractResourcePublicationStatusCodesRevoked,
+		ContractResourcePublicationStatusCodesResolved,
+		ContractResourcePublicationStatusCodesTerminated:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ContractResourcePublicationStatusCodes value in definition order.
+func (ContractResourcePublicationStatusCodes) firstCode() string {
+	return string(ContractResourcePublicationStatusCodesAmended)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ContractResourcePublicationStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ContractResourcePublicationStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ContractResourceStatusCodes represents Contract Resource Status Codes.
 type ContractResourceStatusCodes string
 
@@ -1306,6 +3191,44 @@ const (
 	ContractResourceStatusCodesTerminated ContractResourceStatusCodes = "terminated"
 )
 
+// known reports whether c is one of the ContractResourceStatusCodes values.
+func (c ContractResourceStatusCodes) known() bool {
+	switch c {
+	case ContractResourceStatusCodesAmended,
+		ContractResourceStatusCodesAppended,
+		ContractResourceStatusCodesCancelled,
+		ContractResourceStatusCodesDisputed,
+		ContractResourceStatusCodesEnteredInError,
+		ContractResourceStatusCodesExecutable,
+		ContractResourceStatusCodesExecuted,
+		ContractResourceStatusCodesNegotiable,
+		ContractResourceStatusCodesOffered,
+		ContractResourceStatusCodesPolicy,
+		ContractResourceStatusCodesRejected,
+		ContractResourceStatusCodesRenewed,
+		ContractResourceStatusCodesRevoked,
+		ContractResourceStatusCodesResolved,
+		ContractResourceStatusCodesTerminated:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ContractResourceStatusCodes value in definition order.
+func (ContractResourceStatusCodes) firstCode() string {
+	return string(ContractResourceStatusCodesAmended)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ContractResourceStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ContractResourceStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ContributorType represents ContributorType.
 type ContributorType string
 
@@ -1321,6 +3244,33 @@ const (
 	ContributorTypeEndorser ContributorType = "endorser"
 )
 
+// known reports whether c is one of the ContributorType values.
+func (c ContributorType) known() bool {
+	switch c {
+	case ContributorTypeAuthor,
+		ContributorTypeEditor,
+		ContributorTypeReviewer,
+		ContributorTypeEndorser:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ContributorType value in definition order.
+func (ContributorType) firstCode() string {
+	return string(ContributorTypeAuthor)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ContributorType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ContributorType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // Kind represents Kind.
 type Kind string
 
@@ -1334,6 +3284,32 @@ const (
 	KindOther Kind = "other"
 )
 
+// known reports whether c is one of the Kind values.
+func (c Kind) known() bool {
+	switch c {
+	case KindInsurance,
+		KindSelfPay,
+		KindOther:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first Kind value in definition order.
+func (Kind) firstCode() string {
+	return string(KindInsurance)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c Kind) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *Kind) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DaysOfWeek represents Days Of Week.
 type DaysOfWeek string
 
@@ -1355,6 +3331,36 @@ const (
 	DaysOfWeekSun DaysOfWeek = "sun"
 )
 
+// known reports whether c is one of the DaysOfWeek values.
+func (c DaysOfWeek) known() bool {
+	switch c {
+	case DaysOfWeekMon,
+		DaysOfWeekTue,
+		DaysOfWeekWed,
+		DaysOfWeekThu,
+		DaysOfWeekFri,
+		DaysOfWeekSat,
+		DaysOfWeekSun:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DaysOfWeek value in definition order.
+func (DaysOfWeek) firstCode() string {
+	return string(DaysOfWeekMon)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DaysOfWeek) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DaysOfWeek) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DetectedIssueSeverity represents Detected Issue Severity.
 type DetectedIssueSeverity string
 
@@ -1368,6 +3374,32 @@ const (
 	DetectedIssueSeverityLow DetectedIssueSeverity = "low"
 )
 
+// known reports whether c is one of the DetectedIssueSeverity values.
+func (c DetectedIssueSeverity) known() bool {
+	switch c {
+	case DetectedIssueSeverityHigh,
+		DetectedIssueSeverityModerate,
+		DetectedIssueSeverityLow:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DetectedIssueSeverity value in definition order.
+func (DetectedIssueSeverity) firstCode() string {
+	return string(DetectedIssueSeverityHigh)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DetectedIssueSeverity) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DetectedIssueSeverity) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DetectedIssueStatus represents Detected Issue Status.
 type DetectedIssueStatus string
 
@@ -1383,6 +3415,33 @@ const (
 	DetectedIssueStatusMitigated DetectedIssueStatus = "mitigated"
 )
 
+// known reports whether c is one of the DetectedIssueStatus values.
+func (c DetectedIssueStatus) known() bool {
+	switch c {
+	case DetectedIssueStatusPreliminary,
+		DetectedIssueStatusFinal,
+		DetectedIssueStatusEnteredInError,
+		DetectedIssueStatusMitigated:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DetectedIssueStatus value in definition order.
+func (DetectedIssueStatus) firstCode() string {
+	return string(DetectedIssueStatusPreliminary)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DetectedIssueStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DetectedIssueStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceCorrectiveActionScope represents Device Corrective Action Scope.
 type DeviceCorrectiveActionScope string
 
@@ -1396,6 +3455,32 @@ const (
 	DeviceCorrectiveActionScopeSerialNumbers DeviceCorrectiveActionScope = "serial-numbers"
 )
 
+// known reports whether c is one of the DeviceCorrectiveActionScope values.
+func (c DeviceCorrectiveActionScope) known() bool {
+	switch c {
+	case DeviceCorrectiveActionScopeModel,
+		DeviceCorrectiveActionScopeLotNumbers,
+		DeviceCorrectiveActionScopeSerialNumbers:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceCorrectiveActionScope value in definition order.
+func (DeviceCorrectiveActionScope) firstCode() string {
+	return string(DeviceCorrectiveActionScopeModel)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceCorrectiveActionScope) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceCorrectiveActionScope) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceNameType represents Device Name Type.
 type DeviceNameType string
 
@@ -1409,6 +3494,32 @@ const (
 	DeviceNameTypePatientReportedName DeviceNameType = "patient-reported-name"
 )
 
+// known reports whether c is one of the DeviceNameType values.
+func (c DeviceNameType) known() bool {
+	switch c {
+	case DeviceNameTypeRegisteredName,
+		DeviceNameTypeUserFriendlyName,
+		DeviceNameTypePatientReportedName:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceNameType value in definition order.
+func (DeviceNameType) firstCode() string {
+	return string(DeviceNameTypeRegisteredName)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceNameType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceNameType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceProductionIdentifierInUDI represents Device Production Identifier In UDI.
 type DeviceProductionIdentifierInUDI string
 
@@ -1428,6 +3539,35 @@ const (
 	DeviceProductionIdentifierInUDISoftwareVersion DeviceProductionIdentifierInUDI = "software-version"
 )
 
+// known reports whether c is one of the DeviceProductionIdentifierInUDI values.
+func (c DeviceProductionIdentifierInUDI) known() bool {
+	switch c {
+	case DeviceProductionIdentifierInUDILotNumber,
+		DeviceProductionIdentifierInUDIManufacturedDate,
+		DeviceProductionIdentifierInUDISerialNumber,
+		DeviceProductionIdentifierInUDIExpirationDate,
+		DeviceProductionIdentifierInUDIBiologicalSource,
+		DeviceProductionIdentifierInUDISoftwareVersion:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceProductionIdentifierInUDI value in definition order.
+func (DeviceProductionIdentifierInUDI) firstCode() string {
+	return string(DeviceProductionIdentifierInUDILotNumber)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceProductionIdentifierInUDI) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceProductionIdentifierInUDI) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // FHIRDeviceStatus represents FHIR Device Status.
 type FHIRDeviceStatus string
 
@@ -1441,6 +3581,32 @@ const (
 	FHIRDeviceStatusEnteredInError FHIRDeviceStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the FHIRDeviceStatus values.
+func (c FHIRDeviceStatus) known() bool {
+	switch c {
+	case FHIRDeviceStatusActive,
+		FHIRDeviceStatusInactive,
+		FHIRDeviceStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FHIRDeviceStatus value in definition order.
+func (FHIRDeviceStatus) firstCode() string {
+	return string(FHIRDeviceStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FHIRDeviceStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FHIRDeviceStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceDefinitionRegulatoryIdentifierType represents Device Definition Regulatory Identifier Type.
 type DeviceDefinitionRegulatoryIdentifierType string
 
@@ -1454,6 +3620,32 @@ const (
 	DeviceDefinitionRegulatoryIdentifierTypeLicense DeviceDefinitionRegulatoryIdentifierType = "license"
 )
 
+// known reports whether c is one of the DeviceDefinitionRegulatoryIdentifierType values.
+func (c DeviceDefinitionRegulatoryIdentifierType) known() bool {
+	switch c {
+	case DeviceDefinitionRegulatoryIdentifierTypeBasic,
+		DeviceDefinitionRegulatoryIdentifierTypeMaster,
+		DeviceDefinitionRegulatoryIdentifierTypeLicense:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceDefinitionRegulatoryIdentifierType value in definition order.
+func (DeviceDefinitionRegulatoryIdentifierType) firstCode() string {
+	return string(DeviceDefinitionRegulatoryIdentifierTypeBasic)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceDefinitionRegulatoryIdentifierType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceDefinitionRegulatoryIdentifierType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceDispenseStatusCodes represents DeviceDispense Status Codes.
 type DeviceDispenseStatusCodes string
 
@@ -1479,6 +3671,38 @@ const (
 	DeviceDispenseStatusCodesUnknown DeviceDispenseStatusCodes = "unknown"
 )
 
+// known reports whether c is one of the DeviceDispenseStatusCodes values.
+func (c DeviceDispenseStatusCodes) known() bool {
+	switch c {
+	case DeviceDispenseStatusCodesPreparation,
+		DeviceDispenseStatusCodesInProgress,
+		DeviceDispenseStatusCodesCancelled,
+		DeviceDispenseStatusCodesOnHold,
+		DeviceDispenseStatusCodesCompleted,
+		DeviceDispenseStatusCodesEnteredInError,
+		DeviceDispenseStatusCodesStopped,
+		DeviceDispenseStatusCodesDeclined,
+		DeviceDispenseStatusCodesUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceDispenseStatusCodes value in definition order.
+func (DeviceDispenseStatusCodes) firstCode() string {
+	return string(DeviceDispenseStatusCodesPreparation)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceDispenseStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceDispenseStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceUsageStatus represents Device Usage Status.
 type DeviceUsageStatus string
 
@@ -1500,6 +3724,36 @@ const (
 	DeviceUsageStatusOnHold DeviceUsageStatus = "on-hold"
 )
 
+// known reports whether c is one of the DeviceUsageStatus values.
+func (c DeviceUsageStatus) known() bool {
+	switch c {
+	case DeviceUsageStatusActive,
+		DeviceUsageStatusCompleted,
+		DeviceUsageStatusNotDone,
+		DeviceUsageStatusEnteredInError,
+		DeviceUsageStatusIntended,
+		DeviceUsageStatusStopped,
+		DeviceUsageStatusOnHold:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceUsageStatus value in definition order.
+func (DeviceUsageStatus) firstCode() string {
+	return string(DeviceUsageStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceUsageStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceUsageStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DiagnosticReportStatus represents Diagnostic Report Status.
 type DiagnosticReportStatus string
 
@@ -1529,6 +3783,40 @@ const (
 	DiagnosticReportStatusUnknown DiagnosticReportStatus = "unknown"
 )
 
+// known reports whether c is one of the DiagnosticReportStatus values.
+func (c DiagnosticReportStatus) known() bool {
+	switch c {
+	case DiagnosticReportStatusRegistered,
+		DiagnosticReportStatusPartial,
+		DiagnosticReportStatusPreliminary,
+		DiagnosticReportStatusModified,
+		DiagnosticReportStatusFinal,
+		DiagnosticReportStatusAmended,
+		DiagnosticReportStatusCorrected,
+		DiagnosticReportStatusAppended,
+		DiagnosticReportStatusCancelled,
+		DiagnosticReportStatusEnteredInError,
+		DiagnosticReportStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DiagnosticReportStatus value in definition order.
+func (DiagnosticReportStatus) firstCode() string {
+	return string(DiagnosticReportStatusRegistered)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DiagnosticReportStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DiagnosticReportStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DiscriminatorType represents DiscriminatorType.
 type DiscriminatorType string
 
@@ -1548,6 +3836,35 @@ const (
 	DiscriminatorTypePosition DiscriminatorType = "position"
 )
 
+// known reports whether c is one of the DiscriminatorType values.
+func (c DiscriminatorType) known() bool {
+	switch c {
+	case DiscriminatorTypeValue,
+		DiscriminatorTypeExists,
+		DiscriminatorTypePattern,
+		DiscriminatorTypeType,
+		DiscriminatorTypeProfile,
+		DiscriminatorTypePosition:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DiscriminatorType value in definition order.
+func (DiscriminatorType) firstCode() string {
+	return string(DiscriminatorTypeValue)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DiscriminatorType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DiscriminatorType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DocumentMode represents Document Mode.
 type DocumentMode string
 
@@ -1559,6 +3876,31 @@ const (
 	DocumentModeConsumer DocumentMode = "consumer"
 )
 
+// known reports whether c is one of the DocumentMode values.
+func (c DocumentMode) known() bool {
+	switch c {
+	case DocumentModeProducer,
+		DocumentModeConsumer:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DocumentMode value in definition order.
+func (DocumentMode) firstCode() string {
+	return string(DocumentModeProducer)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DocumentMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DocumentMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DocumentReferenceStatus represents DocumentReferenceStatus.
 type DocumentReferenceStatus string
 
@@ -1572,6 +3914,32 @@ const (
 	DocumentReferenceStatusEnteredInError DocumentReferenceStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the DocumentReferenceStatus values.
+func (c DocumentReferenceStatus) known() bool {
+	switch c {
+	case DocumentReferenceStatusCurrent,
+		DocumentReferenceStatusSuperseded,
+		DocumentReferenceStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DocumentReferenceStatus value in definition order.
+func (DocumentReferenceStatus) firstCode() string {
+	return string(DocumentReferenceStatusCurrent)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DocumentReferenceStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DocumentReferenceStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EligibilityOutcome represents Eligibility Outcome.
 type EligibilityOutcome string
 
@@ -1587,6 +3955,33 @@ const (
 	EligibilityOutcomePartial EligibilityOutcome = "partial"
 )
 
+// known reports whether c is one of the EligibilityOutcome values.
+func (c EligibilityOutcome) known() bool {
+	switch c {
+	case EligibilityOutcomeQueued,
+		EligibilityOutcomeComplete,
+		EligibilityOutcomeError,
+		EligibilityOutcomePartial:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EligibilityOutcome value in definition order.
+func (EligibilityOutcome) firstCode() string {
+	return string(EligibilityOutcomeQueued)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EligibilityOutcome) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EligibilityOutcome) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EligibilityRequestPurpose represents Eligibility Request Purpose.
 type EligibilityRequestPurpose string
 
@@ -1602,6 +3997,33 @@ const (
 	EligibilityRequestPurposeValidation EligibilityRequestPurpose = "validation"
 )
 
+// known reports whether c is one of the EligibilityRequestPurpose values.
+func (c EligibilityRequestPurpose) known() bool {
+	switch c {
+	case EligibilityRequestPurposeAuthRequirements,
+		EligibilityRequestPurposeBenefits,
+		EligibilityRequestPurposeDiscovery,
+		EligibilityRequestPurposeValidation:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EligibilityRequestPurpose value in definition order.
+func (EligibilityRequestPurpose) firstCode() string {
+	return string(EligibilityRequestPurposeAuthRequirements)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EligibilityRequestPurpose) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EligibilityRequestPurpose) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EligibilityResponsePurpose represents Eligibility Response Purpose.
 type EligibilityResponsePurpose string
 
@@ -1617,6 +4039,33 @@ const (
 	EligibilityResponsePurposeValidation EligibilityResponsePurpose = "validation"
 )
 
+// known reports whether c is one of the EligibilityResponsePurpose values.
+func (c EligibilityResponsePurpose) known() bool {
+	switch c {
+	case EligibilityResponsePurposeAuthRequirements,
+		EligibilityResponsePurposeBenefits,
+		EligibilityResponsePurposeDiscovery,
+		EligibilityResponsePurposeValidation:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EligibilityResponsePurpose value in definition order.
+func (EligibilityResponsePurpose) firstCode() string {
+	return string(EligibilityResponsePurposeAuthRequirements)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EligibilityResponsePurpose) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EligibilityResponsePurpose) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EncounterLocationStatus represents Encounter Location Status.
 type EncounterLocationStatus string
 
@@ -1632,6 +4081,33 @@ const (
 	EncounterLocationStatusCompleted EncounterLocationStatus = "completed"
 )
 
+// known reports whether c is one of the EncounterLocationStatus values.
+func (c EncounterLocationStatus) known() bool {
+	switch c {
+	case EncounterLocationStatusPlanned,
+		EncounterLocationStatusActive,
+		EncounterLocationStatusReserved,
+		EncounterLocationStatusCompleted:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EncounterLocationStatus value in definition order.
+func (EncounterLocationStatus) firstCode() string {
+	return string(EncounterLocationStatusPlanned)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EncounterLocationStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EncounterLocationStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EncounterStatus represents Encounter Status.
 type EncounterStatus string
 
@@ -1657,6 +4133,38 @@ const (
 	EncounterStatusUnknown EncounterStatus = "unknown"
 )
 
+// known reports whether c is one of the EncounterStatus values.
+func (c EncounterStatus) known() bool {
+	switch c {
+	case EncounterStatusPlanned,
+		EncounterStatusInProgress,
+		EncounterStatusOnHold,
+		EncounterStatusDischarged,
+		EncounterStatusCompleted,
+		EncounterStatusCancelled,
+		EncounterStatusDiscontinued,
+		EncounterStatusEnteredInError,
+		EncounterStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EncounterStatus value in definition order.
+func (EncounterStatus) firstCode() string {
+	return string(EncounterStatusPlanned)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EncounterStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EncounterStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EndpointStatus represents Endpoint Status.
 type EndpointStatus string
 
@@ -1674,6 +4182,34 @@ const (
 	EndpointStatusEnteredInError EndpointStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the EndpointStatus values.
+func (c EndpointStatus) known() bool {
+	switch c {
+	case EndpointStatusActive,
+		EndpointStatusSuspended,
+		EndpointStatusError,
+		EndpointStatusOff,
+		EndpointStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EndpointStatus value in definition order.
+func (EndpointStatus) firstCode() string {
+	return string(EndpointStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EndpointStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EndpointStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EnrollmentOutcome represents Enrollment Outcome.
 type EnrollmentOutcome string
 
@@ -1689,6 +4225,33 @@ const (
 	EnrollmentOutcomePartial EnrollmentOutcome = "partial"
 )
 
+// known reports whether c is one of the EnrollmentOutcome values.
+func (c EnrollmentOutcome) known() bool {
+	switch c {
+	case EnrollmentOutcomeQueued,
+		EnrollmentOutcomeComplete,
+		EnrollmentOutcomeError,
+		EnrollmentOutcomePartial:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EnrollmentOutcome value in definition order.
+func (EnrollmentOutcome) firstCode() string {
+	return string(EnrollmentOutcomeQueued)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EnrollmentOutcome) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EnrollmentOutcome) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EpisodeOfCareStatus represents Episode Of Care Status.
 type EpisodeOfCareStatus string
 
@@ -1710,6 +4273,36 @@ const (
 	EpisodeOfCareStatusEnteredInError EpisodeOfCareStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the EpisodeOfCareStatus values.
+func (c EpisodeOfCareStatus) known() bool {
+	switch c {
+	case EpisodeOfCareStatusPlanned,
+		EpisodeOfCareStatusWaitlist,
+		EpisodeOfCareStatusActive,
+		EpisodeOfCareStatusOnhold,
+		EpisodeOfCareStatusFinished,
+		EpisodeOfCareStatusCancelled,
+		EpisodeOfCareStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EpisodeOfCareStatus value in definition order.
+func (EpisodeOfCareStatus) firstCode() string {
+	return string(EpisodeOfCareStatusPlanned)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EpisodeOfCareStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EpisodeOfCareStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EventCapabilityMode represents Event Capability Mode.
 type EventCapabilityMode string
 
@@ -1721,6 +4314,31 @@ const (
 	EventCapabilityModeReceiver EventCapabilityMode = "receiver"
 )
 
+// known reports whether c is one of the EventCapabilityMode values.
+func (c EventCapabilityMode) known() bool {
+	switch c {
+	case EventCapabilityModeSender,
+		EventCapabilityModeReceiver:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EventCapabilityMode value in definition order.
+func (EventCapabilityMode) firstCode() string {
+	return string(EventCapabilityModeSender)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EventCapabilityMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EventCapabilityMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EventStatus represents EventStatus.
 type EventStatus string
 
@@ -1744,6 +4362,37 @@ const (
 	EventStatusUnknown EventStatus = "unknown"
 )
 
+// known reports whether c is one of the EventStatus values.
+func (c EventStatus) known() bool {
+	switch c {
+	case EventStatusPreparation,
+		EventStatusInProgress,
+		EventStatusNotDone,
+		EventStatusOnHold,
+		EventStatusStopped,
+		EventStatusCompleted,
+		EventStatusEnteredInError,
+		EventStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EventStatus value in definition order.
+func (EventStatus) firstCode() string {
+	return string(EventStatusPreparation)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EventStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EventStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EventTiming represents EventTiming.
 type EventTiming string
 
@@ -1791,6 +4440,56 @@ const (
 	EventTimingPcv  EventTiming = "PCV"
 )
 
+// known reports whether c is one of the EventTiming values.
+func (c EventTiming) known() bool {
+	switch c {
+	case EventTimingMorn,
+		EventTimingMornEarly,
+		EventTimingMornLate,
+		EventTimingNoon,
+		EventTimingAft,
+		EventTimingAftEarly,
+		EventTimingAftLate,
+		EventTimingEve,
+		EventTimingEveEarly,
+		EventTimingEveLate,
+		EventTimingNight,
+		EventTimingPhs,
+		EventTimingImd,
+		EventTimingHs,
+		EventTimingWake,
+		EventTimingC,
+		EventTimingCm,
+		EventTimingCd,
+		EventTimingCv,
+		EventTimingAc,
+		EventTimingAcm,
+		EventTimingAcd,
+		EventTimingAcv,
+		EventTimingPc,
+		EventTimingPcm,
+		EventTimingPcd,
+		EventTimingPcv:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EventTiming value in definition order.
+func (EventTiming) firstCode() string {
+	return string(EventTimingMorn)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EventTiming) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EventTiming) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ExampleScenarioActorType represents Example Scenario Actor Type.
 type ExampleScenarioActorType string
 
@@ -1802,6 +4501,31 @@ const (
 	ExampleScenarioActorTypeSystem ExampleScenarioActorType = "system"
 )
 
+// known reports whether c is one of the ExampleScenarioActorType values.
+func (c ExampleScenarioActorType) known() bool {
+	switch c {
+	case ExampleScenarioActorTypePerson,
+		ExampleScenarioActorTypeSystem:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ExampleScenarioActorType value in definition order.
+func (ExampleScenarioActorType) firstCode() string {
+	return string(ExampleScenarioActorTypePerson)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ExampleScenarioActorType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ExampleScenarioActorType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ExplanationOfBenefitStatus represents Explanation Of Benefit Status.
 type ExplanationOfBenefitStatus string
 
@@ -1817,6 +4541,33 @@ const (
 	ExplanationOfBenefitStatusEnteredInError ExplanationOfBenefitStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the ExplanationOfBenefitStatus values.
+func (c ExplanationOfBenefitStatus) known() bool {
+	switch c {
+	case ExplanationOfBenefitStatusActive,
+		ExplanationOfBenefitStatusCancelled,
+		ExplanationOfBenefitStatusDraft,
+		ExplanationOfBenefitStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ExplanationOfBenefitStatus value in definition order.
+func (ExplanationOfBenefitStatus) firstCode() string {
+	return string(ExplanationOfBenefitStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ExplanationOfBenefitStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ExplanationOfBenefitStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ExtensionContextType represents Extension Context Type.
 type ExtensionContextType string
 
@@ -1830,6 +4581,32 @@ const (
 	ExtensionContextTypeExtension ExtensionContextType = "extension"
 )
 
+// known reports whether c is one of the ExtensionContextType values.
+func (c ExtensionContextType) known() bool {
+	switch c {
+	case ExtensionContextTypeFhirpath,
+		ExtensionContextTypeElement,
+		ExtensionContextTypeExtension:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ExtensionContextType value in definition order.
+func (ExtensionContextType) firstCode() string {
+	return string(ExtensionContextTypeFhirpath)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ExtensionContextType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ExtensionContextType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // FilterOperator represents Filter Operator.
 type FilterOperator string
 
@@ -1859,6 +4636,40 @@ const (
 	FilterOperatorExists FilterOperator = "exists"
 )
 
+// known reports whether c is one of the FilterOperator values.
+func (c FilterOperator) known() bool {
+	switch c {
+	case FilterOperatorEqual,
+		FilterOperatorIsA,
+		FilterOperatorDescendentOf,
+		FilterOperatorIsNotA,
+		FilterOperatorRegex,
+		FilterOperatorIn,
+		FilterOperatorNotIn,
+		FilterOperatorGeneralizes,
+		FilterOperatorChildOf,
+		FilterOperatorDescendentLeaf,
+		FilterOperatorExists:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FilterOperator value in definition order.
+func (FilterOperator) firstCode() string {
+	return string(FilterOperatorEqual)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FilterOperator) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FilterOperator) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // FlagStatus represents Flag Status.
 type FlagStatus string
 
@@ -1872,6 +4683,32 @@ const (
 	FlagStatusEnteredInError FlagStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the FlagStatus values.
+func (c FlagStatus) known() bool {
+	switch c {
+	case FlagStatusActive,
+		FlagStatusInactive,
+		FlagStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FlagStatus value in definition order.
+func (FlagStatus) firstCode() string {
+	return string(FlagStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FlagStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FlagStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // FinancialResourceStatusCodes represents Financial Resource Status Codes.
 type FinancialResourceStatusCodes string
 
@@ -1887,6 +4724,33 @@ const (
 	FinancialResourceStatusCodesEnteredInError FinancialResourceStatusCodes = "entered-in-error"
 )
 
+// known reports whether c is one of the FinancialResourceStatusCodes values.
+func (c FinancialResourceStatusCodes) known() bool {
+	switch c {
+	case FinancialResourceStatusCodesActive,
+		FinancialResourceStatusCodesCancelled,
+		FinancialResourceStatusCodesDraft,
+		FinancialResourceStatusCodesEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FinancialResourceStatusCodes value in definition order.
+func (FinancialResourceStatusCodes) firstCode() string {
+	return string(FinancialResourceStatusCodesActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FinancialResourceStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FinancialResourceStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // FormularyItemStatusCodes represents FormularyItem Status Codes.
 type FormularyItemStatusCodes string
 
@@ -1900,6 +4764,32 @@ const (
 	FormularyItemStatusCodesInactive FormularyItemStatusCodes = "inactive"
 )
 
+// known reports whether c is one of the FormularyItemStatusCodes values.
+func (c FormularyItemStatusCodes) known() bool {
+	switch c {
+	case FormularyItemStatusCodesActive,
+		FormularyItemStatusCodesEnteredInError,
+		FormularyItemStatusCodesInactive:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FormularyItemStatusCodes value in definition order.
+func (FormularyItemStatusCodes) firstCode() string {
+	return string(FormularyItemStatusCodesActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FormularyItemStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FormularyItemStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GenomicStudyStatus represents Genomic Study Status.
 type GenomicStudyStatus string
 
@@ -1917,6 +4807,34 @@ const (
 	GenomicStudyStatusUnknown GenomicStudyStatus = "unknown"
 )
 
+// known reports whether c is one of the GenomicStudyStatus values.
+func (c GenomicStudyStatus) known() bool {
+	switch c {
+	case GenomicStudyStatusRegistered,
+		GenomicStudyStatusAvailable,
+		GenomicStudyStatusCancelled,
+		GenomicStudyStatusEnteredInError,
+		GenomicStudyStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GenomicStudyStatus value in definition order.
+func (GenomicStudyStatus) firstCode() string {
+	return string(GenomicStudyStatusRegistered)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GenomicStudyStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GenomicStudyStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GoalLifecycleStatus represents Goal Lifecycle Status.
 type GoalLifecycleStatus string
 
@@ -1942,6 +4860,38 @@ const (
 	GoalLifecycleStatusRejected GoalLifecycleStatus = "rejected"
 )
 
+// known reports whether c is one of the GoalLifecycleStatus values.
+func (c GoalLifecycleStatus) known() bool {
+	switch c {
+	case GoalLifecycleStatusProposed,
+		GoalLifecycleStatusPlanned,
+		GoalLifecycleStatusAccepted,
+		GoalLifecycleStatusActive,
+		GoalLifecycleStatusOnHold,
+		GoalLifecycleStatusCompleted,
+		GoalLifecycleStatusCancelled,
+		GoalLifecycleStatusEnteredInError,
+		GoalLifecycleStatusRejected:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GoalLifecycleStatus value in definition order.
+func (GoalLifecycleStatus) firstCode() string {
+	return string(GoalLifecycleStatusProposed)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GoalLifecycleStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GoalLifecycleStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GraphCompartmentRule represents Graph Compartment Rule.
 type GraphCompartmentRule string
 
@@ -1957,6 +4907,33 @@ const (
 	GraphCompartmentRuleCustom GraphCompartmentRule = "custom"
 )
 
+// known reports whether c is one of the GraphCompartmentRule values.
+func (c GraphCompartmentRule) known() bool {
+	switch c {
+	case GraphCompartmentRuleIdentical,
+		GraphCompartmentRuleMatching,
+		GraphCompartmentRuleDifferent,
+		GraphCompartmentRuleCustom:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GraphCompartmentRule value in definition order.
+func (GraphCompartmentRule) firstCode() string {
+	return string(GraphCompartmentRuleIdentical)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GraphCompartmentRule) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GraphCompartmentRule) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GraphCompartmentUse represents Graph Compartment Use.
 type GraphCompartmentUse string
 
@@ -1968,6 +4945,31 @@ const (
 	GraphCompartmentUseRequires GraphCompartmentUse = "requires"
 )
 
+// known reports whether c is one of the GraphCompartmentUse values.
+func (c GraphCompartmentUse) known() bool {
+	switch c {
+	case GraphCompartmentUseWhere,
+		GraphCompartmentUseRequires:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GraphCompartmentUse value in definition order.
+func (GraphCompartmentUse) firstCode() string {
+	return string(GraphCompartmentUseWhere)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GraphCompartmentUse) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GraphCompartmentUse) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GroupMembershipBasis represents Group Membership Basis.
 type GroupMembershipBasis string
 
@@ -1979,6 +4981,31 @@ const (
 	GroupMembershipBasisEnumerated GroupMembershipBasis = "enumerated"
 )
 
+// known reports whether c is one of the GroupMembershipBasis values.
+func (c GroupMembershipBasis) known() bool {
+	switch c {
+	case GroupMembershipBasisDefinitional,
+		GroupMembershipBasisEnumerated:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GroupMembershipBasis value in definition order.
+func (GroupMembershipBasis) firstCode() string {
+	return string(GroupMembershipBasisDefinitional)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GroupMembershipBasis) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GroupMembershipBasis) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GroupType represents Group Type.
 type GroupType string
 
@@ -2006,6 +5033,39 @@ const (
 	GroupTypeSpecimen GroupType = "specimen"
 )
 
+// known reports whether c is one of the GroupType values.
+func (c GroupType) known() bool {
+	switch c {
+	case GroupTypePerson,
+		GroupTypeAnimal,
+		GroupTypePractitioner,
+		GroupTypeDevice,
+		GroupTypeCareteam,
+		GroupTypeHealthcareservice,
+		GroupTypeLocation,
+		GroupTypeOrganization,
+		GroupTypeRelatedperson,
+		GroupTypeSpecimen:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GroupType value in definition order.
+func (GroupType) firstCode() string {
+	return string(GroupTypePerson)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GroupType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GroupType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GuidanceResponseStatus represents Guidance Response Status.
 type GuidanceResponseStatus string
 
@@ -2025,6 +5085,35 @@ const (
 	GuidanceResponseStatusEnteredInError GuidanceResponseStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the GuidanceResponseStatus values.
+func (c GuidanceResponseStatus) known() bool {
+	switch c {
+	case GuidanceResponseStatusSuccess,
+		GuidanceResponseStatusDataRequested,
+		GuidanceResponseStatusDataRequired,
+		GuidanceResponseStatusInProgress,
+		GuidanceResponseStatusFailure,
+		GuidanceResponseStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GuidanceResponseStatus value in definition order.
+func (GuidanceResponseStatus) firstCode() string {
+	return string(GuidanceResponseStatusSuccess)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GuidanceResponseStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GuidanceResponseStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // GuidePageGeneration represents Guide Page Generation.
 type GuidePageGeneration string
 
@@ -2040,6 +5129,33 @@ const (
 	GuidePageGenerationGenerated GuidePageGeneration = "generated"
 )
 
+// known reports whether c is one of the GuidePageGeneration values.
+func (c GuidePageGeneration) known() bool {
+	switch c {
+	case GuidePageGenerationHtml,
+		GuidePageGenerationMarkdown,
+		GuidePageGenerationXml,
+		GuidePageGenerationGenerated:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first GuidePageGeneration value in definition order.
+func (GuidePageGeneration) firstCode() string {
+	return string(GuidePageGenerationHtml)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c GuidePageGeneration) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *GuidePageGeneration) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // FamilyHistoryStatus represents Family History Status.
 type FamilyHistoryStatus string
 
@@ -2055,6 +5171,33 @@ const (
 	FamilyHistoryStatusHealthUnknown FamilyHistoryStatus = "health-unknown"
 )
 
+// known reports whether c is one of the FamilyHistoryStatus values.
+func (c FamilyHistoryStatus) known() bool {
+	switch c {
+	case FamilyHistoryStatusPartial,
+		FamilyHistoryStatusCompleted,
+		FamilyHistoryStatusEnteredInError,
+		FamilyHistoryStatusHealthUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FamilyHistoryStatus value in definition order.
+func (FamilyHistoryStatus) firstCode() string {
+	return string(FamilyHistoryStatusPartial)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FamilyHistoryStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FamilyHistoryStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TestScriptRequestMethodCode represents Test Script Request Method Code.
 type TestScriptRequestMethodCode string
 
@@ -2076,6 +5219,36 @@ const (
 	TestScriptRequestMethodCodeHead TestScriptRequestMethodCode = "head"
 )
 
+// known reports whether c is one of the TestScriptRequestMethodCode values.
+func (c TestScriptRequestMethodCode) known() bool {
+	switch c {
+	case TestScriptRequestMethodCodeDelete,
+		TestScriptRequestMethodCodeGet,
+		TestScriptRequestMethodCodeOptions,
+		TestScriptRequestMethodCodePatch,
+		TestScriptRequestMethodCodePost,
+		TestScriptRequestMethodCodePut,
+		TestScriptRequestMethodCodeHead:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TestScriptRequestMethodCode value in definition order.
+func (TestScriptRequestMethodCode) firstCode() string {
+	return string(TestScriptRequestMethodCodeDelete)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TestScriptRequestMethodCode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TestScriptRequestMethodCode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // HTTPVerb represents H T T P Verb.
 type HTTPVerb string
 
@@ -2095,6 +5268,35 @@ const (
 	HTTPVerbPatch HTTPVerb = "PATCH"
 )
 
+// known reports whether c is one of the HTTPVerb values.
+func (c HTTPVerb) known() bool {
+	switch c {
+	case HTTPVerbGet,
+		HTTPVerbHead,
+		HTTPVerbPost,
+		HTTPVerbPut,
+		HTTPVerbDelete,
+		HTTPVerbPatch:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first HTTPVerb value in definition order.
+func (HTTPVerb) firstCode() string {
+	return string(HTTPVerbGet)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c HTTPVerb) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *HTTPVerb) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // IdentifierUse represents IdentifierUse.
 type IdentifierUse string
 
@@ -2112,6 +5314,34 @@ const (
 	IdentifierUseOld IdentifierUse = "old"
 )
 
+// known reports whether c is one of the IdentifierUse values.
+func (c IdentifierUse) known() bool {
+	switch c {
+	case IdentifierUseUsual,
+		IdentifierUseOfficial,
+		IdentifierUseTemp,
+		IdentifierUseSecondary,
+		IdentifierUseOld:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first IdentifierUse value in definition order.
+func (IdentifierUse) firstCode() string {
+	return string(IdentifierUseUsual)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c IdentifierUse) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *IdentifierUse) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // IdentityAssuranceLevel represents Identity Assurance Level.
 type IdentityAssuranceLevel string
 
@@ -2127,6 +5357,33 @@ const (
 	IdentityAssuranceLevelLevel4 IdentityAssuranceLevel = "level4"
 )
 
+// known reports whether c is one of the IdentityAssuranceLevel values.
+func (c IdentityAssuranceLevel) known() bool {
+	switch c {
+	case IdentityAssuranceLevelLevel1,
+		IdentityAssuranceLevelLevel2,
+		IdentityAssuranceLevelLevel3,
+		IdentityAssuranceLevelLevel4:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first IdentityAssuranceLevel value in definition order.
+func (IdentityAssuranceLevel) firstCode() string {
+	return string(IdentityAssuranceLevelLevel1)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c IdentityAssuranceLevel) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *IdentityAssuranceLevel) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ImagingSelection2DGraphicType represents Imaging Selection2 D Graphic Type.
 type ImagingSelection2DGraphicType string
 
@@ -2144,6 +5401,34 @@ const (
 	ImagingSelection2DGraphicTypeEllipse ImagingSelection2DGraphicType = "ellipse"
 )
 
+// known reports whether c is one of the ImagingSelection2DGraphicType values.
+func (c ImagingSelection2DGraphicType) known() bool {
+	switch c {
+	case ImagingSelection2DGraphicTypePoint,
+		ImagingSelection2DGraphicTypePolyline,
+		ImagingSelection2DGraphicTypeInterpolated,
+		ImagingSelection2DGraphicTypeCircle,
+		ImagingSelection2DGraphicTypeEllipse:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ImagingSelection2DGraphicType value in definition order.
+func (ImagingSelection2DGraphicType) firstCode() string {
+	return string(ImagingSelection2DGraphicTypePoint)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ImagingSelection2DGraphicType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ImagingSelection2DGraphicType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ImagingSelection3DGraphicType represents Imaging Selection3 D Graphic Type.
 type ImagingSelection3DGraphicType string
 
@@ -2163,6 +5448,35 @@ const (
 	ImagingSelection3DGraphicTypeEllipsoid ImagingSelection3DGraphicType = "ellipsoid"
 )
 
+// known reports whether c is one of the ImagingSelection3DGraphicType values.
+func (c ImagingSelection3DGraphicType) known() bool {
+	switch c {
+	case ImagingSelection3DGraphicTypePoint,
+		ImagingSelection3DGraphicTypeMultipoint,
+		ImagingSelection3DGraphicTypePolyline,
+		ImagingSelection3DGraphicTypePolygon,
+		ImagingSelection3DGraphicTypeEllipse,
+		ImagingSelection3DGraphicTypeEllipsoid:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ImagingSelection3DGraphicType value in definition order.
+func (ImagingSelection3DGraphicType) firstCode() string {
+	return string(ImagingSelection3DGraphicTypePoint)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ImagingSelection3DGraphicType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ImagingSelection3DGraphicType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ImagingSelectionStatus represents Imaging Selection Status.
 type ImagingSelectionStatus string
 
@@ -2176,6 +5490,32 @@ const (
 	ImagingSelectionStatusUnknown ImagingSelectionStatus = "unknown"
 )
 
+// known reports whether c is one of the ImagingSelectionStatus values.
+func (c ImagingSelectionStatus) known() bool {
+	switch c {
+	case ImagingSelectionStatusAvailable,
+		ImagingSelectionStatusEnteredInError,
+		ImagingSelectionStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ImagingSelectionStatus value in definition order.
+func (ImagingSelectionStatus) firstCode() string {
+	return string(ImagingSelectionStatusAvailable)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ImagingSelectionStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ImagingSelectionStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ImagingStudyStatus represents Imaging Study Status.
 type ImagingStudyStatus string
 
@@ -2193,6 +5533,34 @@ const (
 	ImagingStudyStatusUnknown ImagingStudyStatus = "unknown"
 )
 
+// known reports whether c is one of the ImagingStudyStatus values.
+func (c ImagingStudyStatus) known() bool {
+	switch c {
+	case ImagingStudyStatusRegistered,
+		ImagingStudyStatusAvailable,
+		ImagingStudyStatusCancelled,
+		ImagingStudyStatusEnteredInError,
+		ImagingStudyStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ImagingStudyStatus value in definition order.
+func (ImagingStudyStatus) firstCode() string {
+	return string(ImagingStudyStatusRegistered)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ImagingStudyStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ImagingStudyStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ImmunizationEvaluationStatusCodes represents Immunization Evaluation Status Codes.
 type ImmunizationEvaluationStatusCodes string
 
@@ -2202,6 +5570,31 @@ const (
 	ImmunizationEvaluationStatusCodesEnteredInError ImmunizationEvaluationStatusCodes = "entered-in-error"
 )
 
+// known reports whether c is one of the ImmunizationEvaluationStatusCodes values.
+func (c ImmunizationEvaluationStatusCodes) known() bool {
+	switch c {
+	case ImmunizationEvaluationStatusCodesCompleted,
+		ImmunizationEvaluationStatusCodesEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ImmunizationEvaluationStatusCodes value in definition order.
+func (ImmunizationEvaluationStatusCodes) firstCode() string {
+	return string(ImmunizationEvaluationStatusCodesCompleted)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ImmunizationEvaluationStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ImmunizationEvaluationStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ImmunizationStatusCodes represents Immunization Status Codes.
 type ImmunizationStatusCodes string
 
@@ -2212,6 +5605,32 @@ const (
 	ImmunizationStatusCodesNotDone        ImmunizationStatusCodes = "not-done"
 )
 
+// known reports whether c is one of the ImmunizationStatusCodes values.
+func (c ImmunizationStatusCodes) known() bool {
+	switch c {
+	case ImmunizationStatusCodesCompleted,
+		ImmunizationStatusCodesEnteredInError,
+		ImmunizationStatusCodesNotDone:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ImmunizationStatusCodes value in definition order.
+func (ImmunizationStatusCodes) firstCode() string {
+	return string(ImmunizationStatusCodesCompleted)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ImmunizationStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ImmunizationStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // IngredientManufacturerRole represents Ingredient Manufacturer Role.
 type IngredientManufacturerRole string
 
@@ -2225,6 +5644,32 @@ const (
 	IngredientManufacturerRoleActual IngredientManufacturerRole = "actual"
 )
 
+// known reports whether c is one of the IngredientManufacturerRole values.
+func (c IngredientManufacturerRole) known() bool {
+	switch c {
+	case IngredientManufacturerRoleAllowed,
+		IngredientManufacturerRolePossible,
+		IngredientManufacturerRoleActual:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first IngredientManufacturerRole value in definition order.
+func (IngredientManufacturerRole) firstCode() string {
+	return string(IngredientManufacturerRoleAllowed)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c IngredientManufacturerRole) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *IngredientManufacturerRole) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // InteractionTrigger represents Interaction Trigger.
 type InteractionTrigger string
 
@@ -2235,6 +5680,32 @@ const (
 	InteractionTriggerDelete InteractionTrigger = "delete"
 )
 
+// known reports whether c is one of the InteractionTrigger values.
+func (c InteractionTrigger) known() bool {
+	switch c {
+	case InteractionTriggerCreate,
+		InteractionTriggerUpdate,
+		InteractionTriggerDelete:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first InteractionTrigger value in definition order.
+func (InteractionTrigger) firstCode() string {
+	return string(InteractionTriggerCreate)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c InteractionTrigger) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *InteractionTrigger) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // InventoryItemStatusCodes represents InventoryItem Status Codes.
 type InventoryItemStatusCodes string
 
@@ -2250,6 +5721,33 @@ const (
 	InventoryItemStatusCodesUnknown InventoryItemStatusCodes = "unknown"
 )
 
+// known reports whether c is one of the InventoryItemStatusCodes values.
+func (c InventoryItemStatusCodes) known() bool {
+	switch c {
+	case InventoryItemStatusCodesActive,
+		InventoryItemStatusCodesInactive,
+		InventoryItemStatusCodesEnteredInError,
+		InventoryItemStatusCodesUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first InventoryItemStatusCodes value in definition order.
+func (InventoryItemStatusCodes) firstCode() string {
+	return string(InventoryItemStatusCodesActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c InventoryItemStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *InventoryItemStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // InventoryCountType represents Inventory Count Type.
 type InventoryCountType string
 
@@ -2261,6 +5759,31 @@ const (
 	InventoryCountTypeDifference InventoryCountType = "difference"
 )
 
+// known reports whether c is one of the InventoryCountType values.
+func (c InventoryCountType) known() bool {
+	switch c {
+	case InventoryCountTypeSnapshot,
+		InventoryCountTypeDifference:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first InventoryCountType value in definition order.
+func (InventoryCountType) firstCode() string {
+	return string(InventoryCountTypeSnapshot)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c InventoryCountType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *InventoryCountType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // InventoryReportStatus represents Inventory Report Status.
 type InventoryReportStatus string
 
@@ -2276,6 +5799,33 @@ const (
 	InventoryReportStatusEnteredInError InventoryReportStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the InventoryReportStatus values.
+func (c InventoryReportStatus) known() bool {
+	switch c {
+	case InventoryReportStatusDraft,
+		InventoryReportStatusRequested,
+		InventoryReportStatusActive,
+		InventoryReportStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first InventoryReportStatus value in definition order.
+func (InventoryReportStatus) firstCode() string {
+	return string(InventoryReportStatusDraft)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c InventoryReportStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *InventoryReportStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // InvoiceStatus represents Invoice Status.
 type InvoiceStatus string
 
@@ -2293,6 +5843,34 @@ const (
 	InvoiceStatusEnteredInError InvoiceStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the InvoiceStatus values.
+func (c InvoiceStatus) known() bool {
+	switch c {
+	case InvoiceStatusDraft,
+		InvoiceStatusIssued,
+		InvoiceStatusBalanced,
+		InvoiceStatusCancelled,
+		InvoiceStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first InvoiceStatus value in definition order.
+func (InvoiceStatus) firstCode() string {
+	return string(InvoiceStatusDraft)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c InvoiceStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *InvoiceStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // IssueSeverity represents Issue Severity.
 type IssueSeverity string
 
@@ -2310,6 +5888,34 @@ const (
 	IssueSeveritySuccess IssueSeverity = "success"
 )
 
+// known reports whether c is one of the IssueSeverity values.
+func (c IssueSeverity) known() bool {
+	switch c {
+	case IssueSeverityFatal,
+		IssueSeverityError,
+		IssueSeverityWarning,
+		IssueSeverityInformation,
+		IssueSeveritySuccess:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first IssueSeverity value in definition order.
+func (IssueSeverity) firstCode() string {
+	return string(IssueSeverityFatal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c IssueSeverity) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *IssueSeverity) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // IssueType represents Issue Type.
 type IssueType string
 
@@ -2383,6 +5989,62 @@ const (
 	IssueTypeSuccess IssueType = "success"
 )
 
+// known reports whether c is one of the IssueType values.
+func (c IssueType) known() bool {
+	switch c {
+	case IssueTypeInvalid,
+		IssueTypeStructure,
+		IssueTypeRequired,
+		IssueTypeValue,
+		IssueTypeInvariant,
+		IssueTypeSecurity,
+		IssueTypeLogin,
+		IssueTypeUnknown,
+		IssueTypeExpired,
+		IssueTypeForbidden,
+		IssueTypeSuppressed,
+		IssueTypeProcessing,
+		IssueTypeNotSupported,
+		IssueTypeDuplicate,
+		IssueTypeMultipleMatches,
+		IssueTypeNotFound,
+		IssueTypeDeleted,
+		IssueTypeTooLong,
+		IssueTypeCodeInvalid,
+		IssueTypeExtension,
+		IssueTypeTooCostly,
+		IssueTypeBusinessRule,
+		IssueTypeConflict,
+		IssueTypeLimitedFilter,
+		IssueTypeTransient,
+		IssueTypeLockError,
+		IssueTypeNoStore,
+		IssueTypeException,
+		IssueTypeTimeout,
+		IssueTypeIncomplete,
+		IssueTypeThrottled,
+		IssueTypeInformational,
+		IssueTypeSuccess:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first IssueType value in definition order.
+func (IssueType) firstCode() string {
+	return string(IssueTypeInvalid)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c IssueType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *IssueType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // QuestionnaireItemType represents Questionnaire Item Type.
 type QuestionnaireItemType string
 
@@ -2422,6 +6084,45 @@ const (
 	QuestionnaireItemTypeQuantity QuestionnaireItemType = "quantity"
 )
 
+// known reports whether c is one of the QuestionnaireItemType values.
+func (c QuestionnaireItemType) known() bool {
+	switch c {
+	case QuestionnaireItemTypeGroup,
+		QuestionnaireItemTypeDisplay,
+		QuestionnaireItemTypeQuestion,
+		QuestionnaireItemTypeBoolean,
+		QuestionnaireItemTypeDecimal,
+		QuestionnaireItemTypeInteger,
+		QuestionnaireItemTypeDate,
+		QuestionnaireItemTypeDatetime,
+		QuestionnaireItemTypeTime,
+		QuestionnaireItemTypeString,
+		QuestionnaireItemTypeText,
+		QuestionnaireItemTypeUrl,
+		QuestionnaireItemTypeCoding,
+		QuestionnaireItemTypeAttachment,
+		QuestionnaireItemTypeReference,
+		QuestionnaireItemTypeQuantity:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first QuestionnaireItemType value in definition order.
+func (QuestionnaireItemType) firstCode() string {
+	return string(QuestionnaireItemTypeGroup)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c QuestionnaireItemType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *QuestionnaireItemType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CommonLanguages represents Common Languages.
 type CommonLanguages string
 
@@ -2593,6 +6294,111 @@ const (
 	CommonLanguagesZhTw CommonLanguages = "zh-TW"
 )
 
+// known reports whether c is one of the CommonLanguages values.
+func (c CommonLanguages) known() bool {
+	switch c {
+	case CommonLanguagesAr,
+		CommonLanguagesBg,
+		CommonLanguagesBgBg,
+		CommonLanguagesBn,
+		CommonLanguagesCs,
+		CommonLanguagesCsCz,
+		CommonLanguagesBs,
+		CommonLanguagesBsBa,
+		CommonLanguagesDa,
+		CommonLanguagesDaDk,
+		CommonLanguagesDe,
+		CommonLanguagesDeAt,
+		CommonLanguagesDeCh,
+		CommonLanguagesDeDe,
+		CommonLanguagesEl,
+		CommonLanguagesElGr,
+		CommonLanguagesEn,
+		CommonLanguagesEnAu,
+		CommonLanguagesEnCa,
+		CommonLanguagesEnGb,
+		CommonLanguagesEnIn,
+		CommonLanguagesEnNz,
+		CommonLanguagesEnSg,
+		CommonLanguagesEnUs,
+		CommonLanguagesEs,
+		CommonLanguagesEsAr,
+		CommonLanguagesEsEs,
+		CommonLanguagesEsUy,
+		CommonLanguagesEt,
+		CommonLanguagesEtEe,
+		CommonLanguagesFi,
+		CommonLanguagesFr,
+		CommonLanguagesFrBe,
+		CommonLanguagesFrCh,
+		CommonLanguagesFrFr,
+		CommonLanguagesFiFi,
+		CommonLanguagesFrCa,
+		CommonLanguagesFy,
+		CommonLanguagesFyNl,
+		CommonLanguagesHi,
+		CommonLanguagesHr,
+		CommonLanguagesHrHr,
+		CommonLanguagesIs,
+		CommonLanguagesIsIs,
+		CommonLanguagesIt,
+		CommonLanguagesItCh,
+		CommonLanguagesItIt,
+		CommonLanguagesJa,
+		CommonLanguagesKo,
+		CommonLanguagesLt,
+		CommonLanguagesLtLt,
+		CommonLanguagesLv,
+		CommonLanguagesLvLv,
+		CommonLanguagesNl,
+		CommonLanguagesNlBe,
+		CommonLanguagesNlNl,
+		CommonLanguagesNo,
+		CommonLanguagesNoNo,
+		CommonLanguagesPa,
+		CommonLanguagesPl,
+		CommonLanguagesPlPl,
+		CommonLanguagesPt,
+		CommonLanguagesPtPt,
+		CommonLanguagesPtBr,
+		CommonLanguagesRo,
+		CommonLanguagesRoRo,
+		CommonLanguagesRu,
+		CommonLanguagesRuRu,
+		CommonLanguagesSk,
+		CommonLanguagesSkSk,
+		CommonLanguagesSl,
+		CommonLanguagesSlSi,
+		CommonLanguagesSr,
+		CommonLanguagesSrRs,
+		CommonLanguagesSv,
+		CommonLanguagesSvSe,
+		CommonLanguagesTe,
+		CommonLanguagesZh,
+		CommonLanguagesZhCn,
+		CommonLanguagesZhHk,
+		CommonLanguagesZhSg,
+		CommonLanguagesZhTw:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CommonLanguages value in definition order.
+func (CommonLanguages) firstCode() string {
+	return string(CommonLanguagesAr)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CommonLanguages) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CommonLanguages) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // LinkType represents Link Type.
 type LinkType string
 
@@ -2608,6 +6414,33 @@ const (
 	LinkTypeSeealso LinkType = "seealso"
 )
 
+// known reports whether c is one of the LinkType values.
+func (c LinkType) known() bool {
+	switch c {
+	case LinkTypeReplacedBy,
+		LinkTypeReplaces,
+		LinkTypeRefer,
+		LinkTypeSeealso:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first LinkType value in definition order.
+func (LinkType) firstCode() string {
+	return string(LinkTypeReplacedBy)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c LinkType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *LinkType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // LinkageType represents Linkage Type.
 type LinkageType string
 
@@ -2621,6 +6454,32 @@ const (
 	LinkageTypeHistorical LinkageType = "historical"
 )
 
+// known reports whether c is one of the LinkageType values.
+func (c LinkageType) known() bool {
+	switch c {
+	case LinkageTypeSource,
+		LinkageTypeAlternate,
+		LinkageTypeHistorical:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first LinkageType value in definition order.
+func (LinkageType) firstCode() string {
+	return string(LinkageTypeSource)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c LinkageType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *LinkageType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ListMode represents List Mode.
 type ListMode string
 
@@ -2634,6 +6493,32 @@ const (
 	ListModeChanges ListMode = "changes"
 )
 
+// known reports whether c is one of the ListMode values.
+func (c ListMode) known() bool {
+	switch c {
+	case ListModeWorking,
+		ListModeSnapshot,
+		ListModeChanges:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ListMode value in definition order.
+func (ListMode) firstCode() string {
+	return string(ListModeWorking)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ListMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ListMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ListStatus represents List Status.
 type ListStatus string
 
@@ -2647,6 +6532,32 @@ const (
 	ListStatusEnteredInError ListStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the ListStatus values.
+func (c ListStatus) known() bool {
+	switch c {
+	case ListStatusCurrent,
+		ListStatusRetired,
+		ListStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ListStatus value in definition order.
+func (ListStatus) firstCode() string {
+	return string(ListStatusCurrent)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ListStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ListStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // LocationMode represents Location Mode.
 type LocationMode string
 
@@ -2658,6 +6569,31 @@ const (
 	LocationModeKind LocationMode = "kind"
 )
 
+// known reports whether c is one of the LocationMode values.
+func (c LocationMode) known() bool {
+	switch c {
+	case LocationModeInstance,
+		LocationModeKind:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first LocationMode value in definition order.
+func (LocationMode) firstCode() string {
+	return string(LocationModeInstance)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c LocationMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *LocationMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // LocationStatus represents Location Status.
 type LocationStatus string
 
@@ -2671,6 +6607,32 @@ const (
 	LocationStatusInactive LocationStatus = "inactive"
 )
 
+// known reports whether c is one of the LocationStatus values.
+func (c LocationStatus) known() bool {
+	switch c {
+	case LocationStatusActive,
+		LocationStatusSuspended,
+		LocationStatusInactive:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first LocationStatus value in definition order.
+func (LocationStatus) firstCode() string {
+	return string(LocationStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c LocationStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *LocationStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapGroupTypeMode represents Structure Map Group Type Mode.
 type StructureMapGroupTypeMode string
 
@@ -2682,6 +6644,31 @@ const (
 	StructureMapGroupTypeModeTypeAndTypes StructureMapGroupTypeMode = "type-and-types"
 )
 
+// known reports whether c is one of the StructureMapGroupTypeMode values.
+func (c StructureMapGroupTypeMode) known() bool {
+	switch c {
+	case StructureMapGroupTypeModeTypes,
+		StructureMapGroupTypeModeTypeAndTypes:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapGroupTypeMode value in definition order.
+func (StructureMapGroupTypeMode) firstCode() string {
+	return string(StructureMapGroupTypeModeTypes)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapGroupTypeMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapGroupTypeMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapInputMode represents Structure Map Input Mode.
 type StructureMapInputMode string
 
@@ -2693,6 +6680,31 @@ const (
 	StructureMapInputModeTarget StructureMapInputMode = "target"
 )
 
+// known reports whether c is one of the StructureMapInputMode values.
+func (c StructureMapInputMode) known() bool {
+	switch c {
+	case StructureMapInputModeSource,
+		StructureMapInputModeTarget:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapInputMode value in definition order.
+func (StructureMapInputMode) firstCode() string {
+	return string(StructureMapInputModeSource)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapInputMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapInputMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapModelMode represents Structure Map Model Mode.
 type StructureMapModelMode string
 
@@ -2708,6 +6720,33 @@ const (
 	StructureMapModelModeProduced StructureMapModelMode = "produced"
 )
 
+// known reports whether c is one of the StructureMapModelMode values.
+func (c StructureMapModelMode) known() bool {
+	switch c {
+	case StructureMapModelModeSource,
+		StructureMapModelModeQueried,
+		StructureMapModelModeTarget,
+		StructureMapModelModeProduced:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapModelMode value in definition order.
+func (StructureMapModelMode) firstCode() string {
+	return string(StructureMapModelModeSource)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapModelMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapModelMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapSourceListMode represents Structure Map Source List Mode.
 type StructureMapSourceListMode string
 
@@ -2725,6 +6764,34 @@ const (
 	StructureMapSourceListModeOnlyOne StructureMapSourceListMode = "only_one"
 )
 
+// known reports whether c is one of the StructureMapSourceListMode values.
+func (c StructureMapSourceListMode) known() bool {
+	switch c {
+	case StructureMapSourceListModeFirst,
+		StructureMapSourceListModeNotFirst,
+		StructureMapSourceListModeLast,
+		StructureMapSourceListModeNotLast,
+		StructureMapSourceListModeOnlyOne:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapSourceListMode value in definition order.
+func (StructureMapSourceListMode) firstCode() string {
+	return string(StructureMapSourceListModeFirst)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapSourceListMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapSourceListMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapTargetListMode represents Structure Map Target List Mode.
 type StructureMapTargetListMode string
 
@@ -2740,6 +6807,33 @@ const (
 	StructureMapTargetListModeSingle StructureMapTargetListMode = "single"
 )
 
+// known reports whether c is one of the StructureMapTargetListMode values.
+func (c StructureMapTargetListMode) known() bool {
+	switch c {
+	case StructureMapTargetListModeFirst,
+		StructureMapTargetListModeShare,
+		StructureMapTargetListModeLast,
+		StructureMapTargetListModeSingle:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapTargetListMode value in definition order.
+func (StructureMapTargetListMode) firstCode() string {
+	return string(StructureMapTargetListModeFirst)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapTargetListMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapTargetListMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureMapTransform represents Structure Map Transform.
 type StructureMapTransform string
 
@@ -2781,6 +6875,46 @@ const (
 	StructureMapTransformCp StructureMapTransform = "cp"
 )
 
+// known reports whether c is one of the StructureMapTransform values.
+func (c StructureMapTransform) known() bool {
+	switch c {
+	case StructureMapTransformCreate,
+		StructureMapTransformCopy,
+		StructureMapTransformTruncate,
+		StructureMapTransformEscape,
+		StructureMapTransformCast,
+		StructureMapTransformAppend,
+		StructureMapTransformTranslate,
+		StructureMapTransformReference,
+		StructureMapTransformDateop,
+		StructureMapTransformUuid,
+		StructureMapTransformPointer,
+		StructureMapTransformEvaluate,
+		StructureMapTransformCc,
+		StructureMapTransformC,
+		StructureMapTransformQty,
+		StructureMapTransformId,
+		StructureMapTransformCp:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureMapTransform value in definition order.
+func (StructureMapTransform) firstCode() string {
+	return string(StructureMapTransformCreate)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureMapTransform) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureMapTransform) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MeasureReportStatus represents Measure Report Status.
 type MeasureReportStatus string
 
@@ -2794,6 +6928,32 @@ const (
 	MeasureReportStatusError MeasureReportStatus = "error"
 )
 
+// known reports whether c is one of the MeasureReportStatus values.
+func (c MeasureReportStatus) known() bool {
+	switch c {
+	case MeasureReportStatusComplete,
+		MeasureReportStatusPending,
+		MeasureReportStatusError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MeasureReportStatus value in definition order.
+func (MeasureReportStatus) firstCode() string {
+	return string(MeasureReportStatusComplete)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MeasureReportStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MeasureReportStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MeasureReportType represents Measure Report Type.
 type MeasureReportType string
 
@@ -2809,6 +6969,33 @@ const (
 	MeasureReportTypeDataExchange MeasureReportType = "data-exchange"
 )
 
+// known reports whether c is one of the MeasureReportType values.
+func (c MeasureReportType) known() bool {
+	switch c {
+	case MeasureReportTypeIndividual,
+		MeasureReportTypeSubjectList,
+		MeasureReportTypeSummary,
+		MeasureReportTypeDataExchange:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MeasureReportType value in definition order.
+func (MeasureReportType) firstCode() string {
+	return string(MeasureReportTypeIndividual)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MeasureReportType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MeasureReportType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationAdministrationStatusCodes represents MedicationAdministration Status Codes.
 type MedicationAdministrationStatusCodes string
 
@@ -2830,6 +7017,36 @@ const (
 	MedicationAdministrationStatusCodesUnknown MedicationAdministrationStatusCodes = "unknown"
 )
 
+// known reports whether c is one of the MedicationAdministrationStatusCodes values.
+func (c MedicationAdministrationStatusCodes) known() bool {
+	switch c {
+	case MedicationAdministrationStatusCodesInProgress,
+		MedicationAdministrationStatusCodesNotDone,
+		MedicationAdministrationStatusCodesOnHold,
+		MedicationAdministrationStatusCodesCompleted,
+		MedicationAdministrationStatusCodesEnteredInError,
+		MedicationAdministrationStatusCodesStopped,
+		MedicationAdministrationStatusCodesUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationAdministrationStatusCodes value in definition order.
+func (MedicationAdministrationStatusCodes) firstCode() string {
+	return string(MedicationAdministrationStatusCodesInProgress)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationAdministrationStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationAdministrationStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationStatementStatusCodes represents MedicationStatement Status Codes.
 type MedicationStatementStatusCodes string
 
@@ -2843,6 +7060,32 @@ const (
 	MedicationStatementStatusCodesDraft MedicationStatementStatusCodes = "draft"
 )
 
+// known reports whether c is one of the MedicationStatementStatusCodes values.
+func (c MedicationStatementStatusCodes) known() bool {
+	switch c {
+	case MedicationStatementStatusCodesRecorded,
+		MedicationStatementStatusCodesEnteredInError,
+		MedicationStatementStatusCodesDraft:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationStatementStatusCodes value in definition order.
+func (MedicationStatementStatusCodes) firstCode() string {
+	return string(MedicationStatementStatusCodesRecorded)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationStatementStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationStatementStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationStatusCodes represents Medication Status Codes.
 type MedicationStatusCodes string
 
@@ -2856,6 +7099,32 @@ const (
 	MedicationStatusCodesEnteredInError MedicationStatusCodes = "entered-in-error"
 )
 
+// known reports whether c is one of the MedicationStatusCodes values.
+func (c MedicationStatusCodes) known() bool {
+	switch c {
+	case MedicationStatusCodesActive,
+		MedicationStatusCodesInactive,
+		MedicationStatusCodesEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationStatusCodes value in definition order.
+func (MedicationStatusCodes) firstCode() string {
+	return string(MedicationStatusCodesActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationDispenseStatusCodes represents MedicationDispense Status Codes.
 type MedicationDispenseStatusCodes string
 
@@ -2881,6 +7150,38 @@ const (
 	MedicationDispenseStatusCodesUnknown MedicationDispenseStatusCodes = "unknown"
 )
 
+// known reports whether c is one of the MedicationDispenseStatusCodes values.
+func (c MedicationDispenseStatusCodes) known() bool {
+	switch c {
+	case MedicationDispenseStatusCodesPreparation,
+		MedicationDispenseStatusCodesInProgress,
+		MedicationDispenseStatusCodesCancelled,
+		MedicationDispenseStatusCodesOnHold,
+		MedicationDispenseStatusCodesCompleted,
+		MedicationDispenseStatusCodesEnteredInError,
+		MedicationDispenseStatusCodesStopped,
+		MedicationDispenseStatusCodesDeclined,
+		MedicationDispenseStatusCodesUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationDispenseStatusCodes value in definition order.
+func (MedicationDispenseStatusCodes) firstCode() string {
+	return string(MedicationDispenseStatusCodesPreparation)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationDispenseStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationDispenseStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationKnowledgeStatusCodes represents MedicationKnowledge Status Codes.
 type MedicationKnowledgeStatusCodes string
 
@@ -2894,6 +7195,32 @@ const (
 	MedicationKnowledgeStatusCodesInactive MedicationKnowledgeStatusCodes = "inactive"
 )
 
+// known reports whether c is one of the MedicationKnowledgeStatusCodes values.
+func (c MedicationKnowledgeStatusCodes) known() bool {
+	switch c {
+	case MedicationKnowledgeStatusCodesActive,
+		MedicationKnowledgeStatusCodesEnteredInError,
+		MedicationKnowledgeStatusCodesInactive:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationKnowledgeStatusCodes value in definition order.
+func (MedicationKnowledgeStatusCodes) firstCode() string {
+	return string(MedicationKnowledgeStatusCodesActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationKnowledgeStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationKnowledgeStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationRequestIntent represents medicationRequest Intent.
 type MedicationRequestIntent string
 
@@ -2917,6 +7244,37 @@ const (
 	MedicationRequestIntentOption MedicationRequestIntent = "option"
 )
 
+// known reports whether c is one of the MedicationRequestIntent values.
+func (c MedicationRequestIntent) known() bool {
+	switch c {
+	case MedicationRequestIntentProposal,
+		MedicationRequestIntentPlan,
+		MedicationRequestIntentOrder,
+		MedicationRequestIntentOriginalOrder,
+		MedicationRequestIntentReflexOrder,
+		MedicationRequestIntentFillerOrder,
+		MedicationRequestIntentInstanceOrder,
+		MedicationRequestIntentOption:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationRequestIntent value in definition order.
+func (MedicationRequestIntent) firstCode() string {
+	return string(MedicationRequestIntentProposal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationRequestIntent) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationRequestIntent) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MedicationrequestStatus represents medicationrequest Status.
 type MedicationrequestStatus string
 
@@ -2942,6 +7300,38 @@ const (
 	MedicationrequestStatusUnknown MedicationrequestStatus = "unknown"
 )
 
+// known reports whether c is one of the MedicationrequestStatus values.
+func (c MedicationrequestStatus) known() bool {
+	switch c {
+	case MedicationrequestStatusActive,
+		MedicationrequestStatusOnHold,
+		MedicationrequestStatusEnded,
+		MedicationrequestStatusStopped,
+		MedicationrequestStatusCompleted,
+		MedicationrequestStatusCancelled,
+		MedicationrequestStatusEnteredInError,
+		MedicationrequestStatusDraft,
+		MedicationrequestStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MedicationrequestStatus value in definition order.
+func (MedicationrequestStatus) firstCode() string {
+	return string(MedicationrequestStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MedicationrequestStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MedicationrequestStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MessageSignificanceCategory represents Message Significance Category.
 type MessageSignificanceCategory string
 
@@ -2955,6 +7345,32 @@ const (
 	MessageSignificanceCategoryNotification MessageSignificanceCategory = "notification"
 )
 
+// known reports whether c is one of the MessageSignificanceCategory values.
+func (c MessageSignificanceCategory) known() bool {
+	switch c {
+	case MessageSignificanceCategoryConsequence,
+		MessageSignificanceCategoryCurrency,
+		MessageSignificanceCategoryNotification:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MessageSignificanceCategory value in definition order.
+func (MessageSignificanceCategory) firstCode() string {
+	return string(MessageSignificanceCategoryConsequence)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MessageSignificanceCategory) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MessageSignificanceCategory) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // MessageheaderResponseRequest represents messageheader-response-request.
 type MessageheaderResponseRequest string
 
@@ -2970,6 +7386,33 @@ const (
 	MessageheaderResponseRequestOnSuccess MessageheaderResponseRequest = "on-success"
 )
 
+// known reports whether c is one of the MessageheaderResponseRequest values.
+func (c MessageheaderResponseRequest) known() bool {
+	switch c {
+	case MessageheaderResponseRequestAlways,
+		MessageheaderResponseRequestOnError,
+		MessageheaderResponseRequestNever,
+		MessageheaderResponseRequestOnSuccess:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first MessageheaderResponseRequest value in definition order.
+func (MessageheaderResponseRequest) firstCode() string {
+	return string(MessageheaderResponseRequestAlways)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c MessageheaderResponseRequest) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *MessageheaderResponseRequest) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceMetricCalibrationState represents Device Metric Calibration State.
 type DeviceMetricCalibrationState string
 
@@ -2985,6 +7428,33 @@ const (
 	DeviceMetricCalibrationStateUnspecified DeviceMetricCalibrationState = "unspecified"
 )
 
+// known reports whether c is one of the DeviceMetricCalibrationState values.
+func (c DeviceMetricCalibrationState) known() bool {
+	switch c {
+	case DeviceMetricCalibrationStateNotCalibrated,
+		DeviceMetricCalibrationStateCalibrationRequired,
+		DeviceMetricCalibrationStateCalibrated,
+		DeviceMetricCalibrationStateUnspecified:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceMetricCalibrationState value in definition order.
+func (DeviceMetricCalibrationState) firstCode() string {
+	return string(DeviceMetricCalibrationStateNotCalibrated)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceMetricCalibrationState) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceMetricCalibrationState) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceMetricCalibrationType represents Device Metric Calibration Type.
 type DeviceMetricCalibrationType string
 
@@ -3000,6 +7470,33 @@ const (
 	DeviceMetricCalibrationTypeTwoPoint DeviceMetricCalibrationType = "two-point"
 )
 
+// known reports whether c is one of the DeviceMetricCalibrationType values.
+func (c DeviceMetricCalibrationType) known() bool {
+	switch c {
+	case DeviceMetricCalibrationTypeUnspecified,
+		DeviceMetricCalibrationTypeOffset,
+		DeviceMetricCalibrationTypeGain,
+		DeviceMetricCalibrationTypeTwoPoint:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceMetricCalibrationType value in definition order.
+func (DeviceMetricCalibrationType) firstCode() string {
+	return string(DeviceMetricCalibrationTypeUnspecified)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceMetricCalibrationType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceMetricCalibrationType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceMetricCategory represents Device Metric Category.
 type DeviceMetricCategory string
 
@@ -3015,6 +7512,33 @@ const (
 	DeviceMetricCategoryUnspecified DeviceMetricCategory = "unspecified"
 )
 
+// known reports whether c is one of the DeviceMetricCategory values.
+func (c DeviceMetricCategory) known() bool {
+	switch c {
+	case DeviceMetricCategoryMeasurement,
+		DeviceMetricCategorySetting,
+		DeviceMetricCategoryCalculation,
+		DeviceMetricCategoryUnspecified:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceMetricCategory value in definition order.
+func (DeviceMetricCategory) firstCode() string {
+	return string(DeviceMetricCategoryMeasurement)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceMetricCategory) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceMetricCategory) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // DeviceMetricOperationalStatus represents Device Metric Operational Status.
 type DeviceMetricOperationalStatus string
 
@@ -3030,6 +7554,33 @@ const (
 	DeviceMetricOperationalStatusEnteredInError DeviceMetricOperationalStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the DeviceMetricOperationalStatus values.
+func (c DeviceMetricOperationalStatus) known() bool {
+	switch c {
+	case DeviceMetricOperationalStatusOn,
+		DeviceMetricOperationalStatusOff,
+		DeviceMetricOperationalStatusStandby,
+		DeviceMetricOperationalStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first DeviceMetricOperationalStatus value in definition order.
+func (DeviceMetricOperationalStatus) firstCode() string {
+	return string(DeviceMetricOperationalStatusOn)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c DeviceMetricOperationalStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *DeviceMetricOperationalStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // NameUse represents NameUse.
 type NameUse string
 
@@ -3051,6 +7602,36 @@ const (
 	NameUseMaiden NameUse = "maiden"
 )
 
+// known reports whether c is one of the NameUse values.
+func (c NameUse) known() bool {
+	switch c {
+	case NameUseUsual,
+		NameUseOfficial,
+		NameUseTemp,
+		NameUseNickname,
+		NameUseAnonymous,
+		NameUseOld,
+		NameUseMaiden:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first NameUse value in definition order.
+func (NameUse) firstCode() string {
+	return string(NameUseUsual)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c NameUse) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *NameUse) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // NamingSystemIdentifierType represents Naming System Identifier Type.
 type NamingSystemIdentifierType string
 
@@ -3070,6 +7651,35 @@ const (
 	NamingSystemIdentifierTypeOther NamingSystemIdentifierType = "other"
 )
 
+// known reports whether c is one of the NamingSystemIdentifierType values.
+func (c NamingSystemIdentifierType) known() bool {
+	switch c {
+	case NamingSystemIdentifierTypeOid,
+		NamingSystemIdentifierTypeUuid,
+		NamingSystemIdentifierTypeUri,
+		NamingSystemIdentifierTypeIriStem,
+		NamingSystemIdentifierTypeV2csmnemonic,
+		NamingSystemIdentifierTypeOther:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first NamingSystemIdentifierType value in definition order.
+func (NamingSystemIdentifierType) firstCode() string {
+	return string(NamingSystemIdentifierTypeOid)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c NamingSystemIdentifierType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *NamingSystemIdentifierType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // NamingSystemType represents Naming System Type.
 type NamingSystemType string
 
@@ -3083,6 +7693,32 @@ const (
 	NamingSystemTypeRoot NamingSystemType = "root"
 )
 
+// known reports whether c is one of the NamingSystemType values.
+func (c NamingSystemType) known() bool {
+	switch c {
+	case NamingSystemTypeCodesystem,
+		NamingSystemTypeIdentifier,
+		NamingSystemTypeRoot:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first NamingSystemType value in definition order.
+func (NamingSystemType) firstCode() string {
+	return string(NamingSystemTypeCodesystem)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c NamingSystemType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *NamingSystemType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // NarrativeStatus represents NarrativeStatus.
 type NarrativeStatus string
 
@@ -3098,6 +7734,33 @@ const (
 	NarrativeStatusEmpty NarrativeStatus = "empty"
 )
 
+// known reports whether c is one of the NarrativeStatus values.
+func (c NarrativeStatus) known() bool {
+	switch c {
+	case NarrativeStatusGenerated,
+		NarrativeStatusExtensions,
+		NarrativeStatusAdditional,
+		NarrativeStatusEmpty:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first NarrativeStatus value in definition order.
+func (NarrativeStatus) firstCode() string {
+	return string(NarrativeStatusGenerated)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c NarrativeStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *NarrativeStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // NoteType represents NoteType.
 type NoteType string
 
@@ -3111,6 +7774,32 @@ const (
 	NoteTypePrintoper NoteType = "printoper"
 )
 
+// known reports whether c is one of the NoteType values.
+func (c NoteType) known() bool {
+	switch c {
+	case NoteTypeDisplay,
+		NoteTypePrint,
+		NoteTypePrintoper:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first NoteType value in definition order.
+func (NoteType) firstCode() string {
+	return string(NoteTypeDisplay)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c NoteType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *NoteType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // NutritionProductStatus represents Nutrition Product Status.
 type NutritionProductStatus string
 
@@ -3124,6 +7813,32 @@ const (
 	NutritionProductStatusEnteredInError NutritionProductStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the NutritionProductStatus values.
+func (c NutritionProductStatus) known() bool {
+	switch c {
+	case NutritionProductStatusActive,
+		NutritionProductStatusInactive,
+		NutritionProductStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first NutritionProductStatus value in definition order.
+func (NutritionProductStatus) firstCode() string {
+	return string(NutritionProductStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c NutritionProductStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *NutritionProductStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ObservationRangeCategory represents Observation Range Category.
 type ObservationRangeCategory string
 
@@ -3137,6 +7852,32 @@ const (
 	ObservationRangeCategoryAbsolute ObservationRangeCategory = "absolute"
 )
 
+// known reports whether c is one of the ObservationRangeCategory values.
+func (c ObservationRangeCategory) known() bool {
+	switch c {
+	case ObservationRangeCategoryReference,
+		ObservationRangeCategoryCritical,
+		ObservationRangeCategoryAbsolute:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ObservationRangeCategory value in definition order.
+func (ObservationRangeCategory) firstCode() string {
+	return string(ObservationRangeCategoryReference)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ObservationRangeCategory) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ObservationRangeCategory) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ObservationStatus represents Observation Status.
 type ObservationStatus string
 
@@ -3160,6 +7901,37 @@ const (
 	ObservationStatusUnknown ObservationStatus = "unknown"
 )
 
+// known reports whether c is one of the ObservationStatus values.
+func (c ObservationStatus) known() bool {
+	switch c {
+	case ObservationStatusRegistered,
+		ObservationStatusPreliminary,
+		ObservationStatusFinal,
+		ObservationStatusAmended,
+		ObservationStatusCorrected,
+		ObservationStatusCancelled,
+		ObservationStatusEnteredInError,
+		ObservationStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ObservationStatus value in definition order.
+func (ObservationStatus) firstCode() string {
+	return string(ObservationStatusRegistered)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ObservationStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ObservationStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TriggeredBytype represents triggered Bytype.
 type TriggeredBytype string
 
@@ -3173,6 +7945,32 @@ const (
 	TriggeredBytypeReRun TriggeredBytype = "re-run"
 )
 
+// known reports whether c is one of the TriggeredBytype values.
+func (c TriggeredBytype) known() bool {
+	switch c {
+	case TriggeredBytypeReflex,
+		TriggeredBytypeRepeat,
+		TriggeredBytypeReRun:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TriggeredBytype value in definition order.
+func (TriggeredBytype) firstCode() string {
+	return string(TriggeredBytypeReflex)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TriggeredBytype) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TriggeredBytype) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // OperationKind represents Operation Kind.
 type OperationKind string
 
@@ -3184,6 +7982,31 @@ const (
 	OperationKindQuery OperationKind = "query"
 )
 
+// known reports whether c is one of the OperationKind values.
+func (c OperationKind) known() bool {
+	switch c {
+	case OperationKindOperation,
+		OperationKindQuery:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first OperationKind value in definition order.
+func (OperationKind) firstCode() string {
+	return string(OperationKindOperation)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c OperationKind) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *OperationKind) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // OperationParameterScope represents Operation Parameter Scope.
 type OperationParameterScope string
 
@@ -3197,6 +8020,32 @@ const (
 	OperationParameterScopeSystem OperationParameterScope = "system"
 )
 
+// known reports whether c is one of the OperationParameterScope values.
+func (c OperationParameterScope) known() bool {
+	switch c {
+	case OperationParameterScopeInstance,
+		OperationParameterScopeType,
+		OperationParameterScopeSystem:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first OperationParameterScope value in definition order.
+func (OperationParameterScope) firstCode() string {
+	return string(OperationParameterScopeInstance)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c OperationParameterScope) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *OperationParameterScope) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // OperationParameterUse represents Operation Parameter Use.
 type OperationParameterUse string
 
@@ -3208,6 +8057,31 @@ const (
 	OperationParameterUseOut OperationParameterUse = "out"
 )
 
+// known reports whether c is one of the OperationParameterUse values.
+func (c OperationParameterUse) known() bool {
+	switch c {
+	case OperationParameterUseIn,
+		OperationParameterUseOut:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first OperationParameterUse value in definition order.
+func (OperationParameterUse) firstCode() string {
+	return string(OperationParameterUseIn)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c OperationParameterUse) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *OperationParameterUse) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // OrientationType represents orientation Type.
 type OrientationType string
 
@@ -3219,6 +8093,31 @@ const (
 	OrientationTypeAntisense OrientationType = "antisense"
 )
 
+// known reports whether c is one of the OrientationType values.
+func (c OrientationType) known() bool {
+	switch c {
+	case OrientationTypeSense,
+		OrientationTypeAntisense:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first OrientationType value in definition order.
+func (OrientationType) firstCode() string {
+	return string(OrientationTypeSense)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c OrientationType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *OrientationType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ParticipationStatus represents Participation Status.
 type ParticipationStatus string
 
@@ -3234,6 +8133,33 @@ const (
 	ParticipationStatusNeedsAction ParticipationStatus = "needs-action"
 )
 
+// known reports whether c is one of the ParticipationStatus values.
+func (c ParticipationStatus) known() bool {
+	switch c {
+	case ParticipationStatusAccepted,
+		ParticipationStatusDeclined,
+		ParticipationStatusTentative,
+		ParticipationStatusNeedsAction:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ParticipationStatus value in definition order.
+func (ParticipationStatus) firstCode() string {
+	return string(ParticipationStatusAccepted)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ParticipationStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ParticipationStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // PaymentOutcome represents Payment Outcome.
 type PaymentOutcome string
 
@@ -3249,6 +8175,33 @@ const (
 	PaymentOutcomePartial PaymentOutcome = "partial"
 )
 
+// known reports whether c is one of the PaymentOutcome values.
+func (c PaymentOutcome) known() bool {
+	switch c {
+	case PaymentOutcomeQueued,
+		PaymentOutcomeComplete,
+		PaymentOutcomeError,
+		PaymentOutcomePartial:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first PaymentOutcome value in definition order.
+func (PaymentOutcome) firstCode() string {
+	return string(PaymentOutcomeQueued)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c PaymentOutcome) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *PaymentOutcome) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // PermissionRuleCombining represents Permission Rule Combining.
 type PermissionRuleCombining string
 
@@ -3268,6 +8221,35 @@ const (
 	PermissionRuleCombiningPermitUnlessDeny PermissionRuleCombining = "permit-unless-deny"
 )
 
+// known reports whether c is one of the PermissionRuleCombining values.
+func (c PermissionRuleCombining) known() bool {
+	switch c {
+	case PermissionRuleCombiningDenyOverrides,
+		PermissionRuleCombiningPermitOverrides,
+		PermissionRuleCombiningOrderedDenyOverrides,
+		PermissionRuleCombiningOrderedPermitOverrides,
+		PermissionRuleCombiningDenyUnlessPermit,
+		PermissionRuleCombiningPermitUnlessDeny:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first PermissionRuleCombining value in definition order.
+func (PermissionRuleCombining) firstCode() string {
+	return string(PermissionRuleCombiningDenyOverrides)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c PermissionRuleCombining) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *PermissionRuleCombining) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // PermissionStatus represents Permission Status.
 type PermissionStatus string
 
@@ -3283,6 +8265,33 @@ const (
 	PermissionStatusRejected PermissionStatus = "rejected"
 )
 
+// known reports whether c is one of the PermissionStatus values.
+func (c PermissionStatus) known() bool {
+	switch c {
+	case PermissionStatusActive,
+		PermissionStatusEnteredInError,
+		PermissionStatusDraft,
+		PermissionStatusRejected:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first PermissionStatus value in definition order.
+func (PermissionStatus) firstCode() string {
+	return string(PermissionStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c PermissionStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *PermissionStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ObservationDataType represents Observation Data Type.
 type ObservationDataType string
 
@@ -3312,6 +8321,40 @@ const (
 	ObservationDataTypePeriod ObservationDataType = "Period"
 )
 
+// known reports whether c is one of the ObservationDataType values.
+func (c ObservationDataType) known() bool {
+	switch c {
+	case ObservationDataTypeQuantity,
+		ObservationDataTypeCodeableconcept,
+		ObservationDataTypeString,
+		ObservationDataTypeBoolean,
+		ObservationDataTypeInteger,
+		ObservationDataTypeRange,
+		ObservationDataTypeRatio,
+		ObservationDataTypeSampleddata,
+		ObservationDataTypeTime,
+		ObservationDataTypeDatetime,
+		ObservationDataTypePeriod:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ObservationDataType value in definition order.
+func (ObservationDataType) firstCode() string {
+	return string(ObservationDataTypeQuantity)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ObservationDataType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ObservationDataType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // PriceComponentType represents Price Component Type.
 type PriceComponentType string
 
@@ -3331,6 +8374,35 @@ const (
 	PriceComponentTypeInformational PriceComponentType = "informational"
 )
 
+// known reports whether c is one of the PriceComponentType values.
+func (c PriceComponentType) known() bool {
+	switch c {
+	case PriceComponentTypeBase,
+		PriceComponentTypeSurcharge,
+		PriceComponentTypeDeduction,
+		PriceComponentTypeDiscount,
+		PriceComponentTypeTax,
+		PriceComponentTypeInformational:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first PriceComponentType value in definition order.
+func (PriceComponentType) firstCode() string {
+	return string(PriceComponentTypeBase)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c PriceComponentType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *PriceComponentType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // PropertyRepresentation represents PropertyRepresentation.
 type PropertyRepresentation string
 
@@ -3348,6 +8420,34 @@ const (
 	PropertyRepresentationXhtml PropertyRepresentation = "xhtml"
 )
 
+// known reports whether c is one of the PropertyRepresentation values.
+func (c PropertyRepresentation) known() bool {
+	switch c {
+	case PropertyRepresentationXmlattr,
+		PropertyRepresentationXmltext,
+		PropertyRepresentationTypeattr,
+		PropertyRepresentationCdatext,
+		PropertyRepresentationXhtml:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first PropertyRepresentation value in definition order.
+func (PropertyRepresentation) firstCode() string {
+	return string(PropertyRepresentationXmlattr)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c PropertyRepresentation) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *PropertyRepresentation) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ProvenanceEntityRole represents Provenance Entity Role.
 type ProvenanceEntityRole string
 
@@ -3365,6 +8465,34 @@ const (
 	ProvenanceEntityRoleRemoval ProvenanceEntityRole = "removal"
 )
 
+// known reports whether c is one of the ProvenanceEntityRole values.
+func (c ProvenanceEntityRole) known() bool {
+	switch c {
+	case ProvenanceEntityRoleRevision,
+		ProvenanceEntityRoleQuotation,
+		ProvenanceEntityRoleSource,
+		ProvenanceEntityRoleInstantiates,
+		ProvenanceEntityRoleRemoval:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ProvenanceEntityRole value in definition order.
+func (ProvenanceEntityRole) firstCode() string {
+	return string(ProvenanceEntityRoleRevision)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ProvenanceEntityRole) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ProvenanceEntityRole) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // PublicationStatus represents PublicationStatus.
 type PublicationStatus string
 
@@ -3380,6 +8508,33 @@ const (
 	PublicationStatusUnknown PublicationStatus = "unknown"
 )
 
+// known reports whether c is one of the PublicationStatus values.
+func (c PublicationStatus) known() bool {
+	switch c {
+	case PublicationStatusDraft,
+		PublicationStatusActive,
+		PublicationStatusRetired,
+		PublicationStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first PublicationStatus value in definition order.
+func (PublicationStatus) firstCode() string {
+	return string(PublicationStatusDraft)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c PublicationStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *PublicationStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // QuantityComparator represents QuantityComparator.
 type QuantityComparator string
 
@@ -3397,6 +8552,34 @@ const (
 	QuantityComparatorAd QuantityComparator = "ad"
 )
 
+// known reports whether c is one of the QuantityComparator values.
+func (c QuantityComparator) known() bool {
+	switch c {
+	case QuantityComparatorLessThan,
+		QuantityComparatorLessOrEqual,
+		QuantityComparatorGreaterOrEqual,
+		QuantityComparatorGreaterThan,
+		QuantityComparatorAd:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first QuantityComparator value in definition order.
+func (QuantityComparator) firstCode() string {
+	return string(QuantityComparatorLessThan)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c QuantityComparator) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *QuantityComparator) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // QuestionnaireAnswerConstraint represents Questionnaire answer constraints value set.
 type QuestionnaireAnswerConstraint string
 
@@ -3410,6 +8593,32 @@ const (
 	QuestionnaireAnswerConstraintOptionsorstring QuestionnaireAnswerConstraint = "optionsOrString"
 )
 
+// known reports whether c is one of the QuestionnaireAnswerConstraint values.
+func (c QuestionnaireAnswerConstraint) known() bool {
+	switch c {
+	case QuestionnaireAnswerConstraintOptionsonly,
+		QuestionnaireAnswerConstraintOptionsortype,
+		QuestionnaireAnswerConstraintOptionsorstring:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first QuestionnaireAnswerConstraint value in definition order.
+func (QuestionnaireAnswerConstraint) firstCode() string {
+	return string(QuestionnaireAnswerConstraintOptionsonly)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c QuestionnaireAnswerConstraint) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *QuestionnaireAnswerConstraint) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // QuestionnaireResponseStatus represents Questionnaire Response Status.
 type QuestionnaireResponseStatus string
 
@@ -3427,6 +8636,34 @@ const (
 	QuestionnaireResponseStatusStopped QuestionnaireResponseStatus = "stopped"
 )
 
+// known reports whether c is one of the QuestionnaireResponseStatus values.
+func (c QuestionnaireResponseStatus) known() bool {
+	switch c {
+	case QuestionnaireResponseStatusInProgress,
+		QuestionnaireResponseStatusCompleted,
+		QuestionnaireResponseStatusAmended,
+		QuestionnaireResponseStatusEnteredInError,
+		QuestionnaireResponseStatusStopped:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first QuestionnaireResponseStatus value in definition order.
+func (QuestionnaireResponseStatus) firstCode() string {
+	return string(QuestionnaireResponseStatusInProgress)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c QuestionnaireResponseStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *QuestionnaireResponseStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // QuestionnaireItemDisabledDisplay represents Questionnaire Item Disabled Display.
 type QuestionnaireItemDisabledDisplay string
 
@@ -3438,6 +8675,31 @@ const (
 	QuestionnaireItemDisabledDisplayProtected QuestionnaireItemDisabledDisplay = "protected"
 )
 
+// known reports whether c is one of the QuestionnaireItemDisabledDisplay values.
+func (c QuestionnaireItemDisabledDisplay) known() bool {
+	switch c {
+	case QuestionnaireItemDisabledDisplayHidden,
+		QuestionnaireItemDisabledDisplayProtected:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first QuestionnaireItemDisabledDisplay value in definition order.
+func (QuestionnaireItemDisabledDisplay) firstCode() string {
+	return string(QuestionnaireItemDisabledDisplayHidden)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c QuestionnaireItemDisabledDisplay) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *QuestionnaireItemDisabledDisplay) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EnableWhenBehavior represents Enable When Behavior.
 type EnableWhenBehavior string
 
@@ -3449,6 +8711,31 @@ const (
 	EnableWhenBehaviorAny EnableWhenBehavior = "any"
 )
 
+// known reports whether c is one of the EnableWhenBehavior values.
+func (c EnableWhenBehavior) known() bool {
+	switch c {
+	case EnableWhenBehaviorAll,
+		EnableWhenBehaviorAny:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EnableWhenBehavior value in definition order.
+func (EnableWhenBehavior) firstCode() string {
+	return string(EnableWhenBehaviorAll)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EnableWhenBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EnableWhenBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // QuestionnaireItemOperator represents Questionnaire Item Operator.
 type QuestionnaireItemOperator string
 
@@ -3470,6 +8757,36 @@ const (
 	QuestionnaireItemOperatorLessOrEqual QuestionnaireItemOperator = "<="
 )
 
+// known reports whether c is one of the QuestionnaireItemOperator values.
+func (c QuestionnaireItemOperator) known() bool {
+	switch c {
+	case QuestionnaireItemOperatorExists,
+		QuestionnaireItemOperatorEqual,
+		QuestionnaireItemOperatorNotEqual,
+		QuestionnaireItemOperatorGreaterThan,
+		QuestionnaireItemOperatorLessThan,
+		QuestionnaireItemOperatorGreaterOrEqual,
+		QuestionnaireItemOperatorLessOrEqual:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first QuestionnaireItemOperator value in definition order.
+func (QuestionnaireItemOperator) firstCode() string {
+	return string(QuestionnaireItemOperatorExists)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c QuestionnaireItemOperator) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *QuestionnaireItemOperator) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AllergyIntoleranceSeverity represents Allergy Intolerance Severity.
 type AllergyIntoleranceSeverity string
 
@@ -3483,6 +8800,32 @@ const (
 	AllergyIntoleranceSeveritySevere AllergyIntoleranceSeverity = "severe"
 )
 
+// known reports whether c is one of the AllergyIntoleranceSeverity values.
+func (c AllergyIntoleranceSeverity) known() bool {
+	switch c {
+	case AllergyIntoleranceSeverityMild,
+		AllergyIntoleranceSeverityModerate,
+		AllergyIntoleranceSeveritySevere:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AllergyIntoleranceSeverity value in definition order.
+func (AllergyIntoleranceSeverity) firstCode() string {
+	return string(AllergyIntoleranceSeverityMild)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AllergyIntoleranceSeverity) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AllergyIntoleranceSeverity) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ReferenceHandlingPolicy represents Reference Handling Policy.
 type ReferenceHandlingPolicy string
 
@@ -3500,6 +8843,34 @@ const (
 	ReferenceHandlingPolicyLocal ReferenceHandlingPolicy = "local"
 )
 
+// known reports whether c is one of the ReferenceHandlingPolicy values.
+func (c ReferenceHandlingPolicy) known() bool {
+	switch c {
+	case ReferenceHandlingPolicyLiteral,
+		ReferenceHandlingPolicyLogical,
+		ReferenceHandlingPolicyResolves,
+		ReferenceHandlingPolicyEnforced,
+		ReferenceHandlingPolicyLocal:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ReferenceHandlingPolicy value in definition order.
+func (ReferenceHandlingPolicy) firstCode() string {
+	return string(ReferenceHandlingPolicyLiteral)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ReferenceHandlingPolicy) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ReferenceHandlingPolicy) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ReferenceVersionRules represents ReferenceVersionRules.
 type ReferenceVersionRules string
 
@@ -3513,6 +8884,32 @@ const (
 	ReferenceVersionRulesSpecific ReferenceVersionRules = "specific"
 )
 
+// known reports whether c is one of the ReferenceVersionRules values.
+func (c ReferenceVersionRules) known() bool {
+	switch c {
+	case ReferenceVersionRulesEither,
+		ReferenceVersionRulesIndependent,
+		ReferenceVersionRulesSpecific:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ReferenceVersionRules value in definition order.
+func (ReferenceVersionRules) firstCode() string {
+	return string(ReferenceVersionRulesEither)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ReferenceVersionRules) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ReferenceVersionRules) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RelatedArtifactTypeExpanded represents Related Artifact Type All.
 type RelatedArtifactTypeExpanded string
 
@@ -3596,6 +8993,67 @@ const (
 	RelatedArtifactTypeExpandedReprintOf RelatedArtifactTypeExpanded = "reprint-of"
 )
 
+// known reports whether c is one of the RelatedArtifactTypeExpanded values.
+func (c RelatedArtifactTypeExpanded) known() bool {
+	switch c {
+	case RelatedArtifactTypeExpandedDocumentation,
+		RelatedArtifactTypeExpandedJustification,
+		RelatedArtifactTypeExpandedCitation,
+		RelatedArtifactTypeExpandedPredecessor,
+		RelatedArtifactTypeExpandedSuccessor,
+		RelatedArtifactTypeExpandedDerivedFrom,
+		RelatedArtifactTypeExpandedDependsOn,
+		RelatedArtifactTypeExpandedComposedOf,
+		RelatedArtifactTypeExpandedPartOf,
+		RelatedArtifactTypeExpandedAmends,
+		RelatedArtifactTypeExpandedAmendedWith,
+		RelatedArtifactTypeExpandedAppends,
+		RelatedArtifactTypeExpandedAppendedWith,
+		RelatedArtifactTypeExpandedCites,
+		RelatedArtifactTypeExpandedCitedBy,
+		RelatedArtifactTypeExpandedCommentsOn,
+		RelatedArtifactTypeExpandedCommentIn,
+		RelatedArtifactTypeExpandedContains,
+		RelatedArtifactTypeExpandedContainedIn,
+		RelatedArtifactTypeExpandedCorrects,
+		RelatedArtifactTypeExpandedCorrectionIn,
+		RelatedArtifactTypeExpandedReplaces,
+		RelatedArtifactTypeExpandedReplacedWith,
+		RelatedArtifactTypeExpandedRetracts,
+		RelatedArtifactTypeExpandedRetractedBy,
+		RelatedArtifactTypeExpandedSigns,
+		RelatedArtifactTypeExpandedSimilarTo,
+		RelatedArtifactTypeExpandedSupports,
+		RelatedArtifactTypeExpandedSupportedWith,
+		RelatedArtifactTypeExpandedTransforms,
+		RelatedArtifactTypeExpandedTransformedInto,
+		RelatedArtifactTypeExpandedTransformedWith,
+		RelatedArtifactTypeExpandedDocuments,
+		RelatedArtifactTypeExpandedSpecificationOf,
+		RelatedArtifactTypeExpandedCreatedWith,
+		RelatedArtifactTypeExpandedCiteAs,
+		RelatedArtifactTypeExpandedReprint,
+		RelatedArtifactTypeExpandedReprintOf:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RelatedArtifactTypeExpanded value in definition order.
+func (RelatedArtifactTypeExpanded) firstCode() string {
+	return string(RelatedArtifactTypeExpandedDocumentation)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RelatedArtifactTypeExpanded) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RelatedArtifactTypeExpanded) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RelatedArtifactType represents RelatedArtifactType.
 type RelatedArtifactType string
 
@@ -3675,6 +9133,65 @@ const (
 	RelatedArtifactTypeCiteAs RelatedArtifactType = "cite-as"
 )
 
+// known reports whether c is one of the RelatedArtifactType values.
+func (c RelatedArtifactType) known() bool {
+	switch c {
+	case RelatedArtifactTypeDocumentation,
+		RelatedArtifactTypeJustification,
+		RelatedArtifactTypeCitation,
+		RelatedArtifactTypePredecessor,
+		RelatedArtifactTypeSuccessor,
+		RelatedArtifactTypeDerivedFrom,
+		RelatedArtifactTypeDependsOn,
+		RelatedArtifactTypeComposedOf,
+		RelatedArtifactTypePartOf,
+		RelatedArtifactTypeAmends,
+		RelatedArtifactTypeAmendedWith,
+		RelatedArtifactTypeAppends,
+		RelatedArtifactTypeAppendedWith,
+		RelatedArtifactTypeCites,
+		RelatedArtifactTypeCitedBy,
+		RelatedArtifactTypeCommentsOn,
+		RelatedArtifactTypeCommentIn,
+		RelatedArtifactTypeContains,
+		RelatedArtifactTypeContainedIn,
+		RelatedArtifactTypeCorrects,
+		RelatedArtifactTypeCorrectionIn,
+		RelatedArtifactTypeReplaces,
+		RelatedArtifactTypeReplacedWith,
+		RelatedArtifactTypeRetracts,
+		RelatedArtifactTypeRetractedBy,
+		RelatedArtifactTypeSigns,
+		RelatedArtifactTypeSimilarTo,
+		RelatedArtifactTypeSupports,
+		RelatedArtifactTypeSupportedWith,
+		RelatedArtifactTypeTransforms,
+		RelatedArtifactTypeTransformedInto,
+		RelatedArtifactTypeTransformedWith,
+		RelatedArtifactTypeDocuments,
+		RelatedArtifactTypeSpecificationOf,
+		RelatedArtifactTypeCreatedWith,
+		RelatedArtifactTypeCiteAs:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RelatedArtifactType value in definition order.
+func (RelatedArtifactType) firstCode() string {
+	return string(RelatedArtifactTypeDocumentation)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RelatedArtifactType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RelatedArtifactType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TestReportActionResult represents Test Report Action Result.
 type TestReportActionResult string
 
@@ -3692,6 +9209,34 @@ const (
 	TestReportActionResultError TestReportActionResult = "error"
 )
 
+// known reports whether c is one of the TestReportActionResult values.
+func (c TestReportActionResult) known() bool {
+	switch c {
+	case TestReportActionResultPass,
+		TestReportActionResultSkip,
+		TestReportActionResultFail,
+		TestReportActionResultWarning,
+		TestReportActionResultError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TestReportActionResult value in definition order.
+func (TestReportActionResult) firstCode() string {
+	return string(TestReportActionResultPass)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TestReportActionResult) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TestReportActionResult) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TestReportParticipantType represents Test Report Participant Type.
 type TestReportParticipantType string
 
@@ -3705,6 +9250,32 @@ const (
 	TestReportParticipantTypeServer TestReportParticipantType = "server"
 )
 
+// known reports whether c is one of the TestReportParticipantType values.
+func (c TestReportParticipantType) known() bool {
+	switch c {
+	case TestReportParticipantTypeTestEngine,
+		TestReportParticipantTypeClient,
+		TestReportParticipantTypeServer:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TestReportParticipantType value in definition order.
+func (TestReportParticipantType) firstCode() string {
+	return string(TestReportParticipantTypeTestEngine)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TestReportParticipantType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TestReportParticipantType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ReportRelationshipType represents Report Relationship Type.
 type ReportRelationshipType string
 
@@ -3728,6 +9299,37 @@ const (
 	ReportRelationshipTypeTransformedwith ReportRelationshipType = "transformedWith"
 )
 
+// known reports whether c is one of the ReportRelationshipType values.
+func (c ReportRelationshipType) known() bool {
+	switch c {
+	case ReportRelationshipTypeReplaces,
+		ReportRelationshipTypeAmends,
+		ReportRelationshipTypeAppends,
+		ReportRelationshipTypeTransforms,
+		ReportRelationshipTypeReplacedwith,
+		ReportRelationshipTypeAmendedwith,
+		ReportRelationshipTypeAppendedwith,
+		ReportRelationshipTypeTransformedwith:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ReportRelationshipType value in definition order.
+func (ReportRelationshipType) firstCode() string {
+	return string(ReportRelationshipTypeReplaces)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ReportRelationshipType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ReportRelationshipType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TestReportResult represents Test Report Result.
 type TestReportResult string
 
@@ -3741,6 +9343,32 @@ const (
 	TestReportResultPending TestReportResult = "pending"
 )
 
+// known reports whether c is one of the TestReportResult values.
+func (c TestReportResult) known() bool {
+	switch c {
+	case TestReportResultPass,
+		TestReportResultFail,
+		TestReportResultPending:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TestReportResult value in definition order.
+func (TestReportResult) firstCode() string {
+	return string(TestReportResultPass)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TestReportResult) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TestReportResult) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TestReportStatus represents Test Report Status.
 type TestReportStatus string
 
@@ -3758,6 +9386,34 @@ const (
 	TestReportStatusEnteredInError TestReportStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the TestReportStatus values.
+func (c TestReportStatus) known() bool {
+	switch c {
+	case TestReportStatusCompleted,
+		TestReportStatusInProgress,
+		TestReportStatusWaiting,
+		TestReportStatusStopped,
+		TestReportStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TestReportStatus value in definition order.
+func (TestReportStatus) firstCode() string {
+	return string(TestReportStatusCompleted)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TestReportStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TestReportStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RequestIntent represents RequestIntent.
 type RequestIntent string
 
@@ -3783,6 +9439,38 @@ const (
 	RequestIntentOption RequestIntent = "option"
 )
 
+// known reports whether c is one of the RequestIntent values.
+func (c RequestIntent) known() bool {
+	switch c {
+	case RequestIntentProposal,
+		RequestIntentPlan,
+		RequestIntentDirective,
+		RequestIntentOrder,
+		RequestIntentOriginalOrder,
+		RequestIntentReflexOrder,
+		RequestIntentFillerOrder,
+		RequestIntentInstanceOrder,
+		RequestIntentOption:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RequestIntent value in definition order.
+func (RequestIntent) firstCode() string {
+	return string(RequestIntentProposal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RequestIntent) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RequestIntent) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RequestPriority represents RequestPriority.
 type RequestPriority string
 
@@ -3798,6 +9486,33 @@ const (
 	RequestPriorityStat RequestPriority = "stat"
 )
 
+// known reports whether c is one of the RequestPriority values.
+func (c RequestPriority) known() bool {
+	switch c {
+	case RequestPriorityRoutine,
+		RequestPriorityUrgent,
+		RequestPriorityAsap,
+		RequestPriorityStat:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RequestPriority value in definition order.
+func (RequestPriority) firstCode() string {
+	return string(RequestPriorityRoutine)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RequestPriority) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RequestPriority) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RequestResourceTypes represents Request Resource Types.
 type RequestResourceTypes string
 
@@ -3822,6 +9537,46 @@ const (
 	RequestResourceTypesVisionprescription         RequestResourceTypes = "VisionPrescription"
 )
 
+// known reports whether c is one of the RequestResourceTypes values.
+func (c RequestResourceTypes) known() bool {
+	switch c {
+	case RequestResourceTypesAppointment,
+		RequestResourceTypesAppointmentresponse,
+		RequestResourceTypesCareplan,
+		RequestResourceTypesClaim,
+		RequestResourceTypesCommunicationrequest,
+		RequestResourceTypesCoverageeligibilityrequest,
+		RequestResourceTypesDevicerequest,
+		RequestResourceTypesEnrollmentrequest,
+		RequestResourceTypesImmunizationrecommendation,
+		RequestResourceTypesMedicationrequest,
+		RequestResourceTypesNutritionorder,
+		RequestResourceTypesRequestorchestration,
+		RequestResourceTypesServicerequest,
+		RequestResourceTypesSupplyrequest,
+		RequestResourceTypesTask,
+		RequestResourceTypesTransport,
+		RequestResourceTypesVisionprescription:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RequestResourceTypes value in definition order.
+func (RequestResourceTypes) firstCode() string {
+	return string(RequestResourceTypesAppointment)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RequestResourceTypes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RequestResourceTypes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RequestStatus represents RequestStatus.
 type RequestStatus string
 
@@ -3843,6 +9598,36 @@ const (
 	RequestStatusUnknown RequestStatus = "unknown"
 )
 
+// known reports whether c is one of the RequestStatus values.
+func (c RequestStatus) known() bool {
+	switch c {
+	case RequestStatusDraft,
+		RequestStatusActive,
+		RequestStatusOnHold,
+		RequestStatusRevoked,
+		RequestStatusCompleted,
+		RequestStatusEnteredInError,
+		RequestStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RequestStatus value in definition order.
+func (RequestStatus) firstCode() string {
+	return string(RequestStatusDraft)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RequestStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RequestStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // AggregationMode represents AggregationMode.
 type AggregationMode string
 
@@ -3856,6 +9641,32 @@ const (
 	AggregationModeBundled AggregationMode = "bundled"
 )
 
+// known reports whether c is one of the AggregationMode values.
+func (c AggregationMode) known() bool {
+	switch c {
+	case AggregationModeContained,
+		AggregationModeReferenced,
+		AggregationModeBundled:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first AggregationMode value in definition order.
+func (AggregationMode) firstCode() string {
+	return string(AggregationModeContained)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c AggregationMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *AggregationMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SlicingRules represents SlicingRules.
 type SlicingRules string
 
@@ -3869,6 +9680,32 @@ const (
 	SlicingRulesOpenatend SlicingRules = "openAtEnd"
 )
 
+// known reports whether c is one of the SlicingRules values.
+func (c SlicingRules) known() bool {
+	switch c {
+	case SlicingRulesClosed,
+		SlicingRulesOpen,
+		SlicingRulesOpenatend:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SlicingRules value in definition order.
+func (SlicingRules) firstCode() string {
+	return string(SlicingRulesClosed)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SlicingRules) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SlicingRules) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ResponseType represents Response Type.
 type ResponseType string
 
@@ -3882,6 +9719,32 @@ const (
 	ResponseTypeFatalError ResponseType = "fatal-error"
 )
 
+// known reports whether c is one of the ResponseType values.
+func (c ResponseType) known() bool {
+	switch c {
+	case ResponseTypeOk,
+		ResponseTypeTransientError,
+		ResponseTypeFatalError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ResponseType value in definition order.
+func (ResponseType) firstCode() string {
+	return string(ResponseTypeOk)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ResponseType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ResponseType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // RestfulCapabilityMode represents Restful Capability Mode.
 type RestfulCapabilityMode string
 
@@ -3893,6 +9756,31 @@ const (
 	RestfulCapabilityModeServer RestfulCapabilityMode = "server"
 )
 
+// known reports whether c is one of the RestfulCapabilityMode values.
+func (c RestfulCapabilityMode) known() bool {
+	switch c {
+	case RestfulCapabilityModeClient,
+		RestfulCapabilityModeServer:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first RestfulCapabilityMode value in definition order.
+func (RestfulCapabilityMode) firstCode() string {
+	return string(RestfulCapabilityModeClient)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c RestfulCapabilityMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *RestfulCapabilityMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SearchComparator represents Search Comparator.
 type SearchComparator string
 
@@ -3918,6 +9806,38 @@ const (
 	SearchComparatorAp SearchComparator = "ap"
 )
 
+// known reports whether c is one of the SearchComparator values.
+func (c SearchComparator) known() bool {
+	switch c {
+	case SearchComparatorEq,
+		SearchComparatorNe,
+		SearchComparatorGt,
+		SearchComparatorLt,
+		SearchComparatorGe,
+		SearchComparatorLe,
+		SearchComparatorSa,
+		SearchComparatorEb,
+		SearchComparatorAp:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SearchComparator value in definition order.
+func (SearchComparator) firstCode() string {
+	return string(SearchComparatorEq)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SearchComparator) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SearchComparator) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SearchEntryMode represents Search Entry Mode.
 type SearchEntryMode string
 
@@ -3931,6 +9851,32 @@ const (
 	SearchEntryModeOutcome SearchEntryMode = "outcome"
 )
 
+// known reports whether c is one of the SearchEntryMode values.
+func (c SearchEntryMode) known() bool {
+	switch c {
+	case SearchEntryModeMatch,
+		SearchEntryModeInclude,
+		SearchEntryModeOutcome:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SearchEntryMode value in definition order.
+func (SearchEntryMode) firstCode() string {
+	return string(SearchEntryModeMatch)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SearchEntryMode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SearchEntryMode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SearchModifierCode represents Search Modifier Code.
 type SearchModifierCode string
 
@@ -3968,6 +9914,44 @@ const (
 	SearchModifierCodeIterate SearchModifierCode = "iterate"
 )
 
+// known reports whether c is one of the SearchModifierCode values.
+func (c SearchModifierCode) known() bool {
+	switch c {
+	case SearchModifierCodeMissing,
+		SearchModifierCodeExact,
+		SearchModifierCodeContains,
+		SearchModifierCodeNot,
+		SearchModifierCodeText,
+		SearchModifierCodeIn,
+		SearchModifierCodeNotIn,
+		SearchModifierCodeBelow,
+		SearchModifierCodeAbove,
+		SearchModifierCodeType,
+		SearchModifierCodeIdentifier,
+		SearchModifierCodeOfType,
+		SearchModifierCodeCodeText,
+		SearchModifierCodeTextAdvanced,
+		SearchModifierCodeIterate:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SearchModifierCode value in definition order.
+func (SearchModifierCode) firstCode() string {
+	return string(SearchModifierCodeMissing)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SearchModifierCode) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SearchModifierCode) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SearchParamType represents SearchParamType.
 type SearchParamType string
 
@@ -3993,6 +9977,38 @@ const (
 	SearchParamTypeSpecial SearchParamType = "special"
 )
 
+// known reports whether c is one of the SearchParamType values.
+func (c SearchParamType) known() bool {
+	switch c {
+	case SearchParamTypeNumber,
+		SearchParamTypeDate,
+		SearchParamTypeString,
+		SearchParamTypeToken,
+		SearchParamTypeReference,
+		SearchParamTypeComposite,
+		SearchParamTypeQuantity,
+		SearchParamTypeUri,
+		SearchParamTypeSpecial:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SearchParamType value in definition order.
+func (SearchParamType) firstCode() string {
+	return string(SearchParamTypeNumber)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SearchParamType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SearchParamType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SearchProcessingModeType represents Search Processing Mode Type.
 type SearchProcessingModeType string
 
@@ -4006,6 +10022,32 @@ const (
 	SearchProcessingModeTypeOther SearchProcessingModeType = "other"
 )
 
+// known reports whether c is one of the SearchProcessingModeType values.
+func (c SearchProcessingModeType) known() bool {
+	switch c {
+	case SearchProcessingModeTypeNormal,
+		SearchProcessingModeTypePhonetic,
+		SearchProcessingModeTypeOther:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SearchProcessingModeType value in definition order.
+func (SearchProcessingModeType) firstCode() string {
+	return string(SearchProcessingModeTypeNormal)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SearchProcessingModeType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SearchProcessingModeType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SequenceType represents sequence Type.
 type SequenceType string
 
@@ -4019,6 +10061,32 @@ const (
 	SequenceTypeRna SequenceType = "rna"
 )
 
+// known reports whether c is one of the SequenceType values.
+func (c SequenceType) known() bool {
+	switch c {
+	case SequenceTypeAa,
+		SequenceTypeDna,
+		SequenceTypeRna:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SequenceType value in definition order.
+func (SequenceType) firstCode() string {
+	return string(SequenceTypeAa)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SequenceType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SequenceType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SlotStatus represents Slot Status.
 type SlotStatus string
 
@@ -4036,6 +10104,34 @@ const (
 	SlotStatusEnteredInError SlotStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the SlotStatus values.
+func (c SlotStatus) known() bool {
+	switch c {
+	case SlotStatusBusy,
+		SlotStatusFree,
+		SlotStatusBusyUnavailable,
+		SlotStatusBusyTentative,
+		SlotStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SlotStatus value in definition order.
+func (SlotStatus) firstCode() string {
+	return string(SlotStatusBusy)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SlotStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SlotStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SortDirection represents SortDirection.
 type SortDirection string
 
@@ -4047,6 +10143,31 @@ const (
 	SortDirectionDescending SortDirection = "descending"
 )
 
+// known reports whether c is one of the SortDirection values.
+func (c SortDirection) known() bool {
+	switch c {
+	case SortDirectionAscending,
+		SortDirectionDescending:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SortDirection value in definition order.
+func (SortDirection) firstCode() string {
+	return string(SortDirectionAscending)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SortDirection) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SortDirection) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SpecimenCombined represents Specimen Combined.
 type SpecimenCombined string
 
@@ -4058,6 +10179,31 @@ const (
 	SpecimenCombinedPooled SpecimenCombined = "pooled"
 )
 
+// known reports whether c is one of the SpecimenCombined values.
+func (c SpecimenCombined) known() bool {
+	switch c {
+	case SpecimenCombinedGrouped,
+		SpecimenCombinedPooled:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SpecimenCombined value in definition order.
+func (SpecimenCombined) firstCode() string {
+	return string(SpecimenCombinedGrouped)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SpecimenCombined) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SpecimenCombined) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SpecimenContainedPreference represents Specimen Contained Preference.
 type SpecimenContainedPreference string
 
@@ -4069,6 +10215,31 @@ const (
 	SpecimenContainedPreferenceAlternate SpecimenContainedPreference = "alternate"
 )
 
+// known reports whether c is one of the SpecimenContainedPreference values.
+func (c SpecimenContainedPreference) known() bool {
+	switch c {
+	case SpecimenContainedPreferencePreferred,
+		SpecimenContainedPreferenceAlternate:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SpecimenContainedPreference value in definition order.
+func (SpecimenContainedPreference) firstCode() string {
+	return string(SpecimenContainedPreferencePreferred)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SpecimenContainedPreference) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SpecimenContainedPreference) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SpecimenStatus represents Specimen Status.
 type SpecimenStatus string
 
@@ -4084,6 +10255,33 @@ const (
 	SpecimenStatusEnteredInError SpecimenStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the SpecimenStatus values.
+func (c SpecimenStatus) known() bool {
+	switch c {
+	case SpecimenStatusAvailable,
+		SpecimenStatusUnavailable,
+		SpecimenStatusUnsatisfactory,
+		SpecimenStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SpecimenStatus value in definition order.
+func (SpecimenStatus) firstCode() string {
+	return string(SpecimenStatusAvailable)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SpecimenStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SpecimenStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StrandType represents strand Type.
 type StrandType string
 
@@ -4095,6 +10293,31 @@ const (
 	StrandTypeCrick StrandType = "crick"
 )
 
+// known reports whether c is one of the StrandType values.
+func (c StrandType) known() bool {
+	switch c {
+	case StrandTypeWatson,
+		StrandTypeCrick:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StrandType value in definition order.
+func (StrandType) firstCode() string {
+	return string(StrandTypeWatson)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StrandType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StrandType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // StructureDefinitionKind represents Structure Definition Kind.
 type StructureDefinitionKind string
 
@@ -4110,6 +10333,33 @@ const (
 	StructureDefinitionKindLogical StructureDefinitionKind = "logical"
 )
 
+// known reports whether c is one of the StructureDefinitionKind values.
+func (c StructureDefinitionKind) known() bool {
+	switch c {
+	case StructureDefinitionKindPrimitiveType,
+		StructureDefinitionKindComplexType,
+		StructureDefinitionKindResource,
+		StructureDefinitionKindLogical:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first StructureDefinitionKind value in definition order.
+func (StructureDefinitionKind) firstCode() string {
+	return string(StructureDefinitionKindPrimitiveType)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c StructureDefinitionKind) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *StructureDefinitionKind) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SubmitDataUpdateType represents Submit Data Update Type.
 type SubmitDataUpdateType string
 
@@ -4121,6 +10371,31 @@ const (
 	SubmitDataUpdateTypeSnapshot SubmitDataUpdateType = "snapshot"
 )
 
+// known reports whether c is one of the SubmitDataUpdateType values.
+func (c SubmitDataUpdateType) known() bool {
+	switch c {
+	case SubmitDataUpdateTypeIncremental,
+		SubmitDataUpdateTypeSnapshot:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SubmitDataUpdateType value in definition order.
+func (SubmitDataUpdateType) firstCode() string {
+	return string(SubmitDataUpdateTypeIncremental)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SubmitDataUpdateType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SubmitDataUpdateType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SubscriptionNotificationType represents Subscription Notification Type.
 type SubscriptionNotificationType string
 
@@ -4138,6 +10413,34 @@ const (
 	SubscriptionNotificationTypeQueryEvent SubscriptionNotificationType = "query-event"
 )
 
+// known reports whether c is one of the SubscriptionNotificationType values.
+func (c SubscriptionNotificationType) known() bool {
+	switch c {
+	case SubscriptionNotificationTypeHandshake,
+		SubscriptionNotificationTypeHeartbeat,
+		SubscriptionNotificationTypeEventNotification,
+		SubscriptionNotificationTypeQueryStatus,
+		SubscriptionNotificationTypeQueryEvent:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SubscriptionNotificationType value in definition order.
+func (SubscriptionNotificationType) firstCode() string {
+	return string(SubscriptionNotificationTypeHandshake)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SubscriptionNotificationType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SubscriptionNotificationType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SubscriptionPayloadContent represents Subscription Payload Content.
 type SubscriptionPayloadContent string
 
@@ -4151,6 +10454,32 @@ const (
 	SubscriptionPayloadContentFullResource SubscriptionPayloadContent = "full-resource"
 )
 
+// known reports whether c is one of the SubscriptionPayloadContent values.
+func (c SubscriptionPayloadContent) known() bool {
+	switch c {
+	case SubscriptionPayloadContentEmpty,
+		SubscriptionPayloadContentIdOnly,
+		SubscriptionPayloadContentFullResource:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SubscriptionPayloadContent value in definition order.
+func (SubscriptionPayloadContent) firstCode() string {
+	return string(SubscriptionPayloadContentEmpty)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SubscriptionPayloadContent) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SubscriptionPayloadContent) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SubscriptionStatusCodes represents Subscription Status.
 type SubscriptionStatusCodes string
 
@@ -4168,6 +10497,34 @@ const (
 	SubscriptionStatusCodesEnteredInError SubscriptionStatusCodes = "entered-in-error"
 )
 
+// known reports whether c is one of the SubscriptionStatusCodes values.
+func (c SubscriptionStatusCodes) known() bool {
+	switch c {
+	case SubscriptionStatusCodesRequested,
+		SubscriptionStatusCodesActive,
+		SubscriptionStatusCodesError,
+		SubscriptionStatusCodesOff,
+		SubscriptionStatusCodesEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SubscriptionStatusCodes value in definition order.
+func (SubscriptionStatusCodes) firstCode() string {
+	return string(SubscriptionStatusCodesRequested)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SubscriptionStatusCodes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SubscriptionStatusCodes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // CriteriaNotExistsBehavior represents Criteria Not Exists Behavior.
 type CriteriaNotExistsBehavior string
 
@@ -4179,6 +10536,31 @@ const (
 	CriteriaNotExistsBehaviorTestFails CriteriaNotExistsBehavior = "test-fails"
 )
 
+// known reports whether c is one of the CriteriaNotExistsBehavior values.
+func (c CriteriaNotExistsBehavior) known() bool {
+	switch c {
+	case CriteriaNotExistsBehaviorTestPasses,
+		CriteriaNotExistsBehaviorTestFails:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first CriteriaNotExistsBehavior value in definition order.
+func (CriteriaNotExistsBehavior) firstCode() string {
+	return string(CriteriaNotExistsBehaviorTestPasses)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c CriteriaNotExistsBehavior) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *CriteriaNotExistsBehavior) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // FHIRSubstanceStatus represents FHIRSubstanceStatus.
 type FHIRSubstanceStatus string
 
@@ -4192,6 +10574,32 @@ const (
 	FHIRSubstanceStatusEnteredInError FHIRSubstanceStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the FHIRSubstanceStatus values.
+func (c FHIRSubstanceStatus) known() bool {
+	switch c {
+	case FHIRSubstanceStatusActive,
+		FHIRSubstanceStatusInactive,
+		FHIRSubstanceStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first FHIRSubstanceStatus value in definition order.
+func (FHIRSubstanceStatus) firstCode() string {
+	return string(FHIRSubstanceStatusActive)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c FHIRSubstanceStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *FHIRSubstanceStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SupplyDeliveryStatus represents Supply Delivery Status.
 type SupplyDeliveryStatus string
 
@@ -4207,6 +10615,33 @@ const (
 	SupplyDeliveryStatusEnteredInError SupplyDeliveryStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the SupplyDeliveryStatus values.
+func (c SupplyDeliveryStatus) known() bool {
+	switch c {
+	case SupplyDeliveryStatusInProgress,
+		SupplyDeliveryStatusCompleted,
+		SupplyDeliveryStatusAbandoned,
+		SupplyDeliveryStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SupplyDeliveryStatus value in definition order.
+func (SupplyDeliveryStatus) firstCode() string {
+	return string(SupplyDeliveryStatusInProgress)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SupplyDeliveryStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SupplyDeliveryStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SupplyRequestStatus represents Supply Request Status.
 type SupplyRequestStatus string
 
@@ -4228,6 +10663,36 @@ const (
 	SupplyRequestStatusUnknown SupplyRequestStatus = "unknown"
 )
 
+// known reports whether c is one of the SupplyRequestStatus values.
+func (c SupplyRequestStatus) known() bool {
+	switch c {
+	case SupplyRequestStatusDraft,
+		SupplyRequestStatusActive,
+		SupplyRequestStatusSuspended,
+		SupplyRequestStatusCancelled,
+		SupplyRequestStatusCompleted,
+		SupplyRequestStatusEnteredInError,
+		SupplyRequestStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SupplyRequestStatus value in definition order.
+func (SupplyRequestStatus) firstCode() string {
+	return string(SupplyRequestStatusDraft)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SupplyRequestStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SupplyRequestStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // SystemRestfulInteraction represents System Restful Interaction.
 type SystemRestfulInteraction string
 
@@ -4239,6 +10704,33 @@ const (
 	SystemRestfulInteractionHistorySystem SystemRestfulInteraction = "history-system"
 )
 
+// known reports whether c is one of the SystemRestfulInteraction values.
+func (c SystemRestfulInteraction) known() bool {
+	switch c {
+	case SystemRestfulInteractionTransaction,
+		SystemRestfulInteractionBatch,
+		SystemRestfulInteractionSearchSystem,
+		SystemRestfulInteractionHistorySystem:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first SystemRestfulInteraction value in definition order.
+func (SystemRestfulInteraction) firstCode() string {
+	return string(SystemRestfulInteractionTransaction)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c SystemRestfulInteraction) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *SystemRestfulInteraction) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TaskIntent represents Task Intent.
 type TaskIntent string
 
@@ -4256,6 +10748,38 @@ const (
 	TaskIntentOption        TaskIntent = "option"
 )
 
+// known reports whether c is one of the TaskIntent values.
+func (c TaskIntent) known() bool {
+	switch c {
+	case TaskIntentUnknown,
+		TaskIntentProposal,
+		TaskIntentPlan,
+		TaskIntentOrder,
+		TaskIntentOriginalOrder,
+		TaskIntentReflexOrder,
+		TaskIntentFillerOrder,
+		TaskIntentInstanceOrder,
+		TaskIntentOption:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TaskIntent value in definition order.
+func (TaskIntent) firstCode() string {
+	return string(TaskIntentUnknown)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TaskIntent) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TaskIntent) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TaskStatus represents Task Status.
 type TaskStatus string
 
@@ -4287,6 +10811,41 @@ const (
 	TaskStatusEnteredInError TaskStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the TaskStatus values.
+func (c TaskStatus) known() bool {
+	switch c {
+	case TaskStatusDraft,
+		TaskStatusRequested,
+		TaskStatusReceived,
+		TaskStatusAccepted,
+		TaskStatusRejected,
+		TaskStatusReady,
+		TaskStatusCancelled,
+		TaskStatusInProgress,
+		TaskStatusOnHold,
+		TaskStatusFailed,
+		TaskStatusCompleted,
+		TaskStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TaskStatus value in definition order.
+func (TaskStatus) firstCode() string {
+	return string(TaskStatusDraft)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TaskStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TaskStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TransportIntent represents Transport Intent.
 type TransportIntent string
 
@@ -4304,6 +10863,38 @@ const (
 	TransportIntentOption        TransportIntent = "option"
 )
 
+// known reports whether c is one of the TransportIntent values.
+func (c TransportIntent) known() bool {
+	switch c {
+	case TransportIntentUnknown,
+		TransportIntentProposal,
+		TransportIntentPlan,
+		TransportIntentOrder,
+		TransportIntentOriginalOrder,
+		TransportIntentReflexOrder,
+		TransportIntentFillerOrder,
+		TransportIntentInstanceOrder,
+		TransportIntentOption:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TransportIntent value in definition order.
+func (TransportIntent) firstCode() string {
+	return string(TransportIntentUnknown)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TransportIntent) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TransportIntent) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TransportStatus represents Transport Status.
 type TransportStatus string
 
@@ -4323,6 +10914,35 @@ const (
 	TransportStatusEnteredInError TransportStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the TransportStatus values.
+func (c TransportStatus) known() bool {
+	switch c {
+	case TransportStatusInProgress,
+		TransportStatusCompleted,
+		TransportStatusAbandoned,
+		TransportStatusCancelled,
+		TransportStatusPlanned,
+		TransportStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TransportStatus value in definition order.
+func (TransportStatus) firstCode() string {
+	return string(TransportStatusInProgress)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TransportStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TransportStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TriggerType represents TriggerType.
 type TriggerType string
 
@@ -4346,6 +10966,37 @@ const (
 	TriggerTypeDataAccessEnded TriggerType = "data-access-ended"
 )
 
+// known reports whether c is one of the TriggerType values.
+func (c TriggerType) known() bool {
+	switch c {
+	case TriggerTypeNamedEvent,
+		TriggerTypePeriodic,
+		TriggerTypeDataChanged,
+		TriggerTypeDataAdded,
+		TriggerTypeDataModified,
+		TriggerTypeDataRemoved,
+		TriggerTypeDataAccessed,
+		TriggerTypeDataAccessEnded:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TriggerType value in definition order.
+func (TriggerType) firstCode() string {
+	return string(TriggerTypeNamedEvent)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TriggerType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TriggerType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TypeDerivationRule represents Type Derivation Rule.
 type TypeDerivationRule string
 
@@ -4357,6 +11008,31 @@ const (
 	TypeDerivationRuleConstraint TypeDerivationRule = "constraint"
 )
 
+// known reports whether c is one of the TypeDerivationRule values.
+func (c TypeDerivationRule) known() bool {
+	switch c {
+	case TypeDerivationRuleSpecialization,
+		TypeDerivationRuleConstraint:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TypeDerivationRule value in definition order.
+func (TypeDerivationRule) firstCode() string {
+	return string(TypeDerivationRuleSpecialization)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TypeDerivationRule) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TypeDerivationRule) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // TypeRestfulInteraction represents Type Restful Interaction.
 type TypeRestfulInteraction string
 
@@ -4373,6 +11049,38 @@ const (
 	TypeRestfulInteractionSearchType      TypeRestfulInteraction = "search-type"
 )
 
+// known reports whether c is one of the TypeRestfulInteraction values.
+func (c TypeRestfulInteraction) known() bool {
+	switch c {
+	case TypeRestfulInteractionRead,
+		TypeRestfulInteractionVread,
+		TypeRestfulInteractionUpdate,
+		TypeRestfulInteractionPatch,
+		TypeRestfulInteractionDelete,
+		TypeRestfulInteractionHistoryInstance,
+		TypeRestfulInteractionHistoryType,
+		TypeRestfulInteractionCreate,
+		TypeRestfulInteractionSearchType:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first TypeRestfulInteraction value in definition order.
+func (TypeRestfulInteraction) firstCode() string {
+	return string(TypeRestfulInteractionRead)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c TypeRestfulInteraction) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *TypeRestfulInteraction) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // UDIEntryType represents UDI Entry Type.
 type UDIEntryType string
 
@@ -4394,6 +11102,36 @@ const (
 	UDIEntryTypeUnknown UDIEntryType = "unknown"
 )
 
+// known reports whether c is one of the UDIEntryType values.
+func (c UDIEntryType) known() bool {
+	switch c {
+	case UDIEntryTypeBarcode,
+		UDIEntryTypeRfid,
+		UDIEntryTypeManual,
+		UDIEntryTypeCard,
+		UDIEntryTypeSelfReported,
+		UDIEntryTypeElectronicTransmission,
+		UDIEntryTypeUnknown:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first UDIEntryType value in definition order.
+func (UDIEntryType) firstCode() string {
+	return string(UDIEntryTypeBarcode)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c UDIEntryType) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *UDIEntryType) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // UnitsOfTime represents UnitsOfTime.
 type UnitsOfTime string
 
@@ -4415,6 +11153,36 @@ const (
 	UnitsOfTimeA UnitsOfTime = "a"
 )
 
+// known reports whether c is one of the UnitsOfTime values.
+func (c UnitsOfTime) known() bool {
+	switch c {
+	case UnitsOfTimeS,
+		UnitsOfTimeMin,
+		UnitsOfTimeH,
+		UnitsOfTimeD,
+		UnitsOfTimeWk,
+		UnitsOfTimeMo,
+		UnitsOfTimeA:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first UnitsOfTime value in definition order.
+func (UnitsOfTime) firstCode() string {
+	return string(UnitsOfTimeS)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c UnitsOfTime) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *UnitsOfTime) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ValueFilterComparator represents Value Filter Comparator.
 type ValueFilterComparator string
 
@@ -4436,6 +11204,36 @@ const (
 	ValueFilterComparatorEb ValueFilterComparator = "eb"
 )
 
+// known reports whether c is one of the ValueFilterComparator values.
+func (c ValueFilterComparator) known() bool {
+	switch c {
+	case ValueFilterComparatorEq,
+		ValueFilterComparatorGt,
+		ValueFilterComparatorLt,
+		ValueFilterComparatorGe,
+		ValueFilterComparatorLe,
+		ValueFilterComparatorSa,
+		ValueFilterComparatorEb:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ValueFilterComparator value in definition order.
+func (ValueFilterComparator) firstCode() string {
+	return string(ValueFilterComparatorEq)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ValueFilterComparator) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ValueFilterComparator) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // EvidenceVariableHandling represents Evidence Variable Handling.
 type EvidenceVariableHandling string
 
@@ -4451,6 +11249,33 @@ const (
 	EvidenceVariableHandlingPolychotomous EvidenceVariableHandling = "polychotomous"
 )
 
+// known reports whether c is one of the EvidenceVariableHandling values.
+func (c EvidenceVariableHandling) known() bool {
+	switch c {
+	case EvidenceVariableHandlingContinuous,
+		EvidenceVariableHandlingDichotomous,
+		EvidenceVariableHandlingOrdinal,
+		EvidenceVariableHandlingPolychotomous:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first EvidenceVariableHandling value in definition order.
+func (EvidenceVariableHandling) firstCode() string {
+	return string(EvidenceVariableHandlingContinuous)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c EvidenceVariableHandling) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *EvidenceVariableHandling) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // VerificationResultStatus represents Verification Result status.
 type VerificationResultStatus string
 
@@ -4472,6 +11297,36 @@ const (
 	VerificationResultStatusEnteredInError VerificationResultStatus = "entered-in-error"
 )
 
+// known reports whether c is one of the VerificationResultStatus values.
+func (c VerificationResultStatus) known() bool {
+	switch c {
+	case VerificationResultStatusAttested,
+		VerificationResultStatusValidated,
+		VerificationResultStatusInProcess,
+		VerificationResultStatusReqRevalid,
+		VerificationResultStatusValFail,
+		VerificationResultStatusRevalFail,
+		VerificationResultStatusEnteredInError:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first VerificationResultStatus value in definition order.
+func (VerificationResultStatus) firstCode() string {
+	return string(VerificationResultStatusAttested)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c VerificationResultStatus) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *VerificationResultStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // VersionIndependentResourceTypesAll represents Version Independent Resource Types (All).
 type VersionIndependentResourceTypesAll string
 
@@ -4561,6 +11416,70 @@ const (
 	VersionIndependentResourceTypesAllSubstancespecification VersionIndependentResourceTypesAll = "SubstanceSpecification"
 )
 
+// known reports whether c is one of the VersionIndependentResourceTypesAll values.
+func (c VersionIndependentResourceTypesAll) known() bool {
+	switch c {
+	case VersionIndependentResourceTypesAllBodysite,
+		VersionIndependentResourceTypesAllCatalogentry,
+		VersionIndependentResourceTypesAllConformance,
+		VersionIndependentResourceTypesAllDataelement,
+		VersionIndependentResourceTypesAllDevicecomponent,
+		VersionIndependentResourceTypesAllDeviceuserequest,
+		VersionIndependentResourceTypesAllDeviceusestatement,
+		VersionIndependentResourceTypesAllDiagnosticorder,
+		VersionIndependentResourceTypesAllDocumentmanifest,
+		VersionIndependentResourceTypesAllEffectevidencesynthesis,
+		VersionIndependentResourceTypesAllEligibilityrequest,
+		VersionIndependentResourceTypesAllEligibilityresponse,
+		VersionIndependentResourceTypesAllExpansionprofile,
+		VersionIndependentResourceTypesAllImagingmanifest,
+		VersionIndependentResourceTypesAllImagingobjectselection,
+		VersionIndependentResourceTypesAllMedia,
+		VersionIndependentResourceTypesAllMedicationorder,
+		VersionIndependentResourceTypesAllMedicationusage,
+		VersionIndependentResourceTypesAllMedicinalproduct,
+		VersionIndependentResourceTypesAllMedicinalproductauthorization,
+		VersionIndependentResourceTypesAllMedicinalproductcontraindication,
+		VersionIndependentResourceTypesAllMedicinalproductindication,
+		VersionIndependentResourceTypesAllMedicinalproductingredient,
+		VersionIndependentResourceTypesAllMedicinalproductinteraction,
+		VersionIndependentResourceTypesAllMedicinalproductmanufactured,
+		VersionIndependentResourceTypesAllMedicinalproductpackaged,
+		VersionIndependentResourceTypesAllMedicinalproductpharmaceutical,
+		VersionIndependentResourceTypesAllMedicinalproductundesirableeffect,
+		VersionIndependentResourceTypesAllOrder,
+		VersionIndependentResourceTypesAllOrderresponse,
+		VersionIndependentResourceTypesAllProcedurerequest,
+		VersionIndependentResourceTypesAllProcessrequest,
+		VersionIndependentResourceTypesAllProcessresponse,
+		VersionIndependentResourceTypesAllReferralrequest,
+		VersionIndependentResourceTypesAllRequestgroup,
+		VersionIndependentResourceTypesAllResearchdefinition,
+		VersionIndependentResourceTypesAllResearchelementdefinition,
+		VersionIndependentResourceTypesAllRiskevidencesynthesis,
+		VersionIndependentResourceTypesAllSequence,
+		VersionIndependentResourceTypesAllServicedefinition,
+		VersionIndependentResourceTypesAllSubstancespecification:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first VersionIndependentResourceTypesAll value in definition order.
+func (VersionIndependentResourceTypesAll) firstCode() string {
+	return string(VersionIndependentResourceTypesAllBodysite)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c VersionIndependentResourceTypesAll) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *VersionIndependentResourceTypesAll) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // ResourceVersionPolicy represents Resource Version Policy.
 type ResourceVersionPolicy string
 
@@ -4574,6 +11493,32 @@ const (
 	ResourceVersionPolicyVersionedUpdate ResourceVersionPolicy = "versioned-update"
 )
 
+// known reports whether c is one of the ResourceVersionPolicy values.
+func (c ResourceVersionPolicy) known() bool {
+	switch c {
+	case ResourceVersionPolicyNoVersion,
+		ResourceVersionPolicyVersioned,
+		ResourceVersionPolicyVersionedUpdate:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first ResourceVersionPolicy value in definition order.
+func (ResourceVersionPolicy) firstCode() string {
+	return string(ResourceVersionPolicyNoVersion)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c ResourceVersionPolicy) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *ResourceVersionPolicy) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // VisionBase represents Vision Base.
 type VisionBase string
 
@@ -4589,6 +11534,33 @@ const (
 	VisionBaseOut VisionBase = "out"
 )
 
+// known reports whether c is one of the VisionBase values.
+func (c VisionBase) known() bool {
+	switch c {
+	case VisionBaseUp,
+		VisionBaseDown,
+		VisionBaseIn,
+		VisionBaseOut:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first VisionBase value in definition order.
+func (VisionBase) firstCode() string {
+	return string(VisionBaseUp)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c VisionBase) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *VisionBase) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
+
 // VisionEyes represents Vision Eyes.
 type VisionEyes string
 
@@ -4599,3 +11571,28 @@ const (
 	// VisionEyesLeft - Left Eye
 	VisionEyesLeft VisionEyes = "left"
 )
+
+// known reports whether c is one of the VisionEyes values.
+func (c VisionEyes) known() bool {
+	switch c {
+	case VisionEyesRight,
+		VisionEyesLeft:
+		return true
+	}
+	return false
+}
+
+// firstCode returns the first VisionEyes value in definition order.
+func (VisionEyes) firstCode() string {
+	return string(VisionEyesRight)
+}
+
+// String returns the code, implementing fmt.Stringer.
+func (c VisionEyes) String() string {
+	return string(c)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, honoring StrictCodes.
+func (c *VisionEyes) UnmarshalJSON(data []byte) error {
+	return unmarshalCode(data, c)
+}
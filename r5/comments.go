@@ -0,0 +1,270 @@
+package r5
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CommentedResource is a resource together with the fhir_comments arrays of
+// its JSON form, which UnmarshalResource drops. Decoding into a
+// CommentedResource instead of calling UnmarshalResource captures them, and
+// marshaling it writes them back in place:
+//
+//	var c r5.CommentedResource
+//	if err := json.Unmarshal(data, &c); err != nil {
+//		return err
+//	}
+//	// ... work with c.Resource ...
+//	out, err := r5.Marshal(c)
+//
+// Comments maps the path of each JSON object carrying fhir_comments to its
+// comments. Paths are built from JSON property names with zero-based
+// indexes, rooted at the resource type, e.g. "Observation.code", or
+// "Patient._birthDate" for a comment on the birthDate primitive. Unlike Walk
+// paths, contained resources are always indexed by position.
+//
+// When marshaling, comments on a primitive whose extension companion is
+// absent create it; comments on any other path not present in Resource are
+// dropped.
+type CommentedResource struct {
+	Resource Resource
+	Comments map[string][]string
+}
+
+// UnmarshalJSON decodes a resource as UnmarshalResource does, also
+// collecting its fhir_comments into Comments.
+func (c *CommentedResource) UnmarshalJSON(data []byte) error {
+	r, err := UnmarshalResource(data)
+	if err != nil {
+		return err
+	}
+	var tree any
+	if err := json.Unmarshal(data, &tree); err != nil {
+		return err
+	}
+	comments := make(map[string][]string)
+	collectComments(r.GetResourceType(), tree, comments)
+	if len(comments) == 0 {
+		comments = nil
+	}
+	c.Resource = r
+	c.Comments = comments
+	return nil
+}
+
+// MarshalJSON encodes Resource as Marshal does, inserting Comments as the
+// first member of the objects at their paths. Use Marshal rather than
+// json.Marshal to keep narrative HTML unescaped.
+func (c CommentedResource) MarshalJSON() ([]byte, error) {
+	if c.Resource == nil {
+		return []byte("null"), nil
+	}
+	data, err := Marshal(c.Resource)
+	if err != nil || len(c.Comments) == 0 {
+		return data, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	w := &commentWriter{dec: dec, comments: c.Comments}
+	if _, _, err := w.value(c.Resource.GetResourceType()); err != nil {
+		return nil, fmt.Errorf("failed to insert fhir_comments: %w", err)
+	}
+	return w.buf.Bytes(), nil
+}
+
+// collectComments records the fhir_comments of v, a decoded JSON value at
+// path, and of every object beneath it.
+func collectComments(path string, v any, comments map[string][]string) {
+	switch v := v.(type) {
+	case map[string]any:
+		if list, ok := v["fhir_comments"].([]any); ok {
+			for _, item := range list {
+				if s, ok := item.(string); ok {
+					comments[path] = append(comments[path], s)
+				}
+			}
+		}
+		for key, child := range v {
+			if key != "fhir_comments" {
+				collectComments(path+"."+key, child, comments)
+			}
+		}
+	case []any:
+		for i, child := range v {
+			collectComments(path+"["+strconv.Itoa(i)+"]", child, comments)
+		}
+	}
+}
+
+// Kinds of JSON value copied by commentWriter.value.
+const (
+	jsonScalar = iota
+	jsonObject
+	jsonArray
+)
+
+// commentWriter copies JSON tokens from dec to buf, inserting comments.
+type commentWriter struct {
+	dec      *json.Decoder
+	comments map[string][]string
+	buf      bytes.Buffer
+}
+
+// value copies the next JSON value, found at path. It returns the kind of
+// the value and, for arrays, the number of elements. Arrays holding objects
+// are reported as jsonObject, since they have no extension companion.
+func (w *commentWriter) value(path string) (kind int, n int, err error) {
+	tok, err := w.dec.Token()
+	if err != nil {
+		return 0, 0, err
+	}
+	switch tok {
+	case json.Delim('{'):
+		return jsonObject, 0, w.object(path)
+	case json.Delim('['):
+		return w.array(path)
+	}
+	return jsonScalar, 0, w.encode(tok)
+}
+
+// object copies the members of an object whose opening brace has been read.
+// A primitive member whose extension companion does not follow it gets one
+// if there are comments for the companion.
+func (w *commentWriter) object(path string) error {
+	w.buf.WriteByte('{')
+	members := 0
+	member := func(key string) error {
+		if members > 0 {
+			w.buf.WriteByte(',')
+		}
+		members++
+		if err := w.encode(key); err != nil {
+			return err
+		}
+		w.buf.WriteByte(':')
+		return nil
+	}
+	if list, ok := w.comments[path]; ok {
+		if err := member("fhir_comments"); err != nil {
+			return err
+		}
+		if err := w.encode(list); err != nil {
+			return err
+		}
+	}
+
+	// The last primitive member, until its companion is seen or ruled out.
+	var prev string
+	var prevKind, prevLen int
+	companion := func() error {
+		if prev == "" {
+			return nil
+		}
+		key := "_" + prev
+		prev = ""
+		c, ok := w.companion(path+"."+key, prevKind, prevLen)
+		if !ok {
+			return nil
+		}
+		if err := member(key); err != nil {
+			return err
+		}
+		return w.encode(c)
+	}
+
+	for w.dec.More() {
+		tok, err := w.dec.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("unexpected object key %v", tok)
+		}
+		if prev != "" && key == "_"+prev {
+			prev = ""
+		}
+		if err := companion(); err != nil {
+			return err
+		}
+		if err := member(key); err != nil {
+			return err
+		}
+		kind, n, err := w.value(path + "." + key)
+		if err != nil {
+			return err
+		}
+		if !strings.HasPrefix(key, "_") && kind != jsonObject {
+			prev, prevKind, prevLen = key, kind, n
+		}
+	}
+	if err := companion(); err != nil {
+		return err
+	}
+	if _, err := w.dec.Token(); err != nil {
+		return err
+	}
+	w.buf.WriteByte('}')
+	return nil
+}
+
+// array copies the elements of an array whose opening bracket has been read.
+func (w *commentWriter) array(path string) (kind int, n int, err error) {
+	w.buf.WriteByte('[')
+	scalars := true
+	for ; w.dec.More(); n++ {
+		if n > 0 {
+			w.buf.WriteByte(',')
+		}
+		k, _, err := w.value(path + "[" + strconv.Itoa(n) + "]")
+		if err != nil {
+			return 0, 0, err
+		}
+		scalars = scalars && k == jsonScalar
+	}
+	if _, err := w.dec.Token(); err != nil {
+		return 0, 0, err
+	}
+	w.buf.WriteByte(']')
+	if !scalars {
+		return jsonObject, n, nil
+	}
+	return jsonArray, n, nil
+}
+
+// companion returns the extension companion to create at path for a missing
+// primitive companion of the given kind, and false if there are no comments
+// for it. Array companions hold null for elements without comments.
+func (w *commentWriter) companion(path string, kind, n int) (any, bool) {
+	if kind == jsonScalar {
+		list, ok := w.comments[path]
+		return map[string]any{"fhir_comments": list}, ok
+	}
+	elems := make([]any, n)
+	found := false
+	for i := range elems {
+		if list, ok := w.comments[path+"["+strconv.Itoa(i)+"]"]; ok {
+			elems[i] = map[string]any{"fhir_comments": list}
+			found = true
+		}
+	}
+	return elems, found
+}
+
+// encode writes v as JSON without HTML escaping.
+func (w *commentWriter) encode(v any) error {
+	if n, ok := v.(json.Number); ok {
+		w.buf.WriteString(n.String())
+		return nil
+	}
+	enc := json.NewEncoder(&w.buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	w.buf.Truncate(w.buf.Len() - 1) // trailing newline
+	return nil
+}
@@ -0,0 +1,63 @@
+package r5_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r5"
+)
+
+func TestDecimalFormat(t *testing.T) {
+	qty := r5.Quantity{Value: r5.MustDecimal("1.5"), Unit: ptrString("mg")}
+
+	data, err := json.Marshal(qty)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"value":1.5,"unit":"mg"}`, string(data))
+	assert.Contains(t, string(data), `"value":1.5,`)
+
+	r5.DecimalFormat = r5.FixedDecimalFormat(3)
+	t.Cleanup(func() { r5.DecimalFormat = nil })
+
+	data, err = json.Marshal(qty)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"value":1.500,`)
+
+	data, err = json.Marshal(r5.MustDecimal("2.71828"))
+	require.NoError(t, err)
+	assert.Equal(t, "2.718", string(data))
+
+	// Decoding is unaffected.
+	var decoded r5.Quantity
+	require.NoError(t, json.Unmarshal([]byte(`{"value":1.25}`), &decoded))
+	assert.Equal(t, "1.25", decoded.Value.String())
+}
+
+func TestDecimal_UnmarshalJSONIntegerForms(t *testing.T) {
+	for _, tt := range []struct {
+		json  string
+		want  string
+		scale int
+	}{
+		{"100", "100", 0},
+		{"100.0", "100.0", 1},
+		{"100.00", "100.00", 2},
+	} {
+		t.Run(tt.json, func(t *testing.T) {
+			var obs r5.Observation
+			require.NoError(t, json.Unmarshal([]byte(`{"resourceType":"Observation","valueQuantity":{"value":`+tt.json+`}}`), &obs))
+			require.NotNil(t, obs.ValueQuantity)
+			got := obs.ValueQuantity.Value.String()
+			assert.Equal(t, tt.want, got)
+			_, frac, _ := strings.Cut(got, ".")
+			assert.Len(t, frac, tt.scale)
+
+			data, err := json.Marshal(obs.ValueQuantity.Value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.json, string(data))
+		})
+	}
+}
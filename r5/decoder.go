@@ -0,0 +1,171 @@
+package r5
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// Decoder reads a stream of whitespace- or newline-separated FHIR JSON
+// resources (such as NDJSON or the output of an Encoder), reusing an internal
+// scratch buffer across calls. Each resource is dispatched on its
+// resourceType as with UnmarshalResource.
+//
+// A Decoder is not safe for concurrent use.
+type Decoder struct {
+	dec *json.Decoder
+	raw json.RawMessage
+}
+
+// NewDecoder returns a Decoder that reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{dec: json.NewDecoder(r)}
+}
+
+// Decode reads the next resource from the stream. It returns io.EOF when the
+// stream ends cleanly.
+func (d *Decoder) Decode() (Resource, error) {
+	if err := d.dec.Decode(&d.raw); err != nil {
+		return nil, err
+	}
+	return UnmarshalResource(d.raw)
+}
+
+// DecodeInto unmarshals the JSON resource in data into r, which must be a
+// non-nil pointer to a resource struct such as *Patient. It lets hot paths
+// reuse one value instead of allocating a new resource per call, as
+// UnmarshalResource does. r is reset to its zero value first, so no fields
+// survive from a previous decode.
+//
+// It returns an error if the resourceType of data does not match the type of
+// r.
+func DecodeInto(data []byte, r Resource) error {
+	v := reflect.ValueOf(r)
+	if r == nil || v.Kind() != reflect.Pointer || v.IsNil() {
+		return errors.New("DecodeInto requires a non-nil resource pointer")
+	}
+	resourceType, err := GetResourceType(data)
+	if err != nil {
+		return fmt.Errorf("failed to get resource type: %w", err)
+	}
+	if want := r.GetResourceType(); resourceType != want {
+		return fmt.Errorf("cannot decode %s into %s", resourceType, want)
+	}
+	v.Elem().SetZero()
+	if err := json.Unmarshal(data, r); err != nil {
+		return fmt.Errorf("failed to unmarshal %s: %w", resourceType, err)
+	}
+	return nil
+}
+
+// DecodeBundleEntries reads a JSON Bundle from r one entry at a time,
+// without holding the whole Bundle in memory, and calls fn for each entry in
+// order. Bundle-level elements other than entry are skipped. No entry is
+// handed to fn before the resourceType is known to be Bundle: entries that
+// precede it in the JSON are buffered until it is read, and it is an error
+// for the resourceType to be missing or anything else.
+//
+// fn always receives the raw entry bytes. An entry that is well-formed JSON
+// but cannot be decoded (e.g. an unknown resourceType or a value of the
+// wrong type) does not abort the stream: fn is called with a nil entry and
+// the decode error, and may return nil to carry on. Otherwise fn gets the
+// entry and a nil error.
+// Decoding stops at the first non-nil error returned by fn, which is
+// returned, and at malformed JSON, from which the stream cannot recover.
+func DecodeBundleEntries(r io.Reader, fn func(entry *BundleEntry, raw []byte, err error) error) error {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return fmt.Errorf("failed to read bundle: %w", err)
+	}
+	var (
+		isBundle bool
+		pending  []json.RawMessage // entries read before resourceType
+	)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("failed to read bundle: %w", err)
+		}
+		switch key := tok.(string); key {
+		case "resourceType":
+			var resourceType string
+			if err := dec.Decode(&resourceType); err != nil {
+				return fmt.Errorf("failed to read bundle: %w", err)
+			}
+			if resourceType != "Bundle" {
+				return fmt.Errorf("expected resourceType Bundle, got %s", resourceType)
+			}
+			isBundle = true
+			for i, raw := range pending {
+				if err := decodeBundleEntry(i, raw, fn); err != nil {
+					return err
+				}
+			}
+			pending = nil
+		case "entry":
+			if !isBundle {
+				if err := dec.Decode(&pending); err != nil {
+					return fmt.Errorf("failed to read bundle entries: %w", err)
+				}
+				continue
+			}
+			if err := decodeBundleEntries(dec, fn); err != nil {
+				return err
+			}
+		default:
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return fmt.Errorf("failed to read bundle: %w", err)
+			}
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	if !isBundle {
+		return errors.New("expected resourceType Bundle, got none")
+	}
+	return nil
+}
+
+// decodeBundleEntries decodes the entry array of a Bundle for
+// DecodeBundleEntries.
+func decodeBundleEntries(dec *json.Decoder, fn func(*BundleEntry, []byte, error) error) error {
+	if err := expectDelim(dec, '['); err != nil {
+		return fmt.Errorf("failed to read bundle entries: %w", err)
+	}
+	for i := 0; dec.More(); i++ {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return fmt.Errorf("failed to read entry %d: %w", i, err)
+		}
+		if err := decodeBundleEntry(i, raw, fn); err != nil {
+			return err
+		}
+	}
+	_, err := dec.Token()
+	return err
+}
+
+// decodeBundleEntry decodes entry i of a Bundle and passes it to fn.
+func decodeBundleEntry(i int, raw json.RawMessage, fn func(*BundleEntry, []byte, error) error) error {
+	var entry BundleEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return fn(nil, raw, fmt.Errorf("entry %d: %w", i, err))
+	}
+	return fn(&entry, raw, nil)
+}
+
+// expectDelim reads the next token from dec and checks that it is delim.
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != delim {
+		return fmt.Errorf("expected %v, got %v", delim, tok)
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package r5_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r5"
+)
+
+func TestDecoder(t *testing.T) {
+	t.Run("concatenated stream", func(t *testing.T) {
+		stream := `{"resourceType":"Patient","id":"p1"}
+{"resourceType":"Observation","id":"o1","status":"final","code":{"text":"hr"}}  {"resourceType":"Organization",
+"id":"org1"}
+`
+		dec := r5.NewDecoder(strings.NewReader(stream))
+
+		var types, ids []string
+		for {
+			r, err := dec.Decode()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			types = append(types, r.GetResourceType())
+			ids = append(ids, *r.GetId())
+		}
+		assert.Equal(t, []string{"Patient", "Observation", "Organization"}, types)
+		assert.Equal(t, []string{"p1", "o1", "org1"}, ids)
+	})
+
+	t.Run("reads encoder output", func(t *testing.T) {
+		var buf bytes.Buffer
+		enc := r5.NewEncoder(&buf)
+		require.NoError(t, enc.Encode(&r5.Patient{Id: ptrString("a")}))
+		require.NoError(t, enc.Encode(&r5.Patient{Id: ptrString("b")}))
+
+		dec := r5.NewDecoder(&buf)
+		first, err := dec.Decode()
+		require.NoError(t, err)
+		second, err := dec.Decode()
+		require.NoError(t, err)
+		_, err = dec.Decode()
+		assert.Equal(t, io.EOF, err)
+
+		// earlier results are unaffected by buffer reuse
+		assert.Equal(t, "a", *first.GetId())
+		assert.Equal(t, "b", *second.GetId())
+	})
+
+	t.Run("invalid resource", func(t *testing.T) {
+		dec := r5.NewDecoder(strings.NewReader(`{"id":"x"}`))
+		_, err := dec.Decode()
+		require.Error(t, err)
+		assert.NotEqual(t, io.EOF, err)
+	})
+
+	t.Run("empty stream", func(t *testing.T) {
+		_, err := r5.NewDecoder(strings.NewReader("  \n")).Decode()
+		assert.Equal(t, io.EOF, err)
+	})
+}
+
+func TestDecodeInto(t *testing.T) {
+	t.Run("reuses a resource", func(t *testing.T) {
+		var p r5.Patient
+		require.NoError(t, r5.DecodeInto([]byte(`{"resourceType":"Patient","id":"p1","active":true}`), &p))
+		assert.Equal(t, "p1", *p.Id)
+		assert.True(t, *p.Active)
+
+		require.NoError(t, r5.DecodeInto([]byte(`{"resourceType":"Patient","id":"p2","contained":[{"resourceType":"Organization","id":"o1"}]}`), &p))
+		assert.Equal(t, "p2", *p.Id)
+		assert.Nil(t, p.Active, "fields from the previous decode are cleared")
+		require.Len(t, p.Contained, 1)
+		assert.IsType(t, &r5.Organization{}, p.Contained[0])
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		p := &r5.Patient{Id: ptrString("keep")}
+		err := r5.DecodeInto([]byte(`{"resourceType":"Observation","id":"o1"}`), p)
+		assert.EqualError(t, err, "cannot decode Observation into Patient")
+		assert.Equal(t, "keep", *p.Id)
+	})
+
+	t.Run("missing resourceType", func(t *testing.T) {
+		err := r5.DecodeInto([]byte(`{"id":"p1"}`), &r5.Patient{})
+		assert.Error(t, err)
+	})
+
+	t.Run("nil resource", func(t *testing.T) {
+		var p *r5.Patient
+		assert.Error(t, r5.DecodeInto([]byte(`{"resourceType":"Patient"}`), p))
+		assert.Error(t, r5.DecodeInto([]byte(`{"resourceType":"Patient"}`), nil))
+	})
+}
+
+func TestDecodeBundleEntries(t *testing.T) {
+	const bundle = `{
+		"resourceType": "Bundle",
+		"type": "collection",
+		"entry": [
+			{"resource": {"resourceType": "Patient", "id": "p1"}},
+			{"resource": {"resourceType": "Unicorn", "id": "u1"}},
+			{"resource": {"resourceType": "Patient", "id": "p2", "active": "yes"}},
+			{"fullUrl": "urn:uuid:1", "resource": {"resourceType": "Organization", "id": "o1"}}
+		],
+		"total": 4
+	}`
+
+	t.Run("reports bad entries and continues", func(t *testing.T) {
+		var ids, bad []string
+		var errs []error
+		err := r5.DecodeBundleEntries(strings.NewReader(bundle), func(entry *r5.BundleEntry, raw []byte, err error) error {
+			if err != nil {
+				assert.Nil(t, entry)
+				bad = append(bad, string(raw))
+				errs = append(errs, err)
+				return nil
+			}
+			ids = append(ids, *entry.Resource.GetId())
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"p1", "o1"}, ids)
+		assert.Equal(t, []string{
+			`{"resource": {"resourceType": "Unicorn", "id": "u1"}}`,
+			`{"resource": {"resourceType": "Patient", "id": "p2", "active": "yes"}}`,
+		}, bad)
+		require.Len(t, errs, 2)
+		assert.ErrorContains(t, errs[0], "entry 1: ")
+		assert.ErrorContains(t, errs[1], "entry 2: ")
+	})
+
+	t.Run("callback error stops decoding", func(t *testing.T) {
+		stop := errors.New("stop")
+		calls := 0
+		err := r5.DecodeBundleEntries(strings.NewReader(bundle), func(_ *r5.BundleEntry, _ []byte, err error) error {
+			calls++
+			return err
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "entry 1")
+		assert.Equal(t, 2, calls)
+
+		err = r5.DecodeBundleEntries(strings.NewReader(bundle), func(*r5.BundleEntry, []byte, error) error {
+			return stop
+		})
+		assert.ErrorIs(t, err, stop)
+	})
+
+	t.Run("malformed JSON", func(t *testing.T) {
+		err := r5.DecodeBundleEntries(strings.NewReader(`{"resourceType":"Bundle","entry":[{"resource":}]}`),
+			func(*r5.BundleEntry, []byte, error) error { return nil })
+		assert.Error(t, err)
+	})
+
+	t.Run("not a bundle", func(t *testing.T) {
+		err := r5.DecodeBundleEntries(strings.NewReader(`{"resourceType":"Patient","id":"p1"}`),
+			func(*r5.BundleEntry, []byte, error) error { return nil })
+		assert.EqualError(t, err, "expected resourceType Bundle, got Patient")
+	})
+
+	t.Run("entry before resourceType", func(t *testing.T) {
+		var ids []string
+		collect := func(entry *r5.BundleEntry, _ []byte, err error) error {
+			require.NoError(t, err)
+			ids = append(ids, *entry.Resource.GetId())
+			return nil
+		}
+
+		err := r5.DecodeBundleEntries(strings.NewReader(
+			`{"entry":[{"resource":{"resourceType":"Patient","id":"p1"}},{"resource":{"resourceType":"Patient","id":"p2"}}],"resourceType":"Bundle"}`),
+			collect)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"p1", "p2"}, ids)
+
+		ids = nil
+		err = r5.DecodeBundleEntries(strings.NewReader(
+			`{"entry":[{"resource":{"resourceType":"Patient","id":"p1"}}],"resourceType":"Parameters"}`),
+			collect)
+		assert.EqualError(t, err, "expected resourceType Bundle, got Parameters")
+		assert.Empty(t, ids)
+
+		err = r5.DecodeBundleEntries(strings.NewReader(
+			`{"entry":[{"resource":{"resourceType":"Patient","id":"p1"}}]}`),
+			collect)
+		assert.EqualError(t, err, "expected resourceType Bundle, got none")
+		assert.Empty(t, ids)
+	})
+}
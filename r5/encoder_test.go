@@ -0,0 +1,83 @@
+package r5_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r5"
+)
+
+func TestEncoder(t *testing.T) {
+	t.Run("writes one resource per line", func(t *testing.T) {
+		var out bytes.Buffer
+		enc := r5.NewEncoder(&out)
+
+		patient := &r5.Patient{Id: ptrString("p1")}
+		org := &r5.Organization{Id: ptrString("o1")}
+		require.NoError(t, enc.Encode(patient))
+		require.NoError(t, enc.Encode(org))
+
+		lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+		require.Len(t, lines, 2)
+		want, err := r5.Marshal(patient)
+		require.NoError(t, err)
+		assert.Equal(t, string(want), lines[0])
+		assert.JSONEq(t, `{"resourceType":"Organization","id":"o1"}`, lines[1])
+	})
+
+	t.Run("does not escape HTML", func(t *testing.T) {
+		var out bytes.Buffer
+		div := `<div xmlns="http://www.w3.org/1999/xhtml"><b>x</b></div>`
+		patient := &r5.Patient{Text: &r5.Narrative{Div: &div}}
+
+		require.NoError(t, r5.NewEncoder(&out).Encode(patient))
+		assert.Contains(t, out.String(), "<b>x</b>")
+	})
+
+	t.Run("nil resource", func(t *testing.T) {
+		assert.Error(t, r5.NewEncoder(io.Discard).Encode(nil))
+	})
+}
+
+func benchmarkPatient() *r5.Patient {
+	gender := r5.AdministrativeGenderFemale
+	return &r5.Patient{
+		Id:     ptrString("p1"),
+		Active: ptrBool(true),
+		Name:   []r5.HumanName{{Family: ptrString("Doe"), Given: []string{"Jane", "Q"}}},
+		Gender: &gender,
+		Identifier: []r5.Identifier{
+			{System: ptrString("urn:oid:1.2.3"), Value: ptrString("12345")},
+		},
+	}
+}
+
+func BenchmarkMarshal(b *testing.B) {
+	patient := benchmarkPatient()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		data, err := r5.Marshal(patient)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := io.Discard.Write(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEncoder(b *testing.B) {
+	patient := benchmarkPatient()
+	enc := r5.NewEncoder(io.Discard)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := enc.Encode(patient); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package r5
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+)
+
+// RemoveExtensions returns a copy of r without the extensions, and modifier
+// extensions, whose url starts with urlPrefix, e.g. a vendor namespace such
+// as "http://vendor.example.org/fhir/". Matching extensions are removed at
+// every level: on the resource and its elements, on primitive extension
+// companions (e.g. Patient._birthDate), within other extensions, and in
+// contained and Bundle entry resources. A primitive companion left empty is
+// removed too. r is not modified.
+//
+// Every url starts with the empty prefix, so an empty urlPrefix removes all
+// extensions and modifier extensions.
+func RemoveExtensions(r Resource, urlPrefix string) Resource {
+	if r == nil {
+		return nil
+	}
+	c := cloneResource(r)
+	var nodes []reflect.Value
+	_ = Walk(c, func(_ string, node any) error {
+		nodes = append(nodes, reflect.ValueOf(node))
+		return nil
+	})
+	// Visit children before their parents so emptied companions are seen.
+	for i := len(nodes) - 1; i >= 0; i-- {
+		v := nodes[i]
+		if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+			continue
+		}
+		removeExtensions(v.Elem(), urlPrefix)
+	}
+	return c
+}
+
+// removeExtensions drops the matching entries of the []Extension fields of
+// struct s and clears primitive companions that are left empty.
+func removeExtensions(s reflect.Value, urlPrefix string) {
+	t := s.Type()
+	for i := 0; i < s.NumField(); i++ {
+		f := s.Field(i)
+		if !f.CanSet() {
+			continue
+		}
+		switch exts := f.Interface().(type) {
+		case []Extension:
+			kept := exts[:0]
+			for _, ext := range exts {
+				if !strings.HasPrefix(ext.Url, urlPrefix) {
+					kept = append(kept, ext)
+				}
+			}
+			if len(kept) == 0 {
+				kept = nil
+			}
+			f.Set(reflect.ValueOf(kept))
+		case *Element:
+			if exts != nil && strings.HasPrefix(jsonFieldName(t.Field(i)), "_") && reflect.ValueOf(*exts).IsZero() {
+				f.Set(reflect.Zero(f.Type()))
+			}
+		case []Element:
+			if strings.HasPrefix(jsonFieldName(t.Field(i)), "_") && allZero(exts) {
+				f.Set(reflect.Zero(f.Type()))
+			}
+		}
+	}
+}
+
+// allZero reports whether every entry of elems is empty.
+func allZero(elems []Element) bool {
+	for i := range elems {
+		if !reflect.ValueOf(elems[i]).IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckModifierExtensions reports the modifier extensions in r whose url is
+// not in known. FHIR requires processors to reject resources carrying
+// modifier extensions they do not understand, since these may change the
+// meaning of the element they sit on. Modifier extensions are checked at
+// every level, including contained and Bundle entry resources.
+//
+// It returns nil if every modifier extension is known, or a *ValidationError
+// per unknown one joined with errors.Join.
+func CheckModifierExtensions(r Resource, known map[string]bool) error {
+	var errs []error
+	_ = Walk(r, func(path string, node any) error {
+		ext, ok := node.(*Extension)
+		if !ok || !strings.HasPrefix(path[strings.LastIndex(path, ".")+1:], "modifierExtension[") {
+			return nil
+		}
+		if !known[ext.Url] {
+			errs = append(errs, &ValidationError{
+				Path:    path,
+				Message: "unknown modifier extension " + ext.Url,
+			})
+		}
+		return nil
+	})
+	return errors.Join(errs...)
+}
+
+// ExtensionURLs returns the distinct urls of the extensions used in r, in
+// first-visit Walk order. It covers extensions and modifier extensions at
+// every level, those on primitive extension companions (e.g. _birthDate),
+// extensions nested within other extensions, and those in contained and
+// Bundle entry resources. Extensions without a url are ignored.
+func ExtensionURLs(r Resource) []string {
+	var urls []string
+	seen := make(map[string]bool)
+	_ = Walk(r, func(_ string, node any) error {
+		if ext, ok := node.(*Extension); ok && ext.Url != "" && !seen[ext.Url] {
+			seen[ext.Url] = true
+			urls = append(urls, ext.Url)
+		}
+		return nil
+	})
+	return urls
+}
@@ -0,0 +1,47 @@
+package r5
+
+import (
+	"encoding/xml"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// narrativeTagRe matches a markup tag, for narratives that do not parse.
+var narrativeTagRe = regexp.MustCompile(`<[^>]*>`)
+
+// PlainText returns the textual content of the narrative's XHTML div, for
+// search indexing and previews. Tags are removed, entities such as &amp; and
+// &nbsp; are decoded, and runs of whitespace are collapsed to single spaces.
+// Elements are treated as word boundaries, so "<td>a</td><td>b</td>" yields
+// "a b". It returns "" if the narrative has no div.
+func (n Narrative) PlainText() string {
+	if n.Div == nil {
+		return ""
+	}
+	var sb strings.Builder
+	dec := xml.NewDecoder(strings.NewReader(*n.Div))
+	dec.Strict = false
+	dec.AutoClose = xml.HTMLAutoClose
+	dec.Entity = xml.HTMLEntity
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// Not well-formed: strip the tags textually instead.
+			sb.Reset()
+			sb.WriteString(html.UnescapeString(narrativeTagRe.ReplaceAllString(*n.Div, " ")))
+			break
+		}
+		switch tok := tok.(type) {
+		case xml.CharData:
+			sb.Write(tok)
+		case xml.StartElement, xml.EndElement:
+			sb.WriteByte(' ')
+		}
+	}
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
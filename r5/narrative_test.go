@@ -0,0 +1,40 @@
+package r5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gofhir/models/r5"
+)
+
+func TestNarrativePlainText(t *testing.T) {
+	tests := map[string]struct {
+		div  string
+		want string
+	}{
+		"nested tags and entities": {
+			div: `<div xmlns="http://www.w3.org/1999/xhtml">
+				<p>Patient <b>John &amp; Jane</b> Doe</p>
+				<table><tr><td>BP</td><td>120&#160;mmHg &lt;normal&gt;</td></tr></table>
+			</div>`,
+			want: "Patient John & Jane Doe BP 120 mmHg <normal>",
+		},
+		"html entity": {
+			div:  `<div xmlns="http://www.w3.org/1999/xhtml">a&nbsp;b<br/>c</div>`,
+			want: "a b c",
+		},
+		"not well-formed": {
+			div:  `<div>Unclosed <b>bold &amp; more</div>`,
+			want: "Unclosed bold & more",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			n := r5.Narrative{Div: &tt.div}
+			assert.Equal(t, tt.want, n.PlainText())
+		})
+	}
+
+	assert.Equal(t, "", r5.Narrative{}.PlainText())
+}
@@ -0,0 +1,202 @@
+package r5
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// NodeAt returns the element of r at a simple FHIRPath made of member names
+// and indexes, such as "Observation.component[0].value", e.g. to show the
+// offending node of a validation error. Contained resources may also be
+// indexed by id, as in Walk paths ("Observation.contained[#p1].name").
+//
+// The first segment must be r's resource type. Choice elements may be named
+// by their base name ("value") or their typed JSON name ("valueQuantity").
+// A repeating element without an index only resolves when it holds exactly
+// one item. Nodes are returned as in Walk: a pointer into r (e.g. *Quantity,
+// *string) or a Resource for contained resources. The second result is false
+// if the path is malformed or the element is absent.
+func NodeAt(r Resource, path string) (any, bool) {
+	if r == nil {
+		return nil, false
+	}
+	segments, ok := splitPath(path)
+	if !ok || segments[0] != r.GetResourceType() {
+		return nil, false
+	}
+
+	// elemPath is the element definition path of v, used to tell choice
+	// elements from other fields sharing a prefix.
+	elemPath := segments[0]
+	v := reflect.ValueOf(r)
+	for _, seg := range segments[1:] {
+		name, key, hasIndex, ok := parsePathSegment(seg)
+		if !ok || v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+			return nil, false
+		}
+		jsonName := name
+		f, ok := fieldByJSONName(v.Elem(), name)
+		if !ok {
+			// Only choice elements may be named by their base name.
+			if FHIRPathModel().ChoiceTypes(elemPath+"."+name) == nil {
+				return nil, false
+			}
+			if f, jsonName, ok = choiceField(v.Elem(), name); !ok {
+				return nil, false
+			}
+		}
+		var index int
+		if hasIndex {
+			// Contained resources are indexed by "#id" in Walk paths.
+			if id, isID := strings.CutPrefix(key, "#"); isID {
+				if index, ok = indexOfResource(f, id); !ok {
+					return nil, false
+				}
+			} else {
+				var err error
+				if index, err = strconv.Atoi(key); err != nil || index < 0 {
+					return nil, false
+				}
+			}
+		}
+		if f.Kind() == reflect.Slice {
+			if !hasIndex {
+				if f.Len() != 1 {
+					return nil, false
+				}
+				index = 0
+			}
+			if index >= f.Len() {
+				return nil, false
+			}
+			f = f.Index(index)
+		} else if hasIndex && index != 0 {
+			return nil, false
+		}
+		if v, ok = nodeValue(f); !ok {
+			return nil, false
+		}
+		elemPath = childElementPath(v, elemPath+"."+jsonName)
+	}
+	return v.Interface(), true
+}
+
+// parsePathSegment splits "name[3]" or "name[#id]" into its name and index key.
+func parsePathSegment(seg string) (name, key string, hasIndex, ok bool) {
+	open := strings.IndexByte(seg, '[')
+	if open < 0 {
+		return seg, "", false, seg != ""
+	}
+	if open == 0 || !strings.HasSuffix(seg, "]") {
+		return "", "", false, false
+	}
+	key = seg[open+1 : len(seg)-1]
+	if key == "" {
+		return "", "", false, false
+	}
+	return seg[:open], key, true, true
+}
+
+// indexOfResource returns the index of the resource with the given id in
+// the []Resource value f.
+func indexOfResource(f reflect.Value, id string) (int, bool) {
+	if f.Kind() != reflect.Slice {
+		return 0, false
+	}
+	for i := 0; i < f.Len(); i++ {
+		if resourceID(f.Index(i)) == id {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// splitPath splits path on the dots outside brackets, so that ids such as
+// "contained[#org.1]" stay in one segment.
+func splitPath(path string) ([]string, bool) {
+	var segments []string
+	start, inKey := 0, false
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case '[':
+			if inKey {
+				return nil, false
+			}
+			inKey = true
+		case ']':
+			inKey = false
+		case '.':
+			if !inKey {
+				segments = append(segments, path[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if inKey {
+		return nil, false
+	}
+	return append(segments, path[start:]), true
+}
+
+// fieldByJSONName returns the field of struct s with the given JSON name.
+func fieldByJSONName(s reflect.Value, name string) (reflect.Value, bool) {
+	t := s.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if jsonFieldName(t.Field(i)) == name && name != "resourceType" {
+			return s.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// choiceField returns the populated typed variant of the choice element base
+// in struct s, e.g. valueQuantity for "value", along with its JSON name.
+func choiceField(s reflect.Value, base string) (reflect.Value, string, bool) {
+	t := s.Type()
+	for i := 0; i < t.NumField(); i++ {
+		jsonName := jsonFieldName(t.Field(i))
+		suffix, found := strings.CutPrefix(jsonName, base)
+		if found && suffix != "" && unicode.IsUpper(rune(suffix[0])) && !s.Field(i).IsZero() {
+			return s.Field(i), jsonName, true
+		}
+	}
+	return reflect.Value{}, "", false
+}
+
+// childElementPath returns the element definition path of node v found at
+// path: the resource or data type name where one starts, otherwise path
+// itself with any contentReference resolved.
+func childElementPath(v reflect.Value, path string) string {
+	if r, ok := v.Interface().(Resource); ok {
+		return r.GetResourceType()
+	}
+	model := FHIRPathModel()
+	path = model.ResolvePath(path)
+	switch typ := model.TypeOf(path); typ {
+	case "", "Element", "BackboneElement":
+		return path
+	default:
+		return typ
+	}
+}
+
+// nodeValue converts a field or slice entry into the node form used by Walk,
+// reporting false for absent values.
+func nodeValue(f reflect.Value) (reflect.Value, bool) {
+	switch f.Kind() {
+	case reflect.Ptr:
+		return f, !f.IsNil()
+	case reflect.Interface:
+		if f.IsNil() {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(f.Interface()), true
+	default:
+		if f.IsZero() || !f.CanAddr() {
+			return reflect.Value{}, false
+		}
+		return f.Addr(), true
+	}
+}
@@ -0,0 +1,109 @@
+package r5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r5"
+)
+
+func TestNodeAt(t *testing.T) {
+	obs := &r5.Observation{
+		Id: ptrString("o1"),
+		Code: r5.CodeableConcept{
+			Coding: []r5.Coding{{System: ptrString("http://loinc.org"), Code: ptrString("85354-9")}},
+		},
+		Component: []r5.ObservationComponent{
+			{
+				Code:          r5.CodeableConcept{Text: ptrString("systolic")},
+				ValueQuantity: &r5.Quantity{Value: r5.MustDecimal("120"), Unit: ptrString("mmHg")},
+			},
+			{
+				Code:        r5.CodeableConcept{Text: ptrString("note")},
+				ValueString: ptrString("n/a"),
+			},
+		},
+		PartOf:    []r5.Reference{{Reference: ptrString("Procedure/1")}},
+		Extension: []r5.Extension{{Url: "http://example.org/note", ValueString: ptrString("ext")}},
+		Contained: []r5.Resource{
+			&r5.Patient{Id: ptrString("p1")},
+			&r5.Organization{Id: ptrString("org.1"), Name: ptrString("Acme")},
+		},
+	}
+
+	t.Run("nested component value", func(t *testing.T) {
+		node, ok := r5.NodeAt(obs, "Observation.component[0].value")
+		require.True(t, ok)
+		q, ok := node.(*r5.Quantity)
+		require.True(t, ok)
+		assert.Same(t, obs.Component[0].ValueQuantity, q)
+
+		node, ok = r5.NodeAt(obs, "Observation.component[1].value")
+		require.True(t, ok)
+		assert.Equal(t, "n/a", *node.(*string))
+
+		node, ok = r5.NodeAt(obs, "Observation.component[0].valueQuantity.unit")
+		require.True(t, ok)
+		assert.Equal(t, "mmHg", *node.(*string))
+	})
+
+	t.Run("non-pointer and unindexed elements", func(t *testing.T) {
+		node, ok := r5.NodeAt(obs, "Observation.code.coding.code")
+		require.True(t, ok)
+		assert.Equal(t, "85354-9", *node.(*string))
+
+		node, ok = r5.NodeAt(obs, "Observation.component[1].code")
+		require.True(t, ok)
+		assert.Same(t, &obs.Component[1].Code, node)
+	})
+
+	t.Run("resources", func(t *testing.T) {
+		node, ok := r5.NodeAt(obs, "Observation")
+		require.True(t, ok)
+		assert.Same(t, obs, node)
+
+		node, ok = r5.NodeAt(obs, "Observation.contained[0].id")
+		require.True(t, ok)
+		assert.Equal(t, "p1", *node.(*string))
+
+		node, ok = r5.NodeAt(obs, "Observation.contained[#p1]")
+		require.True(t, ok)
+		assert.Same(t, obs.Contained[0], node)
+
+		node, ok = r5.NodeAt(obs, "Observation.contained[#org.1].name")
+		require.True(t, ok)
+		assert.Equal(t, "Acme", *node.(*string))
+	})
+
+	t.Run("choice elements of data types", func(t *testing.T) {
+		node, ok := r5.NodeAt(obs, "Observation.extension[0].value")
+		require.True(t, ok)
+		assert.Equal(t, "ext", *node.(*string))
+	})
+
+	t.Run("missing paths", func(t *testing.T) {
+		for _, path := range []string{
+			"Observation.component[2].value",
+			"Observation.component.value",
+			"Observation.component[0].valueString",
+			"Observation.status",
+			"Observation.nope",
+			"Observation.component[x]",
+			"Observation.contained[#p2]",
+			"Observation.contained[p1]",
+			"Observation.contained[#org.1",
+			"Observation.part",
+			"Observation.id[1]",
+			"Patient.id",
+			"",
+		} {
+			_, ok := r5.NodeAt(obs, path)
+			assert.False(t, ok, path)
+		}
+
+		_, ok := r5.NodeAt(nil, "Observation")
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,75 @@
+package r5
+
+// AddString adds a parameter named name with a valueString.
+func (b *ParametersBuilder) AddString(name, value string) *ParametersBuilder {
+	return b.AddParameter(ParametersParameter{Name: &name, ValueString: &value})
+}
+
+// AddReference adds a parameter named name with a valueReference.
+func (b *ParametersBuilder) AddReference(name string, ref Reference) *ParametersBuilder {
+	return b.AddParameter(ParametersParameter{Name: &name, ValueReference: &ref})
+}
+
+// AddResource adds a parameter named name carrying the resource r.
+func (b *ParametersBuilder) AddResource(name string, r Resource) *ParametersBuilder {
+	return b.AddParameter(ParametersParameter{Name: &name, Resource: r})
+}
+
+// AddPart adds a parameter named name whose parts are the parameters added
+// by sub to the builder it is given. Parts may themselves be nested with
+// AddPart. Only the parameters added by sub are kept; any id, meta or other
+// resource-level element it sets is ignored.
+func (b *ParametersBuilder) AddPart(name string, sub func(*ParametersBuilder)) *ParametersBuilder {
+	pb := NewParametersBuilder()
+	sub(pb)
+	return b.AddParameter(ParametersParameter{Name: &name, Part: pb.Build().Parameter})
+}
+
+// Get returns the first top-level parameter named name, or nil if there is
+// none. The result points into p, so changes to it are reflected in p.
+func (p *Parameters) Get(name string) *ParametersParameter {
+	if p == nil {
+		return nil
+	}
+	for i := range p.Parameter {
+		if n := p.Parameter[i].Name; n != nil && *n == name {
+			return &p.Parameter[i]
+		}
+	}
+	return nil
+}
+
+// GetAll returns every top-level parameter named name, in order, for
+// parameters that may repeat. The results point into p.
+func (p *Parameters) GetAll(name string) []*ParametersParameter {
+	if p == nil {
+		return nil
+	}
+	var params []*ParametersParameter
+	for i := range p.Parameter {
+		if n := p.Parameter[i].Name; n != nil && *n == name {
+			params = append(params, &p.Parameter[i])
+		}
+	}
+	return params
+}
+
+// GetString returns the valueString of the first parameter named name. It
+// returns false if there is no such parameter or it has no valueString.
+func (p *Parameters) GetString(name string) (string, bool) {
+	param := p.Get(name)
+	if param == nil || param.ValueString == nil {
+		return "", false
+	}
+	return *param.ValueString, true
+}
+
+// GetResource returns the resource of the first parameter named name, or nil
+// if there is no such parameter or it carries no resource.
+func (p *Parameters) GetResource(name string) Resource {
+	param := p.Get(name)
+	if param == nil {
+		return nil
+	}
+	return param.Resource
+}
@@ -0,0 +1,24 @@
+package r5
+
+import "reflect"
+
+// PrimaryCode returns the code saying what r is about, such as
+// Observation.code, Condition.code, Procedure.code or
+// AllergyIntolerance.code, for generic clinical code extraction. It returns
+// nil if r does not implement CodeHolder or its code is unset.
+func PrimaryCode(r Resource) *CodeableConcept {
+	if h, ok := r.(CodeHolder); ok {
+		return h.PrimaryCode()
+	}
+	return nil
+}
+
+// codeOrNil returns c, or nil if it is the zero CodeableConcept. The
+// generated PrimaryCode methods use it for required code elements, which
+// are not pointers.
+func codeOrNil(c *CodeableConcept) *CodeableConcept {
+	if reflect.ValueOf(*c).IsZero() {
+		return nil
+	}
+	return c
+}
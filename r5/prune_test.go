@@ -0,0 +1,85 @@
+package r5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r5"
+)
+
+func TestPrune(t *testing.T) {
+	t.Run("removes empty contact entry", func(t *testing.T) {
+		patient := &r5.Patient{
+			Id: ptrString("p1"),
+			Contact: []r5.PatientContact{
+				{},
+				{Name: &r5.HumanName{Family: ptrString("Doe")}},
+			},
+		}
+
+		pruned := r5.Prune(patient).(*r5.Patient)
+
+		require.Len(t, pruned.Contact, 1)
+		assert.Equal(t, "Doe", *pruned.Contact[0].Name.Family)
+		assert.Len(t, patient.Contact, 2, "original must be left untouched")
+	})
+
+	t.Run("removes all-empty slice", func(t *testing.T) {
+		patient := &r5.Patient{
+			Contact: []r5.PatientContact{{}, {Telecom: []r5.ContactPoint{{}}}},
+		}
+
+		pruned := r5.Prune(patient).(*r5.Patient)
+
+		assert.Nil(t, pruned.Contact)
+	})
+
+	t.Run("nils out empty pointer datatypes", func(t *testing.T) {
+		patient := &r5.Patient{
+			Meta:          &r5.Meta{},
+			MaritalStatus: &r5.CodeableConcept{Coding: []r5.Coding{{}}},
+			BirthDateExt:  &r5.Element{},
+		}
+
+		pruned := r5.Prune(patient).(*r5.Patient)
+
+		assert.Nil(t, pruned.Meta)
+		assert.Nil(t, pruned.MaritalStatus)
+		assert.Nil(t, pruned.BirthDateExt)
+		assert.NotNil(t, patient.Meta)
+	})
+
+	t.Run("keeps primitive values", func(t *testing.T) {
+		patient := &r5.Patient{
+			Active: ptrBool(false),
+			Name:   []r5.HumanName{{Given: []string{""}}},
+		}
+
+		pruned := r5.Prune(patient).(*r5.Patient)
+
+		require.NotNil(t, pruned.Active)
+		assert.False(t, *pruned.Active)
+		require.Len(t, pruned.Name, 1)
+	})
+
+	t.Run("prunes contained resources without removing them", func(t *testing.T) {
+		patient := &r5.Patient{
+			Contained: []r5.Resource{
+				&r5.Organization{Id: ptrString("org1"), Identifier: []r5.Identifier{{}}},
+			},
+		}
+
+		pruned := r5.Prune(patient).(*r5.Patient)
+
+		require.Len(t, pruned.Contained, 1)
+		org := pruned.Contained[0].(*r5.Organization)
+		assert.Nil(t, org.Identifier)
+		assert.Len(t, patient.Contained[0].(*r5.Organization).Identifier, 1)
+	})
+
+	t.Run("nil resource", func(t *testing.T) {
+		assert.Nil(t, r5.Prune(nil))
+	})
+}
@@ -0,0 +1,63 @@
+package r5
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Canonical returns a normalized copy of q for equality comparison and
+// deduplication, e.g. so that "1.50 mmHg" and "1.5 mmHg" compare equal:
+//
+//   - value has trailing fractional zeros trimmed ("1.50" becomes "1.5");
+//   - system is lowercased.
+//
+// The comparator is kept as is, since "<1.5" and "1.5" are different values.
+//
+// The result is meant for comparison only, not display: trimming the value
+// discards the precision the original representation conveys. q is left
+// intact and shares no memory with the result.
+func (q Quantity) Canonical() Quantity {
+	c := deepCopy(reflect.ValueOf(q)).Interface().(Quantity)
+	if c.Value != nil {
+		c.Value = canonicalDecimal(*c.Value)
+	}
+	if c.System != nil {
+		s := strings.ToLower(*c.System)
+		c.System = &s
+	}
+	return c
+}
+
+// canonicalDecimal returns d with trailing fractional zeros removed.
+// Values in exponent notation are rewritten through float64.
+func canonicalDecimal(d Decimal) *Decimal {
+	s := d.String()
+	if strings.ContainsAny(s, "eE") {
+		return NewDecimalFromFloat64(d.Float64() + 0) // +0 folds -0 into 0
+	}
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(s, "0")
+		s = strings.TrimSuffix(s, ".")
+	}
+	if s == "-0" || s == "" {
+		s = "0"
+	}
+	return &Decimal{value: s}
+}
+
+// ToSimpleQuantity converts q to a SimpleQuantity. It returns an error if q
+// has a comparator, which SimpleQuantity forbids (constraint sqty-1). The
+// result shares no memory with q.
+func (q Quantity) ToSimpleQuantity() (SimpleQuantity, error) {
+	if q.Comparator != nil {
+		return SimpleQuantity{}, fmt.Errorf("SimpleQuantity does not allow a comparator, got %q", *q.Comparator)
+	}
+	return SimpleQuantity(deepCopy(reflect.ValueOf(q)).Interface().(Quantity)), nil
+}
+
+// ToQuantity converts sq to a Quantity. The result shares no memory with sq.
+// A comparator, which a valid SimpleQuantity never has, is carried over.
+func (sq SimpleQuantity) ToQuantity() Quantity {
+	return Quantity(deepCopy(reflect.ValueOf(sq)).Interface().(SimpleQuantity))
+}
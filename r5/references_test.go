@@ -0,0 +1,93 @@
+package r5_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r5"
+)
+
+func TestValidateReferences(t *testing.T) {
+	t.Run("valid subject", func(t *testing.T) {
+		for _, ref := range []r5.Reference{
+			{Reference: ptrString("Patient/123")},
+			{Reference: ptrString("https://example.org/fhir/Group/g1/_history/2")},
+			{Reference: ptrString("#p1")},
+			{Reference: ptrString("urn:uuid:7f4b8a51-3d38-4b6e-9f0e-2d6c5a1b9c01")},
+			{Type: ptrString("Device"), Identifier: &r5.Identifier{Value: ptrString("d-9")}},
+		} {
+			obs := &r5.Observation{Subject: &ref}
+			assert.NoError(t, obs.ValidateReferences(), *obs.Subject)
+		}
+	})
+
+	t.Run("invalid subject", func(t *testing.T) {
+		obs := &r5.Observation{
+			Subject: &r5.Reference{Reference: ptrString("Basic/b1")},
+		}
+		err := obs.ValidateReferences()
+		require.Error(t, err)
+		var ve *r5.ValidationError
+		require.True(t, errors.As(err, &ve))
+		assert.Equal(t, "Observation.subject", ve.Path)
+		assert.Contains(t, ve.Message, "reference to Basic is not allowed")
+		assert.Contains(t, ve.Message, "Patient")
+	})
+
+	t.Run("backbone and contained resources", func(t *testing.T) {
+		patient := &r5.Patient{
+			Link: []r5.PatientLink{{Other: r5.Reference{Reference: ptrString("Observation/o1")}}},
+			Contained: []r5.Resource{&r5.Observation{
+				Subject: &r5.Reference{Reference: ptrString("Basic/b1")},
+			}},
+		}
+		err := patient.ValidateReferences()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Patient.contained[0].subject: reference to Basic")
+		assert.Contains(t, err.Error(), "Patient.link[0].other: reference to Observation")
+	})
+
+	t.Run("any resource allowed", func(t *testing.T) {
+		obs := &r5.Observation{Focus: []r5.Reference{{Reference: ptrString("Medication/m1")}}}
+		assert.NoError(t, obs.ValidateReferences())
+	})
+
+	t.Run("choice elements are not checked", func(t *testing.T) {
+		obs := &r5.Observation{ValueReference: &r5.Reference{Reference: ptrString("Basic/b1")}}
+		assert.NoError(t, obs.ValidateReferences())
+	})
+}
+
+func TestReferenceRelativize(t *testing.T) {
+	const base = "https://our.server/fhir"
+
+	t.Run("own base", func(t *testing.T) {
+		ref := r5.Reference{Reference: ptrString(base + "/Patient/1"), Display: ptrString("Jane")}
+
+		got := ref.Relativize(base)
+		assert.Equal(t, "Patient/1", *got.Reference)
+		assert.Equal(t, "Jane", *got.Display)
+		assert.Equal(t, base+"/Patient/1", *ref.Reference, "original is unchanged")
+
+		got = ref.Relativize(base + "/")
+		assert.Equal(t, "Patient/1", *got.Reference)
+	})
+
+	t.Run("external or relative", func(t *testing.T) {
+		for _, s := range []string{
+			"https://other.server/fhir/Patient/1",
+			"https://our.server/fhir2/Patient/1",
+			"Patient/1",
+			"#p1",
+		} {
+			ref := r5.Reference{Reference: ptrString(s)}
+			assert.Equal(t, s, *ref.Relativize(base).Reference)
+		}
+
+		got := r5.Reference{Display: ptrString("no literal")}.Relativize(base)
+		assert.Nil(t, got.Reference)
+	})
+}
@@ -259,6 +259,178 @@ func TestAllResourceTypes(t *testing.T) {
 	assert.True(t, typeSet["Medication"], "should include Medication")
 }
 
+func TestOnDecode(t *testing.T) {
+	type call struct {
+		resourceType string
+		err          error
+	}
+	var calls []call
+	r5.OnDecode = func(resourceType string, err error) {
+		calls = append(calls, call{resourceType, err})
+	}
+	t.Cleanup(func() { r5.OnDecode = nil })
+
+	t.Run("JSON success", func(t *testing.T) {
+		calls = nil
+		_, err := r5.UnmarshalResource([]byte(`{"resourceType": "Patient", "id": "p1"}`))
+		require.NoError(t, err)
+		require.Len(t, calls, 1)
+		assert.Equal(t, "Patient", calls[0].resourceType)
+		assert.NoError(t, calls[0].err)
+	})
+
+	t.Run("JSON error", func(t *testing.T) {
+		calls = nil
+		_, err := r5.UnmarshalResource([]byte(`{"resourceType": "Patient", "active": "yes"}`))
+		require.Error(t, err)
+		require.Len(t, calls, 1)
+		assert.Equal(t, "Patient", calls[0].resourceType)
+		assert.Equal(t, err, calls[0].err)
+	})
+
+	t.Run("JSON missing resourceType", func(t *testing.T) {
+		calls = nil
+		_, err := r5.UnmarshalResource([]byte(`{"id": "p1"}`))
+		require.Error(t, err)
+		require.Len(t, calls, 1)
+		assert.Empty(t, calls[0].resourceType)
+		assert.Error(t, calls[0].err)
+	})
+
+	t.Run("XML success", func(t *testing.T) {
+		calls = nil
+		_, err := r5.UnmarshalResourceXML([]byte(`<Patient xmlns="http://hl7.org/fhir"><id value="p1"/></Patient>`))
+		require.NoError(t, err)
+		require.Len(t, calls, 1)
+		assert.Equal(t, "Patient", calls[0].resourceType)
+		assert.NoError(t, calls[0].err)
+	})
+
+	t.Run("XML unknown type", func(t *testing.T) {
+		calls = nil
+		_, err := r5.UnmarshalResourceXML([]byte(`<Unknown xmlns="http://hl7.org/fhir"/>`))
+		require.Error(t, err)
+		require.Len(t, calls, 1)
+		assert.Equal(t, "Unknown", calls[0].resourceType)
+		assert.Error(t, calls[0].err)
+	})
+
+	t.Run("contained resources are reported", func(t *testing.T) {
+		calls = nil
+		_, err := r5.UnmarshalResource([]byte(`{"resourceType": "Patient", "contained": [{"resourceType": "Organization", "id": "o1"}]}`))
+		require.NoError(t, err)
+		require.Len(t, calls, 2)
+		assert.Equal(t, "Organization", calls[0].resourceType)
+		assert.Equal(t, "Patient", calls[1].resourceType)
+	})
+}
+
+func TestDecodeResourceWithRaw(t *testing.T) {
+	t.Run("raw equals input", func(t *testing.T) {
+		data := []byte(`{ "resourceType": "Patient",  "id": "123", "active": true }`)
+
+		resource, raw, err := r5.DecodeResourceWithRaw(data)
+		require.NoError(t, err)
+		assert.Equal(t, "123", *resource.(*r5.Patient).Id)
+		assert.Equal(t, data, raw)
+
+		// raw is a copy, independent of the caller's buffer
+		data[0] = 'x'
+		assert.Equal(t, byte('{'), raw[0])
+	})
+
+	t.Run("error", func(t *testing.T) {
+		resource, raw, err := r5.DecodeResourceWithRaw([]byte(`{"id": "123"}`))
+		assert.Error(t, err)
+		assert.Nil(t, resource)
+		assert.Nil(t, raw)
+	})
+}
+
+func TestUnmarshalResourceArrayJSON(t *testing.T) {
+	t.Run("mixed types", func(t *testing.T) {
+		data := []byte(`[
+			{"resourceType": "Patient", "id": "p1"},
+			{"resourceType": "Observation", "id": "o1", "status": "final", "code": {"text": "hr"}}
+		]`)
+
+		resources, err := r5.UnmarshalResourceArrayJSON(data)
+		require.NoError(t, err)
+		require.Len(t, resources, 2)
+
+		patient, ok := resources[0].(*r5.Patient)
+		require.True(t, ok)
+		assert.Equal(t, "p1", *patient.Id)
+
+		obs, ok := resources[1].(*r5.Observation)
+		require.True(t, ok)
+		assert.Equal(t, "o1", *obs.Id)
+	})
+
+	t.Run("empty array", func(t *testing.T) {
+		resources, err := r5.UnmarshalResourceArrayJSON([]byte(`[]`))
+		require.NoError(t, err)
+		assert.NotNil(t, resources)
+		assert.Empty(t, resources)
+	})
+
+	t.Run("invalid element", func(t *testing.T) {
+		_, err := r5.UnmarshalResourceArrayJSON([]byte(`[{"resourceType": "Patient"}, {"id": "x"}]`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "element 1")
+	})
+
+	t.Run("not an array", func(t *testing.T) {
+		_, err := r5.UnmarshalResourceArrayJSON([]byte(`{"resourceType": "Patient"}`))
+		assert.Error(t, err)
+	})
+}
+
+func TestUnmarshalEntryJSON(t *testing.T) {
+	t.Run("envelope", func(t *testing.T) {
+		data := []byte(`{"fullUrl": "urn:uuid:1", "resource": {"resourceType": "Observation", "id": "o1", "status": "final", "code": {"text": "hr"}}}`)
+
+		resource, err := r5.UnmarshalEntryJSON(data)
+		require.NoError(t, err)
+		assert.Equal(t, "Observation", resource.GetResourceType())
+		obs, ok := resource.(*r5.Observation)
+		require.True(t, ok)
+		assert.Equal(t, "o1", *obs.Id)
+	})
+
+	t.Run("missing resource", func(t *testing.T) {
+		for _, data := range []string{`{}`, `{"resource": null}`, `{"resourceType": "Patient"}`} {
+			_, err := r5.UnmarshalEntryJSON([]byte(data))
+			assert.Error(t, err, data)
+		}
+	})
+
+	t.Run("invalid inner resource", func(t *testing.T) {
+		_, err := r5.UnmarshalEntryJSON([]byte(`{"resource": {"id": "x"}}`))
+		assert.Error(t, err)
+	})
+}
+
+func TestUnmarshalResource_ContainedMissingResourceType(t *testing.T) {
+	data := []byte(`{
+		"resourceType": "Patient",
+		"id": "p1",
+		"contained": [
+			{"resourceType": "Organization", "id": "org1"},
+			{"id": "prac1", "active": true}
+		]
+	}`)
+
+	_, err := r5.UnmarshalResource(data)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "contained[1]: missing resourceType")
+
+	var patient r5.Patient
+	err = json.Unmarshal(data, &patient)
+	require.Error(t, err)
+	assert.EqualError(t, err, "contained[1]: missing resourceType")
+}
+
 // Helper functions
 func ptrString(s string) *string {
 	return &s
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -155,6 +154,134 @@ func (r *AdverseEvent) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *AdverseEvent) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// PrimaryCode returns the resource's code element, or nil if it is unset,
+// implementing CodeHolder.
+func (r *AdverseEvent) PrimaryCode() *CodeableConcept {
+	return r.Code
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *AdverseEvent) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *AdverseEvent) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *AdverseEvent) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddActualityExtension appends ext to the extensions of the actuality
+// primitive (JSON "_actuality"), allocating ActualityExt if needed.
+func (r *AdverseEvent) AddActualityExtension(ext Extension) {
+	if r.ActualityExt == nil {
+		r.ActualityExt = &Element{}
+	}
+	r.ActualityExt.Extension = append(r.ActualityExt.Extension, ext)
+}
+
+// AddDetectedExtension appends ext to the extensions of the detected
+// primitive (JSON "_detected"), allocating DetectedExt if needed.
+func (r *AdverseEvent) AddDetectedExtension(ext Extension) {
+	if r.DetectedExt == nil {
+		r.DetectedExt = &Element{}
+	}
+	r.DetectedExt.Extension = append(r.DetectedExt.Extension, ext)
+}
+
+// AddRecordedDateExtension appends ext to the extensions of the recordedDate
+// primitive (JSON "_recordedDate"), allocating RecordedDateExt if needed.
+func (r *AdverseEvent) AddRecordedDateExtension(ext Extension) {
+	if r.RecordedDateExt == nil {
+		r.RecordedDateExt = &Element{}
+	}
+	r.RecordedDateExt.Extension = append(r.RecordedDateExt.Extension, ext)
+}
+
+// AddExpectedInResearchStudyExtension appends ext to the extensions of the expectedInResearchStudy
+// primitive (JSON "_expectedInResearchStudy"), allocating ExpectedInResearchStudyExt if needed.
+func (r *AdverseEvent) AddExpectedInResearchStudyExtension(ext Extension) {
+	if r.ExpectedInResearchStudyExt == nil {
+		r.ExpectedInResearchStudyExt = &Element{}
+	}
+	r.ExpectedInResearchStudyExt.Extension = append(r.ExpectedInResearchStudyExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *AdverseEvent) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstCategory returns a pointer to the first category entry, or nil
+// if there is none.
+func (r *AdverseEvent) FirstCategory() *CodeableConcept {
+	if r == nil || len(r.Category) == 0 {
+		return nil
+	}
+	return &r.Category[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *AdverseEvent) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the AdverseEvent, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *AdverseEvent) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"occurrence": {"OccurrenceDateTime", "OccurrencePeriod", "OccurrenceTiming"},
+	}
+}
+
+// HasOccurrence reports whether any occurrence[x] variant of the
+// AdverseEvent is set.
+func (r *AdverseEvent) HasOccurrence() bool {
+	return r.OccurrenceDateTime != nil || r.OccurrencePeriod != nil || r.OccurrenceTiming != nil
+}
+
+// ValidateReferences checks that every populated reference in the AdverseEvent,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *AdverseEvent) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -195,9 +322,9 @@ func (r *AdverseEvent) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -620,6 +747,12 @@ type AdverseEventContributingFactor struct {
 	ItemCodeableConcept *CodeableConcept `json:"itemCodeableConcept,omitempty"`
 }
 
+// HasItem reports whether any item[x] variant of the
+// AdverseEventContributingFactor is set.
+func (b *AdverseEventContributingFactor) HasItem() bool {
+	return b.ItemReference != nil || b.ItemCodeableConcept != nil
+}
+
 // MarshalXML serializes AdverseEventContributingFactor to FHIR-conformant XML.
 func (b AdverseEventContributingFactor) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -723,6 +856,12 @@ type AdverseEventMitigatingAction struct {
 	ItemCodeableConcept *CodeableConcept `json:"itemCodeableConcept,omitempty"`
 }
 
+// HasItem reports whether any item[x] variant of the
+// AdverseEventMitigatingAction is set.
+func (b *AdverseEventMitigatingAction) HasItem() bool {
+	return b.ItemReference != nil || b.ItemCodeableConcept != nil
+}
+
 // MarshalXML serializes AdverseEventMitigatingAction to FHIR-conformant XML.
 func (b AdverseEventMitigatingAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -925,6 +1064,12 @@ type AdverseEventPreventiveAction struct {
 	ItemCodeableConcept *CodeableConcept `json:"itemCodeableConcept,omitempty"`
 }
 
+// HasItem reports whether any item[x] variant of the
+// AdverseEventPreventiveAction is set.
+func (b *AdverseEventPreventiveAction) HasItem() bool {
+	return b.ItemReference != nil || b.ItemCodeableConcept != nil
+}
+
 // MarshalXML serializes AdverseEventPreventiveAction to FHIR-conformant XML.
 func (b AdverseEventPreventiveAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1028,6 +1173,12 @@ type AdverseEventSupportingInfo struct {
 	ItemCodeableConcept *CodeableConcept `json:"itemCodeableConcept,omitempty"`
 }
 
+// HasItem reports whether any item[x] variant of the
+// AdverseEventSupportingInfo is set.
+func (b *AdverseEventSupportingInfo) HasItem() bool {
+	return b.ItemReference != nil || b.ItemCodeableConcept != nil
+}
+
 // MarshalXML serializes AdverseEventSupportingInfo to FHIR-conformant XML.
 func (b AdverseEventSupportingInfo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1133,6 +1284,12 @@ type AdverseEventSuspectEntity struct {
 	Causality *AdverseEventSuspectEntityCausality `json:"causality,omitempty"`
 }
 
+// HasInstance reports whether any instance[x] variant of the
+// AdverseEventSuspectEntity is set.
+func (b *AdverseEventSuspectEntity) HasInstance() bool {
+	return b.InstanceCodeableConcept != nil || b.InstanceReference != nil
+}
+
 // MarshalXML serializes AdverseEventSuspectEntity to FHIR-conformant XML.
 func (b AdverseEventSuspectEntity) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
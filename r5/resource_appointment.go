@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -173,6 +172,147 @@ func (r *Appointment) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *Appointment) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Appointment) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Appointment) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *Appointment) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddDescriptionExtension appends ext to the extensions of the description
+// primitive (JSON "_description"), allocating DescriptionExt if needed.
+func (r *Appointment) AddDescriptionExtension(ext Extension) {
+	if r.DescriptionExt == nil {
+		r.DescriptionExt = &Element{}
+	}
+	r.DescriptionExt.Extension = append(r.DescriptionExt.Extension, ext)
+}
+
+// AddStartExtension appends ext to the extensions of the start
+// primitive (JSON "_start"), allocating StartExt if needed.
+func (r *Appointment) AddStartExtension(ext Extension) {
+	if r.StartExt == nil {
+		r.StartExt = &Element{}
+	}
+	r.StartExt.Extension = append(r.StartExt.Extension, ext)
+}
+
+// AddEndExtension appends ext to the extensions of the end
+// primitive (JSON "_end"), allocating EndExt if needed.
+func (r *Appointment) AddEndExtension(ext Extension) {
+	if r.EndExt == nil {
+		r.EndExt = &Element{}
+	}
+	r.EndExt.Extension = append(r.EndExt.Extension, ext)
+}
+
+// AddMinutesDurationExtension appends ext to the extensions of the minutesDuration
+// primitive (JSON "_minutesDuration"), allocating MinutesDurationExt if needed.
+func (r *Appointment) AddMinutesDurationExtension(ext Extension) {
+	if r.MinutesDurationExt == nil {
+		r.MinutesDurationExt = &Element{}
+	}
+	r.MinutesDurationExt.Extension = append(r.MinutesDurationExt.Extension, ext)
+}
+
+// AddCreatedExtension appends ext to the extensions of the created
+// primitive (JSON "_created"), allocating CreatedExt if needed.
+func (r *Appointment) AddCreatedExtension(ext Extension) {
+	if r.CreatedExt == nil {
+		r.CreatedExt = &Element{}
+	}
+	r.CreatedExt.Extension = append(r.CreatedExt.Extension, ext)
+}
+
+// AddCancellationDateExtension appends ext to the extensions of the cancellationDate
+// primitive (JSON "_cancellationDate"), allocating CancellationDateExt if needed.
+func (r *Appointment) AddCancellationDateExtension(ext Extension) {
+	if r.CancellationDateExt == nil {
+		r.CancellationDateExt = &Element{}
+	}
+	r.CancellationDateExt.Extension = append(r.CancellationDateExt.Extension, ext)
+}
+
+// AddRecurrenceIdExtension appends ext to the extensions of the recurrenceId
+// primitive (JSON "_recurrenceId"), allocating RecurrenceIdExt if needed.
+func (r *Appointment) AddRecurrenceIdExtension(ext Extension) {
+	if r.RecurrenceIdExt == nil {
+		r.RecurrenceIdExt = &Element{}
+	}
+	r.RecurrenceIdExt.Extension = append(r.RecurrenceIdExt.Extension, ext)
+}
+
+// AddOccurrenceChangedExtension appends ext to the extensions of the occurrenceChanged
+// primitive (JSON "_occurrenceChanged"), allocating OccurrenceChangedExt if needed.
+func (r *Appointment) AddOccurrenceChangedExtension(ext Extension) {
+	if r.OccurrenceChangedExt == nil {
+		r.OccurrenceChangedExt = &Element{}
+	}
+	r.OccurrenceChangedExt.Extension = append(r.OccurrenceChangedExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *Appointment) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *Appointment) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the Appointment, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Appointment) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the Appointment,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Appointment) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -213,9 +353,9 @@ func (r *Appointment) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -131,6 +130,121 @@ func (r *AppointmentResponse) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *AppointmentResponse) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *AppointmentResponse) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddProposedNewTimeExtension appends ext to the extensions of the proposedNewTime
+// primitive (JSON "_proposedNewTime"), allocating ProposedNewTimeExt if needed.
+func (r *AppointmentResponse) AddProposedNewTimeExtension(ext Extension) {
+	if r.ProposedNewTimeExt == nil {
+		r.ProposedNewTimeExt = &Element{}
+	}
+	r.ProposedNewTimeExt.Extension = append(r.ProposedNewTimeExt.Extension, ext)
+}
+
+// AddStartExtension appends ext to the extensions of the start
+// primitive (JSON "_start"), allocating StartExt if needed.
+func (r *AppointmentResponse) AddStartExtension(ext Extension) {
+	if r.StartExt == nil {
+		r.StartExt = &Element{}
+	}
+	r.StartExt.Extension = append(r.StartExt.Extension, ext)
+}
+
+// AddEndExtension appends ext to the extensions of the end
+// primitive (JSON "_end"), allocating EndExt if needed.
+func (r *AppointmentResponse) AddEndExtension(ext Extension) {
+	if r.EndExt == nil {
+		r.EndExt = &Element{}
+	}
+	r.EndExt.Extension = append(r.EndExt.Extension, ext)
+}
+
+// AddParticipantStatusExtension appends ext to the extensions of the participantStatus
+// primitive (JSON "_participantStatus"), allocating ParticipantStatusExt if needed.
+func (r *AppointmentResponse) AddParticipantStatusExtension(ext Extension) {
+	if r.ParticipantStatusExt == nil {
+		r.ParticipantStatusExt = &Element{}
+	}
+	r.ParticipantStatusExt.Extension = append(r.ParticipantStatusExt.Extension, ext)
+}
+
+// AddCommentExtension appends ext to the extensions of the comment
+// primitive (JSON "_comment"), allocating CommentExt if needed.
+func (r *AppointmentResponse) AddCommentExtension(ext Extension) {
+	if r.CommentExt == nil {
+		r.CommentExt = &Element{}
+	}
+	r.CommentExt.Extension = append(r.CommentExt.Extension, ext)
+}
+
+// AddRecurringExtension appends ext to the extensions of the recurring
+// primitive (JSON "_recurring"), allocating RecurringExt if needed.
+func (r *AppointmentResponse) AddRecurringExtension(ext Extension) {
+	if r.RecurringExt == nil {
+		r.RecurringExt = &Element{}
+	}
+	r.RecurringExt.Extension = append(r.RecurringExt.Extension, ext)
+}
+
+// AddOccurrenceDateExtension appends ext to the extensions of the occurrenceDate
+// primitive (JSON "_occurrenceDate"), allocating OccurrenceDateExt if needed.
+func (r *AppointmentResponse) AddOccurrenceDateExtension(ext Extension) {
+	if r.OccurrenceDateExt == nil {
+		r.OccurrenceDateExt = &Element{}
+	}
+	r.OccurrenceDateExt.Extension = append(r.OccurrenceDateExt.Extension, ext)
+}
+
+// AddRecurrenceIdExtension appends ext to the extensions of the recurrenceId
+// primitive (JSON "_recurrenceId"), allocating RecurrenceIdExt if needed.
+func (r *AppointmentResponse) AddRecurrenceIdExtension(ext Extension) {
+	if r.RecurrenceIdExt == nil {
+		r.RecurrenceIdExt = &Element{}
+	}
+	r.RecurrenceIdExt.Extension = append(r.RecurrenceIdExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *AppointmentResponse) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the AppointmentResponse, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *AppointmentResponse) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the AppointmentResponse,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *AppointmentResponse) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -171,9 +285,9 @@ func (r *AppointmentResponse) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
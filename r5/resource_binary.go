@@ -67,6 +67,58 @@ func (r *Binary) SetMeta(m *Meta) {
 	r.Meta = m
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Binary) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Binary) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddContentTypeExtension appends ext to the extensions of the contentType
+// primitive (JSON "_contentType"), allocating ContentTypeExt if needed.
+func (r *Binary) AddContentTypeExtension(ext Extension) {
+	if r.ContentTypeExt == nil {
+		r.ContentTypeExt = &Element{}
+	}
+	r.ContentTypeExt.Extension = append(r.ContentTypeExt.Extension, ext)
+}
+
+// AddDataExtension appends ext to the extensions of the data
+// primitive (JSON "_data"), allocating DataExt if needed.
+func (r *Binary) AddDataExtension(ext Extension) {
+	if r.DataExt == nil {
+		r.DataExt = &Element{}
+	}
+	r.DataExt.Extension = append(r.DataExt.Extension, ext)
+}
+
+// ChoiceGroups returns the choice elements of the Binary, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Binary) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the Binary,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Binary) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -121,6 +120,67 @@ func (r *BiologicallyDerivedProduct) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *BiologicallyDerivedProduct) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *BiologicallyDerivedProduct) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddDivisionExtension appends ext to the extensions of the division
+// primitive (JSON "_division"), allocating DivisionExt if needed.
+func (r *BiologicallyDerivedProduct) AddDivisionExtension(ext Extension) {
+	if r.DivisionExt == nil {
+		r.DivisionExt = &Element{}
+	}
+	r.DivisionExt.Extension = append(r.DivisionExt.Extension, ext)
+}
+
+// AddExpirationDateExtension appends ext to the extensions of the expirationDate
+// primitive (JSON "_expirationDate"), allocating ExpirationDateExt if needed.
+func (r *BiologicallyDerivedProduct) AddExpirationDateExtension(ext Extension) {
+	if r.ExpirationDateExt == nil {
+		r.ExpirationDateExt = &Element{}
+	}
+	r.ExpirationDateExt.Extension = append(r.ExpirationDateExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *BiologicallyDerivedProduct) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the BiologicallyDerivedProduct, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *BiologicallyDerivedProduct) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the BiologicallyDerivedProduct,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *BiologicallyDerivedProduct) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -161,9 +221,9 @@ func (r *BiologicallyDerivedProduct) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -457,6 +517,12 @@ type BiologicallyDerivedProductCollection struct {
 	CollectedPeriod *Period `json:"collectedPeriod,omitempty"`
 }
 
+// HasCollected reports whether any collected[x] variant of the
+// BiologicallyDerivedProductCollection is set.
+func (b *BiologicallyDerivedProductCollection) HasCollected() bool {
+	return b.CollectedDateTime != nil || b.CollectedPeriod != nil
+}
+
 // MarshalXML serializes BiologicallyDerivedProductCollection to FHIR-conformant XML.
 func (b BiologicallyDerivedProductCollection) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -602,6 +668,12 @@ type BiologicallyDerivedProductProperty struct {
 	ValueAttachment *Attachment `json:"valueAttachment,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// BiologicallyDerivedProductProperty is set.
+func (b *BiologicallyDerivedProductProperty) HasValue() bool {
+	return b.ValueBoolean != nil || b.ValueInteger != nil || b.ValueCodeableConcept != nil || b.ValuePeriod != nil || b.ValueQuantity != nil || b.ValueRange != nil || b.ValueRatio != nil || b.ValueString != nil || b.ValueAttachment != nil
+}
+
 // MarshalXML serializes BiologicallyDerivedProductProperty to FHIR-conformant XML.
 func (b BiologicallyDerivedProductProperty) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -131,6 +130,112 @@ func (r *BiologicallyDerivedProductDispense) GetModifierExtension() []Extension
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *BiologicallyDerivedProductDispense) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *BiologicallyDerivedProductDispense) Performers() []Reference {
+	var refs []Reference
+	for _, p := range r.Performer {
+		refs = append(refs, p.Actor)
+	}
+	return refs
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *BiologicallyDerivedProductDispense) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *BiologicallyDerivedProductDispense) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *BiologicallyDerivedProductDispense) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddPreparedDateExtension appends ext to the extensions of the preparedDate
+// primitive (JSON "_preparedDate"), allocating PreparedDateExt if needed.
+func (r *BiologicallyDerivedProductDispense) AddPreparedDateExtension(ext Extension) {
+	if r.PreparedDateExt == nil {
+		r.PreparedDateExt = &Element{}
+	}
+	r.PreparedDateExt.Extension = append(r.PreparedDateExt.Extension, ext)
+}
+
+// AddWhenHandedOverExtension appends ext to the extensions of the whenHandedOver
+// primitive (JSON "_whenHandedOver"), allocating WhenHandedOverExt if needed.
+func (r *BiologicallyDerivedProductDispense) AddWhenHandedOverExtension(ext Extension) {
+	if r.WhenHandedOverExt == nil {
+		r.WhenHandedOverExt = &Element{}
+	}
+	r.WhenHandedOverExt.Extension = append(r.WhenHandedOverExt.Extension, ext)
+}
+
+// AddUsageInstructionExtension appends ext to the extensions of the usageInstruction
+// primitive (JSON "_usageInstruction"), allocating UsageInstructionExt if needed.
+func (r *BiologicallyDerivedProductDispense) AddUsageInstructionExtension(ext Extension) {
+	if r.UsageInstructionExt == nil {
+		r.UsageInstructionExt = &Element{}
+	}
+	r.UsageInstructionExt.Extension = append(r.UsageInstructionExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *BiologicallyDerivedProductDispense) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *BiologicallyDerivedProductDispense) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the BiologicallyDerivedProductDispense, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *BiologicallyDerivedProductDispense) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the BiologicallyDerivedProductDispense,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *BiologicallyDerivedProductDispense) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -171,9 +276,9 @@ func (r *BiologicallyDerivedProductDispense) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
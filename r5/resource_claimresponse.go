@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -167,6 +166,111 @@ func (r *ClaimResponse) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *ClaimResponse) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *ClaimResponse) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *ClaimResponse) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *ClaimResponse) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddUseExtension appends ext to the extensions of the use
+// primitive (JSON "_use"), allocating UseExt if needed.
+func (r *ClaimResponse) AddUseExtension(ext Extension) {
+	if r.UseExt == nil {
+		r.UseExt = &Element{}
+	}
+	r.UseExt.Extension = append(r.UseExt.Extension, ext)
+}
+
+// AddCreatedExtension appends ext to the extensions of the created
+// primitive (JSON "_created"), allocating CreatedExt if needed.
+func (r *ClaimResponse) AddCreatedExtension(ext Extension) {
+	if r.CreatedExt == nil {
+		r.CreatedExt = &Element{}
+	}
+	r.CreatedExt.Extension = append(r.CreatedExt.Extension, ext)
+}
+
+// AddOutcomeExtension appends ext to the extensions of the outcome
+// primitive (JSON "_outcome"), allocating OutcomeExt if needed.
+func (r *ClaimResponse) AddOutcomeExtension(ext Extension) {
+	if r.OutcomeExt == nil {
+		r.OutcomeExt = &Element{}
+	}
+	r.OutcomeExt.Extension = append(r.OutcomeExt.Extension, ext)
+}
+
+// AddDispositionExtension appends ext to the extensions of the disposition
+// primitive (JSON "_disposition"), allocating DispositionExt if needed.
+func (r *ClaimResponse) AddDispositionExtension(ext Extension) {
+	if r.DispositionExt == nil {
+		r.DispositionExt = &Element{}
+	}
+	r.DispositionExt.Extension = append(r.DispositionExt.Extension, ext)
+}
+
+// AddPreAuthRefExtension appends ext to the extensions of the preAuthRef
+// primitive (JSON "_preAuthRef"), allocating PreAuthRefExt if needed.
+func (r *ClaimResponse) AddPreAuthRefExtension(ext Extension) {
+	if r.PreAuthRefExt == nil {
+		r.PreAuthRefExt = &Element{}
+	}
+	r.PreAuthRefExt.Extension = append(r.PreAuthRefExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *ClaimResponse) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the ClaimResponse, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *ClaimResponse) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the ClaimResponse,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *ClaimResponse) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -207,9 +311,9 @@ func (r *ClaimResponse) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -744,6 +848,18 @@ type ClaimResponseAddItem struct {
 	Detail []ClaimResponseAddItemDetail `json:"detail,omitempty"`
 }
 
+// HasServiced reports whether any serviced[x] variant of the
+// ClaimResponseAddItem is set.
+func (b *ClaimResponseAddItem) HasServiced() bool {
+	return b.ServicedDate != nil || b.ServicedPeriod != nil
+}
+
+// HasLocation reports whether any location[x] variant of the
+// ClaimResponseAddItem is set.
+func (b *ClaimResponseAddItem) HasLocation() bool {
+	return b.LocationCodeableConcept != nil || b.LocationAddress != nil || b.LocationReference != nil
+}
+
 // MarshalXML serializes ClaimResponseAddItem to FHIR-conformant XML.
 func (b ClaimResponseAddItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1847,6 +1963,12 @@ type ClaimResponseEvent struct {
 	WhenPeriod *Period `json:"whenPeriod,omitempty"`
 }
 
+// HasWhen reports whether any when[x] variant of the
+// ClaimResponseEvent is set.
+func (b *ClaimResponseEvent) HasWhen() bool {
+	return b.WhenDateTime != nil || b.WhenPeriod != nil
+}
+
 // MarshalXML serializes ClaimResponseEvent to FHIR-conformant XML.
 func (b ClaimResponseEvent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -179,6 +178,154 @@ func (r *Contract) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *Contract) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *Contract) Performers() []Reference {
+	var refs []Reference
+	if r.Author != nil {
+		refs = append(refs, *r.Author)
+	}
+	return refs
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Contract) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Contract) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddUrlExtension appends ext to the extensions of the url
+// primitive (JSON "_url"), allocating UrlExt if needed.
+func (r *Contract) AddUrlExtension(ext Extension) {
+	if r.UrlExt == nil {
+		r.UrlExt = &Element{}
+	}
+	r.UrlExt.Extension = append(r.UrlExt.Extension, ext)
+}
+
+// AddVersionExtension appends ext to the extensions of the version
+// primitive (JSON "_version"), allocating VersionExt if needed.
+func (r *Contract) AddVersionExtension(ext Extension) {
+	if r.VersionExt == nil {
+		r.VersionExt = &Element{}
+	}
+	r.VersionExt.Extension = append(r.VersionExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *Contract) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddInstantiatesUriExtension appends ext to the extensions of the instantiatesUri
+// primitive (JSON "_instantiatesUri"), allocating InstantiatesUriExt if needed.
+func (r *Contract) AddInstantiatesUriExtension(ext Extension) {
+	if r.InstantiatesUriExt == nil {
+		r.InstantiatesUriExt = &Element{}
+	}
+	r.InstantiatesUriExt.Extension = append(r.InstantiatesUriExt.Extension, ext)
+}
+
+// AddIssuedExtension appends ext to the extensions of the issued
+// primitive (JSON "_issued"), allocating IssuedExt if needed.
+func (r *Contract) AddIssuedExtension(ext Extension) {
+	if r.IssuedExt == nil {
+		r.IssuedExt = &Element{}
+	}
+	r.IssuedExt.Extension = append(r.IssuedExt.Extension, ext)
+}
+
+// AddNameExtension appends ext to the extensions of the name
+// primitive (JSON "_name"), allocating NameExt if needed.
+func (r *Contract) AddNameExtension(ext Extension) {
+	if r.NameExt == nil {
+		r.NameExt = &Element{}
+	}
+	r.NameExt.Extension = append(r.NameExt.Extension, ext)
+}
+
+// AddTitleExtension appends ext to the extensions of the title
+// primitive (JSON "_title"), allocating TitleExt if needed.
+func (r *Contract) AddTitleExtension(ext Extension) {
+	if r.TitleExt == nil {
+		r.TitleExt = &Element{}
+	}
+	r.TitleExt.Extension = append(r.TitleExt.Extension, ext)
+}
+
+// AddSubtitleExtension appends ext to the extensions of the subtitle
+// primitive (JSON "_subtitle"), allocating SubtitleExt if needed.
+func (r *Contract) AddSubtitleExtension(ext Extension) {
+	if r.SubtitleExt == nil {
+		r.SubtitleExt = &Element{}
+	}
+	r.SubtitleExt.Extension = append(r.SubtitleExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *Contract) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the Contract, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Contract) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"topic":          {"TopicCodeableConcept", "TopicReference"},
+		"legallyBinding": {"LegallyBindingAttachment", "LegallyBindingReference"},
+	}
+}
+
+// HasTopic reports whether any topic[x] variant of the
+// Contract is set.
+func (r *Contract) HasTopic() bool {
+	return r.TopicCodeableConcept != nil || r.TopicReference != nil
+}
+
+// HasLegallyBinding reports whether any legallyBinding[x] variant of the
+// Contract is set.
+func (r *Contract) HasLegallyBinding() bool {
+	return r.LegallyBindingAttachment != nil || r.LegallyBindingReference != nil
+}
+
+// ValidateReferences checks that every populated reference in the Contract,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Contract) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -219,9 +366,9 @@ func (r *Contract) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -890,6 +1037,12 @@ type ContractFriendly struct {
 	ContentReference *Reference `json:"contentReference,omitempty"`
 }
 
+// HasContent reports whether any content[x] variant of the
+// ContractFriendly is set.
+func (b *ContractFriendly) HasContent() bool {
+	return b.ContentAttachment != nil || b.ContentReference != nil
+}
+
 // MarshalXML serializes ContractFriendly to FHIR-conformant XML.
 func (b ContractFriendly) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -993,6 +1146,12 @@ type ContractLegal struct {
 	ContentReference *Reference `json:"contentReference,omitempty"`
 }
 
+// HasContent reports whether any content[x] variant of the
+// ContractLegal is set.
+func (b *ContractLegal) HasContent() bool {
+	return b.ContentAttachment != nil || b.ContentReference != nil
+}
+
 // MarshalXML serializes ContractLegal to FHIR-conformant XML.
 func (b ContractLegal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1096,6 +1255,12 @@ type ContractRule struct {
 	ContentReference *Reference `json:"contentReference,omitempty"`
 }
 
+// HasContent reports whether any content[x] variant of the
+// ContractRule is set.
+func (b *ContractRule) HasContent() bool {
+	return b.ContentAttachment != nil || b.ContentReference != nil
+}
+
 // MarshalXML serializes ContractRule to FHIR-conformant XML.
 func (b ContractRule) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1329,6 +1494,12 @@ type ContractTerm struct {
 	Group []ContractTerm `json:"group,omitempty"`
 }
 
+// HasTopic reports whether any topic[x] variant of the
+// ContractTerm is set.
+func (b *ContractTerm) HasTopic() bool {
+	return b.TopicCodeableConcept != nil || b.TopicReference != nil
+}
+
 // MarshalXML serializes ContractTerm to FHIR-conformant XML.
 func (b ContractTerm) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1589,6 +1760,12 @@ type ContractTermAction struct {
 	SecurityLabelNumber []uint32 `json:"securityLabelNumber,omitempty"`
 }
 
+// HasOccurrence reports whether any occurrence[x] variant of the
+// ContractTermAction is set.
+func (b *ContractTermAction) HasOccurrence() bool {
+	return b.OccurrenceDateTime != nil || b.OccurrencePeriod != nil || b.OccurrenceTiming != nil
+}
+
 // MarshalXML serializes ContractTermAction to FHIR-conformant XML.
 func (b ContractTermAction) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -2396,6 +2573,12 @@ type ContractTermAssetValuedItem struct {
 	SecurityLabelNumber []uint32 `json:"securityLabelNumber,omitempty"`
 }
 
+// HasEntity reports whether any entity[x] variant of the
+// ContractTermAssetValuedItem is set.
+func (b *ContractTermAssetValuedItem) HasEntity() bool {
+	return b.EntityCodeableConcept != nil || b.EntityReference != nil
+}
+
 // MarshalXML serializes ContractTermAssetValuedItem to FHIR-conformant XML.
 func (b ContractTermAssetValuedItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -2873,6 +3056,12 @@ type ContractTermOfferAnswer struct {
 	ValueReference *Reference `json:"valueReference,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// ContractTermOfferAnswer is set.
+func (b *ContractTermOfferAnswer) HasValue() bool {
+	return b.ValueBoolean != nil || b.ValueDecimal != nil || b.ValueInteger != nil || b.ValueDate != nil || b.ValueDateTime != nil || b.ValueTime != nil || b.ValueString != nil || b.ValueUri != nil || b.ValueAttachment != nil || b.ValueCoding != nil || b.ValueQuantity != nil || b.ValueReference != nil
+}
+
 // MarshalXML serializes ContractTermOfferAnswer to FHIR-conformant XML.
 func (b ContractTermOfferAnswer) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -115,6 +114,76 @@ func (r *DeviceMetric) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *DeviceMetric) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *DeviceMetric) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddOperationalStatusExtension appends ext to the extensions of the operationalStatus
+// primitive (JSON "_operationalStatus"), allocating OperationalStatusExt if needed.
+func (r *DeviceMetric) AddOperationalStatusExtension(ext Extension) {
+	if r.OperationalStatusExt == nil {
+		r.OperationalStatusExt = &Element{}
+	}
+	r.OperationalStatusExt.Extension = append(r.OperationalStatusExt.Extension, ext)
+}
+
+// AddColorExtension appends ext to the extensions of the color
+// primitive (JSON "_color"), allocating ColorExt if needed.
+func (r *DeviceMetric) AddColorExtension(ext Extension) {
+	if r.ColorExt == nil {
+		r.ColorExt = &Element{}
+	}
+	r.ColorExt.Extension = append(r.ColorExt.Extension, ext)
+}
+
+// AddCategoryExtension appends ext to the extensions of the category
+// primitive (JSON "_category"), allocating CategoryExt if needed.
+func (r *DeviceMetric) AddCategoryExtension(ext Extension) {
+	if r.CategoryExt == nil {
+		r.CategoryExt = &Element{}
+	}
+	r.CategoryExt.Extension = append(r.CategoryExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *DeviceMetric) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the DeviceMetric, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *DeviceMetric) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the DeviceMetric,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *DeviceMetric) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -155,9 +224,9 @@ func (r *DeviceMetric) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
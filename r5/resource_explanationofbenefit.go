@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -203,6 +202,111 @@ func (r *ExplanationOfBenefit) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *ExplanationOfBenefit) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *ExplanationOfBenefit) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *ExplanationOfBenefit) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *ExplanationOfBenefit) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddUseExtension appends ext to the extensions of the use
+// primitive (JSON "_use"), allocating UseExt if needed.
+func (r *ExplanationOfBenefit) AddUseExtension(ext Extension) {
+	if r.UseExt == nil {
+		r.UseExt = &Element{}
+	}
+	r.UseExt.Extension = append(r.UseExt.Extension, ext)
+}
+
+// AddCreatedExtension appends ext to the extensions of the created
+// primitive (JSON "_created"), allocating CreatedExt if needed.
+func (r *ExplanationOfBenefit) AddCreatedExtension(ext Extension) {
+	if r.CreatedExt == nil {
+		r.CreatedExt = &Element{}
+	}
+	r.CreatedExt.Extension = append(r.CreatedExt.Extension, ext)
+}
+
+// AddOutcomeExtension appends ext to the extensions of the outcome
+// primitive (JSON "_outcome"), allocating OutcomeExt if needed.
+func (r *ExplanationOfBenefit) AddOutcomeExtension(ext Extension) {
+	if r.OutcomeExt == nil {
+		r.OutcomeExt = &Element{}
+	}
+	r.OutcomeExt.Extension = append(r.OutcomeExt.Extension, ext)
+}
+
+// AddDispositionExtension appends ext to the extensions of the disposition
+// primitive (JSON "_disposition"), allocating DispositionExt if needed.
+func (r *ExplanationOfBenefit) AddDispositionExtension(ext Extension) {
+	if r.DispositionExt == nil {
+		r.DispositionExt = &Element{}
+	}
+	r.DispositionExt.Extension = append(r.DispositionExt.Extension, ext)
+}
+
+// AddPrecedenceExtension appends ext to the extensions of the precedence
+// primitive (JSON "_precedence"), allocating PrecedenceExt if needed.
+func (r *ExplanationOfBenefit) AddPrecedenceExtension(ext Extension) {
+	if r.PrecedenceExt == nil {
+		r.PrecedenceExt = &Element{}
+	}
+	r.PrecedenceExt.Extension = append(r.PrecedenceExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *ExplanationOfBenefit) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the ExplanationOfBenefit, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *ExplanationOfBenefit) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the ExplanationOfBenefit,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *ExplanationOfBenefit) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -243,9 +347,9 @@ func (r *ExplanationOfBenefit) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -921,6 +1025,12 @@ type ExplanationOfBenefitAccident struct {
 	LocationReference *Reference `json:"locationReference,omitempty"`
 }
 
+// HasLocation reports whether any location[x] variant of the
+// ExplanationOfBenefitAccident is set.
+func (b *ExplanationOfBenefitAccident) HasLocation() bool {
+	return b.LocationAddress != nil || b.LocationReference != nil
+}
+
 // MarshalXML serializes ExplanationOfBenefitAccident to FHIR-conformant XML.
 func (b ExplanationOfBenefitAccident) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1096,6 +1206,18 @@ type ExplanationOfBenefitAddItem struct {
 	Detail []ExplanationOfBenefitAddItemDetail `json:"detail,omitempty"`
 }
 
+// HasServiced reports whether any serviced[x] variant of the
+// ExplanationOfBenefitAddItem is set.
+func (b *ExplanationOfBenefitAddItem) HasServiced() bool {
+	return b.ServicedDate != nil || b.ServicedPeriod != nil
+}
+
+// HasLocation reports whether any location[x] variant of the
+// ExplanationOfBenefitAddItem is set.
+func (b *ExplanationOfBenefitAddItem) HasLocation() bool {
+	return b.LocationCodeableConcept != nil || b.LocationAddress != nil || b.LocationReference != nil
+}
+
 // MarshalXML serializes ExplanationOfBenefitAddItem to FHIR-conformant XML.
 func (b ExplanationOfBenefitAddItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -2285,6 +2407,18 @@ type ExplanationOfBenefitBenefitBalanceFinancial struct {
 	UsedMoney *Money `json:"usedMoney,omitempty"`
 }
 
+// HasAllowed reports whether any allowed[x] variant of the
+// ExplanationOfBenefitBenefitBalanceFinancial is set.
+func (b *ExplanationOfBenefitBenefitBalanceFinancial) HasAllowed() bool {
+	return b.AllowedUnsignedInt != nil || b.AllowedString != nil || b.AllowedMoney != nil
+}
+
+// HasUsed reports whether any used[x] variant of the
+// ExplanationOfBenefitBenefitBalanceFinancial is set.
+func (b *ExplanationOfBenefitBenefitBalanceFinancial) HasUsed() bool {
+	return b.UsedUnsignedInt != nil || b.UsedMoney != nil
+}
+
 // MarshalXML serializes ExplanationOfBenefitBenefitBalanceFinancial to FHIR-conformant XML.
 func (b ExplanationOfBenefitBenefitBalanceFinancial) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -2562,6 +2696,12 @@ type ExplanationOfBenefitDiagnosis struct {
 	OnAdmission *CodeableConcept `json:"onAdmission,omitempty"`
 }
 
+// HasDiagnosis reports whether any diagnosis[x] variant of the
+// ExplanationOfBenefitDiagnosis is set.
+func (b *ExplanationOfBenefitDiagnosis) HasDiagnosis() bool {
+	return b.DiagnosisCodeableConcept != nil || b.DiagnosisReference != nil
+}
+
 // MarshalXML serializes ExplanationOfBenefitDiagnosis to FHIR-conformant XML.
 func (b ExplanationOfBenefitDiagnosis) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -2700,6 +2840,12 @@ type ExplanationOfBenefitEvent struct {
 	WhenPeriod *Period `json:"whenPeriod,omitempty"`
 }
 
+// HasWhen reports whether any when[x] variant of the
+// ExplanationOfBenefitEvent is set.
+func (b *ExplanationOfBenefitEvent) HasWhen() bool {
+	return b.WhenDateTime != nil || b.WhenPeriod != nil
+}
+
 // MarshalXML serializes ExplanationOfBenefitEvent to FHIR-conformant XML.
 func (b ExplanationOfBenefitEvent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -2978,6 +3124,18 @@ type ExplanationOfBenefitItem struct {
 	Detail []ExplanationOfBenefitItemDetail `json:"detail,omitempty"`
 }
 
+// HasServiced reports whether any serviced[x] variant of the
+// ExplanationOfBenefitItem is set.
+func (b *ExplanationOfBenefitItem) HasServiced() bool {
+	return b.ServicedDate != nil || b.ServicedPeriod != nil
+}
+
+// HasLocation reports whether any location[x] variant of the
+// ExplanationOfBenefitItem is set.
+func (b *ExplanationOfBenefitItem) HasLocation() bool {
+	return b.LocationCodeableConcept != nil || b.LocationAddress != nil || b.LocationReference != nil
+}
+
 // MarshalXML serializes ExplanationOfBenefitItem to FHIR-conformant XML.
 func (b ExplanationOfBenefitItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -4640,6 +4798,12 @@ type ExplanationOfBenefitProcedure struct {
 	Udi []Reference `json:"udi,omitempty"`
 }
 
+// HasProcedure reports whether any procedure[x] variant of the
+// ExplanationOfBenefitProcedure is set.
+func (b *ExplanationOfBenefitProcedure) HasProcedure() bool {
+	return b.ProcedureCodeableConcept != nil || b.ProcedureReference != nil
+}
+
 // MarshalXML serializes ExplanationOfBenefitProcedure to FHIR-conformant XML.
 func (b ExplanationOfBenefitProcedure) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -5050,6 +5214,18 @@ type ExplanationOfBenefitSupportingInfo struct {
 	Reason *Coding `json:"reason,omitempty"`
 }
 
+// HasTiming reports whether any timing[x] variant of the
+// ExplanationOfBenefitSupportingInfo is set.
+func (b *ExplanationOfBenefitSupportingInfo) HasTiming() bool {
+	return b.TimingDate != nil || b.TimingPeriod != nil
+}
+
+// HasValue reports whether any value[x] variant of the
+// ExplanationOfBenefitSupportingInfo is set.
+func (b *ExplanationOfBenefitSupportingInfo) HasValue() bool {
+	return b.ValueBoolean != nil || b.ValueString != nil || b.ValueQuantity != nil || b.ValueAttachment != nil || b.ValueReference != nil || b.ValueIdentifier != nil
+}
+
 // MarshalXML serializes ExplanationOfBenefitSupportingInfo to FHIR-conformant XML.
 func (b ExplanationOfBenefitSupportingInfo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -131,6 +130,111 @@ func (r *GenomicStudy) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *GenomicStudy) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *GenomicStudy) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *GenomicStudy) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *GenomicStudy) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddStartDateExtension appends ext to the extensions of the startDate
+// primitive (JSON "_startDate"), allocating StartDateExt if needed.
+func (r *GenomicStudy) AddStartDateExtension(ext Extension) {
+	if r.StartDateExt == nil {
+		r.StartDateExt = &Element{}
+	}
+	r.StartDateExt.Extension = append(r.StartDateExt.Extension, ext)
+}
+
+// AddInstantiatesCanonicalExtension appends ext to the extensions of the instantiatesCanonical
+// primitive (JSON "_instantiatesCanonical"), allocating InstantiatesCanonicalExt if needed.
+func (r *GenomicStudy) AddInstantiatesCanonicalExtension(ext Extension) {
+	if r.InstantiatesCanonicalExt == nil {
+		r.InstantiatesCanonicalExt = &Element{}
+	}
+	r.InstantiatesCanonicalExt.Extension = append(r.InstantiatesCanonicalExt.Extension, ext)
+}
+
+// AddInstantiatesUriExtension appends ext to the extensions of the instantiatesUri
+// primitive (JSON "_instantiatesUri"), allocating InstantiatesUriExt if needed.
+func (r *GenomicStudy) AddInstantiatesUriExtension(ext Extension) {
+	if r.InstantiatesUriExt == nil {
+		r.InstantiatesUriExt = &Element{}
+	}
+	r.InstantiatesUriExt.Extension = append(r.InstantiatesUriExt.Extension, ext)
+}
+
+// AddDescriptionExtension appends ext to the extensions of the description
+// primitive (JSON "_description"), allocating DescriptionExt if needed.
+func (r *GenomicStudy) AddDescriptionExtension(ext Extension) {
+	if r.DescriptionExt == nil {
+		r.DescriptionExt = &Element{}
+	}
+	r.DescriptionExt.Extension = append(r.DescriptionExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *GenomicStudy) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *GenomicStudy) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the GenomicStudy, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *GenomicStudy) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the GenomicStudy,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *GenomicStudy) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -171,9 +275,9 @@ func (r *GenomicStudy) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -886,6 +990,12 @@ type GenomicStudyAnalysisInput struct {
 	GeneratedByReference *Reference `json:"generatedByReference,omitempty"`
 }
 
+// HasGeneratedBy reports whether any generatedBy[x] variant of the
+// GenomicStudyAnalysisInput is set.
+func (b *GenomicStudyAnalysisInput) HasGeneratedBy() bool {
+	return b.GeneratedByIdentifier != nil || b.GeneratedByReference != nil
+}
+
 // MarshalXML serializes GenomicStudyAnalysisInput to FHIR-conformant XML.
 func (b GenomicStudyAnalysisInput) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -135,6 +134,111 @@ func (r *Goal) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Goal) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Goal) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddLifecycleStatusExtension appends ext to the extensions of the lifecycleStatus
+// primitive (JSON "_lifecycleStatus"), allocating LifecycleStatusExt if needed.
+func (r *Goal) AddLifecycleStatusExtension(ext Extension) {
+	if r.LifecycleStatusExt == nil {
+		r.LifecycleStatusExt = &Element{}
+	}
+	r.LifecycleStatusExt.Extension = append(r.LifecycleStatusExt.Extension, ext)
+}
+
+// AddContinuousExtension appends ext to the extensions of the continuous
+// primitive (JSON "_continuous"), allocating ContinuousExt if needed.
+func (r *Goal) AddContinuousExtension(ext Extension) {
+	if r.ContinuousExt == nil {
+		r.ContinuousExt = &Element{}
+	}
+	r.ContinuousExt.Extension = append(r.ContinuousExt.Extension, ext)
+}
+
+// AddStatusDateExtension appends ext to the extensions of the statusDate
+// primitive (JSON "_statusDate"), allocating StatusDateExt if needed.
+func (r *Goal) AddStatusDateExtension(ext Extension) {
+	if r.StatusDateExt == nil {
+		r.StatusDateExt = &Element{}
+	}
+	r.StatusDateExt.Extension = append(r.StatusDateExt.Extension, ext)
+}
+
+// AddStatusReasonExtension appends ext to the extensions of the statusReason
+// primitive (JSON "_statusReason"), allocating StatusReasonExt if needed.
+func (r *Goal) AddStatusReasonExtension(ext Extension) {
+	if r.StatusReasonExt == nil {
+		r.StatusReasonExt = &Element{}
+	}
+	r.StatusReasonExt.Extension = append(r.StatusReasonExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *Goal) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstCategory returns a pointer to the first category entry, or nil
+// if there is none.
+func (r *Goal) FirstCategory() *CodeableConcept {
+	if r == nil || len(r.Category) == 0 {
+		return nil
+	}
+	return &r.Category[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *Goal) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the Goal, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Goal) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"start": {"StartDate", "StartCodeableConcept"},
+	}
+}
+
+// HasStart reports whether any start[x] variant of the
+// Goal is set.
+func (r *Goal) HasStart() bool {
+	return r.StartDate != nil || r.StartCodeableConcept != nil
+}
+
+// ValidateReferences checks that every populated reference in the Goal,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Goal) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -175,9 +279,9 @@ func (r *Goal) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -522,6 +626,18 @@ type GoalTarget struct {
 	DueDuration *Duration `json:"dueDuration,omitempty"`
 }
 
+// HasDetail reports whether any detail[x] variant of the
+// GoalTarget is set.
+func (b *GoalTarget) HasDetail() bool {
+	return b.DetailQuantity != nil || b.DetailRange != nil || b.DetailCodeableConcept != nil || b.DetailString != nil || b.DetailBoolean != nil || b.DetailInteger != nil || b.DetailRatio != nil
+}
+
+// HasDue reports whether any due[x] variant of the
+// GoalTarget is set.
+func (b *GoalTarget) HasDue() bool {
+	return b.DueDate != nil || b.DueDuration != nil
+}
+
 // MarshalXML serializes GoalTarget to FHIR-conformant XML.
 func (b GoalTarget) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
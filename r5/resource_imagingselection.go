@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -137,6 +136,138 @@ func (r *ImagingSelection) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *ImagingSelection) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *ImagingSelection) Performers() []Reference {
+	var refs []Reference
+	for _, p := range r.Performer {
+		if p.Actor != nil {
+			refs = append(refs, *p.Actor)
+		}
+	}
+	return refs
+}
+
+// PrimaryCode returns the resource's code element, or nil if it is unset,
+// implementing CodeHolder.
+func (r *ImagingSelection) PrimaryCode() *CodeableConcept {
+	return codeOrNil(&r.Code)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *ImagingSelection) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *ImagingSelection) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *ImagingSelection) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddIssuedExtension appends ext to the extensions of the issued
+// primitive (JSON "_issued"), allocating IssuedExt if needed.
+func (r *ImagingSelection) AddIssuedExtension(ext Extension) {
+	if r.IssuedExt == nil {
+		r.IssuedExt = &Element{}
+	}
+	r.IssuedExt.Extension = append(r.IssuedExt.Extension, ext)
+}
+
+// AddStudyUidExtension appends ext to the extensions of the studyUid
+// primitive (JSON "_studyUid"), allocating StudyUidExt if needed.
+func (r *ImagingSelection) AddStudyUidExtension(ext Extension) {
+	if r.StudyUidExt == nil {
+		r.StudyUidExt = &Element{}
+	}
+	r.StudyUidExt.Extension = append(r.StudyUidExt.Extension, ext)
+}
+
+// AddSeriesUidExtension appends ext to the extensions of the seriesUid
+// primitive (JSON "_seriesUid"), allocating SeriesUidExt if needed.
+func (r *ImagingSelection) AddSeriesUidExtension(ext Extension) {
+	if r.SeriesUidExt == nil {
+		r.SeriesUidExt = &Element{}
+	}
+	r.SeriesUidExt.Extension = append(r.SeriesUidExt.Extension, ext)
+}
+
+// AddSeriesNumberExtension appends ext to the extensions of the seriesNumber
+// primitive (JSON "_seriesNumber"), allocating SeriesNumberExt if needed.
+func (r *ImagingSelection) AddSeriesNumberExtension(ext Extension) {
+	if r.SeriesNumberExt == nil {
+		r.SeriesNumberExt = &Element{}
+	}
+	r.SeriesNumberExt.Extension = append(r.SeriesNumberExt.Extension, ext)
+}
+
+// AddFrameOfReferenceUidExtension appends ext to the extensions of the frameOfReferenceUid
+// primitive (JSON "_frameOfReferenceUid"), allocating FrameOfReferenceUidExt if needed.
+func (r *ImagingSelection) AddFrameOfReferenceUidExtension(ext Extension) {
+	if r.FrameOfReferenceUidExt == nil {
+		r.FrameOfReferenceUidExt = &Element{}
+	}
+	r.FrameOfReferenceUidExt.Extension = append(r.FrameOfReferenceUidExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *ImagingSelection) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstCategory returns a pointer to the first category entry, or nil
+// if there is none.
+func (r *ImagingSelection) FirstCategory() *CodeableConcept {
+	if r == nil || len(r.Category) == 0 {
+		return nil
+	}
+	return &r.Category[0]
+}
+
+// ChoiceGroups returns the choice elements of the ImagingSelection, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *ImagingSelection) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the ImagingSelection,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *ImagingSelection) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -177,9 +308,9 @@ func (r *ImagingSelection) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -141,6 +140,119 @@ func (r *Invoice) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *Invoice) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Invoice) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Invoice) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *Invoice) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddCancelledReasonExtension appends ext to the extensions of the cancelledReason
+// primitive (JSON "_cancelledReason"), allocating CancelledReasonExt if needed.
+func (r *Invoice) AddCancelledReasonExtension(ext Extension) {
+	if r.CancelledReasonExt == nil {
+		r.CancelledReasonExt = &Element{}
+	}
+	r.CancelledReasonExt.Extension = append(r.CancelledReasonExt.Extension, ext)
+}
+
+// AddDateExtension appends ext to the extensions of the date
+// primitive (JSON "_date"), allocating DateExt if needed.
+func (r *Invoice) AddDateExtension(ext Extension) {
+	if r.DateExt == nil {
+		r.DateExt = &Element{}
+	}
+	r.DateExt.Extension = append(r.DateExt.Extension, ext)
+}
+
+// AddCreationExtension appends ext to the extensions of the creation
+// primitive (JSON "_creation"), allocating CreationExt if needed.
+func (r *Invoice) AddCreationExtension(ext Extension) {
+	if r.CreationExt == nil {
+		r.CreationExt = &Element{}
+	}
+	r.CreationExt.Extension = append(r.CreationExt.Extension, ext)
+}
+
+// AddPaymentTermsExtension appends ext to the extensions of the paymentTerms
+// primitive (JSON "_paymentTerms"), allocating PaymentTermsExt if needed.
+func (r *Invoice) AddPaymentTermsExtension(ext Extension) {
+	if r.PaymentTermsExt == nil {
+		r.PaymentTermsExt = &Element{}
+	}
+	r.PaymentTermsExt.Extension = append(r.PaymentTermsExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *Invoice) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *Invoice) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the Invoice, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Invoice) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"period": {"PeriodDate", "PeriodPeriod"},
+	}
+}
+
+// HasPeriod reports whether any period[x] variant of the
+// Invoice is set.
+func (r *Invoice) HasPeriod() bool {
+	return r.PeriodDate != nil || r.PeriodPeriod != nil
+}
+
+// ValidateReferences checks that every populated reference in the Invoice,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Invoice) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -181,9 +293,9 @@ func (r *Invoice) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -543,6 +655,18 @@ type InvoiceLineItem struct {
 	PriceComponent []MonetaryComponent `json:"priceComponent,omitempty"`
 }
 
+// HasServiced reports whether any serviced[x] variant of the
+// InvoiceLineItem is set.
+func (b *InvoiceLineItem) HasServiced() bool {
+	return b.ServicedDate != nil || b.ServicedPeriod != nil
+}
+
+// HasChargeItem reports whether any chargeItem[x] variant of the
+// InvoiceLineItem is set.
+func (b *InvoiceLineItem) HasChargeItem() bool {
+	return b.ChargeItemReference != nil || b.ChargeItemCodeableConcept != nil
+}
+
 // MarshalXML serializes InvoiceLineItem to FHIR-conformant XML.
 func (b InvoiceLineItem) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -135,6 +134,102 @@ func (r *MeasureReport) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *MeasureReport) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *MeasureReport) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *MeasureReport) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *MeasureReport) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddTypeExtension appends ext to the extensions of the type
+// primitive (JSON "_type"), allocating TypeExt if needed.
+func (r *MeasureReport) AddTypeExtension(ext Extension) {
+	if r.TypeExt == nil {
+		r.TypeExt = &Element{}
+	}
+	r.TypeExt.Extension = append(r.TypeExt.Extension, ext)
+}
+
+// AddDataUpdateTypeExtension appends ext to the extensions of the dataUpdateType
+// primitive (JSON "_dataUpdateType"), allocating DataUpdateTypeExt if needed.
+func (r *MeasureReport) AddDataUpdateTypeExtension(ext Extension) {
+	if r.DataUpdateTypeExt == nil {
+		r.DataUpdateTypeExt = &Element{}
+	}
+	r.DataUpdateTypeExt.Extension = append(r.DataUpdateTypeExt.Extension, ext)
+}
+
+// AddMeasureExtension appends ext to the extensions of the measure
+// primitive (JSON "_measure"), allocating MeasureExt if needed.
+func (r *MeasureReport) AddMeasureExtension(ext Extension) {
+	if r.MeasureExt == nil {
+		r.MeasureExt = &Element{}
+	}
+	r.MeasureExt.Extension = append(r.MeasureExt.Extension, ext)
+}
+
+// AddDateExtension appends ext to the extensions of the date
+// primitive (JSON "_date"), allocating DateExt if needed.
+func (r *MeasureReport) AddDateExtension(ext Extension) {
+	if r.DateExt == nil {
+		r.DateExt = &Element{}
+	}
+	r.DateExt.Extension = append(r.DateExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *MeasureReport) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the MeasureReport, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *MeasureReport) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the MeasureReport,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *MeasureReport) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -175,9 +270,9 @@ func (r *MeasureReport) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -522,6 +617,12 @@ type MeasureReportGroup struct {
 	Stratifier []MeasureReportGroupStratifier `json:"stratifier,omitempty"`
 }
 
+// HasMeasureScore reports whether any measureScore[x] variant of the
+// MeasureReportGroup is set.
+func (b *MeasureReportGroup) HasMeasureScore() bool {
+	return b.MeasureScoreQuantity != nil || b.MeasureScoreDateTime != nil || b.MeasureScoreCodeableConcept != nil || b.MeasureScorePeriod != nil || b.MeasureScoreRange != nil || b.MeasureScoreDuration != nil
+}
+
 // MarshalXML serializes MeasureReportGroup to FHIR-conformant XML.
 func (b MeasureReportGroup) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1011,6 +1112,18 @@ type MeasureReportGroupStratifierStratum struct {
 	MeasureScoreDuration *Duration `json:"measureScoreDuration,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// MeasureReportGroupStratifierStratum is set.
+func (b *MeasureReportGroupStratifierStratum) HasValue() bool {
+	return b.ValueCodeableConcept != nil || b.ValueBoolean != nil || b.ValueQuantity != nil || b.ValueRange != nil || b.ValueReference != nil
+}
+
+// HasMeasureScore reports whether any measureScore[x] variant of the
+// MeasureReportGroupStratifierStratum is set.
+func (b *MeasureReportGroupStratifierStratum) HasMeasureScore() bool {
+	return b.MeasureScoreQuantity != nil || b.MeasureScoreDateTime != nil || b.MeasureScoreCodeableConcept != nil || b.MeasureScorePeriod != nil || b.MeasureScoreRange != nil || b.MeasureScoreDuration != nil
+}
+
 // MarshalXML serializes MeasureReportGroupStratifierStratum to FHIR-conformant XML.
 func (b MeasureReportGroupStratifierStratum) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1243,6 +1356,12 @@ type MeasureReportGroupStratifierStratumComponent struct {
 	ValueReference *Reference `json:"valueReference,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// MeasureReportGroupStratifierStratumComponent is set.
+func (b *MeasureReportGroupStratifierStratumComponent) HasValue() bool {
+	return b.ValueCodeableConcept != nil || b.ValueBoolean != nil || b.ValueQuantity != nil || b.ValueRange != nil || b.ValueReference != nil
+}
+
 // MarshalXML serializes MeasureReportGroupStratifierStratumComponent to FHIR-conformant XML.
 func (b MeasureReportGroupStratifierStratumComponent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
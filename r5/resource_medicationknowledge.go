@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -137,6 +136,91 @@ func (r *MedicationKnowledge) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *MedicationKnowledge) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *MedicationKnowledge) Performers() []Reference {
+	var refs []Reference
+	if r.Author != nil {
+		refs = append(refs, *r.Author)
+	}
+	return refs
+}
+
+// PrimaryCode returns the resource's code element, or nil if it is unset,
+// implementing CodeHolder.
+func (r *MedicationKnowledge) PrimaryCode() *CodeableConcept {
+	return r.Code
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *MedicationKnowledge) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *MedicationKnowledge) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *MedicationKnowledge) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddPreparationInstructionExtension appends ext to the extensions of the preparationInstruction
+// primitive (JSON "_preparationInstruction"), allocating PreparationInstructionExt if needed.
+func (r *MedicationKnowledge) AddPreparationInstructionExtension(ext Extension) {
+	if r.PreparationInstructionExt == nil {
+		r.PreparationInstructionExt = &Element{}
+	}
+	r.PreparationInstructionExt.Extension = append(r.PreparationInstructionExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *MedicationKnowledge) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the MedicationKnowledge, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *MedicationKnowledge) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the MedicationKnowledge,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *MedicationKnowledge) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -177,9 +261,9 @@ func (r *MedicationKnowledge) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -550,6 +634,12 @@ type MedicationKnowledgeCost struct {
 	CostCodeableConcept *CodeableConcept `json:"costCodeableConcept,omitempty"`
 }
 
+// HasCost reports whether any cost[x] variant of the
+// MedicationKnowledgeCost is set.
+func (b *MedicationKnowledgeCost) HasCost() bool {
+	return b.CostMoney != nil || b.CostCodeableConcept != nil
+}
+
 // MarshalXML serializes MedicationKnowledgeCost to FHIR-conformant XML.
 func (b MedicationKnowledgeCost) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -834,6 +924,12 @@ type MedicationKnowledgeDefinitionalDrugCharacteristic struct {
 	ValueAttachment *Attachment `json:"valueAttachment,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// MedicationKnowledgeDefinitionalDrugCharacteristic is set.
+func (b *MedicationKnowledgeDefinitionalDrugCharacteristic) HasValue() bool {
+	return b.ValueCodeableConcept != nil || b.ValueString != nil || b.ValueQuantity != nil || b.ValueBase64Binary != nil || b.ValueAttachment != nil
+}
+
 // MarshalXML serializes MedicationKnowledgeDefinitionalDrugCharacteristic to FHIR-conformant XML.
 func (b MedicationKnowledgeDefinitionalDrugCharacteristic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -983,6 +1079,12 @@ type MedicationKnowledgeDefinitionalIngredient struct {
 	StrengthQuantity *Quantity `json:"strengthQuantity,omitempty"`
 }
 
+// HasStrength reports whether any strength[x] variant of the
+// MedicationKnowledgeDefinitionalIngredient is set.
+func (b *MedicationKnowledgeDefinitionalIngredient) HasStrength() bool {
+	return b.StrengthRatio != nil || b.StrengthCodeableConcept != nil || b.StrengthQuantity != nil
+}
+
 // MarshalXML serializes MedicationKnowledgeDefinitionalIngredient to FHIR-conformant XML.
 func (b MedicationKnowledgeDefinitionalIngredient) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1450,6 +1552,12 @@ type MedicationKnowledgeIndicationGuidelineDosingGuidelinePatientCharacteristic
 	ValueRange *Range `json:"valueRange,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// MedicationKnowledgeIndicationGuidelineDosingGuidelinePatientCharacteristic is set.
+func (b *MedicationKnowledgeIndicationGuidelineDosingGuidelinePatientCharacteristic) HasValue() bool {
+	return b.ValueCodeableConcept != nil || b.ValueQuantity != nil || b.ValueRange != nil
+}
+
 // MarshalXML serializes MedicationKnowledgeIndicationGuidelineDosingGuidelinePatientCharacteristic to FHIR-conformant XML.
 func (b MedicationKnowledgeIndicationGuidelineDosingGuidelinePatientCharacteristic) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1579,6 +1687,12 @@ type MedicationKnowledgeMedicineClassification struct {
 	Classification []CodeableConcept `json:"classification,omitempty"`
 }
 
+// HasSource reports whether any source[x] variant of the
+// MedicationKnowledgeMedicineClassification is set.
+func (b *MedicationKnowledgeMedicineClassification) HasSource() bool {
+	return b.SourceString != nil || b.SourceUri != nil
+}
+
 // MarshalXML serializes MedicationKnowledgeMedicineClassification to FHIR-conformant XML.
 func (b MedicationKnowledgeMedicineClassification) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -2554,6 +2668,12 @@ type MedicationKnowledgeStorageGuidelineEnvironmentalSetting struct {
 	ValueCodeableConcept *CodeableConcept `json:"valueCodeableConcept,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// MedicationKnowledgeStorageGuidelineEnvironmentalSetting is set.
+func (b *MedicationKnowledgeStorageGuidelineEnvironmentalSetting) HasValue() bool {
+	return b.ValueQuantity != nil || b.ValueRange != nil || b.ValueCodeableConcept != nil
+}
+
 // MarshalXML serializes MedicationKnowledgeStorageGuidelineEnvironmentalSetting to FHIR-conformant XML.
 func (b MedicationKnowledgeStorageGuidelineEnvironmentalSetting) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -117,6 +116,67 @@ func (r *MessageHeader) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *MessageHeader) Performers() []Reference {
+	var refs []Reference
+	if r.Author != nil {
+		refs = append(refs, *r.Author)
+	}
+	return refs
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *MessageHeader) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *MessageHeader) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddDefinitionExtension appends ext to the extensions of the definition
+// primitive (JSON "_definition"), allocating DefinitionExt if needed.
+func (r *MessageHeader) AddDefinitionExtension(ext Extension) {
+	if r.DefinitionExt == nil {
+		r.DefinitionExt = &Element{}
+	}
+	r.DefinitionExt.Extension = append(r.DefinitionExt.Extension, ext)
+}
+
+// ChoiceGroups returns the choice elements of the MessageHeader, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *MessageHeader) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"event": {"EventCoding", "EventCanonical"},
+	}
+}
+
+// HasEvent reports whether any event[x] variant of the
+// MessageHeader is set.
+func (r *MessageHeader) HasEvent() bool {
+	return r.EventCoding != nil || r.EventCanonical != nil
+}
+
+// ValidateReferences checks that every populated reference in the MessageHeader,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *MessageHeader) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -157,9 +217,9 @@ func (r *MessageHeader) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -433,6 +493,12 @@ type MessageHeaderDestination struct {
 	Receiver *Reference `json:"receiver,omitempty"`
 }
 
+// HasEndpoint reports whether any endpoint[x] variant of the
+// MessageHeaderDestination is set.
+func (b *MessageHeaderDestination) HasEndpoint() bool {
+	return b.EndpointUrl != nil || b.EndpointReference != nil
+}
+
 // MarshalXML serializes MessageHeaderDestination to FHIR-conformant XML.
 func (b MessageHeaderDestination) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -685,6 +751,12 @@ type MessageHeaderSource struct {
 	Contact *ContactPoint `json:"contact,omitempty"`
 }
 
+// HasEndpoint reports whether any endpoint[x] variant of the
+// MessageHeaderSource is set.
+func (b *MessageHeaderSource) HasEndpoint() bool {
+	return b.EndpointUrl != nil || b.EndpointReference != nil
+}
+
 // MarshalXML serializes MessageHeaderSource to FHIR-conformant XML.
 func (b MessageHeaderSource) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
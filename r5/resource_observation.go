@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -197,6 +196,129 @@ func (r *Observation) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *Observation) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *Observation) Performers() []Reference {
+	var refs []Reference
+	refs = append(refs, r.Performer...)
+	return refs
+}
+
+// PrimaryCode returns the resource's code element, or nil if it is unset,
+// implementing CodeHolder.
+func (r *Observation) PrimaryCode() *CodeableConcept {
+	return codeOrNil(&r.Code)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Observation) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Observation) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *Observation) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddIssuedExtension appends ext to the extensions of the issued
+// primitive (JSON "_issued"), allocating IssuedExt if needed.
+func (r *Observation) AddIssuedExtension(ext Extension) {
+	if r.IssuedExt == nil {
+		r.IssuedExt = &Element{}
+	}
+	r.IssuedExt.Extension = append(r.IssuedExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *Observation) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstCategory returns a pointer to the first category entry, or nil
+// if there is none.
+func (r *Observation) FirstCategory() *CodeableConcept {
+	if r == nil || len(r.Category) == 0 {
+		return nil
+	}
+	return &r.Category[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *Observation) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the Observation, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Observation) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"instantiates": {"InstantiatesCanonical", "InstantiatesReference"},
+		"effective":    {"EffectiveDateTime", "EffectivePeriod", "EffectiveTiming", "EffectiveInstant"},
+		"value":        {"ValueQuantity", "ValueCodeableConcept", "ValueString", "ValueBoolean", "ValueInteger", "ValueRange", "ValueRatio", "ValueSampledData", "ValueTime", "ValueDateTime", "ValuePeriod", "ValueAttachment", "ValueReference"},
+	}
+}
+
+// HasInstantiates reports whether any instantiates[x] variant of the
+// Observation is set.
+func (r *Observation) HasInstantiates() bool {
+	return r.InstantiatesCanonical != nil || r.InstantiatesReference != nil
+}
+
+// HasEffective reports whether any effective[x] variant of the
+// Observation is set.
+func (r *Observation) HasEffective() bool {
+	return r.EffectiveDateTime != nil || r.EffectivePeriod != nil || r.EffectiveTiming != nil || r.EffectiveInstant != nil
+}
+
+// HasValue reports whether any value[x] variant of the
+// Observation is set.
+func (r *Observation) HasValue() bool {
+	return r.ValueQuantity != nil || r.ValueCodeableConcept != nil || r.ValueString != nil || r.ValueBoolean != nil || r.ValueInteger != nil || r.ValueRange != nil || r.ValueRatio != nil || r.ValueSampledData != nil || r.ValueTime != nil || r.ValueDateTime != nil || r.ValuePeriod != nil || r.ValueAttachment != nil || r.ValueReference != nil
+}
+
+// ValidateReferences checks that every populated reference in the Observation,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Observation) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -237,9 +359,9 @@ func (r *Observation) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -885,6 +1007,12 @@ type ObservationComponent struct {
 	ReferenceRange []ObservationReferenceRange `json:"referenceRange,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// ObservationComponent is set.
+func (b *ObservationComponent) HasValue() bool {
+	return b.ValueQuantity != nil || b.ValueCodeableConcept != nil || b.ValueString != nil || b.ValueBoolean != nil || b.ValueInteger != nil || b.ValueRange != nil || b.ValueRatio != nil || b.ValueSampledData != nil || b.ValueTime != nil || b.ValueDateTime != nil || b.ValuePeriod != nil || b.ValueAttachment != nil || b.ValueReference != nil
+}
+
 // MarshalXML serializes ObservationComponent to FHIR-conformant XML.
 func (b ObservationComponent) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -60,6 +60,40 @@ func (r *Parameters) SetMeta(m *Meta) {
 	r.Meta = m
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Parameters) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Parameters) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// ChoiceGroups returns the choice elements of the Parameters, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Parameters) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the Parameters,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Parameters) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -332,6 +366,12 @@ type ParametersParameter struct {
 	Part []ParametersParameter `json:"part,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// ParametersParameter is set.
+func (b *ParametersParameter) HasValue() bool {
+	return b.ValueBase64Binary != nil || b.ValueBoolean != nil || b.ValueCanonical != nil || b.ValueCode != nil || b.ValueDate != nil || b.ValueDateTime != nil || b.ValueDecimal != nil || b.ValueId != nil || b.ValueInstant != nil || b.ValueInteger != nil || b.ValueInteger64 != nil || b.ValueMarkdown != nil || b.ValueOid != nil || b.ValuePositiveInt != nil || b.ValueString != nil || b.ValueTime != nil || b.ValueUnsignedInt != nil || b.ValueUri != nil || b.ValueUrl != nil || b.ValueUuid != nil || b.ValueAddress != nil || b.ValueAge != nil || b.ValueAnnotation != nil || b.ValueAttachment != nil || b.ValueCodeableConcept != nil || b.ValueCodeableReference != nil || b.ValueCoding != nil || b.ValueContactPoint != nil || b.ValueCount != nil || b.ValueDistance != nil || b.ValueDuration != nil || b.ValueHumanName != nil || b.ValueIdentifier != nil || b.ValueMoney != nil || b.ValuePeriod != nil || b.ValueQuantity != nil || b.ValueRange != nil || b.ValueRatio != nil || b.ValueRatioRange != nil || b.ValueReference != nil || b.ValueSampledData != nil || b.ValueSignature != nil || b.ValueTiming != nil || b.ValueContactDetail != nil || b.ValueDataRequirement != nil || b.ValueExpression != nil || b.ValueParameterDefinition != nil || b.ValueRelatedArtifact != nil || b.ValueTriggerDefinition != nil || b.ValueUsageContext != nil || b.ValueAvailability != nil || b.ValueExtendedContactDetail != nil || b.ValueDosage != nil || b.ValueMeta != nil
+}
+
 // UnmarshalJSON handles deserialization of polymorphic resource field.
 func (b *ParametersParameter) UnmarshalJSON(data []byte) error {
 	// Use an alias to avoid infinite recursion
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -171,6 +170,156 @@ func (r *PaymentReconciliation) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *PaymentReconciliation) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *PaymentReconciliation) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *PaymentReconciliation) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *PaymentReconciliation) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddCreatedExtension appends ext to the extensions of the created
+// primitive (JSON "_created"), allocating CreatedExt if needed.
+func (r *PaymentReconciliation) AddCreatedExtension(ext Extension) {
+	if r.CreatedExt == nil {
+		r.CreatedExt = &Element{}
+	}
+	r.CreatedExt.Extension = append(r.CreatedExt.Extension, ext)
+}
+
+// AddOutcomeExtension appends ext to the extensions of the outcome
+// primitive (JSON "_outcome"), allocating OutcomeExt if needed.
+func (r *PaymentReconciliation) AddOutcomeExtension(ext Extension) {
+	if r.OutcomeExt == nil {
+		r.OutcomeExt = &Element{}
+	}
+	r.OutcomeExt.Extension = append(r.OutcomeExt.Extension, ext)
+}
+
+// AddDispositionExtension appends ext to the extensions of the disposition
+// primitive (JSON "_disposition"), allocating DispositionExt if needed.
+func (r *PaymentReconciliation) AddDispositionExtension(ext Extension) {
+	if r.DispositionExt == nil {
+		r.DispositionExt = &Element{}
+	}
+	r.DispositionExt.Extension = append(r.DispositionExt.Extension, ext)
+}
+
+// AddDateExtension appends ext to the extensions of the date
+// primitive (JSON "_date"), allocating DateExt if needed.
+func (r *PaymentReconciliation) AddDateExtension(ext Extension) {
+	if r.DateExt == nil {
+		r.DateExt = &Element{}
+	}
+	r.DateExt.Extension = append(r.DateExt.Extension, ext)
+}
+
+// AddCardBrandExtension appends ext to the extensions of the cardBrand
+// primitive (JSON "_cardBrand"), allocating CardBrandExt if needed.
+func (r *PaymentReconciliation) AddCardBrandExtension(ext Extension) {
+	if r.CardBrandExt == nil {
+		r.CardBrandExt = &Element{}
+	}
+	r.CardBrandExt.Extension = append(r.CardBrandExt.Extension, ext)
+}
+
+// AddAccountNumberExtension appends ext to the extensions of the accountNumber
+// primitive (JSON "_accountNumber"), allocating AccountNumberExt if needed.
+func (r *PaymentReconciliation) AddAccountNumberExtension(ext Extension) {
+	if r.AccountNumberExt == nil {
+		r.AccountNumberExt = &Element{}
+	}
+	r.AccountNumberExt.Extension = append(r.AccountNumberExt.Extension, ext)
+}
+
+// AddExpirationDateExtension appends ext to the extensions of the expirationDate
+// primitive (JSON "_expirationDate"), allocating ExpirationDateExt if needed.
+func (r *PaymentReconciliation) AddExpirationDateExtension(ext Extension) {
+	if r.ExpirationDateExt == nil {
+		r.ExpirationDateExt = &Element{}
+	}
+	r.ExpirationDateExt.Extension = append(r.ExpirationDateExt.Extension, ext)
+}
+
+// AddProcessorExtension appends ext to the extensions of the processor
+// primitive (JSON "_processor"), allocating ProcessorExt if needed.
+func (r *PaymentReconciliation) AddProcessorExtension(ext Extension) {
+	if r.ProcessorExt == nil {
+		r.ProcessorExt = &Element{}
+	}
+	r.ProcessorExt.Extension = append(r.ProcessorExt.Extension, ext)
+}
+
+// AddReferenceNumberExtension appends ext to the extensions of the referenceNumber
+// primitive (JSON "_referenceNumber"), allocating ReferenceNumberExt if needed.
+func (r *PaymentReconciliation) AddReferenceNumberExtension(ext Extension) {
+	if r.ReferenceNumberExt == nil {
+		r.ReferenceNumberExt = &Element{}
+	}
+	r.ReferenceNumberExt.Extension = append(r.ReferenceNumberExt.Extension, ext)
+}
+
+// AddAuthorizationExtension appends ext to the extensions of the authorization
+// primitive (JSON "_authorization"), allocating AuthorizationExt if needed.
+func (r *PaymentReconciliation) AddAuthorizationExtension(ext Extension) {
+	if r.AuthorizationExt == nil {
+		r.AuthorizationExt = &Element{}
+	}
+	r.AuthorizationExt.Extension = append(r.AuthorizationExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *PaymentReconciliation) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the PaymentReconciliation, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *PaymentReconciliation) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the PaymentReconciliation,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *PaymentReconciliation) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -211,9 +360,9 @@ func (r *PaymentReconciliation) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -690,6 +839,12 @@ type PaymentReconciliationAllocation struct {
 	Amount *Money `json:"amount,omitempty"`
 }
 
+// HasTargetItem reports whether any targetItem[x] variant of the
+// PaymentReconciliationAllocation is set.
+func (b *PaymentReconciliationAllocation) HasTargetItem() bool {
+	return b.TargetItemString != nil || b.TargetItemIdentifier != nil || b.TargetItemPositiveInt != nil
+}
+
 // MarshalXML serializes PaymentReconciliationAllocation to FHIR-conformant XML.
 func (b PaymentReconciliationAllocation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -129,6 +128,111 @@ func (r *Person) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Person) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Person) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddActiveExtension appends ext to the extensions of the active
+// primitive (JSON "_active"), allocating ActiveExt if needed.
+func (r *Person) AddActiveExtension(ext Extension) {
+	if r.ActiveExt == nil {
+		r.ActiveExt = &Element{}
+	}
+	r.ActiveExt.Extension = append(r.ActiveExt.Extension, ext)
+}
+
+// AddGenderExtension appends ext to the extensions of the gender
+// primitive (JSON "_gender"), allocating GenderExt if needed.
+func (r *Person) AddGenderExtension(ext Extension) {
+	if r.GenderExt == nil {
+		r.GenderExt = &Element{}
+	}
+	r.GenderExt.Extension = append(r.GenderExt.Extension, ext)
+}
+
+// AddBirthDateExtension appends ext to the extensions of the birthDate
+// primitive (JSON "_birthDate"), allocating BirthDateExt if needed.
+func (r *Person) AddBirthDateExtension(ext Extension) {
+	if r.BirthDateExt == nil {
+		r.BirthDateExt = &Element{}
+	}
+	r.BirthDateExt.Extension = append(r.BirthDateExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *Person) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstName returns a pointer to the first name entry, or nil
+// if there is none.
+func (r *Person) FirstName() *HumanName {
+	if r == nil || len(r.Name) == 0 {
+		return nil
+	}
+	return &r.Name[0]
+}
+
+// FirstTelecom returns a pointer to the first telecom entry, or nil
+// if there is none.
+func (r *Person) FirstTelecom() *ContactPoint {
+	if r == nil || len(r.Telecom) == 0 {
+		return nil
+	}
+	return &r.Telecom[0]
+}
+
+// FirstAddress returns a pointer to the first address entry, or nil
+// if there is none.
+func (r *Person) FirstAddress() *Address {
+	if r == nil || len(r.Address) == 0 {
+		return nil
+	}
+	return &r.Address[0]
+}
+
+// ChoiceGroups returns the choice elements of the Person, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Person) ChoiceGroups() map[string][]string {
+	return map[string][]string{
+		"deceased": {"DeceasedBoolean", "DeceasedDateTime"},
+	}
+}
+
+// HasDeceased reports whether any deceased[x] variant of the
+// Person is set.
+func (r *Person) HasDeceased() bool {
+	return r.DeceasedBoolean != nil || r.DeceasedDateTime != nil
+}
+
+// ValidateReferences checks that every populated reference in the Person,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Person) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -169,9 +273,9 @@ func (r *Person) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
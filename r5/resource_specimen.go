@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -129,6 +128,93 @@ func (r *Specimen) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *Specimen) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Specimen) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Specimen) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *Specimen) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddReceivedTimeExtension appends ext to the extensions of the receivedTime
+// primitive (JSON "_receivedTime"), allocating ReceivedTimeExt if needed.
+func (r *Specimen) AddReceivedTimeExtension(ext Extension) {
+	if r.ReceivedTimeExt == nil {
+		r.ReceivedTimeExt = &Element{}
+	}
+	r.ReceivedTimeExt.Extension = append(r.ReceivedTimeExt.Extension, ext)
+}
+
+// AddCombinedExtension appends ext to the extensions of the combined
+// primitive (JSON "_combined"), allocating CombinedExt if needed.
+func (r *Specimen) AddCombinedExtension(ext Extension) {
+	if r.CombinedExt == nil {
+		r.CombinedExt = &Element{}
+	}
+	r.CombinedExt.Extension = append(r.CombinedExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *Specimen) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *Specimen) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the Specimen, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Specimen) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the Specimen,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Specimen) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -169,9 +255,9 @@ func (r *Specimen) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -511,6 +597,18 @@ type SpecimenCollection struct {
 	FastingStatusDuration *Duration `json:"fastingStatusDuration,omitempty"`
 }
 
+// HasCollected reports whether any collected[x] variant of the
+// SpecimenCollection is set.
+func (b *SpecimenCollection) HasCollected() bool {
+	return b.CollectedDateTime != nil || b.CollectedPeriod != nil
+}
+
+// HasFastingStatus reports whether any fastingStatus[x] variant of the
+// SpecimenCollection is set.
+func (b *SpecimenCollection) HasFastingStatus() bool {
+	return b.FastingStatusCodeableConcept != nil || b.FastingStatusDuration != nil
+}
+
 // MarshalXML serializes SpecimenCollection to FHIR-conformant XML.
 func (b SpecimenCollection) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -928,6 +1026,12 @@ type SpecimenProcessing struct {
 	TimePeriod *Period `json:"timePeriod,omitempty"`
 }
 
+// HasTime reports whether any time[x] variant of the
+// SpecimenProcessing is set.
+func (b *SpecimenProcessing) HasTime() bool {
+	return b.TimeDateTime != nil || b.TimePeriod != nil
+}
+
 // MarshalXML serializes SpecimenProcessing to FHIR-conformant XML.
 func (b SpecimenProcessing) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
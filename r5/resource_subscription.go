@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -147,6 +146,156 @@ func (r *Subscription) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *Subscription) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Subscription) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Subscription) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddNameExtension appends ext to the extensions of the name
+// primitive (JSON "_name"), allocating NameExt if needed.
+func (r *Subscription) AddNameExtension(ext Extension) {
+	if r.NameExt == nil {
+		r.NameExt = &Element{}
+	}
+	r.NameExt.Extension = append(r.NameExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *Subscription) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddTopicExtension appends ext to the extensions of the topic
+// primitive (JSON "_topic"), allocating TopicExt if needed.
+func (r *Subscription) AddTopicExtension(ext Extension) {
+	if r.TopicExt == nil {
+		r.TopicExt = &Element{}
+	}
+	r.TopicExt.Extension = append(r.TopicExt.Extension, ext)
+}
+
+// AddEndExtension appends ext to the extensions of the end
+// primitive (JSON "_end"), allocating EndExt if needed.
+func (r *Subscription) AddEndExtension(ext Extension) {
+	if r.EndExt == nil {
+		r.EndExt = &Element{}
+	}
+	r.EndExt.Extension = append(r.EndExt.Extension, ext)
+}
+
+// AddReasonExtension appends ext to the extensions of the reason
+// primitive (JSON "_reason"), allocating ReasonExt if needed.
+func (r *Subscription) AddReasonExtension(ext Extension) {
+	if r.ReasonExt == nil {
+		r.ReasonExt = &Element{}
+	}
+	r.ReasonExt.Extension = append(r.ReasonExt.Extension, ext)
+}
+
+// AddEndpointExtension appends ext to the extensions of the endpoint
+// primitive (JSON "_endpoint"), allocating EndpointExt if needed.
+func (r *Subscription) AddEndpointExtension(ext Extension) {
+	if r.EndpointExt == nil {
+		r.EndpointExt = &Element{}
+	}
+	r.EndpointExt.Extension = append(r.EndpointExt.Extension, ext)
+}
+
+// AddHeartbeatPeriodExtension appends ext to the extensions of the heartbeatPeriod
+// primitive (JSON "_heartbeatPeriod"), allocating HeartbeatPeriodExt if needed.
+func (r *Subscription) AddHeartbeatPeriodExtension(ext Extension) {
+	if r.HeartbeatPeriodExt == nil {
+		r.HeartbeatPeriodExt = &Element{}
+	}
+	r.HeartbeatPeriodExt.Extension = append(r.HeartbeatPeriodExt.Extension, ext)
+}
+
+// AddTimeoutExtension appends ext to the extensions of the timeout
+// primitive (JSON "_timeout"), allocating TimeoutExt if needed.
+func (r *Subscription) AddTimeoutExtension(ext Extension) {
+	if r.TimeoutExt == nil {
+		r.TimeoutExt = &Element{}
+	}
+	r.TimeoutExt.Extension = append(r.TimeoutExt.Extension, ext)
+}
+
+// AddContentTypeExtension appends ext to the extensions of the contentType
+// primitive (JSON "_contentType"), allocating ContentTypeExt if needed.
+func (r *Subscription) AddContentTypeExtension(ext Extension) {
+	if r.ContentTypeExt == nil {
+		r.ContentTypeExt = &Element{}
+	}
+	r.ContentTypeExt.Extension = append(r.ContentTypeExt.Extension, ext)
+}
+
+// AddContentExtension appends ext to the extensions of the content
+// primitive (JSON "_content"), allocating ContentExt if needed.
+func (r *Subscription) AddContentExtension(ext Extension) {
+	if r.ContentExt == nil {
+		r.ContentExt = &Element{}
+	}
+	r.ContentExt.Extension = append(r.ContentExt.Extension, ext)
+}
+
+// AddMaxCountExtension appends ext to the extensions of the maxCount
+// primitive (JSON "_maxCount"), allocating MaxCountExt if needed.
+func (r *Subscription) AddMaxCountExtension(ext Extension) {
+	if r.MaxCountExt == nil {
+		r.MaxCountExt = &Element{}
+	}
+	r.MaxCountExt.Extension = append(r.MaxCountExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *Subscription) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// ChoiceGroups returns the choice elements of the Subscription, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Subscription) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the Subscription,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Subscription) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -187,9 +336,9 @@ func (r *Subscription) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
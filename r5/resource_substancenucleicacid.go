@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -105,6 +104,58 @@ func (r *SubstanceNucleicAcid) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *SubstanceNucleicAcid) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *SubstanceNucleicAcid) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddNumberOfSubunitsExtension appends ext to the extensions of the numberOfSubunits
+// primitive (JSON "_numberOfSubunits"), allocating NumberOfSubunitsExt if needed.
+func (r *SubstanceNucleicAcid) AddNumberOfSubunitsExtension(ext Extension) {
+	if r.NumberOfSubunitsExt == nil {
+		r.NumberOfSubunitsExt = &Element{}
+	}
+	r.NumberOfSubunitsExt.Extension = append(r.NumberOfSubunitsExt.Extension, ext)
+}
+
+// AddAreaOfHybridisationExtension appends ext to the extensions of the areaOfHybridisation
+// primitive (JSON "_areaOfHybridisation"), allocating AreaOfHybridisationExt if needed.
+func (r *SubstanceNucleicAcid) AddAreaOfHybridisationExtension(ext Extension) {
+	if r.AreaOfHybridisationExt == nil {
+		r.AreaOfHybridisationExt = &Element{}
+	}
+	r.AreaOfHybridisationExt.Extension = append(r.AreaOfHybridisationExt.Extension, ext)
+}
+
+// ChoiceGroups returns the choice elements of the SubstanceNucleicAcid, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *SubstanceNucleicAcid) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the SubstanceNucleicAcid,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *SubstanceNucleicAcid) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -145,9 +196,9 @@ func (r *SubstanceNucleicAcid) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
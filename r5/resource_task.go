@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -175,6 +174,163 @@ func (r *Task) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *Task) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// Performers returns the references to the resource's performers, in
+// element order, implementing PerformerHolder.
+func (r *Task) Performers() []Reference {
+	var refs []Reference
+	for _, p := range r.Performer {
+		refs = append(refs, p.Actor)
+	}
+	return refs
+}
+
+// PrimaryCode returns the resource's code element, or nil if it is unset,
+// implementing CodeHolder.
+func (r *Task) PrimaryCode() *CodeableConcept {
+	return r.Code
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *Task) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *Task) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddInstantiatesCanonicalExtension appends ext to the extensions of the instantiatesCanonical
+// primitive (JSON "_instantiatesCanonical"), allocating InstantiatesCanonicalExt if needed.
+func (r *Task) AddInstantiatesCanonicalExtension(ext Extension) {
+	if r.InstantiatesCanonicalExt == nil {
+		r.InstantiatesCanonicalExt = &Element{}
+	}
+	r.InstantiatesCanonicalExt.Extension = append(r.InstantiatesCanonicalExt.Extension, ext)
+}
+
+// AddInstantiatesUriExtension appends ext to the extensions of the instantiatesUri
+// primitive (JSON "_instantiatesUri"), allocating InstantiatesUriExt if needed.
+func (r *Task) AddInstantiatesUriExtension(ext Extension) {
+	if r.InstantiatesUriExt == nil {
+		r.InstantiatesUriExt = &Element{}
+	}
+	r.InstantiatesUriExt.Extension = append(r.InstantiatesUriExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *Task) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddIntentExtension appends ext to the extensions of the intent
+// primitive (JSON "_intent"), allocating IntentExt if needed.
+func (r *Task) AddIntentExtension(ext Extension) {
+	if r.IntentExt == nil {
+		r.IntentExt = &Element{}
+	}
+	r.IntentExt.Extension = append(r.IntentExt.Extension, ext)
+}
+
+// AddPriorityExtension appends ext to the extensions of the priority
+// primitive (JSON "_priority"), allocating PriorityExt if needed.
+func (r *Task) AddPriorityExtension(ext Extension) {
+	if r.PriorityExt == nil {
+		r.PriorityExt = &Element{}
+	}
+	r.PriorityExt.Extension = append(r.PriorityExt.Extension, ext)
+}
+
+// AddDoNotPerformExtension appends ext to the extensions of the doNotPerform
+// primitive (JSON "_doNotPerform"), allocating DoNotPerformExt if needed.
+func (r *Task) AddDoNotPerformExtension(ext Extension) {
+	if r.DoNotPerformExt == nil {
+		r.DoNotPerformExt = &Element{}
+	}
+	r.DoNotPerformExt.Extension = append(r.DoNotPerformExt.Extension, ext)
+}
+
+// AddDescriptionExtension appends ext to the extensions of the description
+// primitive (JSON "_description"), allocating DescriptionExt if needed.
+func (r *Task) AddDescriptionExtension(ext Extension) {
+	if r.DescriptionExt == nil {
+		r.DescriptionExt = &Element{}
+	}
+	r.DescriptionExt.Extension = append(r.DescriptionExt.Extension, ext)
+}
+
+// AddAuthoredOnExtension appends ext to the extensions of the authoredOn
+// primitive (JSON "_authoredOn"), allocating AuthoredOnExt if needed.
+func (r *Task) AddAuthoredOnExtension(ext Extension) {
+	if r.AuthoredOnExt == nil {
+		r.AuthoredOnExt = &Element{}
+	}
+	r.AuthoredOnExt.Extension = append(r.AuthoredOnExt.Extension, ext)
+}
+
+// AddLastModifiedExtension appends ext to the extensions of the lastModified
+// primitive (JSON "_lastModified"), allocating LastModifiedExt if needed.
+func (r *Task) AddLastModifiedExtension(ext Extension) {
+	if r.LastModifiedExt == nil {
+		r.LastModifiedExt = &Element{}
+	}
+	r.LastModifiedExt.Extension = append(r.LastModifiedExt.Extension, ext)
+}
+
+// FirstIdentifier returns a pointer to the first identifier entry, or nil
+// if there is none.
+func (r *Task) FirstIdentifier() *Identifier {
+	if r == nil || len(r.Identifier) == 0 {
+		return nil
+	}
+	return &r.Identifier[0]
+}
+
+// FirstNote returns a pointer to the first note entry, or nil
+// if there is none.
+func (r *Task) FirstNote() *Annotation {
+	if r == nil || len(r.Note) == 0 {
+		return nil
+	}
+	return &r.Note[0]
+}
+
+// ChoiceGroups returns the choice elements of the Task, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *Task) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the Task,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *Task) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -215,9 +371,9 @@ func (r *Task) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -864,6 +1020,12 @@ type TaskInput struct {
 	ValueMeta *Meta `json:"valueMeta,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// TaskInput is set.
+func (b *TaskInput) HasValue() bool {
+	return b.ValueBase64Binary != nil || b.ValueBoolean != nil || b.ValueCanonical != nil || b.ValueCode != nil || b.ValueDate != nil || b.ValueDateTime != nil || b.ValueDecimal != nil || b.ValueId != nil || b.ValueInstant != nil || b.ValueInteger != nil || b.ValueInteger64 != nil || b.ValueMarkdown != nil || b.ValueOid != nil || b.ValuePositiveInt != nil || b.ValueString != nil || b.ValueTime != nil || b.ValueUnsignedInt != nil || b.ValueUri != nil || b.ValueUrl != nil || b.ValueUuid != nil || b.ValueAddress != nil || b.ValueAge != nil || b.ValueAnnotation != nil || b.ValueAttachment != nil || b.ValueCodeableConcept != nil || b.ValueCodeableReference != nil || b.ValueCoding != nil || b.ValueContactPoint != nil || b.ValueCount != nil || b.ValueDistance != nil || b.ValueDuration != nil || b.ValueHumanName != nil || b.ValueIdentifier != nil || b.ValueMoney != nil || b.ValuePeriod != nil || b.ValueQuantity != nil || b.ValueRange != nil || b.ValueRatio != nil || b.ValueRatioRange != nil || b.ValueReference != nil || b.ValueSampledData != nil || b.ValueSignature != nil || b.ValueTiming != nil || b.ValueContactDetail != nil || b.ValueDataRequirement != nil || b.ValueExpression != nil || b.ValueParameterDefinition != nil || b.ValueRelatedArtifact != nil || b.ValueTriggerDefinition != nil || b.ValueUsageContext != nil || b.ValueAvailability != nil || b.ValueExtendedContactDetail != nil || b.ValueDosage != nil || b.ValueMeta != nil
+}
+
 // MarshalXML serializes TaskInput to FHIR-conformant XML.
 func (b TaskInput) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -1652,6 +1814,12 @@ type TaskOutput struct {
 	ValueMeta *Meta `json:"valueMeta,omitempty"`
 }
 
+// HasValue reports whether any value[x] variant of the
+// TaskOutput is set.
+func (b *TaskOutput) HasValue() bool {
+	return b.ValueBase64Binary != nil || b.ValueBoolean != nil || b.ValueCanonical != nil || b.ValueCode != nil || b.ValueDate != nil || b.ValueDateTime != nil || b.ValueDecimal != nil || b.ValueId != nil || b.ValueInstant != nil || b.ValueInteger != nil || b.ValueInteger64 != nil || b.ValueMarkdown != nil || b.ValueOid != nil || b.ValuePositiveInt != nil || b.ValueString != nil || b.ValueTime != nil || b.ValueUnsignedInt != nil || b.ValueUri != nil || b.ValueUrl != nil || b.ValueUuid != nil || b.ValueAddress != nil || b.ValueAge != nil || b.ValueAnnotation != nil || b.ValueAttachment != nil || b.ValueCodeableConcept != nil || b.ValueCodeableReference != nil || b.ValueCoding != nil || b.ValueContactPoint != nil || b.ValueCount != nil || b.ValueDistance != nil || b.ValueDuration != nil || b.ValueHumanName != nil || b.ValueIdentifier != nil || b.ValueMoney != nil || b.ValuePeriod != nil || b.ValueQuantity != nil || b.ValueRange != nil || b.ValueRatio != nil || b.ValueRatioRange != nil || b.ValueReference != nil || b.ValueSampledData != nil || b.ValueSignature != nil || b.ValueTiming != nil || b.ValueContactDetail != nil || b.ValueDataRequirement != nil || b.ValueExpression != nil || b.ValueParameterDefinition != nil || b.ValueRelatedArtifact != nil || b.ValueTriggerDefinition != nil || b.ValueUsageContext != nil || b.ValueAvailability != nil || b.ValueExtendedContactDetail != nil || b.ValueDosage != nil || b.ValueMeta != nil
+}
+
 // MarshalXML serializes TaskOutput to FHIR-conformant XML.
 func (b TaskOutput) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
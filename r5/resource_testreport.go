@@ -8,7 +8,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
 )
 
 // =============================================================================
@@ -129,6 +128,111 @@ func (r *TestReport) GetModifierExtension() []Extension {
 	return r.ModifierExtension
 }
 
+// GetStatus returns the resource's status code, or "" if unset.
+func (r *TestReport) GetStatus() string {
+	if r.Status == nil {
+		return ""
+	}
+	return string(*r.Status)
+}
+
+// AddImplicitRulesExtension appends ext to the extensions of the implicitRules
+// primitive (JSON "_implicitRules"), allocating ImplicitRulesExt if needed.
+func (r *TestReport) AddImplicitRulesExtension(ext Extension) {
+	if r.ImplicitRulesExt == nil {
+		r.ImplicitRulesExt = &Element{}
+	}
+	r.ImplicitRulesExt.Extension = append(r.ImplicitRulesExt.Extension, ext)
+}
+
+// AddLanguageExtension appends ext to the extensions of the language
+// primitive (JSON "_language"), allocating LanguageExt if needed.
+func (r *TestReport) AddLanguageExtension(ext Extension) {
+	if r.LanguageExt == nil {
+		r.LanguageExt = &Element{}
+	}
+	r.LanguageExt.Extension = append(r.LanguageExt.Extension, ext)
+}
+
+// AddNameExtension appends ext to the extensions of the name
+// primitive (JSON "_name"), allocating NameExt if needed.
+func (r *TestReport) AddNameExtension(ext Extension) {
+	if r.NameExt == nil {
+		r.NameExt = &Element{}
+	}
+	r.NameExt.Extension = append(r.NameExt.Extension, ext)
+}
+
+// AddStatusExtension appends ext to the extensions of the status
+// primitive (JSON "_status"), allocating StatusExt if needed.
+func (r *TestReport) AddStatusExtension(ext Extension) {
+	if r.StatusExt == nil {
+		r.StatusExt = &Element{}
+	}
+	r.StatusExt.Extension = append(r.StatusExt.Extension, ext)
+}
+
+// AddTestScriptExtension appends ext to the extensions of the testScript
+// primitive (JSON "_testScript"), allocating TestScriptExt if needed.
+func (r *TestReport) AddTestScriptExtension(ext Extension) {
+	if r.TestScriptExt == nil {
+		r.TestScriptExt = &Element{}
+	}
+	r.TestScriptExt.Extension = append(r.TestScriptExt.Extension, ext)
+}
+
+// AddResultExtension appends ext to the extensions of the result
+// primitive (JSON "_result"), allocating ResultExt if needed.
+func (r *TestReport) AddResultExtension(ext Extension) {
+	if r.ResultExt == nil {
+		r.ResultExt = &Element{}
+	}
+	r.ResultExt.Extension = append(r.ResultExt.Extension, ext)
+}
+
+// AddScoreExtension appends ext to the extensions of the score
+// primitive (JSON "_score"), allocating ScoreExt if needed.
+func (r *TestReport) AddScoreExtension(ext Extension) {
+	if r.ScoreExt == nil {
+		r.ScoreExt = &Element{}
+	}
+	r.ScoreExt.Extension = append(r.ScoreExt.Extension, ext)
+}
+
+// AddTesterExtension appends ext to the extensions of the tester
+// primitive (JSON "_tester"), allocating TesterExt if needed.
+func (r *TestReport) AddTesterExtension(ext Extension) {
+	if r.TesterExt == nil {
+		r.TesterExt = &Element{}
+	}
+	r.TesterExt.Extension = append(r.TesterExt.Extension, ext)
+}
+
+// AddIssuedExtension appends ext to the extensions of the issued
+// primitive (JSON "_issued"), allocating IssuedExt if needed.
+func (r *TestReport) AddIssuedExtension(ext Extension) {
+	if r.IssuedExt == nil {
+		r.IssuedExt = &Element{}
+	}
+	r.IssuedExt.Extension = append(r.IssuedExt.Extension, ext)
+}
+
+// ChoiceGroups returns the choice elements of the TestReport, mapping each
+// base name (e.g. "value" for value[x]) to the Go field names of its typed
+// variants in element order. It returns nil if the resource has none.
+func (r *TestReport) ChoiceGroups() map[string][]string {
+	return nil
+}
+
+// ValidateReferences checks that every populated reference in the TestReport,
+// including those of contained resources, targets a resource type allowed by
+// its element definition. See FHIRPathModelData.ReferenceTargets. References
+// in choice elements, such as medicationReference for medication[x], are not
+// checked, as the model records no targets for them.
+func (r *TestReport) ValidateReferences() error {
+	return validateReferences(r)
+}
+
 // MarshalJSON ensures resourceType is always included in JSON output.
 // HTML escaping is disabled to preserve FHIR narrative XHTML content.
 //
@@ -169,9 +273,9 @@ func (r *TestReport) UnmarshalJSON(data []byte) error {
 	if len(aux.Contained) > 0 {
 		r.Contained = make([]Resource, len(aux.Contained))
 		for i, raw := range aux.Contained {
-			resource, err := UnmarshalResource(raw)
+			resource, err := unmarshalContained(i, raw)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal contained[%d]: %w", i, err)
+				return err
 			}
 			r.Contained[i] = resource
 		}
@@ -873,6 +977,12 @@ type TestReportSetupActionAssertRequirement struct {
 	LinkCanonicalExt *Element `json:"_linkCanonical,omitempty"`
 }
 
+// HasLink reports whether any link[x] variant of the
+// TestReportSetupActionAssertRequirement is set.
+func (b *TestReportSetupActionAssertRequirement) HasLink() bool {
+	return b.LinkUri != nil || b.LinkCanonical != nil
+}
+
 // MarshalXML serializes TestReportSetupActionAssertRequirement to FHIR-conformant XML.
 func (b TestReportSetupActionAssertRequirement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	if start.Name.Local == "" {
@@ -0,0 +1,38 @@
+package r5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r5"
+)
+
+func TestStorageKey(t *testing.T) {
+	t.Run("with id", func(t *testing.T) {
+		key, err := r5.StorageKey(&r5.Patient{Id: ptrString("123")})
+		require.NoError(t, err)
+		assert.Equal(t, "Patient/123", key)
+
+		resourceType, id, err := r5.ParseStorageKey(key)
+		require.NoError(t, err)
+		assert.Equal(t, "Patient", resourceType)
+		assert.Equal(t, "123", id)
+	})
+
+	t.Run("without id", func(t *testing.T) {
+		_, err := r5.StorageKey(&r5.Patient{})
+		assert.EqualError(t, err, "Patient has no id")
+
+		_, err = r5.StorageKey(nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid keys", func(t *testing.T) {
+		for _, key := range []string{"", "Patient", "Patient/", "/123", "Patient/123/_history/1", "Unknown/123"} {
+			_, _, err := r5.ParseStorageKey(key)
+			assert.Error(t, err, key)
+		}
+	})
+}
@@ -0,0 +1,16 @@
+package r5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gofhir/models/r5"
+)
+
+func TestIsSummaryField(t *testing.T) {
+	assert.True(t, r5.IsSummaryField("Patient", "name"))
+	assert.True(t, r5.IsSummaryField("Observation", "status"))
+	assert.False(t, r5.IsSummaryField("Patient", "contact"))
+	assert.False(t, r5.IsSummaryField("Unknown", "id"))
+}
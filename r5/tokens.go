@@ -0,0 +1,64 @@
+package r5
+
+import "reflect"
+
+// ExtractTokens returns the token search values found in r, formatted as
+// "system|code" (or "system|value" for identifiers), for indexing.
+//
+// Tokens are collected from every Coding (including those inside
+// CodeableConcepts), every Identifier, and every code field typed with a
+// generated code enum (e.g. Observation.status), across the whole resource
+// including contained resources. An absent system yields a leading "|",
+// e.g. "|final". Entries without a code or value are skipped, and each token
+// appears once, in the order first encountered.
+func ExtractTokens(r Resource) []string {
+	var tokens []string
+	seen := make(map[string]bool)
+	add := func(system, code string) {
+		if code == "" {
+			return
+		}
+		token := system + "|" + code
+		if !seen[token] {
+			seen[token] = true
+			tokens = append(tokens, token)
+		}
+	}
+
+	_ = Walk(r, func(_ string, node any) error {
+		switch n := node.(type) {
+		case *Coding:
+			add(derefString(n.System), derefString(n.Code))
+		case *Identifier:
+			add(derefString(n.System), derefString(n.Value))
+		default:
+			if code, ok := enumCode(node); ok {
+				add("", code)
+			}
+		}
+		return nil
+	})
+	return tokens
+}
+
+// enumCode returns the code held by a pointer to a generated code enum
+// (a named string type such as *ObservationStatus).
+func enumCode(node any) (string, bool) {
+	v := reflect.ValueOf(node)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return "", false
+	}
+	e := v.Elem()
+	if e.Kind() != reflect.String || e.Type() == reflect.TypeOf("") {
+		return "", false
+	}
+	return e.String(), true
+}
+
+// derefString returns the value of s, or "" if s is nil.
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
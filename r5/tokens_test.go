@@ -0,0 +1,60 @@
+package r5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gofhir/models/r5"
+)
+
+func TestExtractTokens(t *testing.T) {
+	t.Run("observation codes and categories", func(t *testing.T) {
+		status := r5.ObservationStatusFinal
+		obs := &r5.Observation{
+			Identifier: []r5.Identifier{
+				{System: ptrString("urn:ids"), Value: ptrString("obs-1")},
+			},
+			Status: &status,
+			Category: []r5.CodeableConcept{{
+				Coding: []r5.Coding{{
+					System: ptrString("http://terminology.hl7.org/CodeSystem/observation-category"),
+					Code:   ptrString("vital-signs"),
+				}},
+			}},
+			Code: r5.CodeableConcept{
+				Coding: []r5.Coding{
+					{System: ptrString("http://loinc.org"), Code: ptrString("8867-4")},
+					{System: ptrString("http://loinc.org"), Code: ptrString("8867-4")},
+					{Code: ptrString("hr")},
+					{System: ptrString("http://loinc.org"), Display: ptrString("no code")},
+				},
+				Text: ptrString("Heart rate"),
+			},
+		}
+
+		assert.Equal(t, []string{
+			"urn:ids|obs-1",
+			"|final",
+			"http://terminology.hl7.org/CodeSystem/observation-category|vital-signs",
+			"http://loinc.org|8867-4",
+			"|hr",
+		}, r5.ExtractTokens(obs))
+	})
+
+	t.Run("includes contained resources", func(t *testing.T) {
+		patient := &r5.Patient{
+			Contained: []r5.Resource{
+				&r5.Organization{Identifier: []r5.Identifier{
+					{System: ptrString("urn:org"), Value: ptrString("acme")},
+				}},
+			},
+		}
+
+		assert.Equal(t, []string{"urn:org|acme"}, r5.ExtractTokens(patient))
+	})
+
+	t.Run("nil resource", func(t *testing.T) {
+		assert.Empty(t, r5.ExtractTokens(nil))
+	})
+}
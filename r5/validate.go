@@ -0,0 +1,123 @@
+package r5
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ValidationError describes a single problem found by Validate.
+type ValidationError struct {
+	Path    string // FHIRPath of the offending element, as used by Walk
+	Message string
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// MaxContainedDepth is the deepest nesting of contained resources Validate
+// accepts: 1 allows a resource's contained resources but no contained
+// resources within them, 2 allows one further level, and so on. Zero or a
+// negative value disables the check.
+//
+// Modify it only during initialization.
+var MaxContainedDepth = 3
+
+// Validate checks r against the cardinality rules of the StructureDefinitions:
+// every required element (minimum cardinality 1) must be present on the
+// resource and on every populated element beneath it, including contained
+// and Bundle entry resources. A choice element is present when any of its
+// typed variants is populated.
+//
+// It also reports primitive elements whose extension companion (e.g.
+// _birthDate) is present without extensions while the value is absent, as
+// FHIR requires a primitive to have a value, extensions, or both.
+//
+// Contained resources nested more than MaxContainedDepth levels deep are
+// reported at the first resource beyond the limit.
+//
+// It returns nil if r is valid, or the *ValidationError values found joined
+// with errors.Join.
+func Validate(r Resource) error {
+	if r == nil {
+		return errors.New("nil resource")
+	}
+	var errs []error
+	_ = Walk(r, func(path string, node any) error {
+		if _, ok := node.(Resource); ok && MaxContainedDepth > 0 {
+			if depth := strings.Count(path, ".contained["); depth == MaxContainedDepth+1 {
+				errs = append(errs, &ValidationError{
+					Path:    path,
+					Message: fmt.Sprintf("contained resource nesting depth %d exceeds the maximum of %d", depth, MaxContainedDepth),
+				})
+			}
+		}
+		v := reflect.ValueOf(node)
+		if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+			return nil
+		}
+		s := v.Elem()
+		for _, req := range requiredElements[s.Type().Name()] {
+			if !hasElement(s, req) {
+				errs = append(errs, &ValidationError{
+					Path:    path + "." + req.name,
+					Message: "required element is missing",
+				})
+			}
+		}
+		errs = append(errs, emptyPrimitiveErrors(path, s)...)
+		return nil
+	})
+	return errors.Join(errs...)
+}
+
+// hasElement reports whether the required element req is populated in s.
+func hasElement(s reflect.Value, req requiredElement) bool {
+	f, ok := fieldByJSONName(s, req.name)
+	if req.choice {
+		f, _, ok = choiceField(s, req.name)
+	}
+	if !ok {
+		return false
+	}
+	if f.Kind() == reflect.Slice {
+		return f.Len() > 0
+	}
+	return !f.IsZero()
+}
+
+// emptyPrimitiveErrors reports the primitives of struct s at path that have
+// neither a value nor extensions although their extension companion is set.
+func emptyPrimitiveErrors(path string, s reflect.Value) []error {
+	var errs []error
+	t := s.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name, ok := strings.CutPrefix(jsonFieldName(t.Field(i)), "_")
+		if !ok {
+			continue
+		}
+		value, _ := fieldByJSONName(s, name)
+		switch companion := s.Field(i).Interface().(type) {
+		case *Element:
+			if companion != nil && len(companion.Extension) == 0 && (!value.IsValid() || value.IsZero()) {
+				errs = append(errs, emptyPrimitiveError(path+"."+name))
+			}
+		case []Element:
+			for j := range companion {
+				if len(companion[j].Extension) == 0 && (!value.IsValid() || j >= value.Len()) {
+					errs = append(errs, emptyPrimitiveError(path+"."+name+"["+strconv.Itoa(j)+"]"))
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// emptyPrimitiveError returns the ValidationError for an empty primitive.
+func emptyPrimitiveError(path string) error {
+	return &ValidationError{Path: path, Message: "primitive element has neither a value nor extensions"}
+}
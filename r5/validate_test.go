@@ -0,0 +1,117 @@
+package r5_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gofhir/models/r5"
+)
+
+func validationPaths(t *testing.T, err error) []string {
+	t.Helper()
+	require.Error(t, err)
+	var paths []string
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var ve *r5.ValidationError
+		require.True(t, errors.As(e, &ve), "unexpected error %v", e)
+		paths = append(paths, ve.Path)
+	}
+	return paths
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		status := r5.ObservationStatusFinal
+		obs := &r5.Observation{
+			Status: &status,
+			Code:   r5.CodeableConcept{Text: ptrString("weight")},
+		}
+		assert.NoError(t, r5.Validate(obs))
+	})
+
+	t.Run("missing required elements", func(t *testing.T) {
+		obs := &r5.Observation{
+			Component: []r5.ObservationComponent{{ValueString: ptrString("n/a")}},
+		}
+		assert.Equal(t, []string{
+			"Observation.status",
+			"Observation.code",
+			"Observation.component[0].code",
+		}, validationPaths(t, r5.Validate(obs)))
+	})
+
+	t.Run("contained resources", func(t *testing.T) {
+		status := r5.ObservationStatusFinal
+		obs := &r5.Observation{
+			Status:    &status,
+			Code:      r5.CodeableConcept{Text: ptrString("weight")},
+			Contained: []r5.Resource{&r5.Bundle{Id: ptrString("b1")}},
+		}
+		assert.Equal(t, []string{"Observation.contained[#b1].type"}, validationPaths(t, r5.Validate(obs)))
+	})
+
+	t.Run("nested contained resources", func(t *testing.T) {
+		nested := func(depth int) *r5.Basic {
+			root := &r5.Basic{Code: r5.CodeableConcept{Text: ptrString("root")}}
+			parent := root
+			for i := 1; i <= depth; i++ {
+				child := &r5.Basic{
+					Id:   ptrString("c" + strconv.Itoa(i)),
+					Code: r5.CodeableConcept{Text: ptrString("child")},
+				}
+				parent.Contained = []r5.Resource{child}
+				parent = child
+			}
+			return root
+		}
+
+		assert.NoError(t, r5.Validate(nested(r5.MaxContainedDepth)))
+
+		err := r5.Validate(nested(r5.MaxContainedDepth + 2))
+		assert.Equal(t, []string{"Basic.contained[#c1].contained[#c2].contained[#c3].contained[#c4]"}, validationPaths(t, err))
+		assert.ErrorContains(t, err, "nesting depth 4 exceeds the maximum of 3")
+
+		defer func(limit int) { r5.MaxContainedDepth = limit }(r5.MaxContainedDepth)
+		r5.MaxContainedDepth = 1
+		assert.Equal(t, []string{"Basic.contained[#c1].contained[#c2]"}, validationPaths(t, r5.Validate(nested(2))))
+		r5.MaxContainedDepth = 0
+		assert.NoError(t, r5.Validate(nested(5)))
+	})
+
+	t.Run("choice element", func(t *testing.T) {
+		status := r5.ImmunizationStatusCodesCompleted
+		imm := &r5.Immunization{
+			Status:      &status,
+			VaccineCode: r5.CodeableConcept{Text: ptrString("flu")},
+			Patient:     r5.Reference{Reference: ptrString("Patient/1")},
+		}
+		assert.Equal(t, []string{"Immunization.occurrence"}, validationPaths(t, r5.Validate(imm)))
+
+		imm.OccurrenceString = ptrString("last autumn")
+		assert.NoError(t, r5.Validate(imm))
+	})
+
+	t.Run("empty primitive companion", func(t *testing.T) {
+		patient := &r5.Patient{BirthDateExt: &r5.Element{Id: ptrString("bd")}}
+		assert.Equal(t, []string{"Patient.birthDate"}, validationPaths(t, r5.Validate(patient)))
+
+		patient.BirthDate = ptrString("1970-01-01")
+		assert.NoError(t, r5.Validate(patient))
+	})
+
+	t.Run("primitive with only extensions", func(t *testing.T) {
+		patient := &r5.Patient{BirthDateExt: &r5.Element{Extension: []r5.Extension{{
+			Url:       "http://hl7.org/fhir/StructureDefinition/data-absent-reason",
+			ValueCode: ptrString("unknown"),
+		}}}}
+		assert.NoError(t, r5.Validate(patient))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Error(t, r5.Validate(nil))
+	})
+}
@@ -0,0 +1,196 @@
+package r5
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Walk traverses r depth-first in element order, calling fn for the resource
+// itself and for every populated element beneath it, including contained and
+// Bundle entry resources.
+//
+// Paths are built from JSON property names with zero-based indexes, rooted at
+// the resource type, e.g. "Observation.component[0].valueQuantity". Contained
+// resources that have an id are indexed by it instead, prefixed with "#" as
+// in local references, e.g. "Patient.contained[#org1].name". Extension
+// companions of primitives use their JSON names, e.g. "Patient._birthDate".
+//
+// node is a pointer into r (e.g. *HumanName, *string, *ObservationStatus),
+// or the Resource itself for the root and nested resources, so fn may inspect
+// or modify the element in place. Nil pointers, empty slices, and zero-valued
+// non-pointer fields are skipped.
+//
+// Walk stops at and returns the first error returned by fn.
+func Walk(r Resource, fn func(path string, node any) error) error {
+	if r == nil {
+		return nil
+	}
+	return walkResource(r.GetResourceType(), r, fn)
+}
+
+// WalkAll is like Walk but does not stop on errors: it visits every node and
+// returns all errors returned by fn, in visit order. It returns nil if fn
+// never fails.
+func WalkAll(r Resource, fn func(path string, node any) error) []error {
+	var errs []error
+	_ = Walk(r, func(path string, node any) error {
+		if err := fn(path, node); err != nil {
+			errs = append(errs, err)
+		}
+		return nil
+	})
+	return errs
+}
+
+// walkResource visits a resource and then its fields.
+func walkResource(path string, r Resource, fn func(string, any) error) error {
+	if err := fn(path, r); err != nil {
+		return err
+	}
+	v := reflect.ValueOf(r)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return nil
+	}
+	return walkFields(path, v.Elem(), fn)
+}
+
+// walkFields visits every exported field of the struct value v.
+func walkFields(path string, v reflect.Value, fn func(string, any) error) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name := jsonFieldName(sf)
+		if name == "" || (name == "resourceType" && sf.Type.Kind() == reflect.String) {
+			continue
+		}
+		if err := walkValue(path+"."+name, v.Field(i), fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkValue visits a single field value or slice entry.
+func walkValue(path string, v reflect.Value, fn func(string, any) error) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		if err := fn(path, v.Interface()); err != nil {
+			return err
+		}
+		if isCompositeStruct(v.Type().Elem()) {
+			return walkFields(path, v.Elem(), fn)
+		}
+		return nil
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		if r, ok := v.Interface().(Resource); ok {
+			return walkResource(path, r, fn)
+		}
+		return nil
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			index := strconv.Itoa(i)
+			if id := resourceID(v.Index(i)); id != "" {
+				index = "#" + id
+			}
+			if err := walkValue(path+"["+index+"]", v.Index(i), fn); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		// Non-pointer values (required datatypes, Extension.url, slice
+		// entries) are reported by address so fn sees the same pointer
+		// types as for optional fields.
+		if v.IsZero() || !v.CanAddr() {
+			return nil
+		}
+		return walkValue(path, v.Addr(), fn)
+	}
+}
+
+// resourceID returns the id of the resource held by the interface value v,
+// or "" if v holds no resource or the resource has no id.
+func resourceID(v reflect.Value) string {
+	if v.Kind() != reflect.Interface || v.IsNil() {
+		return ""
+	}
+	r, ok := v.Interface().(Resource)
+	if !ok || r.GetId() == nil {
+		return ""
+	}
+	return *r.GetId()
+}
+
+// jsonFieldName returns the JSON property name of a struct field.
+func jsonFieldName(sf reflect.StructField) string {
+	name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+	return name
+}
+
+// PopulatedPaths returns the path of every populated element of r, as
+// visited by Walk, with list indexes removed and duplicates dropped, e.g.
+// "Patient.name", "Patient.name.given". Paths appear in first-visit order and
+// do not include r itself. Counting the paths over a corpus of resources
+// gives a field-usage histogram.
+func PopulatedPaths(r Resource) []string {
+	var paths []string
+	seen := make(map[string]bool)
+	_ = Walk(r, func(path string, _ any) error {
+		p := pathIndexRe.ReplaceAllString(path, "")
+		if !seen[p] && strings.Contains(p, ".") {
+			seen[p] = true
+			paths = append(paths, p)
+		}
+		return nil
+	})
+	return paths
+}
+
+// emptyElements are the top-level elements IsEmpty ignores.
+var emptyElements = map[string]bool{
+	"id":   true,
+	"meta": true,
+}
+
+// errNotEmpty stops the Walk in IsEmpty at the first data element.
+var errNotEmpty = errors.New("resource is not empty")
+
+// IsEmpty reports whether r carries no data beyond its identity: every
+// populated top-level element is id or meta. Any other element counts as
+// data, including implicitRules, language, text, contained, extension and
+// modifierExtension. A nil resource is empty.
+//
+// Elements are judged as Walk visits them, so a non-nil pointer to an empty
+// datatype (e.g. MaritalStatus: &CodeableConcept{}) counts as data; Prune
+// the resource first to discount those.
+func IsEmpty(r Resource) bool {
+	if r == nil {
+		return true
+	}
+	root := r.GetResourceType()
+	err := Walk(r, func(path string, _ any) error {
+		if path == root {
+			return nil
+		}
+		name := strings.TrimPrefix(path, root+".")
+		if i := strings.IndexAny(name, ".["); i >= 0 {
+			name = name[:i]
+		}
+		if !emptyElements[name] {
+			return errNotEmpty
+		}
+		return nil
+	})
+	return err == nil
+}